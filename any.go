@@ -0,0 +1,235 @@
+package easyjson
+
+import (
+	"fmt"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+// AnyKind is the kind of the JSON value held by an Any.
+type AnyKind int
+
+const (
+	// AnyUndefined is the kind of the zero Any, e.g. of a field whose member is missing.
+	AnyUndefined AnyKind = iota
+	AnyNull
+	AnyBool
+	AnyNumber
+	AnyString
+	AnyArray
+	AnyObject
+)
+
+var anyKindNames = [...]string{"undefined", "null", "bool", "number", "string", "array", "object"}
+
+func (k AnyKind) String() string {
+	if k < 0 || int(k) >= len(anyKindNames) {
+		return fmt.Sprintf("AnyKind(%d)", int(k))
+	}
+	return anyKindNames[k]
+}
+
+// Any is a JSON value of any kind kept as its raw bytes, a faster alternative to interface{}
+// fields for schemaless data: unmarshaling only copies the value and records its kind, and
+// marshaling writes the bytes back as is. The typed views decode the value when they are called,
+// and the members and elements returned by Obj and Arr refer to the bytes of the Any.
+//
+// Generated unmarshalers set Any fields to null for null members, as opposed to undefined for
+// missing ones, and the undefined fields are left out with 'omitempty'.
+type Any struct {
+	kind AnyKind
+	raw  []byte
+}
+
+// NewAny returns an Any holding the JSON value raw, which is neither copied nor validated.
+func NewAny(raw []byte) Any {
+	return Any{kind: anyKind(raw), raw: raw}
+}
+
+// NullAny returns an Any holding null.
+func NullAny() Any {
+	return Any{kind: AnyNull, raw: nullBytes}
+}
+
+// anyKind returns the kind of the JSON value raw by its first byte.
+func anyKind(raw []byte) AnyKind {
+	if len(raw) == 0 {
+		return AnyUndefined
+	}
+	switch raw[0] {
+	case 'n':
+		return AnyNull
+	case 't', 'f':
+		return AnyBool
+	case '"':
+		return AnyString
+	case '[':
+		return AnyArray
+	case '{':
+		return AnyObject
+	}
+	return AnyNumber
+}
+
+// Kind returns the kind of the value.
+func (v Any) Kind() AnyKind {
+	return v.kind
+}
+
+// Raw returns the bytes of the value, nil if it is undefined.
+func (v Any) Raw() []byte {
+	return v.raw
+}
+
+// IsDefined is required for integration with omitempty easyjson logic.
+func (v Any) IsDefined() bool {
+	return v.kind != AnyUndefined
+}
+
+// lexer returns a lexer of the value if it is of kind k.
+func (v Any) lexer(k AnyKind) (*jlexer.Lexer, error) {
+	if v.kind != k {
+		return nil, fmt.Errorf("easyjson: Any holds %v, not %v", v.kind, k)
+	}
+	return &jlexer.Lexer{Data: v.raw}, nil
+}
+
+// Str returns the value if it is a string.
+func (v Any) Str() (string, error) {
+	l, err := v.lexer(AnyString)
+	if err != nil {
+		return "", err
+	}
+	s := l.String()
+	return s, l.Error()
+}
+
+// Int returns the value if it is a number fitting an int64.
+func (v Any) Int() (int64, error) {
+	l, err := v.lexer(AnyNumber)
+	if err != nil {
+		return 0, err
+	}
+	n := l.Int64()
+	return n, l.Error()
+}
+
+// Float returns the value if it is a number.
+func (v Any) Float() (float64, error) {
+	l, err := v.lexer(AnyNumber)
+	if err != nil {
+		return 0, err
+	}
+	f := l.Float64()
+	return f, l.Error()
+}
+
+// Bool returns the value if it is a bool.
+func (v Any) Bool() (bool, error) {
+	l, err := v.lexer(AnyBool)
+	if err != nil {
+		return false, err
+	}
+	b := l.Bool()
+	return b, l.Error()
+}
+
+// Obj returns the members of the value if it is an object, without decoding them.
+func (v Any) Obj() (map[string]Any, error) {
+	l, err := v.lexer(AnyObject)
+	if err != nil {
+		return nil, err
+	}
+	ret := make(map[string]Any)
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.String()
+		l.WantColon()
+		ret[key] = NewAny(l.Raw())
+		l.WantComma()
+	}
+	l.Delim('}')
+	return ret, l.Error()
+}
+
+// Arr returns the elements of the value if it is an array, without decoding them.
+func (v Any) Arr() ([]Any, error) {
+	l, err := v.lexer(AnyArray)
+	if err != nil {
+		return nil, err
+	}
+	ret := []Any{}
+	l.Delim('[')
+	for !l.IsDelim(']') {
+		ret = append(ret, NewAny(l.Raw()))
+		l.WantComma()
+	}
+	l.Delim(']')
+	return ret, l.Error()
+}
+
+// Interface returns the value decoded like encoding/json decodes into interface{}, nil if it is
+// undefined.
+func (v Any) Interface() (interface{}, error) {
+	if v.kind == AnyUndefined {
+		return nil, nil
+	}
+	l := jlexer.Lexer{Data: v.raw}
+	ret := l.Interface()
+	return ret, l.Error()
+}
+
+// Decode unmarshals the value into u.
+func (v Any) Decode(u Unmarshaler) error {
+	if v.kind == AnyUndefined {
+		return fmt.Errorf("easyjson: Decode of undefined Any")
+	}
+	return Unmarshal(v.raw, u)
+}
+
+// MarshalEasyJSON does JSON marshaling using easyjson interface. Undefined values are written as
+// null.
+func (v Any) MarshalEasyJSON(w *jwriter.Writer) {
+	if v.kind == AnyUndefined {
+		w.RawString("null")
+	} else {
+		w.Raw(v.raw, nil)
+	}
+}
+
+// UnmarshalEasyJSON does JSON unmarshaling using easyjson interface.
+func (v *Any) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	if l.IsNull() {
+		l.Skip()
+		*v = NullAny()
+		return
+	}
+	raw := l.Raw()
+	if !l.Ok() {
+		return
+	}
+	*v = NewAny(append([]byte(nil), raw...))
+}
+
+// ValidateEasyJSON supports easyjson.Validator interface.
+func (*Any) ValidateEasyJSON(l *jlexer.Lexer) error {
+	l.SkipRecursive()
+	return l.Error()
+}
+
+// MarshalJSON implements encoding/json.Marshaler interface.
+func (v Any) MarshalJSON() ([]byte, error) {
+	if v.kind == AnyUndefined {
+		return nullBytes, nil
+	}
+	return v.raw, nil
+}
+
+// UnmarshalJSON implements encoding/json.Unmarshaler interface.
+func (v *Any) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	v.UnmarshalEasyJSON(&l)
+	l.Consumed()
+	return l.Error()
+}
@@ -0,0 +1,69 @@
+package easyjson
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestAny(t *testing.T) {
+	var v Any
+	if err := json.Unmarshal([]byte(` {"name": "ab", "n": 12, "f": 0.5, "ok": true, "list": [1, "x", null]} `), &v); err != nil {
+		t.Fatalf("json.Unmarshal() error: %v", err)
+	}
+	if v.Kind() != AnyObject {
+		t.Fatalf("Kind() = %v; want object", v.Kind())
+	}
+
+	obj, err := v.Obj()
+	if err != nil {
+		t.Fatalf("Obj() error: %v", err)
+	}
+	if s, err := obj["name"].Str(); err != nil || s != "ab" {
+		t.Errorf(`Obj()["name"].Str() = %q, %v; want "ab"`, s, err)
+	}
+	if n, err := obj["n"].Int(); err != nil || n != 12 {
+		t.Errorf(`Obj()["n"].Int() = %v, %v; want 12`, n, err)
+	}
+	if f, err := obj["f"].Float(); err != nil || f != 0.5 {
+		t.Errorf(`Obj()["f"].Float() = %v, %v; want 0.5`, f, err)
+	}
+	if b, err := obj["ok"].Bool(); err != nil || !b {
+		t.Errorf(`Obj()["ok"].Bool() = %v, %v; want true`, b, err)
+	}
+	if _, err := obj["name"].Int(); err == nil {
+		t.Error(`Obj()["name"].Int() succeeded; want error`)
+	}
+	if _, ok := obj["missing"]; ok || obj["missing"].IsDefined() {
+		t.Error(`Obj()["missing"] is defined`)
+	}
+
+	list, err := obj["list"].Arr()
+	if err != nil || len(list) != 3 {
+		t.Fatalf("Arr() = %v, %v; want 3 elements", list, err)
+	}
+	var kinds []AnyKind
+	for _, e := range list {
+		kinds = append(kinds, e.Kind())
+	}
+	if want := []AnyKind{AnyNumber, AnyString, AnyNull}; !reflect.DeepEqual(kinds, want) {
+		t.Errorf("kinds of Arr() = %v; want %v", kinds, want)
+	}
+	if i, err := obj["list"].Interface(); err != nil || !reflect.DeepEqual(i, []interface{}{1.0, "x", nil}) {
+		t.Errorf("Interface() = %#v, %v", i, err)
+	}
+
+	data, err := json.Marshal(v)
+	if want := `{"name":"ab","n":12,"f":0.5,"ok":true,"list":[1,"x",null]}`; err != nil || string(data) != want {
+		t.Errorf("json.Marshal() = %s, %v; want %s", data, err, want)
+	}
+	if data, err := Marshal(Any{}); err != nil || string(data) != "null" {
+		t.Errorf("Marshal() of the zero Any = %s, %v; want null", data, err)
+	}
+
+	for _, data := range []string{`{"a":}`, `[1,`, ``} {
+		if err := Unmarshal([]byte(data), &v); err == nil {
+			t.Errorf("Unmarshal(%s) succeeded; want error", data)
+		}
+	}
+}
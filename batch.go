@@ -0,0 +1,56 @@
+package easyjson
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/mailru/easyjson/jlexer"
+)
+
+// UnmarshalBatch decodes each of the docs into a new value created by factory, with up to
+// workers goroutines decoding at a time, or GOMAXPROCS of them if workers is not positive.
+// The values and the errors are returned in the order of docs. The value of a document that
+// failed to decode is returned as well, possibly partially filled. errs is nil if all of the
+// documents are decoded.
+func UnmarshalBatch(docs [][]byte, factory func() Unmarshaler, workers int) (values []Unmarshaler, errs []error) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > len(docs) {
+		workers = len(docs)
+	}
+
+	values = make([]Unmarshaler, len(docs))
+	errs = make([]error, len(docs))
+	var failed int32
+	var next int64 = -1
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+
+			// The lexer is reused for all documents decoded by the worker.
+			var l jlexer.Lexer
+			for {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= len(docs) {
+					return
+				}
+				l = jlexer.Lexer{Data: docs[i]}
+				values[i] = factory()
+				if errs[i] = unmarshal(&l, values[i]); errs[i] != nil {
+					atomic.StoreInt32(&failed, 1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if failed == 0 {
+		errs = nil
+	}
+	return values, errs
+}
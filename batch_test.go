@@ -0,0 +1,47 @@
+package easyjson
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestUnmarshalBatch(t *testing.T) {
+	var docs [][]byte
+	for i := 0; i < 100; i++ {
+		docs = append(docs, []byte(fmt.Sprintf(`{"id":%d}`, i)))
+	}
+	factory := func() Unmarshaler { return new(RawMessage) }
+
+	for _, workers := range []int{0, 1, 7, 1000} {
+		values, errs := UnmarshalBatch(docs, factory, workers)
+		if errs != nil {
+			t.Errorf("UnmarshalBatch(%d workers) errors: %v", workers, errs)
+		}
+		if len(values) != len(docs) {
+			t.Fatalf("UnmarshalBatch(%d workers) returned %d values; want %d", workers, len(values), len(docs))
+		}
+		for i, v := range values {
+			if got := string(*v.(*RawMessage)); got != string(docs[i]) {
+				t.Errorf("UnmarshalBatch(%d workers) value %d = %s; want %s", workers, i, got, docs[i])
+			}
+		}
+	}
+
+	docs[3] = []byte(`{"id":`)
+	values, errs := UnmarshalBatch(docs, factory, 4)
+	if len(errs) != len(docs) || errs[3] == nil {
+		t.Fatalf("UnmarshalBatch() errors = %v; want an error for document 3", errs)
+	}
+	for i, err := range errs {
+		if i != 3 && err != nil {
+			t.Errorf("UnmarshalBatch() error for document %d: %v", i, err)
+		}
+	}
+	if values[3] == nil {
+		t.Errorf("UnmarshalBatch() did not return the value of the failed document")
+	}
+
+	if values, errs := UnmarshalBatch(nil, factory, 4); len(values) != 0 || errs != nil {
+		t.Errorf("UnmarshalBatch(nil) = %v, %v", values, errs)
+	}
+}
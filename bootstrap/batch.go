@@ -0,0 +1,107 @@
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// RunBatch generates the code of several generators, e.g. for different packages, with a
+// single bootstrapping program. The packages are built and linked into one generator instead
+// of one per Generator, which saves most of the time and memory of generating many packages.
+//
+// The generators must have the same BuildTags, GenBuildFlags, Env and CleanEnv. If generation
+// fails, none of the outputs is changed, as with Run.
+func RunBatch(ctx context.Context, gens []*Generator) error {
+	if len(gens) == 0 {
+		return nil
+	}
+	for _, g := range gens[1:] {
+		if g.BuildTags != gens[0].BuildTags || g.GenBuildFlags != gens[0].GenBuildFlags {
+			return fmt.Errorf("%v and %v can not be generated together: the build tags or flags differ", gens[0].OutName, g.OutName)
+		}
+		if g.CleanEnv != gens[0].CleanEnv || strings.Join(g.Env, "\x00") != strings.Join(gens[0].Env, "\x00") {
+			return fmt.Errorf("%v and %v can not be generated together: the environments differ", gens[0].OutName, g.OutName)
+		}
+	}
+
+	var err error
+	for _, g := range gens {
+		if g.kept, err = g.keptMethods(); err != nil {
+			return err
+		}
+	}
+
+	// The directories are locked in order, so that concurrent batches do not deadlock.
+	dirs := map[string]bool{}
+	for _, g := range gens {
+		dirs[filepath.Dir(g.OutName)] = true
+	}
+	sorted := make([]string, 0, len(dirs))
+	for dir := range dirs {
+		sorted = append(sorted, dir)
+	}
+	sort.Strings(sorted)
+	for _, dir := range sorted {
+		unlock, err := lockDir(ctx, dir)
+		if err != nil {
+			return err
+		}
+		defer unlock()
+	}
+
+	origs := make([]original, len(gens))
+	for i, g := range gens {
+		if origs[i], err = g.readOriginal(); err != nil {
+			return err
+		}
+	}
+
+	outs, err := generateBatch(ctx, gens)
+	for i, g := range gens {
+		var out []byte
+		if err == nil {
+			out = outs[i]
+		}
+		if ferr := g.finish(ctx, origs[i], out, err); ferr != nil && err == nil {
+			err = ferr
+		}
+	}
+	return err
+}
+
+// generateBatch writes the stubs of the generators, runs the ones not limited to stubs
+// together and returns the generated code of all of them.
+func generateBatch(ctx context.Context, gens []*Generator) ([][]byte, error) {
+	outs := make([][]byte, len(gens))
+	var run []*Generator
+	var runIndexes []int
+	for i, g := range gens {
+		stub, err := g.writeStub()
+		if err != nil {
+			return nil, err
+		}
+		if g.StubsOnly {
+			outs[i] = stub
+		} else {
+			run = append(run, g)
+			runIndexes = append(runIndexes, i)
+		}
+	}
+	if len(run) == 0 {
+		return outs, nil
+	}
+
+	generated, err := runGenerators(ctx, run)
+	if err != nil {
+		return nil, err
+	}
+	for i, g := range run {
+		if outs[runIndexes[i]], err = g.format(generated[i]); err != nil {
+			return nil, fmt.Errorf("%v: %v", g.OutName, err)
+		}
+	}
+	return outs, nil
+}
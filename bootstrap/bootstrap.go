@@ -6,14 +6,25 @@
 package bootstrap
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"go/ast"
 	"go/format"
+	"hash/fnv"
+	"io"
 	"io/ioutil"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"regexp"
+	"runtime"
 	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/mailru/easyjson/gen"
+	"github.com/mailru/easyjson/parser"
 )
 
 const genPackage = "github.com/mailru/easyjson/gen"
@@ -22,9 +33,28 @@ const pkgLexer = "github.com/mailru/easyjson/jlexer"
 
 var buildFlagsRegexp = regexp.MustCompile("'.+'|\".+\"|\\S+")
 
+// cleanEnvKeys are the environment variables kept with CleanEnv.
+var cleanEnvKeys = []string{
+	"PATH", "HOME", "USERPROFILE", "SYSTEMROOT", "TMPDIR", "TEMP", "TMP",
+	"APPDATA", "LOCALAPPDATA", "XDG_CACHE_HOME", "XDG_CONFIG_HOME",
+}
+
 type Generator struct {
 	PkgPath, PkgName string
 	Types            []string
+	Enums            []parser.Enum
+
+	// TypeParams are the type parameters of the generic Types. The methods of these types are
+	// generated as generic ones.
+	TypeParams map[string][]parser.TypeParam
+
+	// Docs are the doc comments of the struct types, carried over to their type info if
+	// TypeInfo is set.
+	Docs map[string]parser.TypeDoc
+
+	// Instances are the instantiations of the generic Types, e.g. Page[User], that get
+	// marshalers specialized for their type arguments.
+	Instances []string
 
 	NoStdMarshalers          bool
 	SnakeCase                bool
@@ -32,28 +62,93 @@ type Generator struct {
 	OmitEmpty                bool
 	DisallowUnknownFields    bool
 	SkipMemberNameUnescaping bool
+	StdlibCompat             bool
+	TaggedOnly               bool
+	FoldKeys                 bool
+	Decimals                 bool
+	KnownTypes               bool
+	NoSQLNull                bool
+	TypeInfo                 bool
+	GojayAdapters            bool
+	Metrics                  bool
+	Validators               bool
+	NilGuards                bool
+
+	// If SlabAlloc is positive, the elements of decoded slices of pointers are allocated
+	// in blocks of up to SlabAlloc elements.
+	SlabAlloc int
+
+	// If Standalone is set, the generated code uses a copy of the easyjson runtime written
+	// to the internal/easyjson directory of the package instead of importing easyjson.
+	Standalone bool
+
+	// If Protobuf is set, the types are expected to be generated by protoc-gen-go, with the
+	// OneofWrappers types of their oneof fields.
+	Protobuf      bool
+	OneofWrappers []string
+
+	// Methods are the marshaling methods declared by hand in the package. If ForceOverride
+	// is set, the ones conflicting with the generated methods are kept, and the generated
+	// methods of the same kind delegate to them. Otherwise, the conflicts are an error.
+	Methods       []parser.Method
+	ForceOverride bool
 
 	OutName       string
 	BuildTags     string
 	GenBuildFlags string
 
+	// Env are the environment variables, as "key=value", of the go commands run while
+	// bootstrapping, e.g. GOFLAGS, GOCACHE or GOPRIVATE. They override the environment of the
+	// process, which is left out except for the variables locating the tools, home and
+	// temporary directories, e.g. PATH and HOME, if CleanEnv is set.
+	Env      []string
+	CleanEnv bool
+
+	// Header is written at the top of the output file and may only consist of comments,
+	// Footer is appended to it.
+	Header, Footer string
+
 	StubsOnly   bool
 	LeaveTemps  bool
 	NoFormat    bool
 	SimpleBytes bool
+
+	// If Output is set, the generated code is written to it and OutName is left unchanged.
+	Output io.Writer
+	// If Diff is set, a unified diff against the existing OutName is written to Output
+	// instead of the generated code.
+	Diff bool
+
+	// If KeepNewer is set, Run fails instead of overwriting an OutName that was modified
+	// after all of the Sources, e.g. by hand or by a newer generator.
+	KeepNewer bool
+	Sources   []string
+
+	// Emits are the artifacts other than the code, e.g. a schema, written from the types in
+	// the same run. They are only written along with OutName, not to Output.
+	Emits []Emit
+
+	kept map[string][]string
+
+	// temporary files the Emits are written into by the bootstrapping program, and their
+	// contents once it is done
+	emitTemps []string
+	emitted   [][]byte
+}
+
+// Emit is an artifact written by a built-in gen.Emitter, e.g. "schema", into OutName.
+type Emit struct {
+	Emitter string
+	OutName string
 }
 
 // writeStub outputs an initial stub for marshalers/unmarshalers so that the package
 // using marshalers/unmarshales compiles correctly for boostrapping code.
-func (g *Generator) writeStub() error {
-	f, err := os.Create(g.OutName)
-	if err != nil {
-		return err
-	}
-	defer f.Close()
+func (g *Generator) writeStub() ([]byte, error) {
+	f := &bytes.Buffer{}
 
 	if g.BuildTags != "" {
-		fmt.Fprintln(f, "// +build ", g.BuildTags)
+		fmt.Fprint(f, gen.BuildConstraint(g.BuildTags))
 		fmt.Fprintln(f)
 	}
 	fmt.Fprintln(f, "// TEMPORARY AUTOGENERATED FILE: easyjson stub code to make the package")
@@ -61,7 +156,7 @@ func (g *Generator) writeStub() error {
 	fmt.Fprintln(f)
 	fmt.Fprintln(f, "package ", g.PkgName)
 
-	if len(g.Types) > 0 {
+	if len(g.Types)+len(g.Enums) > 0 && !g.Standalone {
 		fmt.Fprintln(f)
 		fmt.Fprintln(f, "import (")
 		fmt.Fprintln(f, `  "`+pkgWriter+`"`)
@@ -71,26 +166,105 @@ func (g *Generator) writeStub() error {
 
 	sort.Strings(g.Types)
 	for _, t := range g.Types {
+		g.writeStubMethods(f, t)
+	}
+	for _, e := range g.sortedEnums() {
+		g.writeStubMethods(f, e.Name)
+	}
+	if len(g.OneofWrappers)+len(g.Instances) > 0 {
 		fmt.Fprintln(f)
-		if !g.NoStdMarshalers {
-			fmt.Fprintln(f, "func (", t, ") MarshalJSON() ([]byte, error) { return nil, nil }")
-			fmt.Fprintln(f, "func (*", t, ") UnmarshalJSON([]byte) error { return nil }")
+	}
+	for _, w := range g.OneofWrappers {
+		fmt.Fprintln(f, "type EasyJSON_exporter_"+w+" *"+w)
+	}
+	for _, inst := range g.Instances {
+		fmt.Fprintln(f, "type "+instanceExporter(inst)+" *"+inst)
+	}
+	return f.Bytes(), writeFileAtomic(g.OutName, f.Bytes())
+}
+
+// writeStubMethods outputs the stub marshalers/unmarshalers of type t.
+func (g *Generator) writeStubMethods(f io.Writer, t string) {
+	recv, exporter, typ := t, "EasyJSON_exporter_"+t, t
+	if params := g.TypeParams[t]; len(params) > 0 {
+		var blanks, names, decls []string
+		for _, p := range params {
+			blanks = append(blanks, "_")
+			names = append(names, p.Name)
+			decls = append(decls, p.Name+" "+p.Constraint)
 		}
+		recv = t + "[" + strings.Join(blanks, ", ") + "]"
+		exporter += "[" + strings.Join(decls, ", ") + "]"
+		typ += "[" + strings.Join(names, ", ") + "]"
+	}
 
-		fmt.Fprintln(f, "func (", t, ") MarshalEasyJSON(w *jwriter.Writer) {}")
-		fmt.Fprintln(f, "func (*", t, ") UnmarshalEasyJSON(l *jlexer.Lexer) {}")
-		fmt.Fprintln(f)
-		fmt.Fprintln(f, "type EasyJSON_exporter_"+t+" *"+t)
+	stubs := map[string]string{
+		"MarshalJSON":       "func (" + recv + ") MarshalJSON() ([]byte, error) { return nil, nil }",
+		"UnmarshalJSON":     "func (*" + recv + ") UnmarshalJSON([]byte) error { return nil }",
+		"MarshalEasyJSON":   "func (" + recv + ") MarshalEasyJSON(w *jwriter.Writer) {}",
+		"UnmarshalEasyJSON": "func (*" + recv + ") UnmarshalEasyJSON(l *jlexer.Lexer) {}",
+		"MarshalText":       "func (" + recv + ") MarshalText() ([]byte, error) { return nil, nil }",
+		"UnmarshalText":     "func (*" + recv + ") UnmarshalText([]byte) error { return nil }",
+		"ValidateEasyJSON":  "func (*" + recv + ") ValidateEasyJSON(l *jlexer.Lexer) error { return nil }",
+	}
+
+	fmt.Fprintln(f)
+	for _, m := range g.generatedMethods(g.isEnum(t)) {
+		if !g.isKept(t, m) {
+			fmt.Fprintln(f, stubs[m])
+		}
 	}
-	return nil
+	fmt.Fprintln(f)
+	fmt.Fprintln(f, "type "+exporter+" *"+typ)
 }
 
-// writeMain creates a .go file that launches the generator if 'go run'.
-func (g *Generator) writeMain() (path string, err error) {
-	f, err := ioutil.TempFile(filepath.Dir(g.OutName), "easyjson-bootstrap")
-	if err != nil {
-		return "", err
+// instanceExporter returns the name of the type exporting the instantiation inst of a generic
+// type to the bootstrapping program, e.g. EasyJSON_exporter_Page_User_ for Page[User].
+func instanceExporter(inst string) string {
+	return "EasyJSON_exporter_" + strings.Map(func(r rune) rune {
+		if r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, strings.Replace(inst, " ", "", -1))
+}
+
+// isEnum tells if the type named t is one of the Enums.
+func (g *Generator) isEnum(t string) bool {
+	for _, e := range g.Enums {
+		if e.Name == t {
+			return true
+		}
 	}
+	return false
+}
+
+// sortedEnums returns the enums sorted by name.
+func (g *Generator) sortedEnums() []parser.Enum {
+	enums := append([]parser.Enum(nil), g.Enums...)
+	sort.Slice(enums, func(i, j int) bool { return enums[i].Name < enums[j].Name })
+	return enums
+}
+
+// mainName returns the name of the bootstrapping .go file of the generators, in the directory
+// of the first one. The name is derived from the output files, so that generators for
+// different outputs in one package do not collide.
+func mainName(gens []*Generator) string {
+	h := fnv.New32a()
+	for _, g := range gens {
+		if abs, err := filepath.Abs(g.OutName); err == nil {
+			h.Write([]byte(abs))
+		} else {
+			h.Write([]byte(g.OutName))
+		}
+	}
+	return filepath.Join(filepath.Dir(gens[0].OutName), fmt.Sprintf("easyjson-bootstrap-%08x.go", h.Sum32()))
+}
+
+// writeMain creates a .go file that launches the generators if built. The output of each
+// generator is written to the file named by the respective command line argument.
+func writeMain(gens []*Generator) (path string, err error) {
+	f := &bytes.Buffer{}
 
 	fmt.Fprintln(f, "// +build ignore")
 	fmt.Fprintln(f)
@@ -101,21 +275,84 @@ func (g *Generator) writeMain() (path string, err error) {
 	fmt.Fprintln(f)
 	fmt.Fprintln(f, "import (")
 	fmt.Fprintln(f, `  "fmt"`)
+	fmt.Fprintln(f, `  "io"`)
 	fmt.Fprintln(f, `  "os"`)
 	fmt.Fprintln(f)
 	fmt.Fprintf(f, "  %q\n", genPackage)
-	if len(g.Types) > 0 {
-		fmt.Fprintln(f)
-		fmt.Fprintf(f, "  pkg %q\n", g.PkgPath)
+	fmt.Fprintln(f)
+	for i, g := range gens {
+		if len(g.Types)+len(g.Enums)+len(g.OneofWrappers) > 0 {
+			fmt.Fprintf(f, "  pkg%d %q\n", i, g.PkgPath)
+		}
 	}
 	fmt.Fprintln(f, ")")
 	fmt.Fprintln(f)
 	fmt.Fprintln(f, "func main() {")
+	fmt.Fprint(f, "  for i, generate := range []func(io.Writer) error{")
+	for i := range gens {
+		if i > 0 {
+			fmt.Fprint(f, ", ")
+		}
+		fmt.Fprintf(f, "generate%d", i)
+	}
+	fmt.Fprintln(f, "} {")
+	fmt.Fprintln(f, "    out, err := os.Create(os.Args[i+1])")
+	fmt.Fprintln(f, "    if err == nil {")
+	fmt.Fprintln(f, "      err = generate(out)")
+	fmt.Fprintln(f, "      if cerr := out.Close(); err == nil {")
+	fmt.Fprintln(f, "        err = cerr")
+	fmt.Fprintln(f, "      }")
+	fmt.Fprintln(f, "    }")
+	fmt.Fprintln(f, "    if err != nil {")
+	fmt.Fprintln(f, "      fmt.Fprintln(os.Stderr, err)")
+	fmt.Fprintln(f, "      os.Exit(1)")
+	fmt.Fprintln(f, "    }")
+	fmt.Fprintln(f, "  }")
+	fmt.Fprintln(f, "}")
+
+	for i, g := range gens {
+		fmt.Fprintln(f)
+		g.writeGenerateFunc(f, fmt.Sprint("generate", i), fmt.Sprint("pkg", i), len(gens) > 1)
+	}
+
+	path = mainName(gens)
+	return path, writeFileAtomic(path, f.Bytes())
+}
+
+// writeGenerateFunc writes the function running the generator on the package imported as pkg.
+// If qualify is set, the errors returned are prefixed with the output name.
+func (g *Generator) writeGenerateFunc(f io.Writer, name, pkg string, qualify bool) {
+	// The generic types are instantiated with placeholder types, which the generator turns
+	// back into type parameters.
+	sort.Strings(g.Types)
+	placeholders, n := map[string][]string{}, 0
+	for _, t := range g.Types {
+		for range g.TypeParams[t] {
+			placeholders[t] = append(placeholders[t], fmt.Sprintf("%vTypeParam%d", name, n))
+			n++
+		}
+	}
+	for _, t := range g.Types {
+		for _, p := range placeholders[t] {
+			fmt.Fprintln(f, "type "+p+" struct{}")
+		}
+	}
+	if len(placeholders) > 0 {
+		fmt.Fprintln(f)
+	}
+
+	fmt.Fprintln(f, "func "+name+"(out io.Writer) error {")
 	fmt.Fprintf(f, "  g := gen.NewGenerator(%q)\n", filepath.Base(g.OutName))
 	fmt.Fprintf(f, "  g.SetPkg(%q, %q)\n", g.PkgName, g.PkgPath)
 	if g.BuildTags != "" {
 		fmt.Fprintf(f, "  g.SetBuildTags(%q)\n", g.BuildTags)
 	}
+	if g.Header != "" {
+		fmt.Fprintf(f, "  g.SetHeader(%q)\n", g.Header)
+	}
+	if g.Footer != "" {
+		fmt.Fprintf(f, "  g.SetFooter(%q)\n", g.Footer)
+	}
 	if g.SnakeCase {
 		fmt.Fprintln(f, "  g.UseSnakeCase()")
 	}
@@ -137,80 +374,472 @@ func (g *Generator) writeMain() (path string, err error) {
 	if g.SkipMemberNameUnescaping {
 		fmt.Fprintln(f, "  g.SkipMemberNameUnescaping()")
 	}
+	if g.StdlibCompat {
+		fmt.Fprintln(f, "  g.StdlibCompat()")
+	}
+	if g.TaggedOnly {
+		fmt.Fprintln(f, "  g.TaggedOnly()")
+	}
+	if g.FoldKeys {
+		fmt.Fprintln(f, "  g.FoldKeys()")
+	}
+	if g.Decimals {
+		fmt.Fprintln(f, "  g.Decimals()")
+	}
+	if g.KnownTypes {
+		fmt.Fprintln(f, "  g.KnownTypes()")
+	}
+	if g.NoSQLNull {
+		fmt.Fprintln(f, "  g.NoSQLNull()")
+	}
+	if g.TypeInfo {
+		fmt.Fprintln(f, "  g.TypeInfo()")
+	}
+	if g.GojayAdapters {
+		fmt.Fprintln(f, "  g.GojayAdapters()")
+	}
+	if g.Metrics {
+		fmt.Fprintln(f, "  g.Metrics()")
+	}
+	if g.Validators {
+		fmt.Fprintln(f, "  g.Validators()")
+	}
+	if g.NilGuards {
+		fmt.Fprintln(f, "  g.NilGuards()")
+	}
+	if g.SlabAlloc > 0 {
+		fmt.Fprintf(f, "  g.SlabAlloc(%d)\n", g.SlabAlloc)
+	}
+	if g.Standalone {
+		fmt.Fprintf(f, "  g.Standalone(%q)\n", g.runtimePath())
+	}
+	if g.Protobuf {
+		fmt.Fprintln(f, "  g.Protobuf()")
+	}
+	for _, w := range g.OneofWrappers {
+		fmt.Fprintln(f, "  g.AddOneofWrapper("+pkg+".EasyJSON_exporter_"+w+"(nil))")
+	}
+	kept := make([]string, 0, len(g.kept))
+	for t := range g.kept {
+		kept = append(kept, t)
+	}
+	sort.Strings(kept)
+	for _, t := range kept {
+		fmt.Fprintf(f, "  g.KeepMethods(%v.EasyJSON_exporter_%v(nil)", pkg, t)
+		for _, m := range g.kept[t] {
+			fmt.Fprintf(f, ", %q", m)
+		}
+		fmt.Fprintln(f, ")")
+	}
 
-	sort.Strings(g.Types)
 	for _, v := range g.Types {
-		fmt.Fprintln(f, "  g.Add(pkg.EasyJSON_exporter_"+v+"(nil))")
+		if ps := placeholders[v]; len(ps) > 0 {
+			for i, p := range ps {
+				fmt.Fprintf(f, "  g.AddTypeParam(%v{}, %q)\n", p, g.TypeParams[v][i].Constraint)
+			}
+			fmt.Fprintln(f, "  g.Add("+pkg+".EasyJSON_exporter_"+v+"["+strings.Join(ps, ", ")+"](nil))")
+		} else {
+			fmt.Fprintln(f, "  g.Add("+pkg+".EasyJSON_exporter_"+v+"(nil))")
+		}
+	}
+	for _, inst := range g.Instances {
+		fmt.Fprintln(f, "  g.AddInstance("+pkg+"."+instanceExporter(inst)+"(nil))")
+	}
+	if g.TypeInfo || len(g.Emits) > 0 {
+		g.writeDocs(f, pkg, placeholders)
+	}
+	for _, e := range g.sortedEnums() {
+		fmt.Fprintln(f, "  g.AddEnum("+pkg+".EasyJSON_exporter_"+e.Name+"(nil),")
+		for _, v := range e.Values {
+			fmt.Fprintf(f, "    gen.EnumValue{Value: %d, Name: %q},\n", v.Value, v.Name)
+		}
+		fmt.Fprintln(f, "  )")
 	}
 
-	fmt.Fprintln(f, "  if err := g.Run(os.Stdout); err != nil {")
-	fmt.Fprintln(f, "    fmt.Fprintln(os.Stderr, err)")
-	fmt.Fprintln(f, "    os.Exit(1)")
+	for i, e := range g.Emits {
+		fmt.Fprintf(f, "  e%d, err := gen.NewEmitter(%q)\n", i, e.Emitter)
+		fmt.Fprintln(f, "  if err != nil {")
+		fmt.Fprintln(f, "    return err")
+		fmt.Fprintln(f, "  }")
+		fmt.Fprintf(f, "  f%d, err := os.Create(%q)\n", i, g.emitTemps[i])
+		fmt.Fprintln(f, "  if err != nil {")
+		fmt.Fprintln(f, "    return err")
+		fmt.Fprintln(f, "  }")
+		fmt.Fprintf(f, "  defer f%d.Close()\n", i)
+		fmt.Fprintf(f, "  g.AddEmitter(e%d, f%d)\n", i, i)
+	}
+
+	fmt.Fprintln(f, "  if err := g.Run(out); err != nil {")
+	if qualify {
+		fmt.Fprintf(f, "    return fmt.Errorf(\"%%v: %%v\", %q, err)\n", g.OutName)
+	} else {
+		fmt.Fprintln(f, "    return err")
+	}
 	fmt.Fprintln(f, "  }")
+	for i := range g.Emits {
+		fmt.Fprintf(f, "  if err := f%d.Close(); err != nil {\n", i)
+		fmt.Fprintln(f, "    return err")
+		fmt.Fprintln(f, "  }")
+	}
+	fmt.Fprintln(f, "  for _, w := range g.Warnings() {")
+	fmt.Fprintln(f, `    fmt.Fprintln(os.Stderr, "easyjson: warning:", w)`)
+	fmt.Fprintln(f, "  }")
+	fmt.Fprintln(f, "  return nil")
 	fmt.Fprintln(f, "}")
+}
 
-	src := f.Name()
-	if err := f.Close(); err != nil {
-		return src, err
+// writeDocs writes the code adding the doc comments of the types with docs to the generator,
+// except for the generic and unexported types not generated.
+func (g *Generator) writeDocs(f io.Writer, pkg string, placeholders map[string][]string) {
+	generated := map[string]bool{}
+	for _, v := range g.Types {
+		generated[v] = true
 	}
+	names := make([]string, 0, len(g.Docs))
+	for name := range g.Docs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		var obj string
+		switch {
+		case len(placeholders[name]) > 0:
+			continue
+		case generated[name]:
+			obj = pkg + ".EasyJSON_exporter_" + name + "(nil)"
+		case ast.IsExported(name):
+			obj = "(*" + pkg + "." + name + ")(nil)"
+		default:
+			continue
+		}
 
-	dest := src + ".go"
-	return dest, os.Rename(src, dest)
+		doc := g.Docs[name]
+		fmt.Fprintf(f, "  g.AddDoc(%v, %q, map[string]string{", obj, doc.Doc)
+		fields := make([]string, 0, len(doc.Fields))
+		for field := range doc.Fields {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		for _, field := range fields {
+			fmt.Fprintf(f, "\n    %q: %q,", field, doc.Fields[field])
+		}
+		fmt.Fprintln(f, "})")
+	}
+}
+
+// writeFileAtomic writes data to a uniquely named temporary file next to name and renames
+// it to name, so that concurrent readers never observe a partially written file.
+func writeFileAtomic(name string, data []byte) error {
+	f, err := ioutil.TempFile(filepath.Dir(name), filepath.Base(name)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name()) // will not remove after rename
+
+	_, err = f.Write(data)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Chmod(f.Name(), 0644)
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), name)
 }
 
+// Run generates the marshalers/unmarshalers into OutName. Generation is serialized per
+// package directory with a lockfile, so it is safe to run several generators in one
+// package concurrently, e.g. with parallel 'go generate'.
+//
+// The stub written to OutName for bootstrapping is replaced with the previous contents of
+// OutName if generation fails, so a failed run never leaves a stub instead of working code.
 func (g *Generator) Run() error {
-	if err := g.writeStub(); err != nil {
+	return g.RunContext(context.Background())
+}
+
+// RunContext is like Run, but gives up when ctx is done: the generator process is killed and
+// the error returned includes what it has written to stderr so far.
+func (g *Generator) RunContext(ctx context.Context) error {
+	var err error
+	if g.kept, err = g.keptMethods(); err != nil {
 		return err
 	}
-	if g.StubsOnly {
-		return nil
+
+	unlock, err := lockDir(ctx, filepath.Dir(g.OutName))
+	if err != nil {
+		return err
 	}
+	defer unlock()
 
-	path, err := g.writeMain()
+	orig, err := g.readOriginal()
 	if err != nil {
 		return err
 	}
-	if !g.LeaveTemps {
-		defer os.Remove(path)
+	out, err := g.generate(ctx)
+	return g.finish(ctx, orig, out, err)
+}
+
+// original is the contents of OutName before a run, put back if the run fails.
+type original struct {
+	data []byte
+	info os.FileInfo // nil if OutName did not exist
+}
+
+// readOriginal reads the contents of OutName before a run, failing if KeepNewer is set
+// and OutName is newer than the sources.
+func (g *Generator) readOriginal() (original, error) {
+	info, err := os.Stat(g.OutName)
+	if err != nil && !os.IsNotExist(err) {
+		return original{}, err
 	}
+	if info == nil {
+		return original{}, nil
+	}
+
+	data, err := ioutil.ReadFile(g.OutName)
+	if err != nil {
+		return original{}, err
+	}
+	if g.KeepNewer && g.Output == nil {
+		newer, err := g.isNewer(info)
+		if err != nil {
+			return original{}, err
+		}
+		if newer {
+			return original{}, fmt.Errorf("%v is newer than its sources, not overwriting it", g.OutName)
+		}
+	}
+	return original{data: data, info: info}, nil
+}
 
-	f, err := os.Create(g.OutName + ".tmp")
+// finish completes a run that generated out or failed with err: the output is written to
+// OutName or Output, or the original contents of OutName are put back.
+func (g *Generator) finish(ctx context.Context, orig original, out []byte, err error) error {
+	if err == nil && g.Standalone && !g.StubsOnly && g.Output == nil {
+		err = g.writeRuntime(ctx)
+	}
+	if err != nil || g.Output != nil {
+		if rerr := g.restore(orig.data, orig.info); err == nil {
+			err = rerr
+		}
+	}
 	if err != nil {
 		return err
 	}
-	if !g.LeaveTemps {
-		defer os.Remove(f.Name()) // will not remove after rename
+
+	if g.Output == nil {
+		if err := writeFileAtomic(g.OutName, out); err != nil {
+			return err
+		}
+		for i, data := range g.emitted {
+			if err := writeFileAtomic(g.Emits[i].OutName, data); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if g.Diff {
+		out = unifiedDiff(g.OutName, g.OutName, orig.data, out)
 	}
+	_, err = g.Output.Write(out)
+	return err
+}
 
-	execArgs := []string{"run"}
-	if g.GenBuildFlags != "" {
-		buildFlags := buildFlagsRegexp.FindAllString(g.GenBuildFlags, -1)
-		execArgs = append(execArgs, buildFlags...)
+// restore puts the original contents and modification time back to OutName, or removes
+// OutName if it did not exist (info is nil).
+func (g *Generator) restore(orig []byte, info os.FileInfo) error {
+	if info == nil {
+		if err := os.Remove(g.OutName); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
 	}
-	execArgs = append(execArgs, "-tags", g.BuildTags, filepath.Base(path))
-	cmd := exec.Command("go", execArgs...)
 
-	cmd.Stdout = f
-	cmd.Stderr = os.Stderr
-	cmd.Dir = filepath.Dir(path)
-	if err = cmd.Run(); err != nil {
+	if err := writeFileAtomic(g.OutName, orig); err != nil {
 		return err
 	}
-	f.Close()
+	return os.Chtimes(g.OutName, info.ModTime(), info.ModTime())
+}
 
-	// move unformatted file to out path
-	if g.NoFormat {
-		return os.Rename(f.Name(), g.OutName)
+// isNewer returns true if the output file described by info was modified after all of the
+// Sources.
+func (g *Generator) isNewer(info os.FileInfo) (bool, error) {
+	for _, src := range g.Sources {
+		srcInfo, err := os.Stat(src)
+		if err != nil {
+			return false, err
+		}
+		if !info.ModTime().After(srcInfo.ModTime()) {
+			return false, nil
+		}
 	}
+	return len(g.Sources) > 0, nil
+}
 
-	// format file and write to out path
-	in, err := ioutil.ReadFile(f.Name())
+// generate writes the stub to OutName, runs the generator and returns the generated code.
+func (g *Generator) generate(ctx context.Context) ([]byte, error) {
+	stub, err := g.writeStub()
 	if err != nil {
-		return err
+		return nil, err
 	}
+	if g.StubsOnly {
+		return stub, nil
+	}
+
+	outs, err := runGenerators(ctx, []*Generator{g})
+	if err != nil {
+		return nil, err
+	}
+	return g.format(outs[0])
+}
+
+// runGenerators runs the generators with one bootstrapping program, built with the build tags
+// and flags of the first one, and returns their unformatted outputs. The stubs must have been
+// written before.
+func runGenerators(ctx context.Context, gens []*Generator) ([][]byte, error) {
+	for _, g := range gens {
+		g.emitTemps = nil
+		for range g.Emits {
+			out, err := ioutil.TempFile("", "easyjson-emit")
+			if err != nil {
+				return nil, err
+			}
+			out.Close()
+			defer os.Remove(out.Name())
+			g.emitTemps = append(g.emitTemps, out.Name())
+		}
+	}
+
+	path, err := writeMain(gens)
+	if err != nil {
+		return nil, err
+	}
+	if !gens[0].LeaveTemps {
+		defer os.Remove(path)
+	}
+
+	outNames := make([]string, len(gens))
+	for i := range gens {
+		out, err := ioutil.TempFile("", "easyjson-out")
+		if err != nil {
+			return nil, err
+		}
+		out.Close()
+		defer os.Remove(out.Name())
+		outNames[i] = out.Name()
+	}
+	stderr, err := ioutil.TempFile("", "easyjson-stderr")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(stderr.Name())
+	defer stderr.Close()
+
+	// The generator is built and then run directly rather than with 'go run', so that the
+	// process killed on cancellation is the generator itself, not only the go tool.
+	binDir, err := ioutil.TempDir("", "easyjson-bin")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(binDir)
+	binName := filepath.Join(binDir, "easyjson-bootstrap")
+	if runtime.GOOS == "windows" {
+		binName += ".exe"
+	}
+
+	execArgs := []string{"build", "-o", binName}
+	if gens[0].GenBuildFlags != "" {
+		buildFlags := buildFlagsRegexp.FindAllString(gens[0].GenBuildFlags, -1)
+		execArgs = append(execArgs, buildFlags...)
+	}
+	execArgs = append(execArgs, "-tags", gens[0].BuildTags, filepath.Base(path))
+	cmd := exec.CommandContext(ctx, "go", execArgs...)
+	cmd.Dir = filepath.Dir(path)
+	cmd.Env = gens[0].GoEnv()
+	cmd.Stdout = stderr
+	cmd.Stderr = stderr
+	if err = cmd.Run(); err == nil {
+		cmd = exec.CommandContext(ctx, binName, outNames...)
+		cmd.Dir = filepath.Dir(path)
+		cmd.Env = gens[0].GoEnv()
+		cmd.Stdout = stderr
+		cmd.Stderr = stderr
+		err = cmd.Run()
+	}
+
+	errOutput, rerr := ioutil.ReadFile(stderr.Name())
+	if rerr != nil {
+		return nil, rerr
+	}
+	if err != nil && ctx.Err() != nil {
+		return nil, fmt.Errorf("running generator: %v\n%s", ctx.Err(), errOutput)
+	}
+	os.Stderr.Write(errOutput)
+	if err != nil {
+		return nil, err
+	}
+
+	outs := make([][]byte, len(gens))
+	for i, name := range outNames {
+		if outs[i], err = ioutil.ReadFile(name); err != nil {
+			return nil, err
+		}
+	}
+	for _, g := range gens {
+		g.emitted = make([][]byte, len(g.emitTemps))
+		for i, name := range g.emitTemps {
+			if g.emitted[i], err = ioutil.ReadFile(name); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return outs, nil
+}
+
+// GoEnv returns the environment of the go commands, nil for the one of the process. It is also
+// the environment to parse the package with, parser.Parser.Env.
+func (g *Generator) GoEnv() []string {
+	if len(g.Env) == 0 && !g.CleanEnv {
+		return nil
+	}
+
+	var ret []string
+	for _, kv := range os.Environ() {
+		if !g.CleanEnv {
+			ret = append(ret, kv)
+			continue
+		}
+		key := kv
+		if i := strings.Index(kv, "="); i >= 0 {
+			key = kv[:i]
+		}
+		for _, k := range cleanEnvKeys {
+			// Windows and Plan 9 spell some of them differently.
+			if strings.EqualFold(key, k) {
+				ret = append(ret, kv)
+				break
+			}
+		}
+	}
+	// exec.Cmd uses the last value of variables set more than once.
+	return append(ret, g.Env...)
+}
+
+// format returns the generated code formatted like gofmt does, unless NoFormat is set.
+func (g *Generator) format(in []byte) ([]byte, error) {
+	if g.NoFormat {
+		return in, nil
+	}
+
 	out, err := format.Source(in)
 	if err != nil {
-		return err
+		if g.LeaveTemps {
+			ioutil.WriteFile(g.OutName+".tmp", in, 0644)
+		}
+		return nil, err
 	}
-	return ioutil.WriteFile(g.OutName, out, 0644)
+	return out, nil
 }
@@ -0,0 +1,321 @@
+package bootstrap
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRunRestoresOnFailure(t *testing.T) {
+	dir, err := ioutil.TempDir("", "easyjson-bootstrap")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	outName := filepath.Join(dir, "data_easyjson.go")
+	orig := []byte("package data\n")
+	if err := ioutil.WriteFile(outName, orig, 0644); err != nil {
+		t.Fatal(err)
+	}
+	mtime := time.Now().Add(-time.Hour).Truncate(time.Second)
+	if err := os.Chtimes(outName, mtime, mtime); err != nil {
+		t.Fatal(err)
+	}
+
+	// The bootstrapping code can not be run, as the package does not exist.
+	g := Generator{
+		PkgPath: "example.com/easyjson/nonexistent",
+		PkgName: "data",
+		Types:   []string{"T"},
+		OutName: outName,
+	}
+	if err := g.Run(); err == nil {
+		t.Fatal("Run() succeeded; want error")
+	}
+
+	data, err := ioutil.ReadFile(outName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != string(orig) {
+		t.Errorf("after failed Run() %v contains %q; want %q", outName, data, orig)
+	}
+	if info, err := os.Stat(outName); err != nil || !info.ModTime().Equal(mtime) {
+		t.Errorf("after failed Run() %v is modified at %v (%v); want %v", outName, info.ModTime(), err, mtime)
+	}
+
+	os.Remove(outName)
+	if err := g.Run(); err == nil {
+		t.Fatal("Run() succeeded; want error")
+	}
+	if _, err := os.Stat(outName); !os.IsNotExist(err) {
+		t.Errorf("after failed Run() %v exists (%v); want it removed", outName, err)
+	}
+}
+
+func TestRunKeepNewer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "easyjson-bootstrap")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	src := filepath.Join(dir, "data.go")
+	outName := filepath.Join(dir, "data_easyjson.go")
+	orig := []byte("package data\n")
+	for _, name := range []string{src, outName} {
+		if err := ioutil.WriteFile(name, orig, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	old := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(src, old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	g := Generator{
+		PkgName:   "data",
+		OutName:   outName,
+		StubsOnly: true,
+		KeepNewer: true,
+		Sources:   []string{src},
+	}
+	if err := g.Run(); err == nil {
+		t.Error("Run() of a newer output succeeded; want error")
+	}
+	if data, _ := ioutil.ReadFile(outName); string(data) != string(orig) {
+		t.Errorf("Run() overwrote the newer %v with %q", outName, data)
+	}
+
+	if err := os.Chtimes(outName, old.Add(-time.Hour), old.Add(-time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Run(); err != nil {
+		t.Errorf("Run() of an older output error: %v", err)
+	}
+	if data, _ := ioutil.ReadFile(outName); string(data) == string(orig) {
+		t.Errorf("Run() did not overwrite the older %v", outName)
+	}
+}
+
+func TestRunStandalone(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go tool is not available")
+	}
+
+	// The package is created inside the module, so that the generator can be run.
+	dir, err := ioutil.TempDir(".", "standalone")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	src := "package data\n\ntype T struct {\n\tName string `json:\"name\"`\n\tAny  interface{}\n}\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, "data.go"), []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	pkgPath := "github.com/mailru/easyjson/bootstrap/" + filepath.Base(dir)
+	g := Generator{
+		PkgPath:    pkgPath,
+		PkgName:    "data",
+		Types:      []string{"T"},
+		OutName:    filepath.Join(dir, "data_easyjson.go"),
+		Standalone: true,
+	}
+	if err := g.Run(); err != nil {
+		t.Fatalf("Run() error: %v", err)
+	}
+	if data, _ := ioutil.ReadFile(g.OutName); !strings.Contains(string(data), "func (v T) MarshalJSON() ([]byte, error) {") {
+		t.Errorf("generated code does not contain the marshaler:\n%s", data)
+	}
+
+	if out, err := exec.Command("go", "build", "./"+dir).CombinedOutput(); err != nil {
+		t.Fatalf("standalone package does not build: %v\n%s", err, out)
+	}
+	out, err := exec.Command("go", "list", "-deps", "./"+dir).CombinedOutput()
+	if err != nil {
+		t.Fatalf("go list error: %v\n%s", err, out)
+	}
+	for _, dep := range strings.Fields(string(out)) {
+		if strings.HasPrefix(dep, "github.com/") && !strings.HasPrefix(dep, pkgPath) {
+			t.Errorf("standalone package depends on %v", dep)
+		}
+	}
+}
+
+func TestRunContextCancel(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go tool is not available")
+	}
+
+	dir, err := ioutil.TempDir(".", "stalled")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	ticks, err := filepath.Abs(filepath.Join(dir, "ticks"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The generator imports the package, so it stalls on the package initialization, writing a
+	// tick every 10ms while it is alive.
+	src := "package data\n\nimport (\n\t\"os\"\n\t\"time\"\n)\n\ntype T struct{}\n\n" +
+		"func init() {\n\tos.Stderr.WriteString(\"stalled\\n\")\n" +
+		"\tf, _ := os.Create(" + strconv.Quote(ticks) + ")\n" +
+		"\tfor {\n\t\tf.WriteString(\".\")\n\t\ttime.Sleep(10 * time.Millisecond)\n\t}\n}\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, "data.go"), []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// Build the dependencies beforehand, so that the generator gets to run before the deadline.
+	if out, err := exec.Command("go", "build", "./"+dir, "../gen").CombinedOutput(); err != nil {
+		t.Fatalf("go build error: %v\n%s", err, out)
+	}
+
+	g := Generator{
+		PkgPath: "github.com/mailru/easyjson/bootstrap/" + filepath.Base(dir),
+		PkgName: "data",
+		Types:   []string{"T"},
+		OutName: filepath.Join(dir, "data_easyjson.go"),
+	}
+	// The deadline is only reached if the generator does not start; it is canceled once it runs.
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	go func() {
+		for ctx.Err() == nil {
+			if fi, err := os.Stat(ticks); err == nil && fi.Size() > 0 {
+				cancel()
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}()
+
+	err = g.RunContext(ctx)
+	if err == nil || !strings.Contains(err.Error(), context.Canceled.Error()) {
+		t.Fatalf("RunContext() error: %v; want canceled", err)
+	}
+	if !strings.Contains(err.Error(), "stalled") {
+		t.Errorf("RunContext() error %q does not include the generator stderr", err)
+	}
+	if _, err := os.Stat(g.OutName); !os.IsNotExist(err) {
+		t.Errorf("after timed out RunContext() %v exists (%v); want it removed", g.OutName, err)
+	}
+
+	// The generator is killed along with RunContext returning.
+	before, _ := ioutil.ReadFile(ticks)
+	time.Sleep(100 * time.Millisecond)
+	if after, _ := ioutil.ReadFile(ticks); len(after) != len(before) {
+		t.Errorf("generator still running after RunContext() returned")
+	}
+}
+
+func TestRunBatch(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go tool is not available")
+	}
+
+	dir, err := ioutil.TempDir(".", "batch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var gens []*Generator
+	for _, name := range []string{"a", "b"} {
+		pkgDir := filepath.Join(dir, name)
+		if err := os.Mkdir(pkgDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		src := "package " + name + "\n\ntype T struct {\n\tName string `json:\"name\"`\n}\n"
+		if err := ioutil.WriteFile(filepath.Join(pkgDir, name+".go"), []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+		gens = append(gens, &Generator{
+			PkgPath: "github.com/mailru/easyjson/bootstrap/" + filepath.Base(dir) + "/" + name,
+			PkgName: name,
+			Types:   []string{"T"},
+			OutName: filepath.Join(pkgDir, name+"_easyjson.go"),
+		})
+	}
+	if err := RunBatch(context.Background(), gens); err != nil {
+		t.Fatalf("RunBatch() error: %v", err)
+	}
+	for _, g := range gens {
+		if data, _ := ioutil.ReadFile(g.OutName); !strings.Contains(string(data), "func (v T) MarshalJSON() ([]byte, error) {") {
+			t.Errorf("%v does not contain the marshaler:\n%s", g.OutName, data)
+		}
+	}
+	if out, err := exec.Command("go", "build", "./"+dir+"/...").CombinedOutput(); err != nil {
+		t.Fatalf("generated packages do not build: %v\n%s", err, out)
+	}
+
+	// A failure of one generator leaves all of the outputs unchanged.
+	src := "package b\n\ntype T struct {\n\tC chan int\n}\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, "b", "b.go"), []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+	orig, err := ioutil.ReadFile(gens[0].OutName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gens[0].Types = append(gens[0].Types, "U")
+	if err := ioutil.WriteFile(filepath.Join(dir, "a", "u.go"), []byte("package a\n\ntype U struct{}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := RunBatch(context.Background(), gens); err == nil {
+		t.Fatal("RunBatch() of an unsupported type succeeded; want error")
+	}
+	if data, _ := ioutil.ReadFile(gens[0].OutName); string(data) != string(orig) {
+		t.Errorf("after failed RunBatch() %v is changed:\n%s", gens[0].OutName, data)
+	}
+
+	gens[1].BuildTags = "linux"
+	if err := RunBatch(context.Background(), gens); err == nil {
+		t.Error("RunBatch() with different build tags succeeded; want error")
+	}
+}
+
+func TestRunEnv(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go tool is not available")
+	}
+
+	dir, err := ioutil.TempDir(".", "env")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "data.go"), []byte("package data\n\ntype T struct{ X int }\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	g := Generator{
+		PkgPath: "github.com/mailru/easyjson/bootstrap/" + filepath.Base(dir),
+		PkgName: "data",
+		Types:   []string{"T"},
+		OutName: filepath.Join(dir, "data_easyjson.go"),
+		Env:     []string{"GOFLAGS=-mod=invalid"},
+	}
+	if err := g.Run(); err == nil {
+		t.Fatal("Run() with an invalid GOFLAGS in Env succeeded; want error")
+	}
+
+	// The invalid GOFLAGS of the process are left out.
+	t.Setenv("GOFLAGS", "-mod=invalid")
+	g.Env = nil
+	g.CleanEnv = true
+	if err := g.Run(); err != nil {
+		t.Fatalf("Run() with CleanEnv error: %v", err)
+	}
+	if _, err := os.Stat(g.OutName); err != nil {
+		t.Errorf("after Run() with CleanEnv: %v", err)
+	}
+}
@@ -0,0 +1,67 @@
+package bootstrap
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	for i, test := range []struct {
+		A, B, Want string
+	}{
+		{"a\nb\n", "a\nb\n", ""},
+		{"", "a\n", "--- a\n+++ b\n@@ -0,0 +1 @@\n+a\n"},
+		{"a\n", "", "--- a\n+++ b\n@@ -1 +0,0 @@\n-a\n"},
+		{
+			"1\n2\n3\n4\n5\n6\n7\n8\n",
+			"1\n2\n3\n4\nx\n6\n7\n8\n",
+			"--- a\n+++ b\n@@ -2,7 +2,7 @@\n 2\n 3\n 4\n-5\n+x\n 6\n 7\n 8\n",
+		},
+		{
+			"1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n",
+			"0\n1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n",
+			"--- a\n+++ b\n@@ -1,3 +1,4 @@\n+0\n 1\n 2\n 3\n@@ -9,4 +10,3 @@\n 9\n 10\n 11\n-12\n",
+		},
+		{"a\nb", "a\nc", "--- a\n+++ b\n@@ -1,2 +1,2 @@\n a\n-b\n\\ No newline at end of file\n+c\n\\ No newline at end of file\n"},
+	} {
+		got := string(unifiedDiff("a", "b", []byte(test.A), []byte(test.B)))
+		if got != test.Want {
+			t.Errorf("[%d] unifiedDiff(%q, %q) = \n%s\nwant\n%s", i, test.A, test.B, got, test.Want)
+		}
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	for i, test := range []struct {
+		A, B  string
+		Edits int
+	}{
+		{"abcabba", "cbabac", 5},
+		{"xaxbxc", "abc", 3},
+		{"", "abc", 3},
+		{"kitten", "sitting", 5},
+	} {
+		a, b := strings.Split(test.A, ""), strings.Split(test.B, "")
+		ops := diffLines(a, b)
+
+		var gotA, gotB []string
+		edits := 0
+		for _, op := range ops {
+			if op.kind != ' ' {
+				edits++
+			}
+			if op.kind != '+' {
+				gotA = append(gotA, op.line)
+			}
+			if op.kind != '-' {
+				gotB = append(gotB, op.line)
+			}
+		}
+		if strings.Join(gotA, "") != test.A || strings.Join(gotB, "") != test.B {
+			t.Errorf("[%d] diffLines(%q, %q) does not reproduce the inputs: %v", i, test.A, test.B, ops)
+		}
+		if edits != test.Edits {
+			t.Errorf("[%d] diffLines(%q, %q) has %d edits; want %d", i, test.A, test.B, edits, test.Edits)
+		}
+	}
+}
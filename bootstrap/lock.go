@@ -0,0 +1,72 @@
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// lockName is the name of the lockfile created in the package directory while generating.
+// Files starting with a dot are ignored by the go tool, so the lockfile does not affect the
+// build of the package.
+const lockName = ".easyjson.lock"
+
+const (
+	lockRetryInterval = 50 * time.Millisecond
+
+	// lockStaleAge is the age after which a lockfile is considered to be left by a crashed
+	// process and is removed, even if the process it names seems to be running.
+	lockStaleAge = 10 * time.Minute
+)
+
+// lockDir acquires an exclusive lock for generation in the given directory, waiting for
+// other processes holding it until ctx is done. Locks of processes that are gone are broken.
+// The returned function releases the lock.
+func lockDir(ctx context.Context, dir string) (unlock func(), err error) {
+	path := filepath.Join(dir, lockName)
+	for {
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+		if err == nil {
+			fmt.Fprintln(f, os.Getpid())
+			f.Close()
+			return func() { os.Remove(path) }, nil
+		}
+		if !os.IsExist(err) {
+			return nil, err
+		}
+
+		if lockAbandoned(path) {
+			os.Remove(path)
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting for %v: %v", path, ctx.Err())
+		case <-time.After(lockRetryInterval):
+		}
+	}
+}
+
+// lockAbandoned returns true if the lockfile at path is left by a crashed or killed process: the
+// process whose PID it holds is gone, or the lockfile is older than lockStaleAge.
+func lockAbandoned(path string) bool {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+	if time.Since(fi.ModTime()) > lockStaleAge {
+		return true
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return false
+	}
+	// The lockfile may be empty yet while its owner is writing the PID.
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	return err == nil && pid > 0 && !processExists(pid)
+}
@@ -0,0 +1,14 @@
+//go:build !aix && !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd && !solaris
+// +build !aix,!darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd,!solaris
+
+package bootstrap
+
+import "os"
+
+// processExists returns true if the process with the given PID is running. On Windows finding
+// the process fails if it is gone; elsewhere it always succeeds, leaving the lockfiles of
+// crashed processes to expire by age.
+func processExists(pid int) bool {
+	_, err := os.FindProcess(pid)
+	return err == nil
+}
@@ -0,0 +1,120 @@
+package bootstrap
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLockDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "easyjson-lock")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		holders int
+	)
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			unlock, err := lockDir(context.Background(), dir)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			mu.Lock()
+			holders++
+			if holders > 1 {
+				t.Error("lock is held by several goroutines at once")
+			}
+			mu.Unlock()
+
+			time.Sleep(10 * time.Millisecond)
+
+			mu.Lock()
+			holders--
+			mu.Unlock()
+			unlock()
+		}()
+	}
+	wg.Wait()
+
+	if _, err := os.Stat(filepath.Join(dir, lockName)); !os.IsNotExist(err) {
+		t.Errorf("lockfile is not removed after unlock: %v", err)
+	}
+}
+
+func TestLockDirStale(t *testing.T) {
+	dir, err := ioutil.TempDir("", "easyjson-lock")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, lockName)
+	if err := ioutil.WriteFile(path, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().Add(-2 * lockStaleAge)
+	if err := os.Chtimes(path, old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	unlock, err := lockDir(context.Background(), dir)
+	if err != nil {
+		t.Fatalf("lockDir() with a stale lockfile error: %v", err)
+	}
+	unlock()
+}
+
+func TestLockDirOwner(t *testing.T) {
+	dir, err := ioutil.TempDir("", "easyjson-lock")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, lockName)
+
+	// The lock of a running process is kept.
+	if err := ioutil.WriteFile(path, []byte(strconv.Itoa(os.Getpid())+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	if _, err := lockDir(ctx, dir); err == nil || !strings.Contains(err.Error(), path) {
+		t.Fatalf("lockDir() with a held lock error: %v; want an error naming %v", err, path)
+	}
+
+	// The lock of a process that is gone is broken at once.
+	cmd := exec.Command(os.Args[0], "-test.run=^$")
+	if err := cmd.Run(); err != nil {
+		t.Fatal(err)
+	}
+	if processExists(cmd.Process.Pid) {
+		t.Skipf("exited processes can not be told on %v", runtime.GOOS)
+	}
+	if err := ioutil.WriteFile(path, []byte(strconv.Itoa(cmd.Process.Pid)+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	unlock, err := lockDir(ctx, dir)
+	if err != nil {
+		t.Fatalf("lockDir() with the lock of an exited process error: %v", err)
+	}
+	unlock()
+}
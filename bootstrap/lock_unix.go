@@ -0,0 +1,21 @@
+//go:build aix || darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris
+// +build aix darwin dragonfly freebsd linux netbsd openbsd solaris
+
+package bootstrap
+
+import (
+	"os"
+	"syscall"
+)
+
+// processExists returns true if the process with the given PID is running.
+func processExists(pid int) bool {
+	p, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	// Signal 0 only checks that the process exists; EPERM means it does but belongs to
+	// another user.
+	err = p.Signal(syscall.Signal(0))
+	return err == nil || err == syscall.EPERM
+}
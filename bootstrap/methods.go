@@ -0,0 +1,71 @@
+package bootstrap
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// generatedMethods returns the names of the marshaling methods generated for the type.
+func (g *Generator) generatedMethods(enum bool) []string {
+	var ret []string
+	if !g.NoStdMarshalers {
+		ret = append(ret, "MarshalJSON", "UnmarshalJSON")
+	}
+	if !g.Standalone {
+		ret = append(ret, "MarshalEasyJSON", "UnmarshalEasyJSON")
+	}
+	if enum {
+		ret = append(ret, "MarshalText", "UnmarshalText")
+	} else if g.Validators {
+		ret = append(ret, "ValidateEasyJSON")
+	}
+	return ret
+}
+
+// keptMethods returns the names of the hand-written Methods conflicting with the generated
+// ones by type name. Unless ForceOverride is set, the conflicts are reported as an error
+// before anything is generated, rather than as duplicate methods when compiling the package.
+func (g *Generator) keptMethods() (map[string][]string, error) {
+	generated := map[string]map[string]bool{}
+	for _, t := range g.Types {
+		generated[t] = map[string]bool{}
+		for _, m := range g.generatedMethods(false) {
+			generated[t][m] = true
+		}
+	}
+	for _, e := range g.Enums {
+		generated[e.Name] = map[string]bool{}
+		for _, m := range g.generatedMethods(true) {
+			generated[e.Name][m] = true
+		}
+	}
+
+	kept := map[string][]string{}
+	var conflicts []string
+	for _, m := range g.Methods {
+		if !generated[m.Type][m.Name] {
+			continue
+		}
+		kept[m.Type] = append(kept[m.Type], m.Name)
+		conflicts = append(conflicts, fmt.Sprintf("%v: %v already has a %v method", m.Pos, m.Type, m.Name))
+	}
+	if len(conflicts) > 0 && !g.ForceOverride {
+		return nil, fmt.Errorf("%v\nremove the methods, skip the types with easyjson:skip comments or keep the methods with -force_override",
+			strings.Join(conflicts, "\n"))
+	}
+	for _, names := range kept {
+		sort.Strings(names)
+	}
+	return kept, nil
+}
+
+// isKept tells if the method of type t is hand-written and must not be generated.
+func (g *Generator) isKept(t, method string) bool {
+	for _, m := range g.kept[t] {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
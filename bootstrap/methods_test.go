@@ -0,0 +1,42 @@
+package bootstrap
+
+import (
+	"go/token"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mailru/easyjson/parser"
+)
+
+func TestKeptMethods(t *testing.T) {
+	g := Generator{
+		Types: []string{"A", "B"},
+		Enums: []parser.Enum{{Name: "E"}},
+		Methods: []parser.Method{
+			{Type: "A", Name: "UnmarshalJSON", Pos: token.Position{Filename: "a.go", Line: 3, Column: 1}},
+			{Type: "A", Name: "MarshalJSON", Pos: token.Position{Filename: "a.go", Line: 7, Column: 1}},
+			{Type: "B", Name: "MarshalText"},
+			{Type: "C", Name: "MarshalJSON"},
+			{Type: "E", Name: "MarshalText"},
+		},
+	}
+
+	_, err := g.keptMethods()
+	if err == nil || !strings.Contains(err.Error(), "a.go:7:1: A already has a MarshalJSON method") {
+		t.Errorf("keptMethods() error: %v; want a conflict error", err)
+	}
+
+	g.ForceOverride = true
+	kept, err := g.keptMethods()
+	want := map[string][]string{"A": {"MarshalJSON", "UnmarshalJSON"}, "E": {"MarshalText"}}
+	if err != nil || !reflect.DeepEqual(kept, want) {
+		t.Errorf("keptMethods() = %v, %v; want %v", kept, err, want)
+	}
+
+	g.NoStdMarshalers = true
+	g.ForceOverride = false
+	if _, err := g.keptMethods(); err == nil || strings.Contains(err.Error(), "A already") {
+		t.Errorf("keptMethods() error with NoStdMarshalers: %v; want only the enum conflict", err)
+	}
+}
@@ -0,0 +1,143 @@
+package bootstrap
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/mailru/easyjson/gen"
+)
+
+// RegistryType is a type entered into a registry file.
+type RegistryType struct {
+	PkgPath, PkgName string
+	Name             string
+}
+
+// Registry writes a file mapping the import path qualified names of the types generated in
+// possibly many packages to their easyjson.Codec, for looking up the codecs by name. The
+// unexported types of other packages can not be referred to and are left out.
+type Registry struct {
+	PkgPath, PkgName string
+	Types            []RegistryType
+
+	OutName   string
+	BuildTags string
+
+	// If Output is set, the registry is written to it and OutName is left unchanged.
+	Output io.Writer
+	// If Diff is set, a unified diff against the existing OutName is written to Output
+	// instead of the registry.
+	Diff bool
+}
+
+// Add enters the types generated into the package to the registry, skipping the types
+// already entered.
+func (r *Registry) Add(pkgPath, pkgName string, types ...string) {
+	for _, name := range types {
+		t := RegistryType{PkgPath: pkgPath, PkgName: pkgName, Name: name}
+		found := false
+		for _, t1 := range r.Types {
+			if t1 == t {
+				found = true
+				break
+			}
+		}
+		if !found {
+			r.Types = append(r.Types, t)
+		}
+	}
+}
+
+// AddGenerated enters the types generated by g to the registry, except for the generic ones,
+// which have no codecs without type arguments.
+func (r *Registry) AddGenerated(g *Generator) {
+	var types []string
+	for _, name := range g.Types {
+		if len(g.TypeParams[name]) == 0 {
+			types = append(types, name)
+		}
+	}
+	r.Add(g.PkgPath, g.PkgName, types...)
+}
+
+// Write writes the registry into OutName.
+func (r *Registry) Write() error {
+	var types []RegistryType
+	for _, t := range r.Types {
+		if t.PkgPath == r.PkgPath || ast.IsExported(t.Name) {
+			types = append(types, t)
+		}
+	}
+	sort.Slice(types, func(i, j int) bool {
+		if types[i].PkgPath != types[j].PkgPath {
+			return types[i].PkgPath < types[j].PkgPath
+		}
+		return types[i].Name < types[j].Name
+	})
+
+	aliases := map[string]string{}
+	var paths []string
+	for _, t := range types {
+		if t.PkgPath == r.PkgPath || aliases[t.PkgPath] != "" {
+			continue
+		}
+		if t.PkgName == "main" {
+			return fmt.Errorf("can not enter %v.%v to the registry: main packages can not be imported", t.PkgPath, t.Name)
+		}
+		aliases[t.PkgPath] = "pkg" + strconv.Itoa(len(paths)+1)
+		paths = append(paths, t.PkgPath)
+	}
+
+	f := &bytes.Buffer{}
+	if r.BuildTags != "" {
+		fmt.Fprint(f, gen.BuildConstraint(r.BuildTags))
+		fmt.Fprintln(f)
+	}
+	fmt.Fprintln(f, "// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.")
+	fmt.Fprintln(f)
+	fmt.Fprintln(f, "package ", r.PkgName)
+	fmt.Fprintln(f)
+	fmt.Fprintln(f, "import (")
+	fmt.Fprintf(f, "  easyjson %q\n", "github.com/mailru/easyjson")
+	for _, path := range paths {
+		fmt.Fprintf(f, "  %v %q\n", aliases[path], path)
+	}
+	fmt.Fprintln(f, ")")
+	fmt.Fprintln(f)
+	fmt.Fprintln(f, "// Codecs maps the import path qualified names of the generated types to their codecs.")
+	fmt.Fprintln(f, "var Codecs = map[string]easyjson.Codec{")
+	for _, t := range types {
+		name := strconv.Quote(t.PkgPath + "." + t.Name)
+		typ := t.Name
+		if alias := aliases[t.PkgPath]; alias != "" {
+			typ = alias + "." + t.Name
+		}
+		fmt.Fprintf(f, "  %v: {Name: %v, New: func() easyjson.MarshalerUnmarshaler { return new(%v) }},\n", name, name, typ)
+	}
+	fmt.Fprintln(f, "}")
+
+	out, err := format.Source(f.Bytes())
+	if err != nil {
+		return err
+	}
+
+	if r.Output == nil {
+		return writeFileAtomic(r.OutName, out)
+	}
+	if r.Diff {
+		orig, err := ioutil.ReadFile(r.OutName)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		out = unifiedDiff(r.OutName, r.OutName, orig, out)
+	}
+	_, err = r.Output.Write(out)
+	return err
+}
@@ -0,0 +1,60 @@
+package bootstrap
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/mailru/easyjson/parser"
+)
+
+func TestRegistry(t *testing.T) {
+	var out bytes.Buffer
+	r := Registry{
+		PkgPath: "example.com/app/codecs",
+		PkgName: "codecs",
+		Output:  &out,
+	}
+	r.Add("example.com/app/b", "b", "T")
+	r.Add("example.com/app/a", "a", "U", "T")
+	r.Add("example.com/app/codecs", "codecs", "Local")
+	r.Add("example.com/app/a", "a", "T", "priv")
+	r.Add("example.com/app/codecs", "codecs", "local")
+	r.AddGenerated(&Generator{
+		PkgPath:    "example.com/app/c",
+		PkgName:    "c",
+		Types:      []string{"Page", "V"},
+		TypeParams: map[string][]parser.TypeParam{"Page": {{Name: "T", Constraint: "any"}}},
+	})
+	if err := r.Write(); err != nil {
+		t.Fatalf("Write() error: %v", err)
+	}
+
+	for _, want := range []string{
+		"package codecs",
+		`pkg1 "example.com/app/a"`,
+		`pkg2 "example.com/app/b"`,
+		`{Name: "example.com/app/a.T", New: func() easyjson.MarshalerUnmarshaler { return new(pkg1.T) }},`,
+		`{Name: "example.com/app/b.T", New: func() easyjson.MarshalerUnmarshaler { return new(pkg2.T) }},`,
+		`{Name: "example.com/app/c.V", New: func() easyjson.MarshalerUnmarshaler { return new(pkg3.V) }},`,
+		`New: func() easyjson.MarshalerUnmarshaler { return new(Local) }},`,
+		`New: func() easyjson.MarshalerUnmarshaler { return new(local) }},`,
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("registry does not contain %q:\n%s", want, out.String())
+		}
+	}
+	for _, unwanted := range []string{"priv", "Page"} {
+		if strings.Contains(out.String(), unwanted) {
+			t.Errorf("registry contains %v:\n%s", unwanted, out.String())
+		}
+	}
+	if n := strings.Count(out.String(), "{Name:"); n != 6 {
+		t.Errorf("registry has %d entries; want 6", n)
+	}
+
+	r.Add("example.com/app/cmd", "main", "T")
+	if err := r.Write(); err == nil {
+		t.Error("Write() with a type of a main package succeeded; want error")
+	}
+}
@@ -0,0 +1,128 @@
+package bootstrap
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// runtimePackages are the packages the standalone generated code needs, copied to the
+// runtime directory by their base names.
+var runtimePackages = []string{
+	"github.com/mailru/easyjson/buffer",
+	"github.com/mailru/easyjson/jlexer",
+	"github.com/mailru/easyjson/jwriter",
+	"github.com/josharian/intern",
+}
+
+// runtimePath returns the import path of the runtime copy used by the standalone code.
+func (g *Generator) runtimePath() string {
+	return path.Join(g.PkgPath, "internal", "easyjson")
+}
+
+// writeRuntime copies the sources of the runtime packages under the internal/easyjson
+// directory next to OutName, rewriting the imports between them to point to the copies.
+func (g *Generator) writeRuntime(ctx context.Context) error {
+	dir := filepath.Dir(g.OutName)
+
+	cmd := exec.CommandContext(ctx, "go", append([]string{"list", "-f", "{{.ImportPath}}\t{{.Dir}}"}, runtimePackages...)...)
+	cmd.Dir = dir
+	cmd.Env = g.GoEnv()
+	cmd.Stderr = os.Stderr
+	list, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("locating easyjson runtime packages: %v", err)
+	}
+
+	var replacements []string
+	for _, pkg := range runtimePackages {
+		replacements = append(replacements, `"`+pkg+`"`, `"`+path.Join(g.runtimePath(), path.Base(pkg))+`"`)
+	}
+	rewriter := strings.NewReplacer(replacements...)
+
+	for _, line := range strings.Split(strings.TrimSpace(string(list)), "\n") {
+		parts := strings.SplitN(line, "\t", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("unexpected go list output %q", line)
+		}
+		dest := filepath.Join(dir, "internal", "easyjson", path.Base(parts[0]))
+		if err := copyRuntimePackage(parts[0], parts[1], dest, rewriter); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyRuntimePackage replaces the contents of dest with the non-test sources of the package
+// in src and its license.
+func copyRuntimePackage(importPath, src, dest string, rewriter *strings.Replacer) error {
+	if err := os.RemoveAll(dest); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dest, 0755); err != nil {
+		return err
+	}
+
+	files, err := filepath.Glob(filepath.Join(src, "*.go"))
+	if err != nil {
+		return err
+	}
+	for _, name := range files {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		data, err := ioutil.ReadFile(name)
+		if err != nil {
+			return err
+		}
+
+		var b bytes.Buffer
+		fmt.Fprintf(&b, "// Code generated by easyjson from %v. DO NOT EDIT.\n\n", importPath)
+		b.WriteString(rewriter.Replace(string(data)))
+		if err := writeFileAtomic(filepath.Join(dest, filepath.Base(name)), b.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	// The license is kept in the package directory or, for packages of a larger module, in
+	// the module root one level up.
+	licenses, err := licenseFiles(src)
+	if err == nil && len(licenses) == 0 {
+		licenses, err = licenseFiles(filepath.Dir(src))
+	}
+	if err != nil {
+		return err
+	}
+	for _, name := range licenses {
+		data, err := ioutil.ReadFile(name)
+		if err != nil {
+			return err
+		}
+		if err := writeFileAtomic(filepath.Join(dest, filepath.Base(name)), data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// licenseFiles returns the paths of the license files in dir, matching their names
+// case-insensitively.
+func licenseFiles(dir string) ([]string, error) {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var ret []string
+	for _, info := range infos {
+		if !info.IsDir() && strings.HasPrefix(strings.ToUpper(info.Name()), "LICENSE") {
+			ret = append(ret, filepath.Join(dir, info.Name()))
+		}
+	}
+	return ret, nil
+}
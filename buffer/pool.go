@@ -72,6 +72,9 @@ type Buffer struct {
 
 	toPool []byte
 	bufs   [][]byte
+
+	// filled is the total length of the chunks in bufs.
+	filled int
 }
 
 // EnsureSpace makes sure that the current chunk contains at least s free bytes,
@@ -93,6 +96,7 @@ func (b *Buffer) ensureSpaceSlow(s int) {
 			b.bufs = make([][]byte, 0, 8)
 		}
 		b.bufs = append(b.bufs, b.Buf)
+		b.filled += len(b.Buf)
 		l = cap(b.toPool) * 2
 	} else {
 		l = config.StartSize
@@ -157,13 +161,10 @@ func (b *Buffer) appendStringSlow(data string) {
 	}
 }
 
-// Size computes the size of a buffer by adding sizes of every chunk.
+// Size returns the size of a buffer. It does not depend on the number of chunks, so it is
+// cheap enough to be called after every write.
 func (b *Buffer) Size() int {
-	size := len(b.Buf)
-	for _, buf := range b.bufs {
-		size += len(buf)
-	}
-	return size
+	return b.filled + len(b.Buf)
 }
 
 // DumpTo outputs the contents of a buffer to a writer and resets the buffer.
@@ -182,6 +183,7 @@ func (b *Buffer) DumpTo(w io.Writer) (written int, err error) {
 	b.bufs = nil
 	b.Buf = nil
 	b.toPool = nil
+	b.filled = 0
 
 	return int(n), err
 }
@@ -217,6 +219,7 @@ func (b *Buffer) BuildBytes(reuse ...[]byte) []byte {
 	b.bufs = nil
 	b.toPool = nil
 	b.Buf = nil
+	b.filled = 0
 
 	return ret
 }
@@ -273,6 +276,7 @@ func (b *Buffer) ReadCloser() io.ReadCloser {
 	b.bufs = nil
 	b.toPool = nil
 	b.Buf = nil
+	b.filled = 0
 
 	return ret
 }
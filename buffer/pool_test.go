@@ -78,6 +78,26 @@ func TestDumpTo(t *testing.T) {
 	}
 }
 
+func TestSize(t *testing.T) {
+	var b Buffer
+	for i := 0; i < 1000; i++ {
+		b.AppendString("test")
+		if got, want := b.Size(), 4*(i+1); got != want {
+			t.Fatalf("Size() = %v; want %v", got, want)
+		}
+	}
+
+	b.DumpTo(&bytes.Buffer{})
+	if got := b.Size(); got != 0 {
+		t.Errorf("Size() after DumpTo() = %v; want 0", got)
+	}
+
+	b.AppendString("test")
+	if got := b.Size(); got != 4 {
+		t.Errorf("Size() after DumpTo() and AppendString() = %v; want 4", got)
+	}
+}
+
 func TestReadCloser(t *testing.T) {
 	var b Buffer
 	var want []byte
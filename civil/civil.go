@@ -0,0 +1,291 @@
+// Package civil implements types for dates, times of day and months without a time zone,
+// marshaled in the RFC 3339 partial formats, e.g. "2006-01-02", "15:04:05" and "2006-01".
+//
+// Unlike time.Time, the values are not affected by time zones, so e.g. a birth date
+// round-trips unchanged between clients in different locations.
+package civil
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mailru/easyjson"
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+// Date is a date of the proleptic Gregorian calendar, marshaled as "2006-01-02".
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// DateOf returns the date t occurs on in the location of t.
+func DateOf(t time.Time) Date {
+	y, m, d := t.Date()
+	return Date{Year: y, Month: m, Day: d}
+}
+
+// ParseDate parses a date in the RFC 3339 full-date format, e.g. "2006-01-02".
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		return Date{}, err
+	}
+	return DateOf(t), nil
+}
+
+// String returns the date in the RFC 3339 full-date format.
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// IsValid reports whether the date exists, e.g. it is false for February 30.
+func (d Date) IsValid() bool {
+	return DateOf(d.In(time.UTC)) == d
+}
+
+// In returns the time at midnight of the date in the location.
+func (d Date) In(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// AddDays returns the date n days after d.
+func (d Date) AddDays(n int) Date {
+	return DateOf(d.In(time.UTC).AddDate(0, 0, n))
+}
+
+// Before reports whether d is before d2.
+func (d Date) Before(d2 Date) bool {
+	if d.Year != d2.Year {
+		return d.Year < d2.Year
+	}
+	if d.Month != d2.Month {
+		return d.Month < d2.Month
+	}
+	return d.Day < d2.Day
+}
+
+// TimeOfDay is a time of a day, marshaled as "15:04:05" with a fraction of a second if
+// Nanosecond is not zero, e.g. "15:04:05.5".
+type TimeOfDay struct {
+	Hour       int
+	Minute     int
+	Second     int
+	Nanosecond int
+}
+
+// TimeOfDayOf returns the time of day of t in the location of t.
+func TimeOfDayOf(t time.Time) TimeOfDay {
+	h, m, s := t.Clock()
+	return TimeOfDay{Hour: h, Minute: m, Second: s, Nanosecond: t.Nanosecond()}
+}
+
+// ParseTimeOfDay parses a time of day in the RFC 3339 partial-time format, e.g. "15:04:05"
+// or "15:04:05.999".
+func ParseTimeOfDay(s string) (TimeOfDay, error) {
+	t, err := time.Parse("15:04:05.999999999", s)
+	if err != nil {
+		return TimeOfDay{}, err
+	}
+	return TimeOfDayOf(t), nil
+}
+
+// String returns the time of day in the RFC 3339 partial-time format.
+func (t TimeOfDay) String() string {
+	s := fmt.Sprintf("%02d:%02d:%02d", t.Hour, t.Minute, t.Second)
+	if t.Nanosecond == 0 {
+		return s
+	}
+	frac := fmt.Sprintf("%09d", t.Nanosecond)
+	for frac[len(frac)-1] == '0' {
+		frac = frac[:len(frac)-1]
+	}
+	return s + "." + frac
+}
+
+// IsValid reports whether the fields of the time of day are in their ranges.
+func (t TimeOfDay) IsValid() bool {
+	return t.Hour >= 0 && t.Hour < 24 &&
+		t.Minute >= 0 && t.Minute < 60 &&
+		t.Second >= 0 && t.Second < 60 &&
+		t.Nanosecond >= 0 && t.Nanosecond < 1e9
+}
+
+// On returns the time of the time of day on the date in the location.
+func (t TimeOfDay) On(d Date, loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, t.Hour, t.Minute, t.Second, t.Nanosecond, loc)
+}
+
+// YearMonth is a month of a year, marshaled as "2006-01".
+type YearMonth struct {
+	Year  int
+	Month time.Month
+}
+
+// YearMonthOf returns the month t occurs in in the location of t.
+func YearMonthOf(t time.Time) YearMonth {
+	return YearMonth{Year: t.Year(), Month: t.Month()}
+}
+
+// ParseYearMonth parses a month in the "2006-01" format.
+func ParseYearMonth(s string) (YearMonth, error) {
+	t, err := time.Parse("2006-01", s)
+	if err != nil {
+		return YearMonth{}, err
+	}
+	return YearMonthOf(t), nil
+}
+
+// String returns the month in the "2006-01" format.
+func (m YearMonth) String() string {
+	return fmt.Sprintf("%04d-%02d", m.Year, m.Month)
+}
+
+// IsValid reports whether the month is in the range of 1 to 12.
+func (m YearMonth) IsValid() bool {
+	return m.Month >= time.January && m.Month <= time.December
+}
+
+// Date returns the date of the day of the month.
+func (m YearMonth) Date(day int) Date {
+	return Date{Year: m.Year, Month: m.Month, Day: day}
+}
+
+// unmarshalString unmarshals the string in the input of l with parse, calling reset on null.
+func unmarshalString(l *jlexer.Lexer, reset func(), parse func(string) error) {
+	if l.IsNull() {
+		l.Skip()
+		reset()
+		return
+	}
+	s := l.String()
+	if !l.Ok() {
+		return
+	}
+	if err := parse(s); err != nil {
+		l.AddError(err)
+	}
+}
+
+// MarshalEasyJSON does JSON marshaling using easyjson interface.
+func (d Date) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(d.String())
+}
+
+// UnmarshalEasyJSON does JSON unmarshaling using easyjson interface.
+func (d *Date) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	unmarshalString(l, func() { *d = Date{} }, d.parse)
+}
+
+func (d *Date) parse(s string) (err error) {
+	*d, err = ParseDate(s)
+	return err
+}
+
+// MarshalJSON implements encoding/json.Marshaler interface.
+func (d Date) MarshalJSON() ([]byte, error) {
+	return easyjson.Marshal(d)
+}
+
+// UnmarshalJSON implements encoding/json.Unmarshaler interface.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	return easyjson.Unmarshal(data, d)
+}
+
+// MarshalText implements encoding.TextMarshaler interface, allowing use as a map key.
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler interface.
+func (d *Date) UnmarshalText(data []byte) error {
+	return d.parse(string(data))
+}
+
+// IsDefined is required for integration with omitempty easyjson logic.
+func (d *Date) IsDefined() bool {
+	return *d != Date{}
+}
+
+// MarshalEasyJSON does JSON marshaling using easyjson interface.
+func (t TimeOfDay) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(t.String())
+}
+
+// UnmarshalEasyJSON does JSON unmarshaling using easyjson interface.
+func (t *TimeOfDay) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	unmarshalString(l, func() { *t = TimeOfDay{} }, t.parse)
+}
+
+func (t *TimeOfDay) parse(s string) (err error) {
+	*t, err = ParseTimeOfDay(s)
+	return err
+}
+
+// MarshalJSON implements encoding/json.Marshaler interface.
+func (t TimeOfDay) MarshalJSON() ([]byte, error) {
+	return easyjson.Marshal(t)
+}
+
+// UnmarshalJSON implements encoding/json.Unmarshaler interface.
+func (t *TimeOfDay) UnmarshalJSON(data []byte) error {
+	return easyjson.Unmarshal(data, t)
+}
+
+// MarshalText implements encoding.TextMarshaler interface, allowing use as a map key.
+func (t TimeOfDay) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler interface.
+func (t *TimeOfDay) UnmarshalText(data []byte) error {
+	return t.parse(string(data))
+}
+
+// IsDefined is required for integration with omitempty easyjson logic. Midnight is not
+// distinguished from an undefined time of day.
+func (t *TimeOfDay) IsDefined() bool {
+	return *t != TimeOfDay{}
+}
+
+// MarshalEasyJSON does JSON marshaling using easyjson interface.
+func (m YearMonth) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(m.String())
+}
+
+// UnmarshalEasyJSON does JSON unmarshaling using easyjson interface.
+func (m *YearMonth) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	unmarshalString(l, func() { *m = YearMonth{} }, m.parse)
+}
+
+func (m *YearMonth) parse(s string) (err error) {
+	*m, err = ParseYearMonth(s)
+	return err
+}
+
+// MarshalJSON implements encoding/json.Marshaler interface.
+func (m YearMonth) MarshalJSON() ([]byte, error) {
+	return easyjson.Marshal(m)
+}
+
+// UnmarshalJSON implements encoding/json.Unmarshaler interface.
+func (m *YearMonth) UnmarshalJSON(data []byte) error {
+	return easyjson.Unmarshal(data, m)
+}
+
+// MarshalText implements encoding.TextMarshaler interface, allowing use as a map key.
+func (m YearMonth) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler interface.
+func (m *YearMonth) UnmarshalText(data []byte) error {
+	return m.parse(string(data))
+}
+
+// IsDefined is required for integration with omitempty easyjson logic.
+func (m *YearMonth) IsDefined() bool {
+	return *m != YearMonth{}
+}
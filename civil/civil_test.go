@@ -0,0 +1,112 @@
+package civil
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestDate(t *testing.T) {
+	d, err := ParseDate("2024-02-29")
+	if err != nil || d != (Date{2024, time.February, 29}) {
+		t.Errorf("ParseDate() = %v, %v", d, err)
+	}
+	if got := d.AddDays(1); got != (Date{2024, time.March, 1}) {
+		t.Errorf("AddDays(1) = %v", got)
+	}
+	if !d.IsValid() || (Date{2023, time.February, 29}).IsValid() {
+		t.Error("IsValid() is wrong")
+	}
+	if !d.Before(d.AddDays(1)) || d.AddDays(1).Before(d) {
+		t.Error("Before() is wrong")
+	}
+
+	// the date does not depend on time zones
+	loc := time.FixedZone("UTC+14", 14*60*60)
+	if got := DateOf(d.In(loc)); got != d {
+		t.Errorf("DateOf(In()) = %v; want %v", got, d)
+	}
+
+	for _, s := range []string{"2024-2-29", "2023-02-29", "2024-02-29T00:00:00Z", ""} {
+		if _, err := ParseDate(s); err == nil {
+			t.Errorf("ParseDate(%q) did not fail", s)
+		}
+	}
+}
+
+func TestTimeOfDay(t *testing.T) {
+	for _, test := range []struct {
+		s    string
+		want TimeOfDay
+	}{
+		{"15:04:05", TimeOfDay{15, 4, 5, 0}},
+		{"00:00:00.5", TimeOfDay{0, 0, 0, 5e8}},
+		{"23:59:59.000000001", TimeOfDay{23, 59, 59, 1}},
+	} {
+		got, err := ParseTimeOfDay(test.s)
+		if err != nil || got != test.want {
+			t.Errorf("ParseTimeOfDay(%q) = %v, %v; want %v", test.s, got, err, test.want)
+		}
+		if got.String() != test.s {
+			t.Errorf("String() = %q; want %q", got.String(), test.s)
+		}
+	}
+	for _, s := range []string{"24:00:00", "15:04", "15:04:05Z"} {
+		if _, err := ParseTimeOfDay(s); err == nil {
+			t.Errorf("ParseTimeOfDay(%q) did not fail", s)
+		}
+	}
+}
+
+func TestYearMonth(t *testing.T) {
+	m, err := ParseYearMonth("2024-02")
+	if err != nil || m != (YearMonth{2024, time.February}) || m.String() != "2024-02" {
+		t.Errorf("ParseYearMonth() = %v, %v", m, err)
+	}
+	if _, err := ParseYearMonth("2024-13"); err == nil {
+		t.Error("ParseYearMonth(2024-13) did not fail")
+	}
+}
+
+type values struct {
+	Date  Date         `json:"date"`
+	Time  TimeOfDay    `json:"time"`
+	Month YearMonth    `json:"month"`
+	ByDay map[Date]int `json:"by_day"`
+	Opt   *Date        `json:"opt"`
+}
+
+func TestJSON(t *testing.T) {
+	d := Date{2024, time.January, 2}
+	v := values{
+		Date:  d,
+		Time:  TimeOfDay{8, 30, 0, 0},
+		Month: YearMonth{2024, time.January},
+		ByDay: map[Date]int{d: 1},
+	}
+	want := `{"date":"2024-01-02","time":"08:30:00","month":"2024-01","by_day":{"2024-01-02":1},"opt":null}`
+
+	data, err := json.Marshal(v)
+	if err != nil || string(data) != want {
+		t.Errorf("json.Marshal() = %s, %v; want %s", data, err, want)
+	}
+	var got values
+	if err := json.Unmarshal(data, &got); err != nil || got.Date != v.Date || got.Time != v.Time ||
+		got.Month != v.Month || got.ByDay[d] != 1 {
+		t.Errorf("json.Unmarshal() = %+v, %v; want %+v", got, err, v)
+	}
+
+	if data, err := easyjson.Marshal(d); err != nil || string(data) != `"2024-01-02"` {
+		t.Errorf("easyjson.Marshal() = %s, %v", data, err)
+	}
+	if err := easyjson.Unmarshal([]byte(`null`), &d); err != nil || d != (Date{}) {
+		t.Errorf("easyjson.Unmarshal(null) = %v, %v", d, err)
+	}
+	for _, data := range []string{`"2024-01-32"`, `20240102`} {
+		if err := easyjson.Unmarshal([]byte(data), &d); err == nil {
+			t.Errorf("easyjson.Unmarshal(%s) did not fail", data)
+		}
+	}
+}
@@ -0,0 +1,226 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	jsonPath     = "encoding/json"
+	easyjsonPath = "github.com/mailru/easyjson"
+)
+
+// Analyzer reports the json.Marshal and json.Unmarshal calls on values implementing
+// easyjson.Marshaler and easyjson.Unmarshaler, with fixes calling easyjson.Marshal and
+// easyjson.Unmarshal instead.
+var Analyzer = &analysis.Analyzer{
+	Name: "easyjsonmigrate",
+	Doc:  "report encoding/json calls on types with easyjson code and rewrite them to the easyjson functions",
+	Run:  run,
+}
+
+// migration describes an encoding/json function having an easyjson replacement: the index of
+// its argument that must implement the easyjson interface and the method of the interface.
+type migration struct {
+	arg    int
+	method string
+	param  string
+}
+
+var migrations = map[string]migration{
+	"Marshal":   {arg: 0, method: "MarshalEasyJSON", param: "*github.com/mailru/easyjson/jwriter.Writer"},
+	"Unmarshal": {arg: 1, method: "UnmarshalEasyJSON", param: "*github.com/mailru/easyjson/jlexer.Lexer"},
+}
+
+// call is an encoding/json call that can be migrated.
+type call struct {
+	sel *ast.SelectorExpr
+	typ types.Type
+	fix bool
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	for _, f := range pass.Files {
+		migrateFile(pass, f)
+	}
+	return nil, nil
+}
+
+// migrateFile reports the calls of the file f. The import changes are shared by all the fixes
+// of the file, so they are only attached to the first of them: the fixes are meant to be
+// applied together, e.g. with -fix.
+func migrateFile(pass *analysis.Pass, f *ast.File) {
+	var calls []call
+	jsonUses := 0
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.Ident:
+			if isPkg(pass.TypesInfo.Uses[n], jsonPath) {
+				jsonUses++
+			}
+		case *ast.CallExpr:
+			if c, ok := migratable(pass, n); ok {
+				calls = append(calls, c)
+			}
+		}
+		return true
+	})
+	if len(calls) == 0 {
+		return
+	}
+
+	name, imported := easyjsonName(f)
+	fixes := 0
+	for i := range calls {
+		calls[i].fix = resolves(pass, calls[i].sel.Pos(), name, imported)
+		if calls[i].fix {
+			fixes++
+		}
+	}
+
+	imports := importEdits(pass.Fset, f, imported, fixes == jsonUses)
+	for _, c := range calls {
+		fn := c.sel.Sel.Name
+		d := analysis.Diagnostic{
+			Pos:     c.sel.Pos(),
+			End:     c.sel.End(),
+			Message: fmt.Sprintf("json.%s of %s can be replaced with easyjson.%s", fn, types.TypeString(c.typ, types.RelativeTo(pass.Pkg)), fn),
+		}
+		if c.fix {
+			edits := []analysis.TextEdit{{Pos: c.sel.Pos(), End: c.sel.End(), NewText: []byte(name + "." + fn)}}
+			edits = append(edits, imports...)
+			imports = nil
+			d.SuggestedFixes = []analysis.SuggestedFix{{Message: "Call easyjson." + fn, TextEdits: edits}}
+		}
+		pass.Report(d)
+	}
+}
+
+// migratable tells if the call is an encoding/json call having an easyjson replacement.
+func migratable(pass *analysis.Pass, n *ast.CallExpr) (call, bool) {
+	sel, ok := n.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return call{}, false
+	}
+	fn, ok := pass.TypesInfo.Uses[sel.Sel].(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != jsonPath || fn.Type().(*types.Signature).Recv() != nil {
+		return call{}, false
+	}
+	m, ok := migrations[fn.Name()]
+	if !ok || len(n.Args) <= m.arg || n.Ellipsis.IsValid() {
+		return call{}, false
+	}
+	t := pass.TypesInfo.TypeOf(n.Args[m.arg])
+	if t == nil || !hasMethod(t, m.method, m.param) {
+		return call{}, false
+	}
+	return call{sel: sel, typ: t}, true
+}
+
+// hasMethod tells if the method set of t has the method name taking a single parameter of the
+// type param and returning nothing.
+func hasMethod(t types.Type, name, param string) bool {
+	s := types.NewMethodSet(t).Lookup(nil, name)
+	if s == nil {
+		return false
+	}
+	sig := s.Type().(*types.Signature)
+	return sig.Params().Len() == 1 && sig.Results().Len() == 0 &&
+		types.TypeString(sig.Params().At(0).Type(), nil) == param
+}
+
+// isPkg tells if obj is an imported package with the given path.
+func isPkg(obj types.Object, path string) bool {
+	p, ok := obj.(*types.PkgName)
+	return ok && p.Imported().Path() == path
+}
+
+// easyjsonName returns the name the easyjson package is imported as by the file f, and false
+// if it is not imported yet, so it is going to be imported as easyjson.
+func easyjsonName(f *ast.File) (string, bool) {
+	for _, s := range f.Imports {
+		if path, _ := strconv.Unquote(s.Path.Value); path != easyjsonPath {
+			continue
+		}
+		if s.Name == nil {
+			return "easyjson", true
+		}
+		if s.Name.Name != "_" && s.Name.Name != "." {
+			return s.Name.Name, true
+		}
+	}
+	return "easyjson", false
+}
+
+// resolves tells if name refers to the easyjson package at pos, or would refer to it once
+// imported if it is not imported yet, i.e. nothing else is named so in the scope.
+func resolves(pass *analysis.Pass, pos token.Pos, name string, imported bool) bool {
+	scope := pass.Pkg.Scope().Innermost(pos)
+	if scope == nil {
+		return false
+	}
+	_, obj := scope.LookupParent(name, pos)
+	if !imported {
+		return obj == nil && pass.Pkg.Scope().Lookup(name) == nil
+	}
+	return isPkg(obj, easyjsonPath)
+}
+
+// importEdits returns the edits importing the easyjson package into the file f unless it is
+// imported already, and removing the encoding/json import if the package is not used anymore:
+// then the import is replaced with the easyjson one if there is none.
+func importEdits(fset *token.FileSet, f *ast.File, imported, unusedJSON bool) []analysis.TextEdit {
+	var spec *ast.ImportSpec
+	var decl *ast.GenDecl
+	specs := 0
+	for _, d := range f.Decls {
+		d, ok := d.(*ast.GenDecl)
+		if !ok || d.Tok != token.IMPORT {
+			continue
+		}
+		for _, s := range d.Specs {
+			s := s.(*ast.ImportSpec)
+			if path, _ := strconv.Unquote(s.Path.Value); path == jsonPath {
+				spec, decl = s, d
+				specs++
+			}
+		}
+	}
+	if spec == nil {
+		return nil
+	}
+	// The uses of several imports of the package are not told apart.
+	unusedJSON = unusedJSON && specs == 1
+
+	path := strconv.Quote(easyjsonPath)
+	switch {
+	case imported && unusedJSON:
+		if decl.Lparen.IsValid() {
+			return []analysis.TextEdit{deleteLines(fset, spec.Pos(), spec.End())}
+		}
+		return []analysis.TextEdit{deleteLines(fset, decl.Pos(), decl.End())}
+	case imported:
+		return nil
+	case unusedJSON:
+		return []analysis.TextEdit{{Pos: spec.Pos(), End: spec.End(), NewText: []byte(path)}}
+	case decl.Lparen.IsValid():
+		return []analysis.TextEdit{{Pos: spec.Pos(), End: spec.Pos(), NewText: []byte(path + "\n\t")}}
+	default:
+		return []analysis.TextEdit{{Pos: decl.Pos(), End: decl.Pos(), NewText: []byte("import " + path + "\n")}}
+	}
+}
+
+// deleteLines returns the edit deleting the whole lines from pos to end.
+func deleteLines(fset *token.FileSet, pos, end token.Pos) analysis.TextEdit {
+	f := fset.File(pos)
+	pos = f.LineStart(f.Line(pos))
+	if line := f.Line(end); line < f.LineCount() {
+		end = f.LineStart(line + 1)
+	}
+	return analysis.TextEdit{Pos: pos, End: end}
+}
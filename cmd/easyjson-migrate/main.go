@@ -0,0 +1,18 @@
+// Command easyjson-migrate finds the encoding/json calls on types with easyjson
+// code and rewrites them to the easyjson functions.
+//
+// Without flags it only reports the calls:
+//
+//	easyjson-migrate ./...
+//
+// With -fix the calls are rewritten in place, the easyjson import is added and
+// the encoding/json one removed if it is no longer used:
+//
+//	easyjson-migrate -fix ./...
+package main
+
+import "golang.org/x/tools/go/analysis/singlechecker"
+
+func main() {
+	singlechecker.Main(Analyzer)
+}
@@ -0,0 +1,24 @@
+package a
+
+import (
+	"encoding/json"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+type User struct{ Name string }
+
+func (v User) MarshalEasyJSON(w *jwriter.Writer) {}
+
+func (v *User) UnmarshalEasyJSON(l *jlexer.Lexer) {}
+
+func Roundtrip(u User) (User, error) {
+	data, err := json.Marshal(u) // want `json.Marshal of User can be replaced with easyjson.Marshal`
+	if err != nil {
+		return User{}, err
+	}
+	var got User
+	err = json.Unmarshal(data, &got) // want `json.Unmarshal of \*User can be replaced with easyjson.Unmarshal`
+	return got, err
+}
@@ -0,0 +1,23 @@
+package b
+
+import "encoding/json"
+import "a"
+
+type Plain struct{ Name string }
+
+func Marshal(u *a.User, p Plain) {
+	json.Marshal(u)        // want `json.Marshal of \*a.User can be replaced with easyjson.Marshal`
+	json.Unmarshal(nil, u) // want `json.Unmarshal of \*a.User can be replaced with easyjson.Unmarshal`
+	json.Unmarshal(nil, *u)
+	json.Marshal(p)
+	json.MarshalIndent(u, "", "  ")
+
+	var v interface{} = u
+	json.Marshal(v)
+}
+
+func Shadowed(u a.User) {
+	easyjson := 0
+	json.Marshal(u) // want `json.Marshal of a.User can be replaced with easyjson.Marshal`
+	_ = easyjson
+}
@@ -0,0 +1,19 @@
+package c
+
+import (
+	"encoding/json"
+	"fmt"
+
+	ej "github.com/mailru/easyjson"
+
+	"a"
+)
+
+func Print(u a.User) error {
+	data, err := json.Marshal(u) // want `json.Marshal of a.User can be replaced with easyjson.Marshal`
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(data))
+	return ej.Unmarshal(data, &u)
+}
@@ -0,0 +1,18 @@
+package easyjson
+
+import (
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+type Marshaler interface {
+	MarshalEasyJSON(w *jwriter.Writer)
+}
+
+type Unmarshaler interface {
+	UnmarshalEasyJSON(l *jlexer.Lexer)
+}
+
+func Marshal(v Marshaler) ([]byte, error) { return nil, nil }
+
+func Unmarshal(data []byte, v Unmarshaler) error { return nil }
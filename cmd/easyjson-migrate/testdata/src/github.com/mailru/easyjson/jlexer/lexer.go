@@ -0,0 +1,3 @@
+package jlexer
+
+type Lexer struct{}
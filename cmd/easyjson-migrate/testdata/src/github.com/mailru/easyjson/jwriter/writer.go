@@ -0,0 +1,3 @@
+package jwriter
+
+type Writer struct{}
@@ -0,0 +1,17 @@
+package easyjson
+
+// Codec gives access to marshaling and unmarshaling of a type looked up by its name, e.g. in
+// the registry files generated with the -registry flag.
+type Codec struct {
+	Name string                      // Import path qualified type name, e.g. "example.com/pkg.Type".
+	New  func() MarshalerUnmarshaler // Returns a pointer to a new zero value of the type.
+}
+
+// Unmarshal decodes data into a new value of the type and returns a pointer to it.
+func (c Codec) Unmarshal(data []byte) (MarshalerUnmarshaler, error) {
+	v := c.New()
+	if err := Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
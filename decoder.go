@@ -0,0 +1,75 @@
+package easyjson
+
+import (
+	"io"
+
+	"github.com/mailru/easyjson/jlexer"
+)
+
+// ArrayDecoder unmarshals the elements of a top-level JSON array one at a time, so that large
+// arrays can be processed without keeping all of their elements in memory. The values to
+// unmarshal into are provided by the caller and may be reused between the elements.
+type ArrayDecoder struct {
+	l *jlexer.Lexer
+
+	opened, closed bool
+}
+
+// NewArrayDecoder returns a decoder of the array in the input of l, which has to be positioned
+// at the start of the input. A null input is decoded as an empty array.
+func NewArrayDecoder(l *jlexer.Lexer) *ArrayDecoder {
+	return &ArrayDecoder{l: l}
+}
+
+// More tells if there are elements of the array left to decode. It returns false after the
+// end of the array is reached or an error occurs.
+func (d *ArrayDecoder) More() bool {
+	if !d.opened {
+		d.opened = true
+		if d.l.IsNull() {
+			d.l.Skip()
+			d.close()
+		} else {
+			d.l.Delim('[')
+		}
+	}
+	if d.closed || !d.l.Ok() {
+		return false
+	}
+
+	if d.l.IsDelim(']') {
+		d.l.Delim(']')
+		d.close()
+		return false
+	}
+	return true
+}
+
+// close checks that nothing but whitespace follows the array.
+func (d *ArrayDecoder) close() {
+	d.closed = true
+	d.l.Consumed()
+}
+
+// Decode unmarshals the next element of the array into v. It returns io.EOF if there are no
+// elements left. The observer, if there is one, is notified of each element decoded, with the
+// size of the input consumed since the previous one.
+func (d *ArrayDecoder) Decode(v Unmarshaler) error {
+	o, start := observeStart()
+	pos := d.l.GetPos()
+	if !d.More() {
+		if err := d.l.Error(); err != nil {
+			return err
+		}
+		return io.EOF
+	}
+
+	v.UnmarshalEasyJSON(d.l)
+	d.l.WantComma()
+	return observeUnmarshal(o, start, v, d.l.GetPos()-pos, d.l.Error())
+}
+
+// Err returns the first error that occurred while decoding the array.
+func (d *ArrayDecoder) Err() error {
+	return d.l.Error()
+}
@@ -0,0 +1,59 @@
+package easyjson
+
+import (
+	"io"
+	"testing"
+
+	"github.com/mailru/easyjson/jlexer"
+)
+
+func TestArrayDecoder(t *testing.T) {
+	for _, test := range []struct {
+		In   string
+		Want []string
+		Err  bool
+	}{
+		{In: `[]`},
+		{In: ` null `},
+		{In: `[1, "-2" ,3]`, Want: []string{"1", "-2", "3"}},
+		{In: "[\n4\n]\n", Want: []string{"4"}},
+		{In: `[1, 2`, Want: []string{"1", "2"}, Err: true},
+		{In: `[1 2]`, Want: []string{"1"}, Err: true},
+		{In: `[1, true]`, Want: []string{"1"}, Err: true},
+		{In: `[1] [2]`, Want: []string{"1"}, Err: true},
+		{In: `{}`, Err: true},
+	} {
+		d := NewArrayDecoder(&jlexer.Lexer{Data: []byte(test.In)})
+
+		// The same value is reused for all elements.
+		var v Int128
+		var got []string
+		for d.More() {
+			if err := d.Decode(&v); err != nil {
+				break
+			}
+			got = append(got, v.String())
+		}
+
+		if len(got) != len(test.Want) {
+			t.Errorf("%s: got %v; want %v", test.In, got, test.Want)
+		} else {
+			for i := range got {
+				if got[i] != test.Want[i] {
+					t.Errorf("%s: got %v; want %v", test.In, got, test.Want)
+					break
+				}
+			}
+		}
+
+		err := d.Err()
+		if test.Err != (err != nil) {
+			t.Errorf("%s: Err() = %v; want error %v", test.In, err, test.Err)
+		}
+		if err == nil {
+			if err := d.Decode(&v); err != io.EOF {
+				t.Errorf("%s: Decode() after the end = %v; want io.EOF", test.In, err)
+			}
+		}
+	}
+}
@@ -1,22 +1,25 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
 
 	"github.com/mailru/easyjson/bootstrap"
-	// Reference the gen package to be friendly to vendoring tools,
-	// as it is an indirect dependency.
-	// (The temporary bootstrapping code uses it.)
-	_ "github.com/mailru/easyjson/gen"
+	// The gen package is also an indirect dependency, as the temporary bootstrapping code
+	// uses it.
+	"github.com/mailru/easyjson/gen"
 	"github.com/mailru/easyjson/parser"
 )
 
 var buildTags = flag.String("build_tags", "", "build tags to add to generated file")
+var headerFile = flag.String("header_file", "", "file with comments to put at the top of generated files, e.g. a license header")
+var footerFile = flag.String("footer_file", "", "file with code to append to generated files")
 var genBuildFlags = flag.String("gen_build_flags", "", "build flags when running the generator while bootstrapping")
 var snakeCase = flag.Bool("snake_case", false, "use snake_case names instead of CamelCase by default")
 var lowerCamelCase = flag.Bool("lower_camel_case", false, "use lowerCamelCase names instead of CamelCase by default")
@@ -28,19 +31,66 @@ var leaveTemps = flag.Bool("leave_temps", false, "do not delete temporary files"
 var stubs = flag.Bool("stubs", false, "only generate stubs for marshaler/unmarshaler funcs")
 var noformat = flag.Bool("noformat", false, "do not run 'gofmt -w' on output file")
 var specifiedName = flag.String("output_filename", "", "specify the filename of the output")
+var toStdout = flag.Bool("stdout", false, "print the generated code to stdout instead of writing the output file")
+var showDiff = flag.Bool("diff", false, "print a unified diff against the existing output file instead of writing it")
+var keepNewer = flag.Bool("keep_newer", false, "fail instead of overwriting an output file newer than the input files")
 var processPkg = flag.Bool("pkg", false, "process the whole package instead of just the given file")
 var disallowUnknownFields = flag.Bool("disallow_unknown_fields", false, "return error if any unknown field in json appeared")
 var skipMemberNameUnescaping = flag.Bool("disable_members_unescape", false, "don't perform unescaping of member names to improve performance")
+var stdlibCompat = flag.Bool("stdlib_compat", false, "generate code that marshals and unmarshals exactly like encoding/json")
+var taggedOnly = flag.Bool("tagged_only", false, "skip the fields without json tags instead of marshaling them under their Go names")
+var foldKeys = flag.Bool("fold_keys", false, "match member names to field names case-insensitively if there is no exact match, like encoding/json")
+var decimals = flag.Bool("decimal", false, "marshal and unmarshal shopspring decimal.Decimal values directly instead of with their MarshalJSON and UnmarshalJSON methods")
+var knownTypes = flag.Bool("known_types", false, "marshal and unmarshal the known types, e.g. google/uuid.UUID, directly instead of with their MarshalText and UnmarshalText methods")
+var noSQLNull = flag.Bool("no_sql_null", false, "marshal and unmarshal database/sql Null values, e.g. sql.NullString, as objects of their fields instead of as their values or null")
+var typeInfo = flag.Bool("field_info", false, "generate field metadata of structs registered with easyjson.RegisterTypeInfo")
+var metrics = flag.Bool("metrics", false, "add comments with per-type metrics of the generated code: lines, dispatch switch cases and fallback fields")
+var validators = flag.Bool("validate", false, "generate ValidateEasyJSON methods checking the input without building Go values")
+var nilGuards = flag.Bool("nil_guards", false, "generate MarshalEasyJSON methods writing null for nil pointers and unmarshaling methods failing on them instead of panicking")
+var slabAlloc = flag.Int("slab_alloc", 0, "allocate the elements of decoded slices of pointers in blocks of up to the given number of elements, 0 means one by one")
+var gojayAdapters = flag.Bool("gojay", false, "generate methods satisfying gojay object marshaler/unmarshaler interfaces")
+var batch = flag.Bool("batch", false, "generate the code of all the given files and packages with a single bootstrapping program instead of one per file")
+var timeout = flag.Duration("timeout", 0, "give up on running the generator while bootstrapping after the given time, 0 means no limit")
+var standalone = flag.Bool("standalone", false, "generate code not depending on easyjson, with a copy of its runtime in the internal/easyjson directory")
+var protobuf = flag.Bool("protobuf", false, "follow the conventions of protoc-gen-go structs: skip XXX_ fields, use protojson names, marshal oneof fields and well-known types")
+var forceOverride = flag.Bool("force_override", false, "keep hand-written marshaling methods conflicting with the generated ones, delegating to them")
+var registryName = flag.String("registry", "", "write a registry of the codecs of all generated types to the given file")
+var emitFlag = flag.String("emit", "", "also write artifacts of the generated types, as comma-separated kind=file pairs with the kinds schema and fixtures")
 
-func generate(fname string) (err error) {
+// registry collects the generated types if -registry is set.
+var registry *bootstrap.Registry
+
+// emits are the artifacts requested by -emit.
+var emits []bootstrap.Emit
+
+// parseEmits parses the kind=file pairs of the -emit flag.
+func parseEmits(s string) ([]bootstrap.Emit, error) {
+	var ret []bootstrap.Emit
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		i := strings.Index(pair, "=")
+		if i <= 0 || i == len(pair)-1 {
+			return nil, fmt.Errorf("invalid -emit %q: want kind=file", pair)
+		}
+		kind, name := pair[:i], pair[i+1:]
+		if _, err := gen.NewEmitter(kind); err != nil {
+			return nil, fmt.Errorf("invalid -emit %q: %v", pair, err)
+		}
+		ret = append(ret, bootstrap.Emit{Emitter: kind, OutName: name})
+	}
+	return ret, nil
+}
+
+// newGenerator returns the bootstrap generator of the file or the package directory fname.
+func newGenerator(fname string) (*bootstrap.Generator, error) {
 	fInfo, err := os.Stat(fname)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	p := parser.Parser{AllStructs: *allStructs}
+	p := parser.Parser{AllStructs: *allStructs, Protobuf: *protobuf}
 	if err := p.Parse(fname, fInfo.IsDir()); err != nil {
-		return fmt.Errorf("Error parsing %v: %v", fname, err)
+		return nil, fmt.Errorf("Error parsing %v: %v", fname, err)
 	}
 
 	var outName string
@@ -48,7 +98,7 @@ func generate(fname string) (err error) {
 		outName = filepath.Join(fname, p.PkgName+"_easyjson.go")
 	} else {
 		if s := strings.TrimSuffix(fname, ".go"); s == fname {
-			return errors.New("Filename must end in '.go'")
+			return nil, errors.New("Filename must end in '.go'")
 		} else {
 			outName = s + "_easyjson.go"
 		}
@@ -58,6 +108,13 @@ func generate(fname string) (err error) {
 		outName = *specifiedName
 	}
 
+	sources := []string{fname}
+	if fInfo.IsDir() {
+		if sources, err = packageSources(fname, outName); err != nil {
+			return nil, err
+		}
+	}
+
 	var trimmedBuildTags string
 	if *buildTags != "" {
 		trimmedBuildTags = strings.TrimSpace(*buildTags)
@@ -68,31 +125,170 @@ func generate(fname string) (err error) {
 		trimmedGenBuildFlags = strings.TrimSpace(*genBuildFlags)
 	}
 
-	g := bootstrap.Generator{
+	var header, footer []byte
+	if *headerFile != "" {
+		if header, err = ioutil.ReadFile(*headerFile); err != nil {
+			return nil, err
+		}
+	}
+	if *footerFile != "" {
+		if footer, err = ioutil.ReadFile(*footerFile); err != nil {
+			return nil, err
+		}
+	}
+
+	g := &bootstrap.Generator{
 		BuildTags:                trimmedBuildTags,
 		GenBuildFlags:            trimmedGenBuildFlags,
+		Header:                   string(header),
+		Footer:                   string(footer),
 		PkgPath:                  p.PkgPath,
 		PkgName:                  p.PkgName,
 		Types:                    p.StructNames,
+		Enums:                    p.Enums,
+		TypeParams:               p.TypeParams,
+		Instances:                p.Instances,
+		Docs:                     p.Docs,
 		SnakeCase:                *snakeCase,
 		LowerCamelCase:           *lowerCamelCase,
 		NoStdMarshalers:          *noStdMarshalers,
 		DisallowUnknownFields:    *disallowUnknownFields,
 		SkipMemberNameUnescaping: *skipMemberNameUnescaping,
+		StdlibCompat:             *stdlibCompat,
+		TaggedOnly:               *taggedOnly,
+		FoldKeys:                 *foldKeys,
+		Decimals:                 *decimals,
+		KnownTypes:               *knownTypes,
+		NoSQLNull:                *noSQLNull,
+		TypeInfo:                 *typeInfo,
+		GojayAdapters:            *gojayAdapters,
+		Metrics:                  *metrics,
+		Validators:               *validators,
+		NilGuards:                *nilGuards,
+		SlabAlloc:                *slabAlloc,
+		Standalone:               *standalone,
+		Protobuf:                 *protobuf,
+		OneofWrappers:            p.OneofWrappers,
+		Methods:                  p.Methods,
+		ForceOverride:            *forceOverride,
 		OmitEmpty:                *omitEmpty,
 		LeaveTemps:               *leaveTemps,
 		OutName:                  outName,
 		StubsOnly:                *stubs,
 		NoFormat:                 *noformat,
 		SimpleBytes:              *simpleBytes,
+		Diff:                     *showDiff,
+		KeepNewer:                *keepNewer,
+		Sources:                  sources,
+		Emits:                    emits,
 	}
+	if *toStdout || *showDiff {
+		g.Output = os.Stdout
+	}
+	return g, nil
+}
 
-	if err := g.Run(); err != nil {
+// runContext returns the context limiting the run of the generators by -timeout.
+func runContext() (context.Context, context.CancelFunc) {
+	if *timeout > 0 {
+		return context.WithTimeout(context.Background(), *timeout)
+	}
+	return context.WithCancel(context.Background())
+}
+
+func generate(fname string) error {
+	g, err := newGenerator(fname)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := runContext()
+	defer cancel()
+	if err := g.RunContext(ctx); err != nil {
+		return fmt.Errorf("Bootstrap failed: %v", err)
+	}
+	if registry != nil {
+		registry.AddGenerated(g)
+	}
+	return nil
+}
+
+// generateBatch generates the code of all the files with a single bootstrapping program.
+func generateBatch(fnames []string) error {
+	gens := make([]*bootstrap.Generator, len(fnames))
+	for i, fname := range fnames {
+		var err error
+		if gens[i], err = newGenerator(fname); err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := runContext()
+	defer cancel()
+	if err := bootstrap.RunBatch(ctx, gens); err != nil {
 		return fmt.Errorf("Bootstrap failed: %v", err)
 	}
+	if registry != nil {
+		for _, g := range gens {
+			registry.AddGenerated(g)
+		}
+	}
 	return nil
 }
 
+// newRegistry returns the registry written into the file name, of the package in its
+// directory or, if there is none yet, of the package named after the directory.
+func newRegistry(name string) (*bootstrap.Registry, error) {
+	if *standalone {
+		return nil, errors.New("-registry can not be used with -standalone")
+	}
+	if !strings.HasSuffix(name, ".go") {
+		return nil, errors.New("Registry filename must end in '.go'")
+	}
+
+	dir := filepath.Dir(name)
+	p := parser.Parser{}
+	if err := p.Parse(dir, true); err != nil {
+		return nil, fmt.Errorf("Error parsing %v: %v", dir, err)
+	}
+	if p.PkgName == "" {
+		abs, err := filepath.Abs(dir)
+		if err != nil {
+			return nil, err
+		}
+		p.PkgName = filepath.Base(abs)
+	}
+
+	r := &bootstrap.Registry{
+		PkgPath:   p.PkgPath,
+		PkgName:   p.PkgName,
+		OutName:   name,
+		BuildTags: strings.TrimSpace(*buildTags),
+		Diff:      *showDiff,
+	}
+	if *toStdout || *showDiff {
+		r.Output = os.Stdout
+	}
+	return r, nil
+}
+
+// packageSources returns the .go files of the package in dir, leaving out tests and
+// generated files.
+func packageSources(dir, outName string) ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	var sources []string
+	for _, f := range files {
+		if f != filepath.Clean(outName) && !strings.HasSuffix(f, "_test.go") && !strings.HasSuffix(f, "_easyjson.go") {
+			sources = append(sources, f)
+		}
+	}
+	return sources, nil
+}
+
 func main() {
 	flag.Parse()
 
@@ -110,8 +306,42 @@ func main() {
 		os.Exit(1)
 	}
 
-	for _, fname := range files {
-		if err := generate(fname); err != nil {
+	if *emitFlag != "" {
+		if len(files) > 1 {
+			fmt.Fprintln(os.Stderr, "-emit can only be used with a single file or package")
+			os.Exit(1)
+		}
+		var err error
+		if emits, err = parseEmits(*emitFlag); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+
+	if *registryName != "" {
+		var err error
+		if registry, err = newRegistry(*registryName); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+
+	if *batch {
+		if err := generateBatch(files); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	} else {
+		for _, fname := range files {
+			if err := generate(fname); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+		}
+	}
+
+	if registry != nil {
+		if err := registry.Write(); err != nil {
 			fmt.Fprintln(os.Stderr, err)
 			os.Exit(1)
 		}
@@ -0,0 +1,111 @@
+package easyjson
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/mailru/easyjson/jwriter"
+)
+
+// Encoder writes a stream of JSON values to an output.
+type Encoder struct {
+	w io.Writer
+
+	newline         bool
+	itemSep, keySep string
+}
+
+// NewEncoder returns an encoder writing to w.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: w, itemSep: ",", keySep: ":"}
+}
+
+// SetTrailingNewline makes the encoder write '\n' after each value, as newline-delimited
+// JSON and many log collectors require.
+func (e *Encoder) SetTrailingNewline(on bool) {
+	e.newline = on
+}
+
+// SetSeparators sets the strings written between the elements of arrays and objects and
+// between the keys and the values of objects, e.g. ", " and ": ". The separators must consist
+// of a single ',' and ':' respectively, optionally surrounded by whitespace. Values using
+// other than the default separators are rewritten as they are written out.
+func (e *Encoder) SetSeparators(item, key string) {
+	e.itemSep, e.keySep = item, key
+}
+
+// Encode writes the JSON encoding of v to the output.
+func (e *Encoder) Encode(v Marshaler) error {
+	if !isSeparator(e.itemSep, ',') || !isSeparator(e.keySep, ':') {
+		return errors.New("easyjson: invalid separators")
+	}
+
+	w := jwriter.Writer{}
+	if isNilInterface(v) {
+		w.RawString("null")
+	} else {
+		v.MarshalEasyJSON(&w)
+	}
+	if w.Error != nil {
+		return w.Error
+	}
+	if e.newline {
+		w.RawByte('\n')
+	}
+
+	if e.itemSep != "," || e.keySep != ":" {
+		w.SetTransform(func(out io.Writer) io.WriteCloser {
+			return &separatorWriter{w: out, itemSep: e.itemSep, keySep: e.keySep}
+		})
+	}
+	_, err := w.DumpTo(e.w)
+	return err
+}
+
+// isSeparator tells if s is the character c, optionally surrounded by whitespace.
+func isSeparator(s string, c byte) bool {
+	s = strings.Trim(s, " \t\r\n")
+	return len(s) == 1 && s[0] == c
+}
+
+// separatorWriter replaces the separators outside of strings in the data written to it.
+// The data may be written in chunks splitting strings at arbitrary points.
+type separatorWriter struct {
+	w               io.Writer
+	itemSep, keySep string
+
+	inString, escaped bool
+	buf               []byte
+}
+
+func (s *separatorWriter) Write(p []byte) (int, error) {
+	s.buf = s.buf[:0]
+	for _, c := range p {
+		switch {
+		case s.escaped:
+			s.escaped = false
+		case s.inString:
+			s.escaped = c == '\\'
+			s.inString = c != '"'
+		case c == '"':
+			s.inString = true
+		case c == ',':
+			s.buf = append(s.buf, s.itemSep...)
+			continue
+		case c == ':':
+			s.buf = append(s.buf, s.keySep...)
+			continue
+		}
+		s.buf = append(s.buf, c)
+	}
+
+	if _, err := s.w.Write(s.buf); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (s *separatorWriter) Close() error {
+	return nil
+}
@@ -0,0 +1,66 @@
+package easyjson
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestEncoder(t *testing.T) {
+	raw := RawMessage(`{"a":"x,y:\"z\"","b":[1,2]}`)
+	values := []Marshaler{
+		&raw,
+		Int128From64(-1),
+		nil,
+	}
+
+	for _, test := range []struct {
+		Newline         bool
+		ItemSep, KeySep string
+		Want            string
+	}{
+		{false, ",", ":", `{"a":"x,y:\"z\"","b":[1,2]}"-1"null`},
+		{true, ",", ":", "{\"a\":\"x,y:\\\"z\\\"\",\"b\":[1,2]}\n\"-1\"\nnull\n"},
+		{false, ", ", ": ", `{"a": "x,y:\"z\"", "b": [1, 2]}"-1"null`},
+		{true, " ,\n", "\t:", "{\"a\"\t:\"x,y:\\\"z\\\"\" ,\n\"b\"\t:[1 ,\n2]}\n\"-1\"\nnull\n"},
+	} {
+		var out bytes.Buffer
+		e := NewEncoder(&out)
+		e.SetTrailingNewline(test.Newline)
+		e.SetSeparators(test.ItemSep, test.KeySep)
+		for _, v := range values {
+			if err := e.Encode(v); err != nil {
+				t.Errorf("Encode(%v) error: %v", v, err)
+			}
+		}
+		if got := out.String(); got != test.Want {
+			t.Errorf("newline %v, separators %q %q: got %q; want %q", test.Newline, test.ItemSep, test.KeySep, got, test.Want)
+		}
+	}
+}
+
+func TestEncoderLongStrings(t *testing.T) {
+	// The strings span several chunks, so the writer has to keep the state between them.
+	s := strings.Repeat(`,:\"`, 20000)
+	v := RawMessage(`["` + s + `","` + s + `"]`)
+
+	var out bytes.Buffer
+	e := NewEncoder(&out)
+	e.SetSeparators(", ", ":")
+	if err := e.Encode(&v); err != nil {
+		t.Fatal(err)
+	}
+	if want := `["` + s + `", "` + s + `"]`; out.String() != want {
+		t.Errorf("separators are replaced inside of strings")
+	}
+}
+
+func TestEncoderInvalidSeparators(t *testing.T) {
+	for _, seps := range [][2]string{{"", ":"}, {",", ";"}, {",,", ":"}, {" x,", ":"}} {
+		e := NewEncoder(&bytes.Buffer{})
+		e.SetSeparators(seps[0], seps[1])
+		if err := e.Encode(Int128{}); err == nil {
+			t.Errorf("Encode() with separators %q succeeded", seps)
+		}
+	}
+}
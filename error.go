@@ -0,0 +1,12 @@
+package easyjson
+
+// Error is an error unmarshaled into a field of type error, holding the message of the
+// marshaled error.
+type Error struct {
+	Message string
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	return e.Message
+}
@@ -0,0 +1,45 @@
+package gen
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+)
+
+// Names of the jwriter.Writer and jlexer.Lexer methods of the math/big types.
+var bigTypes = map[reflect.Type]string{
+	reflect.TypeOf(big.Int{}):   "BigInt",
+	reflect.TypeOf(big.Float{}): "BigFloat",
+	reflect.TypeOf(big.Rat{}):   "BigRat",
+}
+
+// isBigType returns true if the type of a field is big.Int, big.Float, big.Rat or a pointer to
+// them, which the easyjson 'number' directive applies to.
+func isBigType(t reflect.Type) bool {
+	if t.Name() == "" && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return bigTypes[t] != ""
+}
+
+// genBigEncoder generates code that encodes in of a math/big type t. Integers are marshaled as
+// numbers and the other types as strings by default, like their MarshalJSON and MarshalText
+// methods do it, unless the 'string' option or the easyjson 'number' directive is set.
+func (g *Generator) genBigEncoder(t reflect.Type, in string, tags fieldTags, indent int) {
+	ws := strings.Repeat("  ", indent)
+
+	enc := bigTypes[t]
+	if tags.asString || (enc != "BigInt" && !tags.asNumber) {
+		enc += "Str"
+	}
+	fmt.Fprintln(g.out, ws+"out."+enc+"(&"+in+")")
+}
+
+// genBigDecoder generates code that decodes out of a math/big type t from either a number or a
+// string, leaving it unchanged on null.
+func (g *Generator) genBigDecoder(t reflect.Type, out string, indent int) {
+	ws := strings.Repeat("  ", indent)
+
+	fmt.Fprintln(g.out, ws+"in."+bigTypes[t]+"(&"+out+")")
+}
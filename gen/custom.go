@@ -0,0 +1,36 @@
+package gen
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// isIdentifier returns true if s is a Go identifier, e.g. the name of a function given with the
+// easyjson 'custom' directive.
+func isIdentifier(s string) bool {
+	for i, c := range s {
+		if !unicode.IsLetter(c) && c != '_' && (i == 0 || !unicode.IsDigit(c)) {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// genCustomEncoder generates code that encodes in with the function of the package named by the
+// easyjson 'custom' directive, taking the writer and the value.
+func (g *Generator) genCustomEncoder(in string, tags fieldTags, indent int) {
+	ws := strings.Repeat("  ", indent)
+	fmt.Fprintln(g.out, ws+tags.customEncoder+"(out, "+in+")")
+}
+
+// genCustomDecoder generates code that decodes out with the function of the package named by the
+// easyjson 'custom' directive, taking the lexer and returning a pointer to the value, or nil to
+// leave out unchanged.
+func (g *Generator) genCustomDecoder(out string, tags fieldTags, indent int) {
+	ws := strings.Repeat("  ", indent)
+	tmpVar := g.uniqueVarName()
+	fmt.Fprintln(g.out, ws+"if "+tmpVar+" := "+tags.customDecoder+"(in); "+tmpVar+" != nil {")
+	fmt.Fprintln(g.out, ws+"  "+out+" = *"+tmpVar)
+	fmt.Fprintln(g.out, ws+"}")
+}
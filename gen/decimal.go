@@ -0,0 +1,49 @@
+package gen
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// decimalPkgPath is the package of the decimal.Decimal type marshaled directly with the
+// Decimals option.
+const decimalPkgPath = "github.com/shopspring/decimal"
+
+// isDecimalType returns true if t is decimal.Decimal or a pointer to it, which the easyjson
+// 'number' directive applies to.
+func isDecimalType(t reflect.Type) bool {
+	if t.Name() == "" && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct && t.Name() == "Decimal" && fixPkgPathVendoring(t.PkgPath()) == decimalPkgPath
+}
+
+// genDecimalEncoder generates code that encodes in of type decimal.Decimal as a string, like its
+// MarshalJSON method does it by default, or as a number with the easyjson 'number' directive.
+func (g *Generator) genDecimalEncoder(in string, tags fieldTags, indent int) {
+	ws := strings.Repeat("  ", indent)
+
+	if tags.asNumber {
+		fmt.Fprintln(g.out, ws+"out.RawString(("+in+").String())")
+	} else {
+		fmt.Fprintln(g.out, ws+"out.String(("+in+").String())")
+	}
+}
+
+// genDecimalDecoder generates code that decodes out of type t, decimal.Decimal, from either a
+// number or a string, leaving it unchanged on null and failing on an empty string like its
+// UnmarshalJSON method.
+func (g *Generator) genDecimalDecoder(t reflect.Type, out string, indent int) {
+	ws := strings.Repeat("  ", indent)
+	decVar := g.uniqueVarName()
+	errVar := g.uniqueVarName()
+
+	fmt.Fprintln(g.out, ws+"if in.IsNull() {")
+	fmt.Fprintln(g.out, ws+"  in.Skip()")
+	fmt.Fprintln(g.out, ws+"} else if "+decVar+", "+errVar+" := "+g.pkgAlias(t.PkgPath())+".NewFromString(string(in.JsonNumber())); "+errVar+" != nil {")
+	fmt.Fprintln(g.out, ws+"  in.AddError("+errVar+")")
+	fmt.Fprintln(g.out, ws+"} else {")
+	fmt.Fprintln(g.out, ws+"  "+out+" = "+decVar)
+	fmt.Fprintln(g.out, ws+"}")
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 	"unicode"
 
@@ -38,6 +39,7 @@ var primitiveDecoders = map[reflect.Kind]string{
 
 var primitiveStringDecoders = map[reflect.Kind]string{
 	reflect.String:  "in.String()",
+	reflect.Bool:    "in.BoolStr()",
 	reflect.Int:     "in.IntStr()",
 	reflect.Int8:    "in.Int8Str()",
 	reflect.Int16:   "in.Int16Str()",
@@ -61,8 +63,48 @@ var customDecoders = map[string]string{
 func (g *Generator) genTypeDecoder(t reflect.Type, out string, tags fieldTags, indent int) error {
 	ws := strings.Repeat("  ", indent)
 
+	if tags.unknown != "" && g.enums[t] != nil {
+		return g.genEnumFallbackDecoder(t, out, tags.unknown, indent)
+	}
+	if t == timeType && (tags.timeLayout != "" || tags.timeFormat != "") {
+		g.genTimeDecoder(out, tags, indent)
+		return nil
+	}
+	if bigTypes[t] != "" {
+		g.genBigDecoder(t, out, indent)
+		return nil
+	}
+	if g.decimals && t.Kind() == reflect.Struct && isDecimalType(t) {
+		g.genDecimalDecoder(t, out, indent)
+		return nil
+	}
+	if ipTypes[t] != "" {
+		g.genIPDecoder(t, out, indent)
+		return nil
+	}
+	if k := knownTypeOf(t); g.knownTypes && k != nil {
+		g.genKnownDecoder(k, out, indent)
+		return nil
+	}
+	if g.sqlNulls() && isSQLNullType(t) {
+		return g.genSQLNullDecoder(t, out, tags, indent)
+	}
+	if g.protoWellKnown(t) {
+		return g.genProtoWellKnownDecoder(t, out, indent)
+	}
+
+	if g.callsGenerated(t) {
+		dec := g.getDecoderName(t)
+		if len(out) > 0 && out[0] == '*' {
+			fmt.Fprintln(g.out, ws+dec+"(in, "+out[1:]+")")
+		} else {
+			fmt.Fprintln(g.out, ws+dec+"(in, &"+out+")")
+		}
+		return nil
+	}
+
 	unmarshalerIface := reflect.TypeOf((*easyjson.Unmarshaler)(nil)).Elem()
-	if reflect.PtrTo(t).Implements(unmarshalerIface) {
+	if !g.standalone && reflect.PtrTo(t).Implements(unmarshalerIface) {
 		fmt.Fprintln(g.out, ws+"("+out+").UnmarshalEasyJSON(in)")
 		return nil
 	}
@@ -70,7 +112,7 @@ func (g *Generator) genTypeDecoder(t reflect.Type, out string, tags fieldTags, i
 	unmarshalerIface = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
 	if reflect.PtrTo(t).Implements(unmarshalerIface) {
 		fmt.Fprintln(g.out, ws+"if data := in.Raw(); in.Ok() {")
-		fmt.Fprintln(g.out, ws+"  in.AddError( ("+out+").UnmarshalJSON(data) )")
+		fmt.Fprintln(g.out, ws+"  in.AddValueError( ("+out+").UnmarshalJSON(data) )")
 		fmt.Fprintln(g.out, ws+"}")
 		return nil
 	}
@@ -78,7 +120,7 @@ func (g *Generator) genTypeDecoder(t reflect.Type, out string, tags fieldTags, i
 	unmarshalerIface = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
 	if reflect.PtrTo(t).Implements(unmarshalerIface) {
 		fmt.Fprintln(g.out, ws+"if data := in.UnsafeBytes(); in.Ok() {")
-		fmt.Fprintln(g.out, ws+"  in.AddError( ("+out+").UnmarshalText(data) )")
+		fmt.Fprintln(g.out, ws+"  in.AddValueError( ("+out+").UnmarshalText(data) )")
 		fmt.Fprintln(g.out, ws+"}")
 		return nil
 	}
@@ -108,17 +150,52 @@ func hasUnknownsMarshaler(t reflect.Type) bool {
 // genTypeDecoderNoCheck generates decoding code for the type t.
 func (g *Generator) genTypeDecoderNoCheck(t reflect.Type, out string, tags fieldTags, indent int) error {
 	ws := strings.Repeat("  ", indent)
+	if g.isTypeParam(t) {
+		g.genTypeParamDecoder(out, indent)
+		return nil
+	}
+	if t == errorType {
+		g.genErrorDecoder(out, tags, indent)
+		return nil
+	}
+
+	if tags.hex && isHexType(t) && t.Kind() != reflect.Ptr {
+		switch t.Kind() {
+		case reflect.Slice:
+			fmt.Fprintln(g.out, ws+"if in.IsNull() {")
+			fmt.Fprintln(g.out, ws+"  in.Skip()")
+			fmt.Fprintln(g.out, ws+"  "+out+" = nil")
+			fmt.Fprintln(g.out, ws+"} else {")
+			fmt.Fprintln(g.out, ws+"  "+out+" = "+g.getType(t)+"(in.BytesHex())")
+			fmt.Fprintln(g.out, ws+"}")
+		case reflect.Array:
+			fmt.Fprintln(g.out, ws+"if in.IsNull() {")
+			fmt.Fprintln(g.out, ws+"  in.Skip()")
+			fmt.Fprintln(g.out, ws+"} else {")
+			fmt.Fprintln(g.out, ws+"  in.BytesHexInto(("+out+")[:])")
+			fmt.Fprintln(g.out, ws+"}")
+		default:
+			fmt.Fprintln(g.out, ws+out+" = "+g.getType(t)+"(in.Uint64Hex())")
+		}
+		return nil
+	}
 	// Check whether type is primitive, needs to be done after interface check.
 	if dec := customDecoders[t.String()]; dec != "" {
 		fmt.Fprintln(g.out, ws+out+" = "+dec)
 		return nil
 	} else if dec := primitiveStringDecoders[t.Kind()]; dec != "" && tags.asString {
+		if g.stdlibCompat && t.Kind() == reflect.String {
+			return fmt.Errorf("the 'string' option for string type %v is not supported in stdlib-compat mode", t)
+		}
 		if tags.intern && t.Kind() == reflect.String {
 			dec = "in.StringIntern()"
 		}
 		fmt.Fprintln(g.out, ws+out+" = "+g.getType(t)+"("+dec+")")
 		return nil
 	} else if dec := primitiveDecoders[t.Kind()]; dec != "" {
+		if g.stdlibCompat && t.Kind() == reflect.String {
+			dec = "in.StringCompat()"
+		}
 		if tags.intern && t.Kind() == reflect.String {
 			dec = "in.StringIntern()"
 		}
@@ -134,12 +211,14 @@ func (g *Generator) genTypeDecoderNoCheck(t reflect.Type, out string, tags field
 		tmpVar := g.uniqueVarName()
 		elem := t.Elem()
 
-		if elem.Kind() == reflect.Uint8 && elem.Name() == "uint8" {
+		if elem.Kind() == reflect.Uint8 && elem.Name() == "uint8" && tags.bytesFormat != bytesFormatArray {
 			fmt.Fprintln(g.out, ws+"if in.IsNull() {")
 			fmt.Fprintln(g.out, ws+"  in.Skip()")
 			fmt.Fprintln(g.out, ws+"  "+out+" = nil")
 			fmt.Fprintln(g.out, ws+"} else {")
-			if g.simpleBytes {
+			if dec := bytesDecoders[tags.bytesFormat]; dec != "" {
+				fmt.Fprintln(g.out, ws+"  "+out+" = "+dec)
+			} else if g.simpleBytes && !g.stdlibCompat {
 				fmt.Fprintln(g.out, ws+"  "+out+" = []byte(in.String())")
 			} else {
 				fmt.Fprintln(g.out, ws+"  "+out+" = in.Bytes()")
@@ -157,6 +236,11 @@ func (g *Generator) genTypeDecoderNoCheck(t reflect.Type, out string, tags field
 			fmt.Fprintln(g.out, ws+"if in.IsNull() {")
 			fmt.Fprintln(g.out, ws+"  in.Skip()")
 			fmt.Fprintln(g.out, ws+"  "+out+" = nil")
+			// a value of another type is skipped, keeping the slice; with UseMultipleErrors the
+			// failed Delim leaves a closing delimiter in its place
+			fmt.Fprintln(g.out, ws+"} else if !in.IsDelim('[') {")
+			fmt.Fprintln(g.out, ws+"  in.Delim('[')")
+			fmt.Fprintln(g.out, ws+"  in.Delim(']')")
 			fmt.Fprintln(g.out, ws+"} else {")
 			fmt.Fprintln(g.out, ws+"  in.Delim('[')")
 			fmt.Fprintln(g.out, ws+"  if "+out+" == nil {")
@@ -168,8 +252,37 @@ func (g *Generator) genTypeDecoderNoCheck(t reflect.Type, out string, tags field
 			fmt.Fprintln(g.out, ws+"  } else { ")
 			fmt.Fprintln(g.out, ws+"    "+out+" = ("+out+")[:0]")
 			fmt.Fprintln(g.out, ws+"  }")
+			slabVar := ""
+			if g.slabSize > 0 && elem.Kind() == reflect.Ptr {
+				slabVar = g.uniqueVarName()
+				fmt.Fprintln(g.out, ws+"  var "+slabVar+" []"+g.getType(elem.Elem()))
+			}
 			fmt.Fprintln(g.out, ws+"  for !in.IsDelim(']') {")
 			fmt.Fprintln(g.out, ws+"    var "+tmpVar+" "+g.getType(elem))
+			if g.stdlibCompat {
+				// encoding/json decodes into the elements that are already allocated
+				fmt.Fprintln(g.out, ws+"    if len("+out+") < cap("+out+") {")
+				fmt.Fprintln(g.out, ws+"      "+tmpVar+" = ("+out+")[:len("+out+")+1][len("+out+")]")
+				fmt.Fprintln(g.out, ws+"    }")
+			}
+			if slabVar != "" {
+				// the slabs grow with the number of decoded elements, so short slices do not
+				// allocate whole slabs
+				cond := "!in.IsNull()"
+				if g.stdlibCompat {
+					cond = tmpVar + " == nil && " + cond
+				}
+				fmt.Fprintln(g.out, ws+"    if "+cond+" {")
+				fmt.Fprintln(g.out, ws+"      if len("+slabVar+") == 0 {")
+				fmt.Fprintln(g.out, ws+"        n := len("+out+") + 1")
+				fmt.Fprintln(g.out, ws+"        if n > "+fmt.Sprint(g.slabSize)+" {")
+				fmt.Fprintln(g.out, ws+"          n = "+fmt.Sprint(g.slabSize))
+				fmt.Fprintln(g.out, ws+"        }")
+				fmt.Fprintln(g.out, ws+"        "+slabVar+" = make([]"+g.getType(elem.Elem())+", n)")
+				fmt.Fprintln(g.out, ws+"      }")
+				fmt.Fprintln(g.out, ws+"      "+tmpVar+", "+slabVar+" = &"+slabVar+"[0], "+slabVar+"[1:]")
+				fmt.Fprintln(g.out, ws+"    }")
+			}
 
 			if err := g.genTypeDecoder(elem, tmpVar, tags, indent+2); err != nil {
 				return err
@@ -186,11 +299,18 @@ func (g *Generator) genTypeDecoderNoCheck(t reflect.Type, out string, tags field
 		iterVar := g.uniqueVarName()
 		elem := t.Elem()
 
-		if elem.Kind() == reflect.Uint8 && elem.Name() == "uint8" {
+		if dec := bytesDecoders[tags.bytesFormat]; elem.Kind() == reflect.Uint8 && elem.Name() == "uint8" &&
+			(dec != "" || !g.stdlibCompat && tags.bytesFormat != bytesFormatArray) {
+			if dec == "" {
+				dec = "in.Bytes()"
+			}
+			// the elements missing in shorter strings are zeroed like the ones of arrays
 			fmt.Fprintln(g.out, ws+"if in.IsNull() {")
 			fmt.Fprintln(g.out, ws+"  in.Skip()")
 			fmt.Fprintln(g.out, ws+"} else {")
-			fmt.Fprintln(g.out, ws+"  copy("+out+"[:], in.Bytes())")
+			fmt.Fprintln(g.out, ws+"  for "+iterVar+" := copy(("+out+")[:], "+dec+"); "+iterVar+" < "+fmt.Sprint(t.Len())+"; "+iterVar+"++ {")
+			fmt.Fprintln(g.out, ws+"    ("+out+")["+iterVar+"] = 0")
+			fmt.Fprintln(g.out, ws+"  }")
 			fmt.Fprintln(g.out, ws+"}")
 
 		} else {
@@ -199,6 +319,9 @@ func (g *Generator) genTypeDecoderNoCheck(t reflect.Type, out string, tags field
 
 			fmt.Fprintln(g.out, ws+"if in.IsNull() {")
 			fmt.Fprintln(g.out, ws+"  in.Skip()")
+			fmt.Fprintln(g.out, ws+"} else if !in.IsDelim('[') {")
+			fmt.Fprintln(g.out, ws+"  in.Delim('[')")
+			fmt.Fprintln(g.out, ws+"  in.Delim(']')")
 			fmt.Fprintln(g.out, ws+"} else {")
 			fmt.Fprintln(g.out, ws+"  in.Delim('[')")
 			fmt.Fprintln(g.out, ws+"  "+iterVar+" := 0")
@@ -215,6 +338,10 @@ func (g *Generator) genTypeDecoderNoCheck(t reflect.Type, out string, tags field
 			fmt.Fprintln(g.out, ws+"    }")
 			fmt.Fprintln(g.out, ws+"    in.WantComma()")
 			fmt.Fprintln(g.out, ws+"  }")
+			// encoding/json zeroes the elements missing in the input
+			fmt.Fprintln(g.out, ws+"  for ; "+iterVar+" < "+fmt.Sprint(length)+"; "+iterVar+"++ {")
+			fmt.Fprintln(g.out, ws+"    ("+out+")["+iterVar+"] = "+g.zeroValue(elem))
+			fmt.Fprintln(g.out, ws+"  }")
 			fmt.Fprintln(g.out, ws+"  in.Delim(']')")
 			fmt.Fprintln(g.out, ws+"}")
 		}
@@ -247,18 +374,27 @@ func (g *Generator) genTypeDecoderNoCheck(t reflect.Type, out string, tags field
 
 	case reflect.Map:
 		key := t.Key()
-		keyDec, ok := primitiveStringDecoders[key.Kind()]
-		if !ok && !hasCustomUnmarshaler(key) {
-			return fmt.Errorf("map type %v not supported: only string and integer keys and types implementing json.Unmarshaler are allowed", key)
-		} // else assume the caller knows what they are doing and that the custom unmarshaler performs the translation from string or integer keys to the key type
 		elem := t.Elem()
 		tmpVar := g.uniqueVarName()
-		keepEmpty := tags.required || tags.noOmitEmpty || (!g.omitEmpty && !tags.omitEmpty)
+		startVar := g.uniqueVarName()
+		keepEmpty := tags.required || tags.noOmitEmpty || (!g.omitEmpty && !tags.omitEmpty) || g.stdlibCompat
 
 		fmt.Fprintln(g.out, ws+"if in.IsNull() {")
 		fmt.Fprintln(g.out, ws+"  in.Skip()")
+		if g.stdlibCompat {
+			fmt.Fprintln(g.out, ws+"  "+out+" = nil")
+		}
+		fmt.Fprintln(g.out, ws+"} else if !in.IsDelim('{') {")
+		fmt.Fprintln(g.out, ws+"  in.Delim('{')")
+		fmt.Fprintln(g.out, ws+"  in.Delim('}')")
 		fmt.Fprintln(g.out, ws+"} else {")
 		fmt.Fprintln(g.out, ws+"  in.Delim('{')")
+		if g.stdlibCompat {
+			// encoding/json keeps the existing entries of a map
+			fmt.Fprintln(g.out, ws+"  if "+out+" == nil {")
+		} else {
+			fmt.Fprintln(g.out, ws+"  if !in.MergePatch || "+out+" == nil {")
+		}
 		if !keepEmpty {
 			fmt.Fprintln(g.out, ws+"  if !in.IsDelim('}') {")
 		}
@@ -268,45 +404,84 @@ func (g *Generator) genTypeDecoderNoCheck(t reflect.Type, out string, tags field
 			fmt.Fprintln(g.out, ws+"  "+out+" = nil")
 			fmt.Fprintln(g.out, ws+"  }")
 		}
+		fmt.Fprintln(g.out, ws+"  }")
 
 		fmt.Fprintln(g.out, ws+"  for !in.IsDelim('}') {")
-		// NOTE: extra check for TextUnmarshaler. It overrides default methods.
-		if reflect.PtrTo(key).Implements(reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()) {
-			fmt.Fprintln(g.out, ws+"    var key "+g.getType(key))
-			fmt.Fprintln(g.out, ws+"if data := in.UnsafeBytes(); in.Ok() {")
-			fmt.Fprintln(g.out, ws+"  in.AddError(key.UnmarshalText(data) )")
-			fmt.Fprintln(g.out, ws+"}")
-		} else if keyDec != "" {
-			fmt.Fprintln(g.out, ws+"    key := "+g.getType(key)+"("+keyDec+")")
-		} else {
-			fmt.Fprintln(g.out, ws+"    var key "+g.getType(key))
-			if err := g.genTypeDecoder(key, "key", tags, indent+2); err != nil {
-				return err
-			}
+		if err := g.genMapKeyDecoder(key, tags, indent+2); err != nil {
+			return err
 		}
-
 		fmt.Fprintln(g.out, ws+"    in.WantColon()")
+		fmt.Fprintln(g.out, ws+"    "+startVar+" := in.ValueStart()")
 		fmt.Fprintln(g.out, ws+"    var "+tmpVar+" "+g.getType(elem))
+		fmt.Fprintln(g.out, ws+"    if in.MergePatch {")
+		fmt.Fprintln(g.out, ws+"      if in.IsNull() {")
+		fmt.Fprintln(g.out, ws+"        in.Skip()")
+		fmt.Fprintln(g.out, ws+"        if !in.KeepOnNull {")
+		fmt.Fprintln(g.out, ws+"          delete("+out+", key)")
+		fmt.Fprintln(g.out, ws+"        }")
+		fmt.Fprintln(g.out, ws+"        in.WantComma()")
+		fmt.Fprintln(g.out, ws+"        continue")
+		fmt.Fprintln(g.out, ws+"      }")
+		fmt.Fprintln(g.out, ws+"      "+tmpVar+" = ("+out+")[key]")
+		fmt.Fprintln(g.out, ws+"    }")
 
 		if err := g.genTypeDecoder(elem, tmpVar, tags, indent+2); err != nil {
 			return err
 		}
 
-		fmt.Fprintln(g.out, ws+"    ("+out+")[key] = "+tmpVar)
+		// the values skipped for errors are not added as zero entries
+		fmt.Fprintln(g.out, ws+"    if !in.ValueSkipped("+startVar+") {")
+		fmt.Fprintln(g.out, ws+"      ("+out+")[key] = "+tmpVar)
+		fmt.Fprintln(g.out, ws+"    }")
 		fmt.Fprintln(g.out, ws+"    in.WantComma()")
 		fmt.Fprintln(g.out, ws+"  }")
 		fmt.Fprintln(g.out, ws+"  in.Delim('}')")
 		fmt.Fprintln(g.out, ws+"}")
 
 	case reflect.Interface:
+		if tags.discriminator != "" {
+			return g.genVariantDecoder(t, out, tags, indent)
+		}
+		if isTypedInterface(t) {
+			return g.genTypedDecoder(t, out, indent)
+		}
 		if t.NumMethod() != 0 {
-			if g.interfaceIsEasyjsonUnmarshaller(t) {
-				fmt.Fprintln(g.out, ws+out+".UnmarshalEasyJSON(in)")
-			} else if g.interfaceIsJsonUnmarshaller(t) {
-				fmt.Fprintln(g.out, ws+out+".UnmarshalJSON(in.Raw())")
-			} else {
-				return fmt.Errorf("interface type %v not supported: only interface{} and easyjson/json Unmarshaler are allowed", t)
+			easy := !g.standalone && g.interfaceIsEasyjsonUnmarshaller(t)
+			if !easy && !g.interfaceIsJsonUnmarshaller(t) && !g.interfaceIsTextUnmarshaller(t) {
+				return fmt.Errorf("interface type %v not supported: only interface{} and easyjson/json/text Unmarshaler are allowed", t)
+			}
+
+			// The dynamic value decodes itself; there is none to decode into in a nil interface.
+			fmt.Fprintln(g.out, ws+"if in.IsNull() {")
+			fmt.Fprintln(g.out, ws+"  in.Skip()")
+			fmt.Fprintln(g.out, ws+"  "+out+" = nil")
+			fmt.Fprintln(g.out, ws+"} else if "+out+" == nil {")
+			fmt.Fprintln(g.out, ws+"  in.SkipRecursive()")
+			fmt.Fprintf(g.out, ws+"  in.AddValueError(%v.New(%q))\n", g.pkgAlias("errors"), "easyjson: unmarshal into nil "+t.String())
+			fmt.Fprintln(g.out, ws+"} else {")
+			switch {
+			case easy:
+				fmt.Fprintln(g.out, ws+"  "+out+".UnmarshalEasyJSON(in)")
+			case g.interfaceIsJsonUnmarshaller(t):
+				fmt.Fprintln(g.out, ws+"  if data := in.Raw(); in.Ok() {")
+				fmt.Fprintln(g.out, ws+"    in.AddValueError("+out+".UnmarshalJSON(data))")
+				fmt.Fprintln(g.out, ws+"  }")
+			default:
+				fmt.Fprintln(g.out, ws+"  if data := in.UnsafeBytes(); in.Ok() {")
+				fmt.Fprintln(g.out, ws+"    in.AddValueError("+out+".UnmarshalText(data))")
+				fmt.Fprintln(g.out, ws+"  }")
 			}
+			fmt.Fprintln(g.out, ws+"}")
+		} else if g.stdlibCompat {
+			fmt.Fprintln(g.out, ws+"if data := in.Raw(); in.Ok() {")
+			fmt.Fprintln(g.out, ws+"  in.AddError(json.Unmarshal(data, &"+out+"))")
+			fmt.Fprintln(g.out, ws+"}")
+		} else if g.standalone {
+			fmt.Fprintln(g.out, ws+"if m, ok := "+out+".(json.Unmarshaler); ok {")
+			fmt.Fprintln(g.out, ws+"_ = m.UnmarshalJSON(in.Raw())")
+			fmt.Fprintln(g.out, ws+"} else {")
+			fmt.Fprintln(g.out, ws+"  "+out+" = in.Interface()")
+			fmt.Fprintln(g.out, ws+"}")
 		} else {
 			fmt.Fprintln(g.out, ws+"if m, ok := "+out+".(easyjson.Unmarshaler); ok {")
 			fmt.Fprintln(g.out, ws+"m.UnmarshalEasyJSON(in)")
@@ -316,6 +491,12 @@ func (g *Generator) genTypeDecoderNoCheck(t reflect.Type, out string, tags field
 			fmt.Fprintln(g.out, ws+"  "+out+" = in.Interface()")
 			fmt.Fprintln(g.out, ws+"}")
 		}
+	case reflect.Func:
+		if _, _, ok := iteratorTypes(t); !ok {
+			return fmt.Errorf("don't know how to decode %v", t)
+		}
+		return g.genIteratorDecoder(t, out, tags, indent)
+
 	default:
 		return fmt.Errorf("don't know how to decode %v", t)
 	}
@@ -323,6 +504,51 @@ func (g *Generator) genTypeDecoderNoCheck(t reflect.Type, out string, tags field
 
 }
 
+// genMapKeyDecoder generates code that decodes a map key of type key into the variable key.
+func (g *Generator) genMapKeyDecoder(key reflect.Type, tags fieldTags, indent int) error {
+	ws := strings.Repeat("  ", indent)
+
+	keyDec, ok := primitiveStringDecoders[key.Kind()]
+	if !ok && !hasCustomUnmarshaler(key) {
+		return fmt.Errorf("map type %v not supported: only string and integer keys and types implementing json.Unmarshaler or encoding.TextUnmarshaler are allowed", key)
+	} // else assume the caller knows what they are doing and that the custom unmarshaler performs the translation from string or integer keys to the key type
+
+	fmt.Fprintln(g.out, ws+"in.MarkKey()")
+	// NOTE: extra check for TextUnmarshaler. It overrides default methods.
+	if reflect.PtrTo(key).Implements(reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()) {
+		fmt.Fprintln(g.out, ws+"var key "+g.getType(key))
+		fmt.Fprintln(g.out, ws+"if data := in.UnsafeBytes(); in.Ok() {")
+		fmt.Fprintln(g.out, ws+"  in.AddError(key.UnmarshalText(data) )")
+		fmt.Fprintln(g.out, ws+"}")
+	} else if keyDec != "" {
+		fmt.Fprintln(g.out, ws+"key := "+g.getType(key)+"("+keyDec+")")
+	} else {
+		fmt.Fprintln(g.out, ws+"var key "+g.getType(key))
+		return g.genTypeDecoder(key, "key", tags, indent)
+	}
+	return nil
+}
+
+// genTransformDecoder generates code that decodes a string and converts it back with a
+// registered transform into out of type t.
+func (g *Generator) genTransformDecoder(t reflect.Type, out string, tags fieldTags, indent int) error {
+	if !isTransformableType(t) {
+		return fmt.Errorf("transform %q is not supported for type %v: only string and []byte are allowed", tags.transform, t)
+	}
+	if g.standalone {
+		return fmt.Errorf("transform %q is not supported in standalone mode", tags.transform)
+	}
+	ws := strings.Repeat("  ", indent)
+	tmpVar := g.uniqueVarName()
+
+	fmt.Fprintln(g.out, ws+"if data := in.UnsafeBytes(); in.Ok() {")
+	fmt.Fprintf(g.out, ws+"  %v, err := easyjson.DecodeTransform(%q, data)\n", tmpVar, tags.transform)
+	fmt.Fprintln(g.out, ws+"  in.AddError(err)")
+	fmt.Fprintln(g.out, ws+"  "+out+" = "+g.getType(t)+"("+tmpVar+")")
+	fmt.Fprintln(g.out, ws+"}")
+	return nil
+}
+
 func (g *Generator) interfaceIsEasyjsonUnmarshaller(t reflect.Type) bool {
 	return t.Implements(reflect.TypeOf((*easyjson.Unmarshaler)(nil)).Elem())
 }
@@ -331,8 +557,11 @@ func (g *Generator) interfaceIsJsonUnmarshaller(t reflect.Type) bool {
 	return t.Implements(reflect.TypeOf((*json.Unmarshaler)(nil)).Elem())
 }
 
+func (g *Generator) interfaceIsTextUnmarshaller(t reflect.Type) bool {
+	return t.Implements(reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem())
+}
+
 func (g *Generator) genStructFieldDecoder(t reflect.Type, f reflect.StructField) error {
-	jsonName := g.fieldNamer.GetJSONFieldName(t, f)
 	tags := parseFieldTags(f)
 
 	if tags.omit {
@@ -341,9 +570,26 @@ func (g *Generator) genStructFieldDecoder(t reflect.Type, f reflect.StructField)
 	if tags.intern && tags.noCopy {
 		return errors.New("Mutually exclusive tags are specified: 'intern' and 'nocopy'")
 	}
+	if g.isOneofField(f) {
+		return g.genOneofFieldDecoder(t, f)
+	}
+	if tags.unknown != "" {
+		if err := g.checkEnumFallback(t, f, tags.unknown); err != nil {
+			return err
+		}
+	}
 
-	fmt.Fprintf(g.out, "    case %q:\n", jsonName)
-	if err := g.genTypeDecoder(f.Type, "out."+f.Name, tags, 3); err != nil {
+	g.genFieldCase(g.fieldKeys(t, f))
+	fmt.Fprint(g.out, g.embeddedAlloc("out", t, f, "      "))
+	if tags.customDecoder != "" {
+		g.genCustomDecoder("out."+f.Name, tags, 3)
+	} else if isTypeField(f, tags) {
+		fmt.Fprintf(g.out, "      %sRaw = in.Raw()\n", f.Name)
+	} else if tags.transform != "" {
+		if err := g.genTransformDecoder(f.Type, "out."+f.Name, tags, 3); err != nil {
+			return err
+		}
+	} else if err := g.genTypeDecoder(f.Type, "out."+f.Name, tags, 3); err != nil {
 		return err
 	}
 
@@ -354,6 +600,23 @@ func (g *Generator) genStructFieldDecoder(t reflect.Type, f reflect.StructField)
 	return nil
 }
 
+// genFieldCase starts the case of the key switch matching any of the keys.
+func (g *Generator) genFieldCase(keys []string) {
+	if g.curMetrics != nil {
+		g.curMetrics.Keys += len(keys)
+	}
+	fmt.Fprintf(g.out, "    case %v:\n", quoteKeys(keys))
+}
+
+// quoteKeys returns the keys as a list of Go string literals.
+func quoteKeys(keys []string) string {
+	quoted := make([]string, len(keys))
+	for i, key := range keys {
+		quoted[i] = strconv.Quote(key)
+	}
+	return strings.Join(quoted, ", ")
+}
+
 func (g *Generator) genRequiredFieldSet(t reflect.Type, f reflect.StructField) {
 	tags := parseFieldTags(f)
 
@@ -379,92 +642,349 @@ func (g *Generator) genRequiredFieldCheck(t reflect.Type, f reflect.StructField)
 	fmt.Fprintf(g.out, "}\n")
 }
 
-func mergeStructFields(fields1, fields2 []reflect.StructField) (fields []reflect.StructField) {
-	used := map[string]bool{}
-	for _, f := range fields2 {
-		used[f.Name] = true
-		fields = append(fields, f)
+// genMergePatchNullFields generates code resetting the field named by key, which is
+// what a null value means in a JSON Merge Patch.
+func (g *Generator) genMergePatchNullFields(t reflect.Type, fs []reflect.StructField) {
+	var cases []string
+	for _, f := range fs {
+		if tags := parseFieldTags(f); tags.omit || tags.keepOnNull {
+			continue
+		}
+		keys := g.fieldKeys(t, f)
+		if g.isOneofField(f) {
+			keys = g.oneofKeys(t, f)
+		}
+		cases = append(cases, fmt.Sprintf("         case %v:\n           out.%v = %v", quoteKeys(keys), f.Name, g.zeroValue(f.Type)))
+	}
+	if len(cases) == 0 {
+		return
 	}
 
-	for _, f := range fields1 {
-		if !used[f.Name] {
-			fields = append(fields, f)
+	fmt.Fprintln(g.out, "       if in.MergePatch && !in.KeepOnNull {")
+	fmt.Fprintln(g.out, "         switch key {")
+	for _, c := range cases {
+		fmt.Fprintln(g.out, c)
+	}
+	fmt.Fprintln(g.out, "         }")
+	fmt.Fprintln(g.out, "       }")
+}
+
+// genCompatNullFields generates code that handles null in the stdlib-compat mode as
+// encoding/json does it: nilable fields are reset, json.Unmarshaler is called with null.
+func (g *Generator) genCompatNullFields(t reflect.Type, fs []reflect.StructField) {
+	var cases []string
+	for _, f := range fs {
+		if tags := parseFieldTags(f); tags.omit || tags.keepOnNull {
+			continue
+		}
+		name := g.fieldNamer.GetJSONFieldName(t, f)
+		switch {
+		case f.Type.Kind() != reflect.Ptr && reflect.PtrTo(f.Type).Implements(reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()):
+			cases = append(cases, fmt.Sprintf("         case %q:\n           in.AddError(out.%v.UnmarshalJSON([]byte(\"null\")))", name, f.Name))
+		case f.Type.Kind() == reflect.Ptr, f.Type.Kind() == reflect.Slice, f.Type.Kind() == reflect.Map, f.Type.Kind() == reflect.Interface:
+			cases = append(cases, fmt.Sprintf("         case %q:\n           out.%v = nil", name, f.Name))
 		}
 	}
-	return
+	if len(cases) == 0 {
+		return
+	}
+
+	fmt.Fprintln(g.out, "       if !in.MergePatch && !in.KeepOnNull {")
+	fmt.Fprintln(g.out, "         switch key {")
+	for _, c := range cases {
+		fmt.Fprintln(g.out, c)
+	}
+	fmt.Fprintln(g.out, "         }")
+	fmt.Fprintln(g.out, "       }")
 }
 
+var anyType = reflect.TypeOf(easyjson.Any{})
+
+// genNullValueFields generates code resetting the fields that hold null as a value for null
+// members, to tell them from missing ones: easyjson.Any fields are set to null and database/sql
+// Null fields to invalid ones. The stdlib-compat mode calls UnmarshalJSON with null instead.
+func (g *Generator) genNullValueFields(t reflect.Type, fs []reflect.StructField) {
+	var cases []string
+	for _, f := range fs {
+		if tags := parseFieldTags(f); tags.omit || tags.keepOnNull {
+			continue
+		}
+		switch {
+		case f.Type == anyType && !g.standalone:
+			cases = append(cases, fmt.Sprintf("         case %v:\n           out.%v = %v.NullAny()", quoteKeys(g.fieldKeys(t, f)), f.Name, g.pkgAlias(pkgEasyJSON)))
+		case isSQLNullType(f.Type) && g.sqlNulls():
+			cases = append(cases, fmt.Sprintf("         case %v:\n           out.%v = %v{}", quoteKeys(g.fieldKeys(t, f)), f.Name, g.getType(f.Type)))
+		}
+	}
+	if len(cases) == 0 {
+		return
+	}
+
+	fmt.Fprintln(g.out, "       if !in.MergePatch && !in.KeepOnNull {")
+	fmt.Fprintln(g.out, "         switch key {")
+	for _, c := range cases {
+		fmt.Fprintln(g.out, c)
+	}
+	fmt.Fprintln(g.out, "         }")
+	fmt.Fprintln(g.out, "       }")
+}
+
+// genFieldNameFolding generates code that matches member names to field names
+// case-insensitively if there is no exact match, as encoding/json does it. The first field
+// in order wins if several fields match.
+func (g *Generator) genFieldNameFolding(t reflect.Type, fs []reflect.StructField) {
+	var names []string
+	for _, f := range fs {
+		if parseFieldTags(f).omit {
+			continue
+		}
+		names = append(names, strconv.Quote(g.fieldNamer.GetJSONFieldName(t, f)))
+	}
+	if len(names) == 0 {
+		return
+	}
+
+	fmt.Fprintln(g.out, "    switch key {")
+	fmt.Fprintln(g.out, "    case "+strings.Join(names, ", ")+":")
+	fmt.Fprintln(g.out, "    default:")
+	fmt.Fprintln(g.out, "      switch {")
+	for _, name := range names {
+		fmt.Fprintf(g.out, "      case %v.EqualFold(key, %v):\n", g.pkgAlias("strings"), name)
+		fmt.Fprintf(g.out, "        key = %v\n", name)
+	}
+	fmt.Fprintln(g.out, "      }")
+	fmt.Fprintln(g.out, "    }")
+}
+
+// zeroValue returns an expression for the zero value of type t.
+func (g *Generator) zeroValue(t reflect.Type) string {
+	if g.isTypeParam(t) {
+		return "*new(" + g.getType(t) + ")"
+	}
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Interface, reflect.Func, reflect.Chan:
+		return "nil"
+	case reflect.Bool:
+		return "false"
+	case reflect.String:
+		return `""`
+	case reflect.Struct, reflect.Array:
+		return g.getType(t) + "{}"
+	default:
+		return "0"
+	}
+}
+
+// getStructFields returns the fields of struct type t that can be marshaled, in the order
+// encoding/json marshals them: the fields of embedded structs without a name in their json tag
+// take the place of the embedded field. The Index of the fields is the index sequence from t,
+// so fields promoted from embedded structs have longer ones. Fields marshaled under the same
+// name are left to structFields.
 func getStructFields(t reflect.Type) ([]reflect.StructField, error) {
 	if t.Kind() != reflect.Struct {
 		return nil, fmt.Errorf("got %v; expected a struct", t)
 	}
+	return appendStructFields(nil, t, nil, map[reflect.Type]bool{t: true}), nil
+}
 
-	var efields []reflect.StructField
-	var fields []reflect.StructField
+// appendStructFields appends the fields of struct type t, embedded at the index sequence
+// index, to fs. The types t is embedded in are set in outer, so that embedding cycles through
+// pointers are not followed.
+func appendStructFields(fs []reflect.StructField, t reflect.Type, index []int, outer map[reflect.Type]bool) []reflect.StructField {
 	for i := 0; i < t.NumField(); i++ {
 		f := t.Field(i)
-		tags := parseFieldTags(f)
-		if !f.Anonymous || tags.name != "" {
+		f.Index = append(append([]int(nil), index...), i)
+		exported := unicode.IsUpper([]rune(f.Name)[0])
+		if !f.Anonymous {
+			if exported {
+				fs = append(fs, f)
+			}
 			continue
 		}
 
+		tags := parseFieldTags(f)
 		t1 := f.Type
 		if t1.Kind() == reflect.Ptr {
 			t1 = t1.Elem()
 		}
+		switch {
+		case tags.omit || !exported && t1.Kind() != reflect.Struct:
+			// encoding/json ignores embedded fields of unexported non-struct types
+		case tags.name != "" || t1.Kind() != reflect.Struct:
+			fs = append(fs, f)
+		case !outer[t1]:
+			outer[t1] = true
+			fs = appendStructFields(fs, t1, f.Index, outer)
+			delete(outer, t1)
+		}
+	}
+	return fs
+}
 
-		if t1.Kind() == reflect.Struct {
-			fs, err := getStructFields(t1)
-			if err != nil {
-				return nil, fmt.Errorf("error processing embedded field: %v", err)
+// structFields returns the fields of struct type t like getStructFields, resolving the fields
+// marshaled under the same JSON name like encoding/json: the fields at the smallest depth of
+// embedding win over the deeper ones, and a field named in its json tag wins over the others
+// at the same depth. It is an error if the rules do not resolve a collision between the fields
+// of t itself, e.g. after snake_case conversion, while promoted fields are dropped with a
+// warning, as encoding/json leaves them out.
+func (g *Generator) structFields(t reflect.Type) ([]reflect.StructField, error) {
+	fs, err := getStructFields(t)
+	if err != nil {
+		return nil, err
+	}
+	// The field collecting unknown members is handled separately.
+	known := fs[:0]
+	for _, f := range fs {
+		if !parseFieldTags(f).unknownFields {
+			known = append(known, f)
+		}
+	}
+	fs = known
+	if g.protobuf {
+		// The XXX_ fields of protoc-gen-go structs are internal to the protobuf runtime.
+		exported := fs[:0]
+		for _, f := range fs {
+			if !strings.HasPrefix(f.Name, "XXX_") {
+				exported = append(exported, f)
 			}
-			efields = mergeStructFields(efields, fs)
-		} else if (t1.Kind() >= reflect.Bool && t1.Kind() < reflect.Complex128) || t1.Kind() == reflect.String {
-			if strings.Contains(f.Name, ".") || unicode.IsUpper([]rune(f.Name)[0]) {
-				fields = append(fields, f)
+		}
+		fs = exported
+	}
+	if g.taggedOnly {
+		tagged := fs[:0]
+		for _, f := range fs {
+			if _, ok := f.Tag.Lookup("json"); ok {
+				tagged = append(tagged, f)
 			}
 		}
+		fs = tagged
 	}
 
-	for i := 0; i < t.NumField(); i++ {
-		f := t.Field(i)
-		tags := parseFieldTags(f)
-		if f.Anonymous && tags.name == "" {
+	var names []string
+	byName := map[string][]int{}
+	for i, f := range fs {
+		if parseFieldTags(f).omit {
 			continue
 		}
+		name := g.fieldNamer.GetJSONFieldName(t, f)
+		if byName[name] == nil {
+			names = append(names, name)
+		}
+		byName[name] = append(byName[name], i)
+	}
 
-		c := []rune(f.Name)[0]
-		if unicode.IsUpper(c) {
-			fields = append(fields, f)
+	dropped := map[int]bool{}
+	for _, name := range names {
+		candidates := byName[name]
+		if len(candidates) > 1 {
+			depth := len(fs[candidates[0]].Index)
+			for _, i := range candidates {
+				if len(fs[i].Index) < depth {
+					depth = len(fs[i].Index)
+				}
+			}
+			var shallowest, tagged []int
+			for _, i := range candidates {
+				if len(fs[i].Index) != depth {
+					continue
+				}
+				shallowest = append(shallowest, i)
+				if parseFieldTags(fs[i]).name != "" {
+					tagged = append(tagged, i)
+				}
+			}
+
+			var fieldNames []string
+			for _, i := range shallowest {
+				fieldNames = append(fieldNames, fs[i].Name)
+			}
+			switch {
+			case len(shallowest) == 1:
+				candidates = shallowest
+			case len(tagged) == 1:
+				for _, i := range shallowest {
+					if i != tagged[0] {
+						g.warnf("field %v of %v is not marshaled: field %v is named %q in its json tag", fs[i].Name, t, fs[tagged[0]].Name, name)
+					}
+				}
+				candidates = tagged
+			case depth == 1:
+				return nil, fmt.Errorf("fields %v of %v are all marshaled as %q: rename them or set distinct names in json tags",
+					strings.Join(fieldNames, ", "), t, name)
+			default:
+				g.warnf("fields %v promoted to %v are not marshaled: they are all marshaled as %q at the same depth", strings.Join(fieldNames, ", "), t, name)
+				candidates = nil
+			}
+		}
+
+		// The generated code refers to the fields by name, so the promoted fields must not
+		// be shadowed in Go by fields marshaled under other names.
+		if len(candidates) == 1 {
+			if f := fs[candidates[0]]; len(f.Index) > 1 {
+				if sf, ok := t.FieldByName(f.Name); !ok || !reflect.DeepEqual(sf.Index, f.Index) {
+					g.warnf("field %v promoted to %v is not marshaled as %q: it is shadowed by another field named %v", f.Name, t, name, f.Name)
+					candidates = nil
+				}
+			}
+		}
+
+		for _, i := range byName[name] {
+			if len(candidates) == 0 || i != candidates[0] {
+				dropped[i] = true
+			}
+		}
+	}
+
+	if len(dropped) == 0 {
+		return fs, nil
+	}
+	ret := make([]reflect.StructField, 0, len(fs)-len(dropped))
+	for i, f := range fs {
+		if !dropped[i] {
+			ret = append(ret, f)
 		}
 	}
-	return mergeStructFields(efields, fields), nil
+	return ret, nil
 }
 
 func (g *Generator) genDecoder(t reflect.Type) error {
-	switch t.Kind() {
-	case reflect.Slice, reflect.Array, reflect.Map:
-		return g.genSliceArrayDecoder(t)
-	default:
+	if g.enums[t] != nil {
+		return g.genEnumDecoder(t)
+	}
+	if t.Kind() == reflect.Struct {
 		return g.genStructDecoder(t)
 	}
+	return g.genValueDecoder(t)
 }
 
-func (g *Generator) genSliceArrayDecoder(t reflect.Type) error {
-	switch t.Kind() {
-	case reflect.Slice, reflect.Array, reflect.Map:
-	default:
-		return fmt.Errorf("cannot generate encoder/decoder for %v, not a slice/array/map type", t)
+// genValueDecoder generates the decoder of a named type of a kind other than struct, e.g.
+// type Labels map[string]string or type UserID int64.
+func (g *Generator) genValueDecoder(t reflect.Type) error {
+	if !isValueKind(t.Kind()) {
+		return fmt.Errorf("cannot generate encoder/decoder for %v, not a struct, slice, array, map or basic type", t)
 	}
 
 	fname := g.getDecoderName(t)
 	typ := g.getType(t)
+	params, _ := g.typeParamLists(typ)
 
-	fmt.Fprintln(g.out, "func "+fname+"(in *jlexer.Lexer, out *"+typ+") {")
+	fmt.Fprintln(g.out, "func "+fname+params+"(in *jlexer.Lexer, out *"+typ+") {")
+	g.genInstanceDecoderDispatch(t)
 	fmt.Fprintln(g.out, " isTopLevel := in.IsStart()")
-	err := g.genTypeDecoderNoCheck(t, "*out", fieldTags{}, 1)
-	if err != nil {
-		return err
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		if err := g.genTypeDecoderNoCheck(t, "*out", fieldTags{}, 1); err != nil {
+			return err
+		}
+	default:
+		// null leaves values of basic kinds unchanged, like encoding/json
+		fmt.Fprintln(g.out, "  if in.IsNull() {")
+		fmt.Fprintln(g.out, "    in.Skip()")
+		fmt.Fprintln(g.out, "  } else {")
+		if err := g.genTypeDecoderNoCheck(t, "*out", fieldTags{}, 2); err != nil {
+			return err
+		}
+		fmt.Fprintln(g.out, "  }")
 	}
 	fmt.Fprintln(g.out, "  if isTopLevel {")
 	fmt.Fprintln(g.out, "    in.Consumed()")
@@ -481,8 +1001,10 @@ func (g *Generator) genStructDecoder(t reflect.Type) error {
 
 	fname := g.getDecoderName(t)
 	typ := g.getType(t)
+	params, _ := g.typeParamLists(typ)
 
-	fmt.Fprintln(g.out, "func "+fname+"(in *jlexer.Lexer, out *"+typ+") {")
+	fmt.Fprintln(g.out, "func "+fname+params+"(in *jlexer.Lexer, out *"+typ+") {")
+	g.genInstanceDecoderDispatch(t)
 	fmt.Fprintln(g.out, "  isTopLevel := in.IsStart()")
 	fmt.Fprintln(g.out, "  if in.IsNull() {")
 	fmt.Fprintln(g.out, "    if isTopLevel {")
@@ -492,30 +1014,43 @@ func (g *Generator) genStructDecoder(t reflect.Type) error {
 	fmt.Fprintln(g.out, "    return")
 	fmt.Fprintln(g.out, "  }")
 
-	// Init embedded pointer fields.
-	for i := 0; i < t.NumField(); i++ {
-		f := t.Field(i)
-		if !f.Anonymous || f.Type.Kind() != reflect.Ptr {
-			continue
-		}
-		fmt.Fprintln(g.out, "  out."+f.Name+" = new("+g.getType(f.Type.Elem())+")")
+	fs, err := g.structFields(t)
+	if err != nil {
+		return fmt.Errorf("cannot generate decoder for %v: %v", t, err)
 	}
 
-	fs, err := getStructFields(t)
+	uf, hasUnknownFields, err := g.unknownFieldsField(t)
 	if err != nil {
 		return fmt.Errorf("cannot generate decoder for %v: %v", t, err)
 	}
 
 	for _, f := range fs {
 		g.genRequiredFieldSet(t, f)
+		g.genTypeFieldRawVar(f)
+		if g.isFallbackType(f.Type) {
+			g.curMetrics.FallbackFields++
+		}
 	}
 
 	fmt.Fprintln(g.out, "  in.Delim('{')")
 	fmt.Fprintln(g.out, "  for !in.IsDelim('}') {")
 	fmt.Fprintf(g.out, "    key := in.UnsafeFieldName(%v)\n", g.skipMemberNameUnescaping)
+	if g.foldKeys {
+		g.genFieldNameFolding(t, fs)
+	}
 	fmt.Fprintln(g.out, "    in.WantColon()")
 	fmt.Fprintln(g.out, "    if in.IsNull() {")
 	fmt.Fprintln(g.out, "       in.Skip()")
+	g.genEmbeddedNullAlloc(t, fs)
+	g.genMergePatchNullFields(t, fs)
+	if g.stdlibCompat {
+		g.genCompatNullFields(t, fs)
+	} else {
+		g.genNullValueFields(t, fs)
+	}
+	if hasUnknownFields {
+		g.genUnknownNullFieldDecoder(t, fs, uf)
+	}
 	fmt.Fprintln(g.out, "       in.WantComma()")
 	fmt.Fprintln(g.out, "       continue")
 	fmt.Fprintln(g.out, "    }")
@@ -528,13 +1063,18 @@ func (g *Generator) genStructDecoder(t reflect.Type) error {
 	}
 
 	fmt.Fprintln(g.out, "    default:")
-	if g.disallowUnknownFields {
+	if hasUnknownFields {
+		g.genUnknownFieldDecoder(uf, false, 3)
+	} else if g.disallowUnknownFields {
 		fmt.Fprintln(g.out, `      in.AddError(&jlexer.LexerError{
           Offset: in.GetPos(),
           Reason: "unknown field",
           Data: key,
       })`)
 	} else if hasUnknownsUnmarshaler(t) {
+		if g.standalone {
+			return fmt.Errorf("cannot generate decoder for %v: unknown fields unmarshalers are not supported in standalone mode", t)
+		}
 		fmt.Fprintln(g.out, "      out.UnmarshalUnknown(in, key)")
 	} else {
 		fmt.Fprintln(g.out, "      in.SkipRecursive()")
@@ -543,6 +1083,11 @@ func (g *Generator) genStructDecoder(t reflect.Type) error {
 	fmt.Fprintln(g.out, "    in.WantComma()")
 	fmt.Fprintln(g.out, "  }")
 	fmt.Fprintln(g.out, "  in.Delim('}')")
+	for _, f := range fs {
+		if err := g.genTypeFieldDecoder(t, fs, f); err != nil {
+			return err
+		}
+	}
 	fmt.Fprintln(g.out, "  if isTopLevel {")
 	fmt.Fprintln(g.out, "    in.Consumed()")
 	fmt.Fprintln(g.out, "  }")
@@ -557,27 +1102,59 @@ func (g *Generator) genStructDecoder(t reflect.Type) error {
 }
 
 func (g *Generator) genStructUnmarshaler(t reflect.Type) error {
-	switch t.Kind() {
-	case reflect.Slice, reflect.Array, reflect.Map, reflect.Struct:
+	switch {
+	case g.enums[t] != nil:
+	case t.Kind() == reflect.Struct, isValueKind(t.Kind()):
 	default:
-		return fmt.Errorf("cannot generate encoder/decoder for %v, not a struct/slice/array/map type", t)
+		return fmt.Errorf("cannot generate encoder/decoder for %v, not a struct, slice, array, map or basic type", t)
 	}
 
 	fname := g.getDecoderName(t)
 	typ := g.getType(t)
 
-	if !g.noStdMarshalers {
+	if !g.noStdMarshalers && !g.kept[t]["UnmarshalJSON"] {
 		fmt.Fprintln(g.out, "// UnmarshalJSON supports json.Unmarshaler interface")
 		fmt.Fprintln(g.out, "func (v *"+typ+") UnmarshalJSON(data []byte) error {")
+		if g.nilGuards {
+			fmt.Fprintln(g.out, "  if v == nil {")
+			fmt.Fprintf(g.out, "    return %v.New(%q)\n", g.pkgAlias("errors"), "easyjson: UnmarshalJSON(nil *"+typ+")")
+			fmt.Fprintln(g.out, "  }")
+		}
 		fmt.Fprintln(g.out, "  r := jlexer.Lexer{Data: data}")
-		fmt.Fprintln(g.out, "  "+fname+"(&r, v)")
+		if g.stdlibCompat {
+			fmt.Fprintln(g.out, "  r.CheckValid()")
+		}
+		if g.kept[t]["UnmarshalEasyJSON"] {
+			fmt.Fprintln(g.out, "  v.UnmarshalEasyJSON(&r)")
+		} else {
+			fmt.Fprintln(g.out, "  "+fname+"(&r, v)")
+		}
 		fmt.Fprintln(g.out, "  return r.Error()")
 		fmt.Fprintln(g.out, "}")
 	}
 
+	if g.standalone || g.kept[t]["UnmarshalEasyJSON"] {
+		return nil
+	}
+
 	fmt.Fprintln(g.out, "// UnmarshalEasyJSON supports easyjson.Unmarshaler interface")
 	fmt.Fprintln(g.out, "func (v *"+typ+") UnmarshalEasyJSON(l *jlexer.Lexer) {")
-	fmt.Fprintln(g.out, "  "+fname+"(l, v)")
+	if g.nilGuards {
+		fmt.Fprintln(g.out, "  if v == nil {")
+		fmt.Fprintf(g.out, "    l.AddError(%v.New(%q))\n", g.pkgAlias("errors"), "easyjson: UnmarshalEasyJSON(nil *"+typ+")")
+		fmt.Fprintln(g.out, "    return")
+		fmt.Fprintln(g.out, "  }")
+	}
+	if g.stdlibCompat {
+		fmt.Fprintln(g.out, "  l.CheckValid()")
+	}
+	if g.kept[t]["UnmarshalJSON"] {
+		fmt.Fprintln(g.out, "  if data := l.Raw(); l.Ok() {")
+		fmt.Fprintln(g.out, "    l.AddError(v.UnmarshalJSON(data))")
+		fmt.Fprintln(g.out, "  }")
+	} else {
+		fmt.Fprintln(g.out, "  "+fname+"(l, v)")
+	}
 	fmt.Fprintln(g.out, "}")
 
 	return nil
@@ -0,0 +1,93 @@
+package gen
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type collisionEmbedded struct {
+	UserID int
+}
+
+type collisionPromoted struct {
+	collisionEmbedded
+	UserId int
+}
+
+type collisionTagged struct {
+	UserID int `json:"user_id"`
+	UserId int
+}
+
+type collisionAmbiguous struct {
+	UserID int
+	UserId int
+	Skip   int `json:"-"`
+	Skip_  int `json:"-"`
+}
+
+type collisionEmbeddedCopy struct {
+	UserID int
+}
+
+type collisionEmbeddedTagged struct {
+	UserId int `json:"user_id"`
+}
+
+type collisionPromotedAmbiguous struct {
+	collisionEmbedded
+	collisionEmbeddedCopy
+	Other int
+}
+
+type collisionPromotedTagged struct {
+	collisionEmbedded
+	collisionEmbeddedTagged
+}
+
+type collisionPromotedDeep struct {
+	collisionPromoted
+	Other int
+}
+
+func TestStructFieldCollisions(t *testing.T) {
+	for _, test := range []struct {
+		Type      reflect.Type
+		Want      []string
+		WantWarns int
+	}{
+		{reflect.TypeOf(collisionPromoted{}), []string{"UserId"}, 0},
+		{reflect.TypeOf(collisionTagged{}), []string{"UserID"}, 1},
+		{reflect.TypeOf(collisionAmbiguous{}), nil, 0},
+		{reflect.TypeOf(collisionPromotedAmbiguous{}), []string{"Other"}, 1},
+		{reflect.TypeOf(collisionPromotedTagged{}), []string{"UserId"}, 1},
+		{reflect.TypeOf(collisionPromotedDeep{}), []string{"UserId", "Other"}, 0},
+	} {
+		g := NewGenerator("decoder_test.go")
+		g.UseSnakeCase()
+
+		fs, err := g.structFields(test.Type)
+		if test.Want == nil {
+			if err == nil || !strings.Contains(err.Error(), `marshaled as "user_id"`) {
+				t.Errorf("%v: structFields() error %v; want a collision error", test.Type, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: structFields() error: %v", test.Type, err)
+			continue
+		}
+
+		var got []string
+		for _, f := range fs {
+			got = append(got, f.Name)
+		}
+		if !reflect.DeepEqual(got, test.Want) {
+			t.Errorf("%v: structFields() = %v; want %v", test.Type, got, test.Want)
+		}
+		if len(g.Warnings()) != test.WantWarns {
+			t.Errorf("%v: warnings %q; want %d", test.Type, g.Warnings(), test.WantWarns)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package gen
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// embeddedPointer is an embedded pointer field that a promoted field is reached through.
+type embeddedPointer struct {
+	path string       // selector of the field from the outer struct, e.g. "Inner.Base"
+	typ  reflect.Type // type the field points to
+}
+
+// embeddedPointers returns the embedded pointer fields that the field f of struct type t, with
+// the index sequence from t, is promoted through, outermost first.
+func embeddedPointers(t reflect.Type, f reflect.StructField) []embeddedPointer {
+	var ps []embeddedPointer
+	var path []string
+	for _, i := range f.Index[:len(f.Index)-1] {
+		sf := t.Field(i)
+		path = append(path, sf.Name)
+		t = sf.Type
+		if t.Kind() == reflect.Ptr {
+			t = t.Elem()
+			ps = append(ps, embeddedPointer{strings.Join(path, "."), t})
+		}
+	}
+	return ps
+}
+
+// embeddedNotNilChecks returns the conditions of the embedded pointers that the promoted field f
+// of struct v of type t is reached through being set.
+func embeddedNotNilChecks(v string, t reflect.Type, f reflect.StructField) []string {
+	var conditions []string
+	for _, p := range embeddedPointers(t, f) {
+		conditions = append(conditions, v+"."+p.path+" != nil")
+	}
+	return conditions
+}
+
+// embeddedAlloc returns code, indented with ws, allocating the nil embedded pointers that the
+// promoted field f of struct v of type t is reached through, like encoding/json does it when it
+// decodes the field.
+func (g *Generator) embeddedAlloc(v string, t reflect.Type, f reflect.StructField, ws string) string {
+	var b strings.Builder
+	for _, p := range embeddedPointers(t, f) {
+		fmt.Fprintln(&b, ws+"if "+v+"."+p.path+" == nil {")
+		fmt.Fprintln(&b, ws+"  "+v+"."+p.path+" = new("+g.getType(p.typ)+")")
+		fmt.Fprintln(&b, ws+"}")
+	}
+	return b.String()
+}
+
+// genEmbeddedNullAlloc generates code allocating the nil embedded pointers that the field named
+// by key is promoted through when its value is null, as encoding/json allocates them before it
+// finds out the value is null.
+func (g *Generator) genEmbeddedNullAlloc(t reflect.Type, fs []reflect.StructField) {
+	var cases []string
+	for _, f := range fs {
+		if parseFieldTags(f).omit || g.isOneofField(f) {
+			continue
+		}
+		if alloc := g.embeddedAlloc("out", t, f, "           "); alloc != "" {
+			cases = append(cases, fmt.Sprintf("         case %v:\n%s", quoteKeys(g.fieldKeys(t, f)), alloc))
+		}
+	}
+	if len(cases) == 0 {
+		return
+	}
+
+	fmt.Fprintln(g.out, "       switch key {")
+	for _, c := range cases {
+		fmt.Fprint(g.out, c)
+	}
+	fmt.Fprintln(g.out, "       }")
+}
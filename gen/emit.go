@@ -0,0 +1,181 @@
+package gen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+)
+
+// Emitter writes an artifact other than the marshaling code, e.g. a schema, from the types
+// analyzed by a generator run, so that it does not need another bootstrapping run.
+type Emitter interface {
+	Emit(out io.Writer, types []TypeDesc) error
+}
+
+// TypeDesc describes a type the generator generated code for, as seen by Emitters.
+type TypeDesc struct {
+	Type reflect.Type
+
+	// Name is the name of the type, qualified by its package name if it is declared in
+	// another package, or empty if the type is not named.
+	Name string
+
+	// Marshaler is set for the types the marshaling methods are generated for, as opposed to
+	// the types only marshaled as parts of them.
+	Marshaler bool
+
+	// Doc is the doc comment of the type, if any.
+	Doc string
+
+	// Fields are the members of struct types, in the order they are marshaled.
+	Fields []FieldDesc
+
+	// Enum are the names of the values of enum types, in the order they were added.
+	Enum []string
+}
+
+// FieldDesc describes a member of a struct type.
+type FieldDesc struct {
+	Name     string
+	JSONName string
+	Type     reflect.Type
+	Doc      string
+
+	OmitEmpty bool
+	OmitZero  bool
+	Required  bool
+	AsString  bool
+}
+
+// emitterNames are the names of the built-in emitters, as used by the easyjson -emit flag.
+var emitterNames = map[string]func() Emitter{
+	"schema":   func() Emitter { return SchemaEmitter{} },
+	"fixtures": func() Emitter { return FixtureEmitter{} },
+}
+
+// NewEmitter returns the built-in emitter with the given name: "schema" for SchemaEmitter and
+// "fixtures" for FixtureEmitter.
+func NewEmitter(name string) (Emitter, error) {
+	if e := emitterNames[name]; e != nil {
+		return e(), nil
+	}
+	return nil, fmt.Errorf("unknown emitter %q", name)
+}
+
+// emitter is an Emitter added to the generator with its output.
+type emitter struct {
+	e   Emitter
+	out io.Writer
+}
+
+// AddEmitter requests to write the artifact of the emitter e into out once the code of the
+// types is generated by Run. Run fails if the emitter does.
+func (g *Generator) AddEmitter(e Emitter, out io.Writer) {
+	g.emitters = append(g.emitters, emitter{e: e, out: out})
+}
+
+// runEmitters passes the descriptions of the generated types to the emitters.
+func (g *Generator) runEmitters() error {
+	if len(g.emitters) == 0 {
+		return nil
+	}
+	types, err := g.typeDescs()
+	if err != nil {
+		return err
+	}
+	for _, e := range g.emitters {
+		if err := e.e.Emit(e.out, types); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// typeDescs returns the descriptions of the named and struct types the code was generated for,
+// sorted by name.
+func (g *Generator) typeDescs() ([]TypeDesc, error) {
+	var ret []TypeDesc
+	for t := range g.typesSeen {
+		if t.Name() == "" && t.Kind() != reflect.Struct || g.isTypeParam(t) {
+			continue
+		}
+		d := TypeDesc{Type: t, Marshaler: g.marshalers[t], Doc: g.docs[t].doc}
+		if t.Name() != "" {
+			d.Name = g.getType(t)
+		}
+		for _, v := range g.enums[t] {
+			d.Enum = append(d.Enum, v.Name)
+		}
+		if t.Kind() == reflect.Struct && g.enums[t] == nil {
+			fs, err := g.structFields(t)
+			if err != nil {
+				return nil, err
+			}
+			for _, f := range fs {
+				tags := parseFieldTags(f)
+				if tags.omit {
+					continue
+				}
+				d.Fields = append(d.Fields, FieldDesc{
+					Name:      f.Name,
+					JSONName:  g.fieldNamer.GetJSONFieldName(t, f),
+					Type:      f.Type,
+					Doc:       g.fieldDoc(t, f),
+					OmitEmpty: (tags.omitEmpty || g.omitEmpty) && !tags.noOmitEmpty && !tags.required,
+					OmitZero:  tags.omitZero,
+					Required:  tags.required,
+					AsString:  tags.asString,
+				})
+			}
+		}
+		ret = append(ret, d)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Name != ret[j].Name {
+			return ret[i].Name < ret[j].Name
+		}
+		return ret[i].Type.String() < ret[j].Type.String()
+	})
+	return ret, nil
+}
+
+// object is a JSON object keeping the order of its members.
+type object []member
+
+type member struct {
+	name  string
+	value interface{}
+}
+
+func (o object) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, m := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, _ := json.Marshal(m.name)
+		buf.Write(name)
+		buf.WriteByte(':')
+		value, err := json.Marshal(m.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// writeIndented writes v as indented JSON followed by a newline.
+func writeIndented(out io.Writer, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = out.Write(append(data, '\n'))
+	return err
+}
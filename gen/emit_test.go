@@ -0,0 +1,128 @@
+package gen
+
+import (
+	"bytes"
+	"testing"
+)
+
+type emitItem struct {
+	ID    int      `json:"id,string"`
+	Tags  []string `json:"tags,omitempty"`
+	Child *emitItem
+	Skip  int `json:"-"`
+}
+
+type emitResult struct {
+	Items []emitItem      `json:"items" easyjson:"required"`
+	Extra map[string]bool `json:"extra"`
+	Pair  [2]float64      `json:"pair"`
+}
+
+func TestRunEmitters(t *testing.T) {
+	g := NewGenerator("emit_easyjson.go")
+	g.SetPkg("gen", "github.com/mailru/easyjson/gen")
+	g.Add(emitResult{})
+	g.AddDoc(emitResult{}, "A list of items.", map[string]string{"Extra": "Flags by name."})
+
+	var schema, fixtures bytes.Buffer
+	g.AddEmitter(SchemaEmitter{ID: "https://example.com/emit.json"}, &schema)
+	g.AddEmitter(FixtureEmitter{}, &fixtures)
+	if err := g.Run(&bytes.Buffer{}); err != nil {
+		t.Fatalf("Run() error: %v", err)
+	}
+
+	wantSchema := `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "$id": "https://example.com/emit.json",
+  "$defs": {
+    "emitItem": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string",
+          "pattern": "^-?[0-9]+$"
+        },
+        "tags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "Child": {
+          "$ref": "#/$defs/emitItem"
+        }
+      }
+    },
+    "emitResult": {
+      "type": "object",
+      "properties": {
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/$defs/emitItem"
+          }
+        },
+        "extra": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "boolean"
+          },
+          "description": "Flags by name."
+        },
+        "pair": {
+          "type": "array",
+          "items": {
+            "type": "number"
+          },
+          "minItems": 2,
+          "maxItems": 2
+        }
+      },
+      "required": [
+        "items"
+      ],
+      "description": "A list of items."
+    }
+  }
+}
+`
+	if got := schema.String(); got != wantSchema {
+		t.Errorf("schema:\n%s\nwant:\n%s", got, wantSchema)
+	}
+
+	wantFixtures := `{
+  "emitResult": {
+    "items": [
+      {
+        "id": "0",
+        "tags": [
+          ""
+        ],
+        "Child": null
+      }
+    ],
+    "extra": {
+      "": false
+    },
+    "pair": [
+      0,
+      0
+    ]
+  }
+}
+`
+	if got := fixtures.String(); got != wantFixtures {
+		t.Errorf("fixtures:\n%s\nwant:\n%s", got, wantFixtures)
+	}
+}
+
+func TestNewEmitter(t *testing.T) {
+	for _, name := range []string{"schema", "fixtures"} {
+		if _, err := NewEmitter(name); err != nil {
+			t.Errorf("NewEmitter(%q) error: %v", name, err)
+		}
+	}
+	if _, err := NewEmitter("yaml"); err == nil {
+		t.Error("NewEmitter(\"yaml\") succeeded; want error")
+	}
+}
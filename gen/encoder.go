@@ -5,10 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
-	"strconv"
+	"sort"
 	"strings"
+	"unicode"
 
 	"github.com/mailru/easyjson"
+	"github.com/mailru/easyjson/jwriter"
 )
 
 func (g *Generator) getEncoderName(t reflect.Type) string {
@@ -34,6 +36,7 @@ var primitiveEncoders = map[reflect.Kind]string{
 
 var primitiveStringEncoders = map[reflect.Kind]string{
 	reflect.String:  "out.String(string(%v))",
+	reflect.Bool:    "out.BoolStr(bool(%v))",
 	reflect.Int:     "out.IntStr(int(%v))",
 	reflect.Int8:    "out.Int8Str(int8(%v))",
 	reflect.Int16:   "out.Int16Str(int16(%v))",
@@ -49,35 +52,119 @@ var primitiveStringEncoders = map[reflect.Kind]string{
 	reflect.Float64: "out.Float64Str(float64(%v))",
 }
 
+// compatEncoders override primitiveEncoders and primitiveStringEncoders in the
+// stdlib-compat profile.
+var compatEncoders = map[reflect.Kind]string{
+	reflect.String:  "out.StringCompat(string(%v))",
+	reflect.Float32: "out.Float32Compat(float32(%v))",
+	reflect.Float64: "out.Float64Compat(float64(%v))",
+}
+
+var customEncoders = map[string]string{
+	"json.Number": "out.JsonNumber(%v)",
+}
+
+var customStringEncoders = map[string]string{
+	"json.Number": "out.JsonNumberStr(%v)",
+}
+
+var compatStringEncoders = map[reflect.Kind]string{
+	reflect.Float32: "out.Float32CompatStr(float32(%v))",
+	reflect.Float64: "out.Float64CompatStr(float64(%v))",
+}
+
 // fieldTags contains parsed version of json struct field tags.
 type fieldTags struct {
 	name string
 
 	omit        bool
 	omitEmpty   bool
+	omitZero    bool
 	noOmitEmpty bool
 	asString    bool
+	asNumber    bool
 	required    bool
 	intern      bool
 	noCopy      bool
+
+	// if the strings are known to need no escaping, see jwriter.Writer.StringNoEscape
+	noEscape bool
+
+	// API versions (see jwriter.Writer.SetVersion) the field is marshaled for,
+	// from the easyjson tag.
+	since string
+	until string
+
+	// name of the easyjson.Transform to apply to the field value
+	transform string
+
+	// name of the enum value unknown enum names are unmarshaled as
+	unknown string
+
+	// if null leaves the field unchanged in merge patches and the stdlib-compat mode
+	keepOnNull bool
+
+	// if the unsigned integers or bytes are marshaled as 0x-prefixed hex strings
+	hex bool
+
+	// if errors are marshaled as objects with a message member instead of strings
+	errObject bool
+
+	// if the field collects the members not matching other fields
+	unknownFields bool
+
+	// name of the member selecting the concrete type of interface values
+	discriminator string
+
+	// name of the sibling member of the object selecting the concrete type of the interface value
+	typeField string
+
+	// layout or format (see the timeFormat constants) times are marshaled with
+	timeLayout string
+	timeFormat string
+
+	// format of byte slices and arrays set by the json/v2 'format' option, see bytesFormat constants
+	bytesFormat string
+
+	// names of the functions of the package marshaling and unmarshaling the field value
+	customEncoder string
+	customDecoder string
+
+	// name of the variable of the package ordering the members of map values, see easyjson.KeyOrder
+	keyOrder string
 }
 
-// parseFieldTags parses the json field tag into a structure.
+// parseFieldTags parses the json field tag into a structure. Parsing follows encoding/json:
+// invalid names are ignored, as well as unknown options and the 'string' option on
+// non-scalar fields.
 func parseFieldTags(f reflect.StructField) fieldTags {
 	var ret fieldTags
 
-	for i, s := range strings.Split(f.Tag.Get("json"), ",") {
+	tag := f.Tag.Get("json")
+	if tag == "-" {
+		// The easyjson directives still apply, e.g. to collect unknown fields.
+		ret.omit = true
+		tag = ""
+	}
+
+	for i, s := range splitTagOptions(tag) {
 		switch {
-		case i == 0 && s == "-":
-			ret.omit = true
 		case i == 0:
-			ret.name = s
+			if name, ok := unquoteTagName(s); ok {
+				ret.name = name
+			} else if isValidTag(s) {
+				ret.name = s
+			}
+		case strings.HasPrefix(s, "format:"):
+			applyFormatOption(f.Type, formatOptionValue(s), &ret)
 		case s == "omitempty":
 			ret.omitEmpty = true
+		case s == "omitzero":
+			ret.omitZero = true
 		case s == "!omitempty":
 			ret.noOmitEmpty = true
 		case s == "string":
-			ret.asString = true
+			ret.asString = isStringableType(f.Type)
 		case s == "required":
 			ret.required = true
 		case s == "intern":
@@ -87,28 +174,383 @@ func parseFieldTags(f reflect.StructField) fieldTags {
 		}
 	}
 
+	directives, layout := splitTimeLayout(f.Tag.Get("easyjson"))
+	if layout != "" && isTimeType(f.Type) {
+		ret.timeLayout, ret.timeFormat = layout, ""
+	}
+	ds := strings.Split(directives, ",")
+	for i := 0; i < len(ds); i++ {
+		switch s := ds[i]; {
+		case strings.HasPrefix(s, "custom="):
+			ret.customEncoder = strings.TrimPrefix(s, "custom=")
+			if i+1 < len(ds) {
+				i++
+				ret.customDecoder = ds[i]
+			}
+		case strings.HasPrefix(s, "since="):
+			ret.since = strings.TrimPrefix(s, "since=")
+		case strings.HasPrefix(s, "until="):
+			ret.until = strings.TrimPrefix(s, "until=")
+		case strings.HasPrefix(s, "transform="):
+			ret.transform = strings.TrimPrefix(s, "transform=")
+		case strings.HasPrefix(s, "unknown="):
+			ret.unknown = strings.TrimPrefix(s, "unknown=")
+		case strings.HasPrefix(s, "discriminator="):
+			ret.discriminator = strings.TrimPrefix(s, "discriminator=")
+		case strings.HasPrefix(s, "typefield="):
+			ret.typeField = strings.TrimPrefix(s, "typefield=")
+		case strings.HasPrefix(s, "keyorder="):
+			ret.keyOrder = strings.TrimPrefix(s, "keyorder=")
+		case s == "number":
+			ret.asNumber = isBigType(f.Type) || isDecimalType(f.Type)
+		case s == "keepnull":
+			ret.keepOnNull = true
+		case s == "hex":
+			ret.hex = isHexType(f.Type)
+		case s == "noescape":
+			ret.noEscape = isNoEscapeType(f.Type)
+		case s == "errobject":
+			ret.errObject = true
+		case s == "required":
+			ret.required = true
+		case s == "unknown":
+			ret.unknownFields = true
+		case strings.HasPrefix(s, "format=") && timeFormatMethods[strings.TrimPrefix(s, "format=")] != "":
+			if isTimeType(f.Type) {
+				ret.timeFormat = strings.TrimPrefix(s, "format=")
+			}
+		}
+	}
+
 	return ret
 }
 
+// isValidTag reports whether s can be used as a json name, the same way encoding/json does.
+func isValidTag(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		switch {
+		case strings.ContainsRune("!#$%&()*+-./:;<=>?@[]^_{|}~ ", c):
+			// Backslash and quote chars are reserved, but
+			// otherwise any punctuation chars are allowed
+			// in a tag name.
+		case !unicode.IsLetter(c) && !unicode.IsDigit(c):
+			return false
+		}
+	}
+	return true
+}
+
+// isStringableType returns true if the 'string' tag option applies to the type of a field.
+func isStringableType(t reflect.Type) bool {
+	if t.Name() == "" && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if isSQLNullType(t) {
+		t = t.Field(0).Type
+	}
+	switch t.Kind() {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.String:
+		return true
+	}
+	return bigTypes[t] != ""
+}
+
+// isMapType returns true if the easyjson 'keyorder' tag option applies to the type of a field: maps
+// and pointers to them.
+func isMapType(t reflect.Type) bool {
+	if t.Name() == "" && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Map
+}
+
+// isHexType returns true if the easyjson 'hex' tag option applies to the type of a field: the
+// 64-bit wide unsigned integers, byte slices and byte arrays.
+func isHexType(t reflect.Type) bool {
+	if t.Name() == "" && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Uint, reflect.Uint64, reflect.Uintptr:
+		return true
+	case reflect.Slice, reflect.Array:
+		return t.Elem().Kind() == reflect.Uint8 && t.Elem().Name() == "uint8"
+	}
+	return false
+}
+
+// isNoEscapeType returns true if the easyjson 'noescape' directive applies to the type of a
+// field: strings and pointers, slices, arrays, maps and database/sql Null types of them.
+func isNoEscapeType(t reflect.Type) bool {
+	for {
+		switch t.Kind() {
+		case reflect.String:
+			return true
+		case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+			t = t.Elem()
+		case reflect.Struct:
+			if !isSQLNullType(t) {
+				return false
+			}
+			t = t.Field(0).Type
+		default:
+			return false
+		}
+	}
+}
+
+// needsNoEscaping returns true if s can be written with jwriter.Writer.StringNoEscape whatever
+// the writer options.
+func needsNoEscaping(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c < 0x20 || c >= 0x80 || strings.IndexByte(`"\<>&`, c) >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+var knownTagOptions = map[string]bool{
+	"omitempty":  true,
+	"!omitempty": true,
+	"omitzero":   true,
+	"string":     true,
+	"required":   true,
+	"intern":     true,
+	"nocopy":     true,
+}
+
+// fieldTagWarnings returns the problems found in the json tag of a field, which are silently
+// ignored while parsing it.
+func fieldTagWarnings(f reflect.StructField) []string {
+	var ret []string
+
+	tag, ok := f.Tag.Lookup("json")
+	if !ok {
+		if s := string(f.Tag); strings.HasPrefix(s, "json:") || strings.Contains(s, " json:") {
+			ret = append(ret, fmt.Sprintf("malformed struct tag %q", f.Tag))
+		}
+		return ret
+	}
+	if tag == "-" {
+		return ret
+	}
+
+	parts := splitTagOptions(tag)
+	if name := parts[0]; strings.HasPrefix(name, "'") {
+		if _, ok := unquoteTagName(name); !ok {
+			ret = append(ret, fmt.Sprintf("invalid quoted json name %s is ignored", name))
+		}
+	} else if name != "" {
+		if !isValidTag(name) {
+			ret = append(ret, fmt.Sprintf("invalid json name %q is ignored", name))
+		} else if strings.Contains(name, " ") {
+			ret = append(ret, fmt.Sprintf("json name %q contains spaces", name))
+		}
+	}
+
+	for _, opt := range parts[1:] {
+		switch {
+		case opt == "":
+		case opt == "string" && !isStringableType(f.Type):
+			ret = append(ret, fmt.Sprintf("option \"string\" is ignored for type %v", f.Type))
+		case strings.HasPrefix(opt, "format:"):
+			if !applyFormatOption(f.Type, formatOptionValue(opt), &fieldTags{}) {
+				ret = append(ret, fmt.Sprintf("option %q is ignored for type %v", opt, f.Type))
+			}
+		case knownTagOptions[opt]:
+		case normalizeTagOption(opt) == "omitempty":
+			ret = append(ret, fmt.Sprintf("unknown option %q is ignored, did you mean \"omitempty\"?", opt))
+		default:
+			ret = append(ret, fmt.Sprintf("unknown option %q is ignored", opt))
+		}
+	}
+	return ret
+}
+
+// flagDirectives are the easyjson directives without values, which may be mistaken for the
+// decoder name of the custom directive following them.
+var flagDirectives = map[string]bool{
+	"keepnull":  true,
+	"required":  true,
+	"unknown":   true,
+	"number":    true,
+	"hex":       true,
+	"noescape":  true,
+	"errobject": true,
+}
+
+// easyJSONTagWarnings returns the problems found in the easyjson tag of a field.
+func easyJSONTagWarnings(f reflect.StructField) []string {
+	var ret []string
+
+	tag, ok := f.Tag.Lookup("easyjson")
+	if !ok {
+		return ret
+	}
+	tag, layout := splitTimeLayout(tag)
+	if layout != "" && !isTimeType(f.Type) {
+		ret = append(ret, fmt.Sprintf("easyjson directive \"layout\" is ignored for type %v", f.Type))
+	}
+	ds := strings.Split(tag, ",")
+	for i := 0; i < len(ds); i++ {
+		switch s := ds[i]; {
+		case s == "":
+		case strings.HasPrefix(s, "custom="):
+			names := []string{strings.TrimPrefix(s, "custom="), ""}
+			if i+1 < len(ds) {
+				i++
+				names[1] = ds[i]
+			}
+			if names[0] == "" && names[1] == "" {
+				ret = append(ret, "empty function names in easyjson directive \"custom\"")
+			}
+			if flagDirectives[names[1]] {
+				ret = append(ret, fmt.Sprintf("easyjson directive %q is the decoder name of \"custom\", "+
+					"leave the name empty with \"custom=%s,,%s\" to apply it", names[1], names[0], names[1]))
+			}
+			for _, name := range names {
+				if name != "" && !isIdentifier(name) {
+					ret = append(ret, fmt.Sprintf("invalid function name %q in easyjson directive \"custom\"", name))
+				}
+			}
+		case strings.HasPrefix(s, "since="), strings.HasPrefix(s, "until="):
+			if strings.IndexByte(s, '=') == len(s)-1 {
+				ret = append(ret, fmt.Sprintf("empty version in easyjson directive %q", s))
+			}
+		case strings.HasPrefix(s, "transform="):
+			if s == "transform=" {
+				ret = append(ret, "empty transform name in easyjson directive")
+			}
+		case strings.HasPrefix(s, "unknown="):
+			if s == "unknown=" {
+				ret = append(ret, "empty fallback value in easyjson directive")
+			}
+		case strings.HasPrefix(s, "discriminator="):
+			if s == "discriminator=" {
+				ret = append(ret, "empty discriminator name in easyjson directive")
+			} else if !isVariantType(f.Type) {
+				ret = append(ret, fmt.Sprintf("easyjson directive \"discriminator\" is ignored for type %v", f.Type))
+			}
+		case strings.HasPrefix(s, "typefield="):
+			if s == "typefield=" {
+				ret = append(ret, "empty type field name in easyjson directive")
+			} else if f.Type.Kind() != reflect.Interface {
+				ret = append(ret, fmt.Sprintf("easyjson directive \"typefield\" is ignored for type %v", f.Type))
+			}
+		case strings.HasPrefix(s, "keyorder="):
+			if name := strings.TrimPrefix(s, "keyorder="); !isIdentifier(name) {
+				ret = append(ret, fmt.Sprintf("invalid variable name %q in easyjson directive \"keyorder\"", name))
+			} else if !isMapType(f.Type) {
+				ret = append(ret, fmt.Sprintf("easyjson directive \"keyorder\" is ignored for type %v", f.Type))
+			}
+		case s == "keepnull", s == "required", s == "unknown":
+		case s == "number":
+			if !isBigType(f.Type) && !isDecimalType(f.Type) {
+				ret = append(ret, fmt.Sprintf("easyjson directive \"number\" is ignored for type %v", f.Type))
+			}
+		case s == "hex":
+			if !isHexType(f.Type) {
+				ret = append(ret, fmt.Sprintf("easyjson directive \"hex\" is ignored for type %v", f.Type))
+			}
+		case s == "noescape":
+			if !isNoEscapeType(f.Type) {
+				ret = append(ret, fmt.Sprintf("easyjson directive \"noescape\" is ignored for type %v", f.Type))
+			}
+		case s == "errobject":
+			if !isErrorType(f.Type) {
+				ret = append(ret, fmt.Sprintf("easyjson directive \"errobject\" is ignored for type %v", f.Type))
+			}
+		case strings.HasPrefix(s, "format="):
+			if timeFormatMethods[strings.TrimPrefix(s, "format=")] == "" {
+				ret = append(ret, fmt.Sprintf("unknown time format in easyjson directive %q is ignored", s))
+			} else if !isTimeType(f.Type) {
+				ret = append(ret, fmt.Sprintf("easyjson directive \"format\" is ignored for type %v", f.Type))
+			}
+		default:
+			ret = append(ret, fmt.Sprintf("unknown easyjson directive %q is ignored", s))
+		}
+	}
+	return ret
+}
+
+// normalizeTagOption lowercases an option and strips separators, to detect misspellings.
+func normalizeTagOption(opt string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '_', '-', '!':
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, opt)
+}
+
 // genTypeEncoder generates code that encodes in of type t into the writer, but uses marshaler interface if implemented by t.
 func (g *Generator) genTypeEncoder(t reflect.Type, in string, tags fieldTags, indent int, assumeNonEmpty bool) error {
 	ws := strings.Repeat("  ", indent)
 
+	if t == timeType && (tags.timeLayout != "" || tags.timeFormat != "") {
+		g.genTimeEncoder(in, tags, indent)
+		return nil
+	}
+	if bigTypes[t] != "" {
+		g.genBigEncoder(t, in, tags, indent)
+		return nil
+	}
+	if g.decimals && t.Kind() == reflect.Struct && isDecimalType(t) {
+		g.genDecimalEncoder(in, tags, indent)
+		return nil
+	}
+	if ipTypes[t] != "" {
+		g.genIPEncoder(t, in, indent)
+		return nil
+	}
+	if k := knownTypeOf(t); g.knownTypes && k != nil {
+		g.genKnownEncoder(k, in, indent)
+		return nil
+	}
+	if g.sqlNulls() && isSQLNullType(t) {
+		return g.genSQLNullEncoder(t, in, tags, indent)
+	}
+	if g.protoWellKnown(t) {
+		return g.genProtoWellKnownEncoder(t, in, indent)
+	}
+
+	if g.callsGenerated(t) {
+		fmt.Fprintln(g.out, ws+g.getEncoderName(t)+"(out, "+in+")")
+		return nil
+	}
+
 	marshalerIface := reflect.TypeOf((*easyjson.Marshaler)(nil)).Elem()
-	if reflect.PtrTo(t).Implements(marshalerIface) {
+	if !g.standalone && reflect.PtrTo(t).Implements(marshalerIface) {
 		fmt.Fprintln(g.out, ws+"("+in+").MarshalEasyJSON(out)")
 		return nil
 	}
 
 	marshalerIface = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
 	if reflect.PtrTo(t).Implements(marshalerIface) {
-		fmt.Fprintln(g.out, ws+"out.Raw( ("+in+").MarshalJSON() )")
+		if g.stdlibCompat {
+			fmt.Fprintln(g.out, ws+"out.RawCompat( ("+in+").MarshalJSON() )")
+		} else {
+			fmt.Fprintln(g.out, ws+"out.Raw( ("+in+").MarshalJSON() )")
+		}
 		return nil
 	}
 
 	marshalerIface = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
 	if reflect.PtrTo(t).Implements(marshalerIface) {
-		fmt.Fprintln(g.out, ws+"out.RawText( ("+in+").MarshalText() )")
+		if g.stdlibCompat {
+			fmt.Fprintln(g.out, ws+"out.RawTextCompat( ("+in+").MarshalText() )")
+		} else {
+			fmt.Fprintln(g.out, ws+"out.RawText( ("+in+").MarshalText() )")
+		}
 		return nil
 	}
 
@@ -116,6 +558,32 @@ func (g *Generator) genTypeEncoder(t reflect.Type, in string, tags fieldTags, in
 	return err
 }
 
+// genNilElemCheck generates code that leaves out the nil pointer element in of a slice or an array
+// if the writer flags say so, and writes a comma before all but the first element written.
+func (g *Generator) genNilElemCheck(in, wroteVar string, indent int) {
+	ws := strings.Repeat("  ", indent)
+
+	fmt.Fprintln(g.out, ws+"if "+in+" == nil && out.SkipNilElem() {")
+	fmt.Fprintln(g.out, ws+"  continue")
+	fmt.Fprintln(g.out, ws+"}")
+	fmt.Fprintln(g.out, ws+"if "+wroteVar+" {")
+	fmt.Fprintln(g.out, ws+"  out.RawByte(',')")
+	fmt.Fprintln(g.out, ws+"}")
+	fmt.Fprintln(g.out, ws+wroteVar+" = true")
+}
+
+// callsGenerated returns true if the values of type t are marshaled and unmarshaled by calling
+// the functions generated for it in this file instead of its methods, e.g. the elements of a
+// named slice type, so that they are not dispatched through the method wrappers. Types with
+// hand-written methods and enums keep their methods. The same goes for the added instantiations
+// of generic types.
+func (g *Generator) callsGenerated(t reflect.Type) bool {
+	if g.isInstance(t) {
+		t = g.genericOf(t)
+	}
+	return g.marshalers[t] && len(g.kept[t]) == 0 && g.enums[t] == nil
+}
+
 // returns true if the type t implements one of the custom marshaler interfaces
 func hasCustomMarshaler(t reflect.Type) bool {
 	t = reflect.PtrTo(t)
@@ -128,13 +596,55 @@ func hasCustomMarshaler(t reflect.Type) bool {
 func (g *Generator) genTypeEncoderNoCheck(t reflect.Type, in string, tags fieldTags, indent int, assumeNonEmpty bool) error {
 	ws := strings.Repeat("  ", indent)
 
+	if g.isTypeParam(t) {
+		return g.genTypeParamEncoder(in, tags, indent, assumeNonEmpty)
+	}
+	if t == errorType {
+		g.genErrorEncoder(in, tags, indent, assumeNonEmpty)
+		return nil
+	}
+
+	if tags.hex && isHexType(t) && t.Kind() != reflect.Ptr {
+		switch t.Kind() {
+		case reflect.Slice:
+			fmt.Fprintln(g.out, ws+"out.RawStringHex("+in+")")
+		case reflect.Array:
+			fmt.Fprintln(g.out, ws+"out.RawStringHex(("+in+")[:])")
+		default:
+			fmt.Fprintln(g.out, ws+"out.Uint64Hex(uint64("+in+"))")
+		}
+		return nil
+	}
+
 	// Check whether type is primitive, needs to be done after interface check.
-	if enc := primitiveStringEncoders[t.Kind()]; enc != "" && tags.asString {
+	if enc := customEncoders[t.String()]; enc != "" {
+		if tags.asString {
+			enc = customStringEncoders[t.String()]
+		}
+		fmt.Fprintf(g.out, ws+enc+"\n", in)
+		return nil
+	} else if enc := primitiveStringEncoders[t.Kind()]; enc != "" && tags.asString {
+		if g.stdlibCompat {
+			if t.Kind() == reflect.String {
+				return fmt.Errorf("the 'string' option for string type %v is not supported in stdlib-compat mode", t)
+			}
+			if compatEnc := compatStringEncoders[t.Kind()]; compatEnc != "" {
+				enc = compatEnc
+			}
+		}
 		fmt.Fprintf(g.out, ws+enc+"\n", in)
 		return nil
 	}
 
+	if tags.noEscape && t.Kind() == reflect.String {
+		fmt.Fprintln(g.out, ws+"out.StringNoEscape(string("+in+"))")
+		return nil
+	}
+
 	if enc := primitiveEncoders[t.Kind()]; enc != "" {
+		if compatEnc := compatEncoders[t.Kind()]; compatEnc != "" && g.stdlibCompat {
+			enc = compatEnc
+		}
 		fmt.Fprintf(g.out, ws+enc+"\n", in)
 		return nil
 	}
@@ -145,8 +655,10 @@ func (g *Generator) genTypeEncoderNoCheck(t reflect.Type, in string, tags fieldT
 		iVar := g.uniqueVarName()
 		vVar := g.uniqueVarName()
 
-		if t.Elem().Kind() == reflect.Uint8 && elem.Name() == "uint8" {
-			if g.simpleBytes {
+		if t.Elem().Kind() == reflect.Uint8 && elem.Name() == "uint8" && tags.bytesFormat != bytesFormatArray {
+			if enc := bytesEncoders[tags.bytesFormat]; enc != "" {
+				fmt.Fprintf(g.out, ws+enc+"\n", in)
+			} else if g.simpleBytes && !g.stdlibCompat {
 				fmt.Fprintln(g.out, ws+"out.String(string("+in+"))")
 			} else {
 				fmt.Fprintln(g.out, ws+"out.Base64Bytes("+in+")")
@@ -160,10 +672,16 @@ func (g *Generator) genTypeEncoderNoCheck(t reflect.Type, in string, tags fieldT
 				fmt.Fprintln(g.out, ws+"{")
 			}
 			fmt.Fprintln(g.out, ws+"  out.RawByte('[')")
-			fmt.Fprintln(g.out, ws+"  for "+iVar+", "+vVar+" := range "+in+" {")
-			fmt.Fprintln(g.out, ws+"    if "+iVar+" > 0 {")
-			fmt.Fprintln(g.out, ws+"      out.RawByte(',')")
-			fmt.Fprintln(g.out, ws+"    }")
+			if elem.Kind() == reflect.Ptr {
+				fmt.Fprintln(g.out, ws+"  "+iVar+" := false")
+				fmt.Fprintln(g.out, ws+"  for _, "+vVar+" := range "+in+" {")
+				g.genNilElemCheck(vVar, iVar, indent+2)
+			} else {
+				fmt.Fprintln(g.out, ws+"  for "+iVar+", "+vVar+" := range "+in+" {")
+				fmt.Fprintln(g.out, ws+"    if "+iVar+" > 0 {")
+				fmt.Fprintln(g.out, ws+"      out.RawByte(',')")
+				fmt.Fprintln(g.out, ws+"    }")
+			}
 
 			if err := g.genTypeEncoder(elem, vVar, tags, indent+2, false); err != nil {
 				return err
@@ -178,7 +696,10 @@ func (g *Generator) genTypeEncoderNoCheck(t reflect.Type, in string, tags fieldT
 		elem := t.Elem()
 		iVar := g.uniqueVarName()
 
-		if t.Elem().Kind() == reflect.Uint8 && elem.Name() == "uint8" {
+		// encoding/json only encodes byte slices as base64, not arrays
+		if enc := bytesEncoders[tags.bytesFormat]; enc != "" {
+			fmt.Fprintf(g.out, ws+enc+"\n", "("+in+")[:]")
+		} else if t.Elem().Kind() == reflect.Uint8 && elem.Name() == "uint8" && !g.stdlibCompat && tags.bytesFormat != bytesFormatArray {
 			if g.simpleBytes {
 				fmt.Fprintln(g.out, ws+"out.String(string("+in+"[:]))")
 			} else {
@@ -186,13 +707,26 @@ func (g *Generator) genTypeEncoderNoCheck(t reflect.Type, in string, tags fieldT
 			}
 		} else {
 			fmt.Fprintln(g.out, ws+"out.RawByte('[')")
-			fmt.Fprintln(g.out, ws+"for "+iVar+" := range "+in+" {")
-			fmt.Fprintln(g.out, ws+"  if "+iVar+" > 0 {")
-			fmt.Fprintln(g.out, ws+"    out.RawByte(',')")
-			fmt.Fprintln(g.out, ws+"  }")
-
-			if err := g.genTypeEncoder(elem, "("+in+")["+iVar+"]", tags, indent+1, false); err != nil {
-				return err
+			if elem.Kind() == reflect.Ptr {
+				wroteVar := g.uniqueVarName()
+				vVar := g.uniqueVarName()
+				fmt.Fprintln(g.out, ws+wroteVar+" := false")
+				fmt.Fprintln(g.out, ws+"for "+iVar+" := range "+in+" {")
+				fmt.Fprintln(g.out, ws+"  "+vVar+" := ("+in+")["+iVar+"]")
+				g.genNilElemCheck(vVar, wroteVar, indent+1)
+
+				if err := g.genTypeEncoder(elem, vVar, tags, indent+1, false); err != nil {
+					return err
+				}
+			} else {
+				fmt.Fprintln(g.out, ws+"for "+iVar+" := range "+in+" {")
+				fmt.Fprintln(g.out, ws+"  if "+iVar+" > 0 {")
+				fmt.Fprintln(g.out, ws+"    out.RawByte(',')")
+				fmt.Fprintln(g.out, ws+"  }")
+
+				if err := g.genTypeEncoder(elem, "("+in+")["+iVar+"]", tags, indent+1, false); err != nil {
+					return err
+				}
 			}
 
 			fmt.Fprintln(g.out, ws+"}")
@@ -221,11 +755,11 @@ func (g *Generator) genTypeEncoderNoCheck(t reflect.Type, in string, tags fieldT
 		}
 
 	case reflect.Map:
+		if g.stdlibCompat || tags.keyOrder != "" {
+			return g.genSortedMapEncoder(t, in, tags, indent, assumeNonEmpty)
+		}
+
 		key := t.Key()
-		keyEnc, ok := primitiveStringEncoders[key.Kind()]
-		if !ok && !hasCustomMarshaler(key) {
-			return fmt.Errorf("map key type %v not supported: only string and integer keys and types implementing Marshaler interfaces are allowed", key)
-		} // else assume the caller knows what they are doing and that the custom marshaler performs the translation from the key type to a string or integer
 		tmpVar := g.uniqueVarName()
 
 		if !assumeNonEmpty {
@@ -240,17 +774,9 @@ func (g *Generator) genTypeEncoderNoCheck(t reflect.Type, in string, tags fieldT
 		fmt.Fprintln(g.out, ws+"  for "+tmpVar+"Name, "+tmpVar+"Value := range "+in+" {")
 		fmt.Fprintln(g.out, ws+"    if "+tmpVar+"First { "+tmpVar+"First = false } else { out.RawByte(',') }")
 
-		// NOTE: extra check for TextMarshaler. It overrides default methods.
-		if reflect.PtrTo(key).Implements(reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()) {
-			fmt.Fprintln(g.out, ws+"    "+fmt.Sprintf("out.RawText(("+tmpVar+"Name).MarshalText()"+")"))
-		} else if keyEnc != "" {
-			fmt.Fprintln(g.out, ws+"    "+fmt.Sprintf(keyEnc, tmpVar+"Name"))
-		} else {
-			if err := g.genTypeEncoder(key, tmpVar+"Name", tags, indent+2, false); err != nil {
-				return err
-			}
+		if err := g.genMapKeyEncoder(key, tmpVar+"Name", tags, indent+2); err != nil {
+			return err
 		}
-
 		fmt.Fprintln(g.out, ws+"    out.RawByte(':')")
 
 		if err := g.genTypeEncoder(t.Elem(), tmpVar+"Value", tags, indent+2, false); err != nil {
@@ -262,18 +788,50 @@ func (g *Generator) genTypeEncoderNoCheck(t reflect.Type, in string, tags fieldT
 		fmt.Fprintln(g.out, ws+"}")
 
 	case reflect.Interface:
+		if tags.typeField != "" {
+			return g.genTypeFieldEncoder(t, in, indent)
+		}
+		if tags.discriminator != "" {
+			return g.genVariantEncoder(t, in, tags, indent)
+		}
+		if isTypedInterface(t) {
+			return g.genTypedEncoder(t, in, indent)
+		}
 		if t.NumMethod() != 0 {
-			if g.interfaceIsEasyjsonMarshaller(t) {
-				fmt.Fprintln(g.out, ws+in+".MarshalEasyJSON(out)")
-			} else if g.interfaceIsJSONMarshaller(t) {
-				fmt.Fprintln(g.out, ws+"if m, ok := "+in+".(easyjson.Marshaler); ok {")
-				fmt.Fprintln(g.out, ws+"  m.MarshalEasyJSON(out)")
+			easy := !g.standalone && g.interfaceIsEasyjsonMarshaller(t)
+			if !easy && !g.interfaceIsJSONMarshaller(t) && !g.interfaceIsTextMarshaller(t) {
+				return fmt.Errorf("interface type %v not supported: only interface{} and interfaces that implement json, text or easyjson Marshaling are allowed", t)
+			}
+			raw, rawText := "Raw", "RawText"
+			if g.stdlibCompat {
+				raw, rawText = "RawCompat", "RawTextCompat"
+			}
+
+			// nil values are written as null, the others with the most specific method.
+			fmt.Fprintln(g.out, ws+"if "+in+" == nil {")
+			fmt.Fprintln(g.out, ws+`  out.RawString("null")`)
+			switch {
+			case easy:
 				fmt.Fprintln(g.out, ws+"} else {")
-				fmt.Fprintln(g.out, ws+in+".MarshalJSON(out)")
-				fmt.Fprintln(g.out, ws+"}")
-			} else {
-				return fmt.Errorf("interface type %v not supported: only interface{} and interfaces that implement json or easyjson Marshaling are allowed", t)
+				fmt.Fprintln(g.out, ws+"  "+in+".MarshalEasyJSON(out)")
+			case g.interfaceIsJSONMarshaller(t):
+				if !g.standalone {
+					fmt.Fprintln(g.out, ws+"} else if m, ok := "+in+".(easyjson.Marshaler); ok {")
+					fmt.Fprintln(g.out, ws+"  m.MarshalEasyJSON(out)")
+				}
+				fmt.Fprintln(g.out, ws+"} else {")
+				fmt.Fprintln(g.out, ws+"  out."+raw+"("+in+".MarshalJSON())")
+			default:
+				fmt.Fprintln(g.out, ws+"} else {")
+				fmt.Fprintln(g.out, ws+"  out."+rawText+"("+in+".MarshalText())")
 			}
+			fmt.Fprintln(g.out, ws+"}")
+		} else if g.standalone {
+			fmt.Fprintln(g.out, ws+"if m, ok := "+in+".(json.Marshaler); ok {")
+			fmt.Fprintln(g.out, ws+"  out.Raw(m.MarshalJSON())")
+			fmt.Fprintln(g.out, ws+"} else {")
+			fmt.Fprintln(g.out, ws+"  out.Raw(json.Marshal("+in+"))")
+			fmt.Fprintln(g.out, ws+"}")
 		} else {
 			fmt.Fprintln(g.out, ws+"if m, ok := "+in+".(easyjson.Marshaler); ok {")
 			fmt.Fprintln(g.out, ws+"  m.MarshalEasyJSON(out)")
@@ -283,12 +841,179 @@ func (g *Generator) genTypeEncoderNoCheck(t reflect.Type, in string, tags fieldT
 			fmt.Fprintln(g.out, ws+"  out.Raw(json.Marshal("+in+"))")
 			fmt.Fprintln(g.out, ws+"}")
 		}
+	case reflect.Func:
+		if _, _, ok := iteratorTypes(t); !ok {
+			return fmt.Errorf("don't know how to encode %v", t)
+		}
+		return g.genIteratorEncoder(t, in, tags, indent, assumeNonEmpty)
+
 	default:
 		return fmt.Errorf("don't know how to encode %v", t)
 	}
 	return nil
 }
 
+// genMapKeyEncoder generates code that encodes the map key name of type key.
+func (g *Generator) genMapKeyEncoder(key reflect.Type, name string, tags fieldTags, indent int) error {
+	ws := strings.Repeat("  ", indent)
+
+	keyEnc, ok := primitiveStringEncoders[key.Kind()]
+	if !ok && !hasCustomMarshaler(key) {
+		return fmt.Errorf("map key type %v not supported: only string and integer keys and types implementing Marshaler interfaces or encoding.TextMarshaler are allowed", key)
+	} // else assume the caller knows what they are doing and that the custom marshaler performs the translation from the key type to a string or integer
+
+	// NOTE: extra check for TextMarshaler. It overrides default methods, but keys of string kinds
+	// are written as is, like encoding/json does.
+	if key.Kind() != reflect.String && reflect.PtrTo(key).Implements(reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()) {
+		fmt.Fprintln(g.out, ws+"out.TextKey(("+name+").MarshalText())")
+	} else if keyEnc != "" {
+		fmt.Fprintln(g.out, ws+fmt.Sprintf(keyEnc, name))
+	} else {
+		return g.genTypeEncoder(key, name, tags, indent, false)
+	}
+	return nil
+}
+
+// genSortedMapEncoder generates code that encodes map in of type t like encoding/json: keys
+// are converted to strings and written in sorted order, or in the order of the keyorder easyjson
+// directive.
+func (g *Generator) genSortedMapEncoder(t reflect.Type, in string, tags fieldTags, indent int, assumeNonEmpty bool) error {
+	ws := strings.Repeat("  ", indent)
+	key := t.Key()
+	tmpVar := g.uniqueVarName()
+
+	mode := "in stdlib-compat mode"
+	less := tmpVar + "Pairs[i].Name < " + tmpVar + "Pairs[j].Name"
+	if tags.keyOrder != "" {
+		if g.standalone {
+			return fmt.Errorf("easyjson directive \"keyorder\" is not supported in standalone mode")
+		}
+		mode = "with easyjson directive \"keyorder\""
+		// the names the order does not tell apart are ordered by themselves
+		order := g.keyOrderVar(tags.keyOrder)
+		less = order + "(" + tmpVar + "Pairs[i].Name, " + tmpVar + "Pairs[j].Name) || !" +
+			order + "(" + tmpVar + "Pairs[j].Name, " + tmpVar + "Pairs[i].Name) && " + less
+	}
+	// the order applies to the map only, not to the maps in its values
+	elemTags := tags
+	elemTags.keyOrder = ""
+
+	// The key conversion follows encoding/json: string kinds take precedence over
+	// encoding.TextMarshaler, integers are formatted in decimal.
+	var keyName string
+	switch key.Kind() {
+	case reflect.String:
+		keyName = "string(" + tmpVar + "Name)"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		keyName = g.pkgAlias("strconv") + ".FormatInt(int64(" + tmpVar + "Name), 10)"
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		keyName = g.pkgAlias("strconv") + ".FormatUint(uint64(" + tmpVar + "Name), 10)"
+	}
+	textMarshaler := reflect.PtrTo(key).Implements(reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem())
+	if keyName == "" && !textMarshaler {
+		return fmt.Errorf("map key type %v not supported %v: only string and integer keys and types implementing encoding.TextMarshaler are allowed", key, mode)
+	}
+
+	pair := "struct { Name string; Value " + g.getType(t.Elem()) + " }"
+
+	if !assumeNonEmpty {
+		fmt.Fprintln(g.out, ws+"if "+in+" == nil && (out.Flags & jwriter.NilMapAsEmpty) == 0 {")
+		fmt.Fprintln(g.out, ws+"  out.RawString(`null`)")
+		fmt.Fprintln(g.out, ws+"} else {")
+	} else {
+		fmt.Fprintln(g.out, ws+"{")
+	}
+	fmt.Fprintln(g.out, ws+"  "+tmpVar+"Pairs := make([]"+pair+", 0, len("+in+"))")
+	fmt.Fprintln(g.out, ws+"  for "+tmpVar+"Name, "+tmpVar+"Value := range "+in+" {")
+	if keyName == "" {
+		fmt.Fprintln(g.out, ws+"    "+tmpVar+"Text, err := ("+tmpVar+"Name).MarshalText()")
+		fmt.Fprintln(g.out, ws+"    if err != nil && out.Error == nil {")
+		fmt.Fprintln(g.out, ws+"      out.Error = err")
+		fmt.Fprintln(g.out, ws+"    }")
+		// encoding/json sorts marshaled keys as written, i.e. with invalid UTF-8 replaced
+		keyName = "jwriter.ValidUTF8(string(" + tmpVar + "Text))"
+	}
+	fmt.Fprintln(g.out, ws+"    "+tmpVar+"Pairs = append("+tmpVar+"Pairs, "+pair+"{"+keyName+", "+tmpVar+"Value})")
+	fmt.Fprintln(g.out, ws+"  }")
+	fmt.Fprintln(g.out, ws+"  "+g.pkgAlias("sort")+".SliceStable("+tmpVar+"Pairs, func(i, j int) bool { return "+less+" })")
+	fmt.Fprintln(g.out, ws+"  out.RawByte('{')")
+	fmt.Fprintln(g.out, ws+"  for "+tmpVar+"I, "+tmpVar+"Pair := range "+tmpVar+"Pairs {")
+	fmt.Fprintln(g.out, ws+"    if "+tmpVar+"I > 0 {")
+	fmt.Fprintln(g.out, ws+"      out.RawByte(',')")
+	fmt.Fprintln(g.out, ws+"    }")
+	if g.stdlibCompat {
+		fmt.Fprintln(g.out, ws+"    out.StringCompat("+tmpVar+"Pair.Name)")
+	} else {
+		fmt.Fprintln(g.out, ws+"    out.String("+tmpVar+"Pair.Name)")
+	}
+	fmt.Fprintln(g.out, ws+"    out.RawByte(':')")
+
+	if err := g.genTypeEncoder(t.Elem(), tmpVar+"Pair.Value", elemTags, indent+2, false); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(g.out, ws+"  }")
+	fmt.Fprintln(g.out, ws+"  out.RawByte('}')")
+	fmt.Fprintln(g.out, ws+"}")
+	return nil
+}
+
+// keyOrderVar returns the name of the variable of the generated code holding the easyjson.KeyOrder
+// function of the variable name of the package.
+func (g *Generator) keyOrderVar(name string) string {
+	v, ok := g.keyOrders[name]
+	if !ok {
+		v = joinFunctionNameParts(true, "easyjson", g.hashString, "keyOrder", name)
+		g.keyOrders[name] = v
+	}
+	return v
+}
+
+// genKeyOrders generates the variables holding the easyjson.KeyOrder functions of the keyorder
+// easyjson directives.
+func (g *Generator) genKeyOrders() {
+	var names []string
+	for name := range g.keyOrders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintln(g.out, "var "+g.keyOrders[name]+" = easyjson.KeyOrder("+name+")")
+	}
+}
+
+// quoteFieldName returns the member name as a JSON string literal. Names set with single-quoted
+// json tags may hold any characters, so they are escaped by the writer like other strings.
+func (g *Generator) quoteFieldName(name string) string {
+	if g.stdlibCompat {
+		data, _ := json.Marshal(name)
+		return string(data)
+	}
+	w := jwriter.Writer{NoEscapeHTML: true}
+	w.String(name)
+	return string(w.Buffer.BuildBytes())
+}
+
+// isTransformableType returns true if a registered transform can be applied to type t.
+func isTransformableType(t reflect.Type) bool {
+	return t.Kind() == reflect.String || (t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8)
+}
+
+// genTransformEncoder generates code that converts in of type t with a registered transform
+// and encodes the result as a string.
+func (g *Generator) genTransformEncoder(t reflect.Type, in string, tags fieldTags, indent int) error {
+	if !isTransformableType(t) {
+		return fmt.Errorf("transform %q is not supported for type %v: only string and []byte are allowed", tags.transform, t)
+	}
+	if g.standalone {
+		return fmt.Errorf("transform %q is not supported in standalone mode", tags.transform)
+	}
+	ws := strings.Repeat("  ", indent)
+
+	fmt.Fprintf(g.out, ws+"out.TextString(easyjson.EncodeTransform(%q, []byte(%v)))\n", tags.transform, in)
+	return nil
+}
+
 func (g *Generator) interfaceIsEasyjsonMarshaller(t reflect.Type) bool {
 	return t.Implements(reflect.TypeOf((*easyjson.Marshaler)(nil)).Elem())
 }
@@ -297,16 +1022,27 @@ func (g *Generator) interfaceIsJSONMarshaller(t reflect.Type) bool {
 	return t.Implements(reflect.TypeOf((*json.Marshaler)(nil)).Elem())
 }
 
+func (g *Generator) interfaceIsTextMarshaller(t reflect.Type) bool {
+	return t.Implements(reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem())
+}
+
 func (g *Generator) notEmptyCheck(t reflect.Type, v string) string {
 	optionalIface := reflect.TypeOf((*easyjson.Optional)(nil)).Elem()
 	if reflect.PtrTo(t).Implements(optionalIface) {
 		return "(" + v + ").IsDefined()"
 	}
+	// A pointer to an undefined optional value is as empty as a nil pointer.
+	if t.Kind() == reflect.Ptr && t.Implements(optionalIface) {
+		return v + " != nil && (" + v + ").IsDefined()"
+	}
+	if g.sqlNulls() && isSQLNullType(t) {
+		return "(" + v + ").Valid"
+	}
 
 	switch t.Kind() {
 	case reflect.Slice, reflect.Map:
 		return "len(" + v + ") != 0"
-	case reflect.Interface, reflect.Ptr:
+	case reflect.Interface, reflect.Ptr, reflect.Func:
 		return v + " != nil"
 	case reflect.Bool:
 		return v
@@ -324,6 +1060,80 @@ func (g *Generator) notEmptyCheck(t reflect.Type, v string) string {
 	}
 }
 
+// notZeroCheck returns the condition of v of type t not being the zero value, for the
+// omitzero option. Like in encoding/json, the IsZero method is used if t has one.
+func (g *Generator) notZeroCheck(t reflect.Type, v string) string {
+	isZeroer := reflect.TypeOf((*interface{ IsZero() bool })(nil)).Elem()
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if t.Implements(isZeroer) {
+			return v + " != nil && !(" + v + ").IsZero()"
+		}
+	}
+	if t.Implements(isZeroer) || reflect.PtrTo(t).Implements(isZeroer) {
+		return "!(" + v + ").IsZero()"
+	}
+
+	switch t.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Interface, reflect.Ptr, reflect.Func, reflect.Chan:
+		return v + " != nil"
+	case reflect.Bool:
+		return v
+	case reflect.String:
+		return v + ` != ""`
+	case reflect.Float32, reflect.Float64,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Complex64, reflect.Complex128:
+		return v + " != 0"
+	}
+	if !g.isTypeParam(t) && isZeroComparable(t) {
+		return v + " != (" + g.getType(t) + "{})"
+	}
+	return "!" + g.pkgAlias("reflect") + ".ValueOf(&" + v + ").Elem().IsZero()"
+}
+
+// isZeroComparable tells if the zero value of the struct or array type t can be detected with
+// ==. It can not if t has interface fields, as comparing them panics for some dynamic types.
+func isZeroComparable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Interface:
+		return false
+	case reflect.Array:
+		return isZeroComparable(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if !isZeroComparable(t.Field(i).Type) {
+				return false
+			}
+		}
+	}
+	return t.Comparable()
+}
+
+// genFieldPrefix writes the name of the member, preceded by a comma unless it is the first one
+// written. The first member written is only known at runtime if there were conditional ones.
+func (g *Generator) genFieldPrefix(jsonName string, first, firstCondition, conditional bool) {
+	fmt.Fprintf(g.out, "    const prefix string = %q\n", ","+g.quoteFieldName(jsonName)+":")
+	if !firstCondition {
+		fmt.Fprintln(g.out, "    out.RawString(prefix)")
+		return
+	}
+	if first {
+		if conditional {
+			fmt.Fprintln(g.out, "      first = false")
+		}
+		fmt.Fprintln(g.out, "      out.RawString(prefix[1:])")
+		return
+	}
+	fmt.Fprintln(g.out, "    if first {")
+	fmt.Fprintln(g.out, "      first = false")
+	fmt.Fprintln(g.out, "      out.RawString(prefix[1:])")
+	fmt.Fprintln(g.out, "    } else {")
+	fmt.Fprintln(g.out, "      out.RawString(prefix)")
+	fmt.Fprintln(g.out, "    }")
+}
+
 func (g *Generator) genStructFieldEncoder(t reflect.Type, f reflect.StructField, first, firstCondition bool) (bool, error) {
 	jsonName := g.fieldNamer.GetJSONFieldName(t, f)
 	tags := parseFieldTags(f)
@@ -331,39 +1141,46 @@ func (g *Generator) genStructFieldEncoder(t reflect.Type, f reflect.StructField,
 	if tags.omit {
 		return firstCondition, nil
 	}
+	if g.isOneofField(f) {
+		return g.genOneofFieldEncoder(t, f, first, firstCondition)
+	}
 
 	toggleFirstCondition := firstCondition
 
 	noOmitEmpty := (!tags.omitEmpty && !g.omitEmpty) || tags.noOmitEmpty
-	if noOmitEmpty {
+
+	// encoding/json skips the fields promoted through nil embedded pointers
+	conditions := embeddedNotNilChecks("in", t, f)
+	if !noOmitEmpty {
+		conditions = append(conditions, g.notEmptyCheck(f.Type, "in."+f.Name))
+	}
+	if tags.omitZero {
+		conditions = append(conditions, g.notZeroCheck(f.Type, "in."+f.Name))
+	}
+	if tags.since != "" {
+		conditions = append(conditions, fmt.Sprintf("out.VersionAtLeast(%q)", tags.since))
+	}
+	if tags.until != "" {
+		conditions = append(conditions, fmt.Sprintf("out.VersionBefore(%q)", tags.until))
+	}
+
+	if len(conditions) == 0 {
 		fmt.Fprintln(g.out, "  {")
 		toggleFirstCondition = false
 	} else {
-		fmt.Fprintln(g.out, "  if", g.notEmptyCheck(f.Type, "in."+f.Name), "{")
+		fmt.Fprintln(g.out, "  if", strings.Join(conditions, " && "), "{")
 		// can be any in runtime, so toggleFirstCondition stay as is
 	}
 
-	if firstCondition {
-		fmt.Fprintf(g.out, "    const prefix string = %q\n", ","+strconv.Quote(jsonName)+":")
-		if first {
-			if !noOmitEmpty {
-				fmt.Fprintln(g.out, "      first = false")
-			}
-			fmt.Fprintln(g.out, "      out.RawString(prefix[1:])")
-		} else {
-			fmt.Fprintln(g.out, "    if first {")
-			fmt.Fprintln(g.out, "      first = false")
-			fmt.Fprintln(g.out, "      out.RawString(prefix[1:])")
-			fmt.Fprintln(g.out, "    } else {")
-			fmt.Fprintln(g.out, "      out.RawString(prefix)")
-			fmt.Fprintln(g.out, "    }")
-		}
-	} else {
-		fmt.Fprintf(g.out, "    const prefix string = %q\n", ","+strconv.Quote(jsonName)+":")
-		fmt.Fprintln(g.out, "    out.RawString(prefix)")
-	}
+	g.genFieldPrefix(jsonName, first, firstCondition, len(conditions) > 0)
 
-	if err := g.genTypeEncoder(f.Type, "in."+f.Name, tags, 2, !noOmitEmpty); err != nil {
+	if tags.customEncoder != "" {
+		g.genCustomEncoder("in."+f.Name, tags, 2)
+	} else if tags.transform != "" {
+		if err := g.genTransformEncoder(f.Type, "in."+f.Name, tags, 2); err != nil {
+			return toggleFirstCondition, err
+		}
+	} else if err := g.genTypeEncoder(f.Type, "in."+f.Name, tags, 2, !noOmitEmpty); err != nil {
 		return toggleFirstCondition, err
 	}
 	fmt.Fprintln(g.out, "  }")
@@ -371,25 +1188,39 @@ func (g *Generator) genStructFieldEncoder(t reflect.Type, f reflect.StructField,
 }
 
 func (g *Generator) genEncoder(t reflect.Type) error {
-	switch t.Kind() {
-	case reflect.Slice, reflect.Array, reflect.Map:
-		return g.genSliceArrayMapEncoder(t)
-	default:
+	if g.enums[t] != nil {
+		return g.genEnumEncoder(t)
+	}
+	if t.Kind() == reflect.Struct {
 		return g.genStructEncoder(t)
 	}
+	return g.genValueEncoder(t)
 }
 
-func (g *Generator) genSliceArrayMapEncoder(t reflect.Type) error {
-	switch t.Kind() {
-	case reflect.Slice, reflect.Array, reflect.Map:
-	default:
-		return fmt.Errorf("cannot generate encoder/decoder for %v, not a slice/array/map type", t)
+// isValueKind tells if marshalers can be generated for named types of kind k other than structs.
+func isValueKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// genValueEncoder generates the encoder of a named type of a kind other than struct.
+func (g *Generator) genValueEncoder(t reflect.Type) error {
+	if !isValueKind(t.Kind()) {
+		return fmt.Errorf("cannot generate encoder/decoder for %v, not a struct, slice, array, map or basic type", t)
 	}
 
 	fname := g.getEncoderName(t)
 	typ := g.getType(t)
+	params, _ := g.typeParamLists(typ)
 
-	fmt.Fprintln(g.out, "func "+fname+"(out *jwriter.Writer, in "+typ+") {")
+	fmt.Fprintln(g.out, "func "+fname+params+"(out *jwriter.Writer, in "+typ+") {")
+	g.genInstanceEncoderDispatch(t)
 	err := g.genTypeEncoderNoCheck(t, "in", fieldTags{}, 1, false)
 	if err != nil {
 		return err
@@ -405,19 +1236,28 @@ func (g *Generator) genStructEncoder(t reflect.Type) error {
 
 	fname := g.getEncoderName(t)
 	typ := g.getType(t)
+	params, _ := g.typeParamLists(typ)
 
-	fmt.Fprintln(g.out, "func "+fname+"(out *jwriter.Writer, in "+typ+") {")
+	fmt.Fprintln(g.out, "func "+fname+params+"(out *jwriter.Writer, in "+typ+") {")
+	g.genInstanceEncoderDispatch(t)
 	fmt.Fprintln(g.out, "  out.RawByte('{')")
 	fmt.Fprintln(g.out, "  first := true")
 	fmt.Fprintln(g.out, "  _ = first")
 
-	fs, err := getStructFields(t)
+	fs, err := g.structFields(t)
 	if err != nil {
 		return fmt.Errorf("cannot generate encoder for %v: %v", t, err)
 	}
 
 	firstCondition := true
 	for i, f := range fs {
+		for _, w := range fieldTagWarnings(f) {
+			g.warnf("%v.%v: %v", t, f.Name, w)
+		}
+		for _, w := range easyJSONTagWarnings(f) {
+			g.warnf("%v.%v: %v", t, f.Name, w)
+		}
+
 		firstCondition, err = g.genStructFieldEncoder(t, f, i == 0, firstCondition)
 
 		if err != nil {
@@ -425,7 +1265,18 @@ func (g *Generator) genStructEncoder(t reflect.Type) error {
 		}
 	}
 
+	uf, hasUnknownFields, err := g.unknownFieldsField(t)
+	if err != nil {
+		return fmt.Errorf("cannot generate encoder for %v: %v", t, err)
+	}
+	if hasUnknownFields {
+		g.genUnknownFieldsEncoder(t, fs, uf, firstCondition)
+	}
+
 	if hasUnknownsMarshaler(t) {
+		if g.standalone {
+			return fmt.Errorf("cannot generate encoder for %v: unknown fields marshalers are not supported in standalone mode", t)
+		}
 		if !firstCondition {
 			fmt.Fprintln(g.out, "  in.MarshalUnknowns(out, false)")
 		} else {
@@ -440,27 +1291,50 @@ func (g *Generator) genStructEncoder(t reflect.Type) error {
 }
 
 func (g *Generator) genStructMarshaler(t reflect.Type) error {
-	switch t.Kind() {
-	case reflect.Slice, reflect.Array, reflect.Map, reflect.Struct:
+	switch {
+	case g.enums[t] != nil:
+	case t.Kind() == reflect.Struct, isValueKind(t.Kind()):
 	default:
-		return fmt.Errorf("cannot generate encoder/decoder for %v, not a struct/slice/array/map type", t)
+		return fmt.Errorf("cannot generate encoder/decoder for %v, not a struct, slice, array, map or basic type", t)
 	}
 
 	fname := g.getEncoderName(t)
 	typ := g.getType(t)
 
-	if !g.noStdMarshalers {
+	if !g.noStdMarshalers && !g.kept[t]["MarshalJSON"] {
 		fmt.Fprintln(g.out, "// MarshalJSON supports json.Marshaler interface")
 		fmt.Fprintln(g.out, "func (v "+typ+") MarshalJSON() ([]byte, error) {")
 		fmt.Fprintln(g.out, "  w := jwriter.Writer{}")
-		fmt.Fprintln(g.out, "  "+fname+"(&w, v)")
+		if g.kept[t]["MarshalEasyJSON"] {
+			fmt.Fprintln(g.out, "  v.MarshalEasyJSON(&w)")
+		} else {
+			fmt.Fprintln(g.out, "  "+fname+"(&w, v)")
+		}
 		fmt.Fprintln(g.out, "  return w.Buffer.BuildBytes(), w.Error")
 		fmt.Fprintln(g.out, "}")
 	}
 
+	if g.standalone || g.kept[t]["MarshalEasyJSON"] {
+		return nil
+	}
+
 	fmt.Fprintln(g.out, "// MarshalEasyJSON supports easyjson.Marshaler interface")
-	fmt.Fprintln(g.out, "func (v "+typ+") MarshalEasyJSON(w *jwriter.Writer) {")
-	fmt.Fprintln(g.out, "  "+fname+"(w, v)")
+	if g.nilGuards {
+		fmt.Fprintln(g.out, "func (v *"+typ+") MarshalEasyJSON(w *jwriter.Writer) {")
+		fmt.Fprintln(g.out, "  if v == nil {")
+		fmt.Fprintln(g.out, "    w.RawString(\"null\")")
+		fmt.Fprintln(g.out, "    return")
+		fmt.Fprintln(g.out, "  }")
+	} else {
+		fmt.Fprintln(g.out, "func (v "+typ+") MarshalEasyJSON(w *jwriter.Writer) {")
+	}
+	if g.kept[t]["MarshalJSON"] {
+		fmt.Fprintln(g.out, "  w.Raw(v.MarshalJSON())")
+	} else if g.nilGuards {
+		fmt.Fprintln(g.out, "  "+fname+"(w, *v)")
+	} else {
+		fmt.Fprintln(g.out, "  "+fname+"(w, v)")
+	}
 	fmt.Fprintln(g.out, "}")
 
 	return nil
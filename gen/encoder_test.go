@@ -0,0 +1,150 @@
+package gen
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseFieldTags(t *testing.T) {
+	for i, test := range []struct {
+		Tag  reflect.StructTag
+		Type reflect.Type
+		Want fieldTags
+	}{
+		{``, reflect.TypeOf(0), fieldTags{}},
+		{`json:"-"`, reflect.TypeOf(0), fieldTags{omit: true}},
+		{`json:"-,"`, reflect.TypeOf(0), fieldTags{name: "-"}},
+		{`json:"name,omitempty"`, reflect.TypeOf(0), fieldTags{name: "name", omitEmpty: true}},
+		{`json:"na\\me,omitempty"`, reflect.TypeOf(0), fieldTags{omitEmpty: true}},
+		{`json:",string"`, reflect.TypeOf(0), fieldTags{asString: true}},
+		{`json:",string"`, reflect.TypeOf(new(int)), fieldTags{asString: true}},
+		{`json:",string"`, reflect.TypeOf([]int{}), fieldTags{}},
+		{`json:",string"`, reflect.TypeOf(new(big.Int)), fieldTags{asString: true}},
+		{`easyjson:"number"`, reflect.TypeOf(big.Rat{}), fieldTags{asNumber: true}},
+		{`easyjson:"number"`, reflect.TypeOf(""), fieldTags{}},
+		{`json:"name,omitzero"`, reflect.TypeOf(0), fieldTags{name: "name", omitZero: true}},
+		{`json:"name,omitEmpty,unknown"`, reflect.TypeOf(0), fieldTags{name: "name"}},
+		{`json:"name" easyjson:"since=v2,until=v3"`, reflect.TypeOf(0), fieldTags{name: "name", since: "v2", until: "v3"}},
+		{`json:"data" easyjson:"transform=gzipb64"`, reflect.TypeOf([]byte(nil)), fieldTags{name: "data", transform: "gzipb64"}},
+		{`easyjson:"hex"`, reflect.TypeOf(new(uint64)), fieldTags{hex: true}},
+		{`easyjson:"hex"`, reflect.TypeOf([20]byte{}), fieldTags{hex: true}},
+		{`easyjson:"hex"`, reflect.TypeOf(int64(0)), fieldTags{}},
+		{`json:"id" easyjson:"noescape"`, reflect.TypeOf([]string(nil)), fieldTags{name: "id", noEscape: true}},
+		{`easyjson:"noescape"`, reflect.TypeOf([]byte(nil)), fieldTags{}},
+		{`easyjson:"errobject"`, errorType, fieldTags{errObject: true}},
+		{`easyjson:"unknown"`, reflect.TypeOf(map[string][]byte(nil)), fieldTags{unknownFields: true}},
+		{`easyjson:"discriminator=kind"`, errorType, fieldTags{discriminator: "kind"}},
+		{`easyjson:"typefield=kind"`, errorType, fieldTags{typeField: "kind"}},
+		{`json:"id" easyjson:"required"`, reflect.TypeOf(0), fieldTags{name: "id", required: true}},
+		{`easyjson:"layout=2006-01-02"`, timeType, fieldTags{timeLayout: "2006-01-02"}},
+		{`easyjson:"keepnull,layout=Mon, 02 Jan 2006"`, reflect.TypeOf([]*time.Time{}), fieldTags{keepOnNull: true, timeLayout: "Mon, 02 Jan 2006"}},
+		{`easyjson:"format=unixmilli"`, reflect.TypeOf(new(time.Time)), fieldTags{timeFormat: "unixmilli"}},
+		{`easyjson:"format=unix"`, reflect.TypeOf(0), fieldTags{}},
+		{`easyjson:"format=iso"`, timeType, fieldTags{}},
+		{`json:"at,format:RFC3339Nano"`, timeType, fieldTags{name: "at", timeLayout: time.RFC3339Nano}},
+		{`json:"at,format:unix"`, reflect.TypeOf([]time.Time{}), fieldTags{name: "at", timeFormat: "unix"}},
+		{`json:"at,format:'Jan 2, 2006',omitempty"`, timeType, fieldTags{name: "at", timeLayout: "Jan 2, 2006", omitEmpty: true}},
+		{`json:"at,format:DateOnly" easyjson:"format=unixmilli"`, timeType, fieldTags{name: "at", timeLayout: "2006-01-02", timeFormat: "unixmilli"}},
+		{`json:"at,format:unix" easyjson:"layout=15:04"`, timeType, fieldTags{name: "at", timeLayout: "15:04"}},
+		{`json:",format:base64url"`, reflect.TypeOf([]byte(nil)), fieldTags{bytesFormat: "base64url"}},
+		{`json:",format:hex"`, reflect.TypeOf(new([4]byte)), fieldTags{bytesFormat: "base16"}},
+		{`json:",format:base32"`, reflect.TypeOf([]byte(nil)), fieldTags{}},
+		{`json:",format:base64"`, reflect.TypeOf(""), fieldTags{}},
+		{`easyjson:"custom=encode,decode,keepnull"`, reflect.TypeOf(0), fieldTags{customEncoder: "encode", customDecoder: "decode", keepOnNull: true}},
+		{`easyjson:"custom=,decode"`, reflect.TypeOf(0), fieldTags{customDecoder: "decode"}},
+		{`easyjson:"custom=encode"`, reflect.TypeOf(0), fieldTags{customEncoder: "encode"}},
+		{`easyjson:"custom=encode,,keepnull"`, reflect.TypeOf(0), fieldTags{customEncoder: "encode", keepOnNull: true}},
+	} {
+		got := parseFieldTags(reflect.StructField{Name: "F", Type: test.Type, Tag: test.Tag})
+		if got != test.Want {
+			t.Errorf("[%d] parseFieldTags(%s) = %+v; want %+v", i, test.Tag, got, test.Want)
+		}
+	}
+}
+
+func TestFieldTagWarnings(t *testing.T) {
+	for i, test := range []struct {
+		Tag  reflect.StructTag
+		Type reflect.Type
+		Want []string
+	}{
+		{`json:"name,omitempty"`, reflect.TypeOf(0), nil},
+		{`json:"-"`, reflect.TypeOf(0), nil},
+		{`json:"name,"`, reflect.TypeOf(0), nil},
+		{`json: "name"`, reflect.TypeOf(0), []string{`malformed struct tag "json: \"name\""`}},
+		{`easyjson:"unknown=none"`, reflect.TypeOf(0), nil},
+		{`json:"a" easyjson:"keepnull"`, reflect.TypeOf(0), nil},
+		{`json:"na\\me"`, reflect.TypeOf(0), []string{`invalid json name "na\\me" is ignored`}},
+		{`json:"first name"`, reflect.TypeOf(0), []string{`json name "first name" contains spaces`}},
+		{`json:"'@id,x',omitempty"`, reflect.TypeOf(0), nil},
+		{`json:"'#text'"`, reflect.TypeOf(0), nil},
+		{`json:"'@id"`, reflect.TypeOf(0), []string{`invalid quoted json name '@id is ignored`}},
+		{`json:",omitEmpty"`, reflect.TypeOf(0), []string{`unknown option "omitEmpty" is ignored, did you mean "omitempty"?`}},
+		{`json:", omitempty"`, reflect.TypeOf(0), []string{`unknown option " omitempty" is ignored, did you mean "omitempty"?`}},
+		{`json:",omit_empty"`, reflect.TypeOf(0), []string{`unknown option "omit_empty" is ignored, did you mean "omitempty"?`}},
+		{`json:",inline"`, reflect.TypeOf(0), []string{`unknown option "inline" is ignored`}},
+		{`json:",string"`, reflect.TypeOf([]int{}), []string{`option "string" is ignored for type []int`}},
+		{`json:",format:'15:04, Jan 2'"`, timeType, nil},
+		{`json:",format:array"`, reflect.TypeOf([]byte(nil)), nil},
+		{`json:",format:base32"`, reflect.TypeOf([]byte(nil)), []string{`option "format:base32" is ignored for type []uint8`}},
+		{`json:",format:unix"`, reflect.TypeOf(0), []string{`option "format:unix" is ignored for type int`}},
+		{`json:",format:unixnano"`, timeType, nil},
+		{`json:",format:unixnanos"`, timeType, []string{`option "format:unixnanos" is ignored for type time.Time`}},
+	} {
+		got := fieldTagWarnings(reflect.StructField{Name: "F", Type: test.Type, Tag: test.Tag})
+		if !reflect.DeepEqual(got, test.Want) {
+			t.Errorf("[%d] fieldTagWarnings(%s) = %q; want %q", i, test.Tag, got, test.Want)
+		}
+	}
+}
+
+func TestEasyJSONTagWarnings(t *testing.T) {
+	for i, test := range []struct {
+		Tag  reflect.StructTag
+		Type reflect.Type
+		Want []string
+	}{
+		{`easyjson:"since=v2,keepnull"`, reflect.TypeOf(0), nil},
+		{`easyjson:"required"`, reflect.TypeOf(0), nil},
+		{`easyjson:"since="`, reflect.TypeOf(0), []string{`empty version in easyjson directive "since="`}},
+		{`easyjson:"hex"`, reflect.TypeOf([]byte(nil)), nil},
+		{`easyjson:"hex"`, reflect.TypeOf(""), []string{`easyjson directive "hex" is ignored for type string`}},
+		{`easyjson:"noescape"`, reflect.TypeOf(map[string][]*string(nil)), nil},
+		{`easyjson:"noescape"`, reflect.TypeOf(0), []string{`easyjson directive "noescape" is ignored for type int`}},
+		{`easyjson:"errobject"`, reflect.TypeOf([]error(nil)), nil},
+		{`easyjson:"errobject"`, reflect.TypeOf(""), []string{`easyjson directive "errobject" is ignored for type string`}},
+		{`easyjson:"discriminator=type"`, reflect.TypeOf([]interface{}(nil)), nil},
+		{`easyjson:"discriminator=type"`, reflect.TypeOf(0), []string{`easyjson directive "discriminator" is ignored for type int`}},
+		{`easyjson:"typefield=kind"`, reflect.TypeOf((*interface{})(nil)).Elem(), nil},
+		{`easyjson:"typefield="`, errorType, []string{`empty type field name in easyjson directive`}},
+		{`easyjson:"typefield=kind"`, reflect.TypeOf([]error(nil)), []string{`easyjson directive "typefield" is ignored for type []error`}},
+		{`easyjson:"required,layout=15:04, 2006"`, timeType, nil},
+		{`easyjson:"layout=15:04"`, reflect.TypeOf(""), []string{`easyjson directive "layout" is ignored for type string`}},
+		{`easyjson:"format=unix"`, reflect.TypeOf(map[string]time.Time(nil)), nil},
+		{`easyjson:"format=unix"`, reflect.TypeOf(0), []string{`easyjson directive "format" is ignored for type int`}},
+		{`easyjson:"format=iso"`, timeType, []string{`unknown time format in easyjson directive "format=iso" is ignored`}},
+		{`easyjson:"custom=encode,decode"`, reflect.TypeOf(0), nil},
+		{`easyjson:"custom=,"`, reflect.TypeOf(0), []string{`empty function names in easyjson directive "custom"`}},
+		{`easyjson:"custom=encode,keepnull"`, reflect.TypeOf(0), []string{
+			`easyjson directive "keepnull" is the decoder name of "custom", leave the name empty with "custom=encode,,keepnull" to apply it`,
+		}},
+		{`easyjson:"custom=encode,,keepnull"`, reflect.TypeOf(0), nil},
+		{`easyjson:"custom=pkg.Encode,1decode"`, reflect.TypeOf(0), []string{
+			`invalid function name "pkg.Encode" in easyjson directive "custom"`,
+			`invalid function name "1decode" in easyjson directive "custom"`,
+		}},
+		{`easyjson:"keyorder=order"`, reflect.TypeOf((*map[int]string)(nil)), nil},
+		{`easyjson:"keyorder="`, reflect.TypeOf(map[string]int(nil)), []string{`invalid variable name "" in easyjson directive "keyorder"`}},
+		{`easyjson:"keyorder=order"`, reflect.TypeOf([]string(nil)), []string{`easyjson directive "keyorder" is ignored for type []string`}},
+		{`easyjson:"number"`, reflect.TypeOf(new(big.Float)), nil},
+		{`easyjson:"number"`, reflect.TypeOf([]*big.Float(nil)), []string{`easyjson directive "number" is ignored for type []*big.Float`}},
+		{`easyjson:"inline"`, reflect.TypeOf(0), []string{`unknown easyjson directive "inline" is ignored`}},
+	} {
+		got := easyJSONTagWarnings(reflect.StructField{Name: "F", Type: test.Type, Tag: test.Tag})
+		if !reflect.DeepEqual(got, test.Want) {
+			t.Errorf("[%d] easyJSONTagWarnings(%s) = %q; want %q", i, test.Tag, got, test.Want)
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package gen
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// EnumValue is an entry of the table an enum type is marshaled with.
+type EnumValue struct {
+	Value int64
+	Name  string
+}
+
+// AddEnum requests to generate marshaler/unmarshalers for the integer type of given object,
+// marshaling its values as strings according to the table. The generation fails for the values
+// out of the range of the type.
+func (g *Generator) AddEnum(obj interface{}, values ...EnumValue) {
+	t := reflect.TypeOf(obj)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	g.enums[t] = values
+	g.Add(obj)
+}
+
+// isEnumKind returns true if an enum can be based on the types of kind k.
+func isEnumKind(k reflect.Kind) bool {
+	return k >= reflect.Int && k <= reflect.Uint64
+}
+
+// checkEnum returns an error if t is not an integer type or a value of its table is out of
+// the range of t, so that the generated code would not compile.
+func (g *Generator) checkEnum(t reflect.Type) error {
+	if !isEnumKind(t.Kind()) {
+		return fmt.Errorf("cannot generate enum marshalers for %v, not an integer type", t)
+	}
+	zero := reflect.Zero(t)
+	for _, v := range g.enums[t] {
+		var overflows bool
+		if t.Kind() >= reflect.Uint {
+			overflows = v.Value < 0 || zero.OverflowUint(uint64(v.Value))
+		} else {
+			overflows = zero.OverflowInt(v.Value)
+		}
+		if overflows {
+			return fmt.Errorf("enum %v value %d (%v) overflows %v", t.Name(), v.Value, v.Name, t.Kind())
+		}
+	}
+	return nil
+}
+
+// genEnumEncoder generates an encoder writing the values of enum type t as strings, failing
+// on the values missing from its table.
+func (g *Generator) genEnumEncoder(t reflect.Type) error {
+	if err := g.checkEnum(t); err != nil {
+		return err
+	}
+	fname := g.getEncoderName(t)
+	typ := g.getType(t)
+
+	fmt.Fprintln(g.out, "func "+fname+"(out *jwriter.Writer, in "+typ+") {")
+	fmt.Fprintln(g.out, "  switch in {")
+	for _, v := range g.enums[t] {
+		fmt.Fprintf(g.out, "  case %d:\n", v.Value)
+		if needsNoEscaping(v.Name) {
+			fmt.Fprintf(g.out, "    out.StringNoEscape(%q)\n", v.Name)
+		} else {
+			fmt.Fprintf(g.out, "    out.String(%q)\n", v.Name)
+		}
+	}
+	fmt.Fprintln(g.out, "  default:")
+	fmt.Fprintln(g.out, "    if out.Error == nil {")
+	fmt.Fprintf(g.out, "      out.Error = %v.Errorf(\"invalid %v value %%d\", in)\n", g.pkgAlias("fmt"), t.Name())
+	fmt.Fprintln(g.out, "    }")
+	fmt.Fprintln(g.out, "  }")
+	fmt.Fprintln(g.out, "}")
+	return nil
+}
+
+// genEnumDecoder generates a decoder of the strings from the table of enum type t, failing on
+// any other strings.
+func (g *Generator) genEnumDecoder(t reflect.Type) error {
+	if err := g.checkEnum(t); err != nil {
+		return err
+	}
+	fname := g.getDecoderName(t)
+	typ := g.getType(t)
+
+	fmt.Fprintln(g.out, "func "+fname+"(in *jlexer.Lexer, out *"+typ+") {")
+	fmt.Fprintln(g.out, "  if in.IsNull() {")
+	fmt.Fprintln(g.out, "    in.Skip()")
+	fmt.Fprintln(g.out, "    return")
+	fmt.Fprintln(g.out, "  }")
+	fmt.Fprintln(g.out, "  pos := in.GetPos()")
+	fmt.Fprintln(g.out, "  switch data := in.UnsafeBytes(); string(data) {")
+	for _, v := range g.enums[t] {
+		fmt.Fprintf(g.out, "  case %q:\n", v.Name)
+		fmt.Fprintf(g.out, "    *out = %d\n", v.Value)
+	}
+	fmt.Fprintln(g.out, "  default:")
+	fmt.Fprintln(g.out, "    if in.Ok() {")
+	fmt.Fprintln(g.out, "      in.AddError(&jlexer.LexerError{")
+	fmt.Fprintln(g.out, "        Offset: pos,")
+	fmt.Fprintf(g.out, "        Reason: %q,\n", "unknown "+t.Name()+" value")
+	fmt.Fprintln(g.out, "        Data: string(data),")
+	fmt.Fprintln(g.out, "      })")
+	fmt.Fprintln(g.out, "    }")
+	fmt.Fprintln(g.out, "  }")
+	fmt.Fprintln(g.out, "}")
+	return nil
+}
+
+// checkEnumFallback checks that the field f of type t, tagged with unknown=fallback, holds
+// values of an enum type, directly or as elements, and that fallback is in its table.
+func (g *Generator) checkEnumFallback(t reflect.Type, f reflect.StructField, fallback string) error {
+	et := f.Type
+	for et.Kind() == reflect.Ptr || et.Kind() == reflect.Slice || et.Kind() == reflect.Array {
+		et = et.Elem()
+	}
+	values := g.enums[et]
+	if values == nil {
+		return fmt.Errorf("%v.%v: unknown=%v requires an easyjson:enum type generated together with the field, got %v", t, f.Name, fallback, f.Type)
+	}
+	for _, v := range values {
+		if v.Name == fallback {
+			return nil
+		}
+	}
+	return fmt.Errorf("%v.%v: fallback %q is not a %v value", t, f.Name, fallback, et.Name())
+}
+
+// genEnumFallbackDecoder generates code decoding a value of enum type t into out, assigning
+// the fallback value to it if the name is missing from the table.
+func (g *Generator) genEnumFallbackDecoder(t reflect.Type, out, fallback string, indent int) error {
+	ws := strings.Repeat("  ", indent)
+	typ := g.getType(t)
+
+	fmt.Fprintln(g.out, ws+"if in.IsNull() {")
+	fmt.Fprintln(g.out, ws+"  in.Skip()")
+	fmt.Fprintln(g.out, ws+"} else {")
+	fmt.Fprintln(g.out, ws+"  switch string(in.UnsafeBytes()) {")
+	var fallbackValue int64
+	for _, v := range g.enums[t] {
+		if v.Name == fallback {
+			fallbackValue = v.Value
+			continue
+		}
+		fmt.Fprintf(g.out, ws+"  case %q:\n", v.Name)
+		fmt.Fprintf(g.out, ws+"    %v = %v(%d)\n", out, typ, v.Value)
+	}
+	fmt.Fprintln(g.out, ws+"  default:")
+	fmt.Fprintf(g.out, ws+"    %v = %v(%d)\n", out, typ, fallbackValue)
+	fmt.Fprintln(g.out, ws+"  }")
+	fmt.Fprintln(g.out, ws+"}")
+	return nil
+}
+
+// genEnumTextMarshalers generates the encoding.TextMarshaler and encoding.TextUnmarshaler
+// methods of enum type t, allowing to use it as a map key.
+func (g *Generator) genEnumTextMarshalers(t reflect.Type) {
+	typ := g.getType(t)
+	fmtPkg := g.pkgAlias("fmt")
+
+	if !g.kept[t]["MarshalText"] {
+		fmt.Fprintln(g.out, "// MarshalText supports encoding.TextMarshaler interface")
+		fmt.Fprintln(g.out, "func (v "+typ+") MarshalText() ([]byte, error) {")
+		fmt.Fprintln(g.out, "  switch v {")
+		for _, v := range g.enums[t] {
+			fmt.Fprintf(g.out, "  case %d:\n", v.Value)
+			fmt.Fprintf(g.out, "    return []byte(%q), nil\n", v.Name)
+		}
+		fmt.Fprintln(g.out, "  }")
+		fmt.Fprintf(g.out, "  return nil, %v.Errorf(\"invalid %v value %%d\", v)\n", fmtPkg, t.Name())
+		fmt.Fprintln(g.out, "}")
+	}
+
+	if !g.kept[t]["UnmarshalText"] {
+		fmt.Fprintln(g.out, "// UnmarshalText supports encoding.TextUnmarshaler interface")
+		fmt.Fprintln(g.out, "func (v *"+typ+") UnmarshalText(data []byte) error {")
+		fmt.Fprintln(g.out, "  switch string(data) {")
+		for _, v := range g.enums[t] {
+			fmt.Fprintf(g.out, "  case %q:\n", v.Name)
+			fmt.Fprintf(g.out, "    *v = %d\n", v.Value)
+			fmt.Fprintln(g.out, "    return nil")
+		}
+		fmt.Fprintln(g.out, "  }")
+		fmt.Fprintf(g.out, "  return %v.Errorf(\"unknown %v value %%q\", data)\n", fmtPkg, t.Name())
+		fmt.Fprintln(g.out, "}")
+	}
+}
@@ -0,0 +1,60 @@
+package gen
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type fallbackEnum int
+
+type smallEnum uint8
+
+type fallbackStruct struct {
+	Enum     fallbackEnum   `easyjson:"unknown=none"`
+	List     []fallbackEnum `easyjson:"unknown=none"`
+	Missing  fallbackEnum   `easyjson:"unknown=other"`
+	NotEnum  int            `easyjson:"unknown=none"`
+	NotEnums []int          `easyjson:"unknown=none"`
+}
+
+func TestCheckEnumFallback(t *testing.T) {
+	g := NewGenerator("enum_test.go")
+	g.AddEnum(fallbackEnum(0), EnumValue{0, "none"}, EnumValue{1, "some"})
+
+	typ := reflect.TypeOf(fallbackStruct{})
+	for _, test := range []struct {
+		Field string
+		Err   string
+	}{
+		{"Enum", ""},
+		{"List", ""},
+		{"Missing", `fallback "other" is not a fallbackEnum value`},
+		{"NotEnum", "requires an easyjson:enum type"},
+		{"NotEnums", "requires an easyjson:enum type"},
+	} {
+		f, _ := typ.FieldByName(test.Field)
+		err := g.checkEnumFallback(typ, f, parseFieldTags(f).unknown)
+		if test.Err == "" && err != nil || test.Err != "" && (err == nil || !strings.Contains(err.Error(), test.Err)) {
+			t.Errorf("%v: checkEnumFallback() error: %v; want %q", test.Field, err, test.Err)
+		}
+	}
+}
+
+func TestCheckEnum(t *testing.T) {
+	for _, test := range []struct {
+		Values []EnumValue
+		Err    string
+	}{
+		{[]EnumValue{{0, "none"}, {255, "all"}}, ""},
+		{[]EnumValue{{-1, "unknown"}}, `value -1 (unknown) overflows uint8`},
+		{[]EnumValue{{256, "more"}}, `value 256 (more) overflows uint8`},
+	} {
+		g := NewGenerator("enum_test.go")
+		g.AddEnum(smallEnum(0), test.Values...)
+		err := g.checkEnum(reflect.TypeOf(smallEnum(0)))
+		if test.Err == "" && err != nil || test.Err != "" && (err == nil || !strings.Contains(err.Error(), test.Err)) {
+			t.Errorf("checkEnum(%v) error: %v; want %q", test.Values, err, test.Err)
+		}
+	}
+}
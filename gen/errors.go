@@ -0,0 +1,80 @@
+package gen
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// isErrorType returns true if the easyjson 'errobject' tag option applies to the type of a
+// field: error, and the pointers, slices, arrays and maps of errors.
+func isErrorType(t reflect.Type) bool {
+	for t.Name() == "" {
+		switch t.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+			t = t.Elem()
+		default:
+			return false
+		}
+	}
+	return t == errorType
+}
+
+// genErrorEncoder generates code that encodes in, a value of type error, as its message, or as
+// an object with a "message" member if the errobject easyjson directive is set.
+func (g *Generator) genErrorEncoder(in string, tags fieldTags, indent int, assumeNonEmpty bool) {
+	ws := strings.Repeat("  ", indent)
+
+	if !assumeNonEmpty {
+		fmt.Fprintln(g.out, ws+"if "+in+" == nil {")
+		fmt.Fprintln(g.out, ws+"  out.RawString(\"null\")")
+		fmt.Fprintln(g.out, ws+"} else {")
+	} else {
+		fmt.Fprintln(g.out, ws+"{")
+	}
+	if tags.errObject {
+		fmt.Fprintln(g.out, ws+"  out.RawString(`{\"message\":`)")
+		fmt.Fprintln(g.out, ws+"  out.String(("+in+").Error())")
+		fmt.Fprintln(g.out, ws+"  out.RawByte('}')")
+	} else {
+		fmt.Fprintln(g.out, ws+"  out.String(("+in+").Error())")
+	}
+	fmt.Fprintln(g.out, ws+"}")
+}
+
+// genErrorDecoder generates code that decodes the message of an error into out, a value of type
+// error, as an *easyjson.Error, or an errors.New one for the standalone code.
+func (g *Generator) genErrorDecoder(out string, tags fieldTags, indent int) {
+	ws := strings.Repeat("  ", indent)
+	tmpVar := g.uniqueVarName()
+
+	fmt.Fprintln(g.out, ws+"if in.IsNull() {")
+	fmt.Fprintln(g.out, ws+"  in.Skip()")
+	fmt.Fprintln(g.out, ws+"  "+out+" = nil")
+	fmt.Fprintln(g.out, ws+"} else {")
+	if tags.errObject {
+		fmt.Fprintln(g.out, ws+"  var "+tmpVar+" string")
+		fmt.Fprintln(g.out, ws+"  in.Delim('{')")
+		fmt.Fprintln(g.out, ws+"  for !in.IsDelim('}') {")
+		fmt.Fprintln(g.out, ws+"    key := in.UnsafeFieldName(false)")
+		fmt.Fprintln(g.out, ws+"    in.WantColon()")
+		fmt.Fprintln(g.out, ws+"    if key == \"message\" {")
+		fmt.Fprintln(g.out, ws+"      "+tmpVar+" = in.String()")
+		fmt.Fprintln(g.out, ws+"    } else {")
+		fmt.Fprintln(g.out, ws+"      in.SkipRecursive()")
+		fmt.Fprintln(g.out, ws+"    }")
+		fmt.Fprintln(g.out, ws+"    in.WantComma()")
+		fmt.Fprintln(g.out, ws+"  }")
+		fmt.Fprintln(g.out, ws+"  in.Delim('}')")
+	} else {
+		fmt.Fprintln(g.out, ws+"  "+tmpVar+" := in.String()")
+	}
+	if g.standalone {
+		fmt.Fprintln(g.out, ws+"  "+out+" = "+g.pkgAlias("errors")+".New("+tmpVar+")")
+	} else {
+		fmt.Fprintln(g.out, ws+"  "+out+" = &"+g.pkgAlias(pkgEasyJSON)+".Error{Message: "+tmpVar+"}")
+	}
+	fmt.Fprintln(g.out, ws+"}")
+}
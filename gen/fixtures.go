@@ -0,0 +1,135 @@
+package gen
+
+import (
+	"io"
+	"reflect"
+)
+
+// FixtureEmitter writes a JSON object with a sample document of each type the marshaling
+// methods are generated for, by type name, e.g. for tests checking that the documents
+// round-trip. All the members are present, with zero values except that pointers to structs
+// are followed unless the structs are recursive, and slices and maps have a single element.
+type FixtureEmitter struct{}
+
+func (FixtureEmitter) Emit(out io.Writer, types []TypeDesc) error {
+	f := fixtureWriter{descs: map[reflect.Type]*TypeDesc{}, seen: map[reflect.Type]bool{}}
+	for i := range types {
+		f.descs[types[i].Type] = &types[i]
+	}
+
+	doc := object{}
+	for i := range types {
+		if d := &types[i]; d.Marshaler && d.Name != "" {
+			doc = append(doc, member{d.Name, f.sample(d.Type, false)})
+		}
+	}
+	return writeIndented(out, doc)
+}
+
+type fixtureWriter struct {
+	descs map[reflect.Type]*TypeDesc
+	// types being sampled, to stop at recursive types
+	seen map[reflect.Type]bool
+}
+
+// sample returns a sample value of type t. If asString is set, numbers and bools are strings.
+func (f fixtureWriter) sample(t reflect.Type, asString bool) interface{} {
+	switch t {
+	case timeType:
+		return "0001-01-01T00:00:00Z"
+	case jsonNumberType:
+		return 0
+	}
+	if bigTypes[t] == "BigInt" && !asString {
+		return 0
+	}
+	if bigTypes[t] != "" || isDecimalType(t) {
+		return "0"
+	}
+	if ipTypes[t] != "" {
+		return ""
+	}
+	if knownTypeOf(t) != nil {
+		return "00000000-0000-0000-0000-000000000000"
+	}
+	if isSQLNullType(t) {
+		return f.sample(t.Field(0).Type, asString)
+	}
+	switch name := protoWellKnownType(t); name {
+	case "":
+	case "Timestamp":
+		return "1970-01-01T00:00:00Z"
+	case "Duration":
+		return "0s"
+	default:
+		v, _ := t.FieldByName("Value")
+		return f.sample(v.Type, v.Type.Kind() == reflect.Int64 || v.Type.Kind() == reflect.Uint64)
+	}
+
+	d := f.descs[t]
+	switch {
+	case d != nil && d.Enum != nil:
+		return d.Enum[0]
+	case d != nil && t.Kind() == reflect.Struct:
+		if f.seen[t] {
+			return object{}
+		}
+		f.seen[t] = true
+		defer delete(f.seen, t)
+
+		ret := object{}
+		for _, fd := range d.Fields {
+			ret = append(ret, member{fd.JSONName, f.sample(fd.Type, fd.AsString)})
+		}
+		return ret
+	case d == nil && (t.Kind() == reflect.Struct || hasCustomMarshaler(t)):
+		// A type with its own marshaler, or not generated.
+		return nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		if asString {
+			return "false"
+		}
+		return false
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		if asString {
+			return "0"
+		}
+		return 0
+	case reflect.String:
+		return ""
+	case reflect.Ptr:
+		if f.seen[t.Elem()] {
+			return nil
+		}
+		return f.sample(t.Elem(), asString)
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 && t.Elem().Name() == "uint8" {
+			return ""
+		}
+		n := 1
+		if t.Kind() == reflect.Array {
+			n = t.Len()
+		}
+		ret := make([]interface{}, n)
+		for i := range ret {
+			ret[i] = f.sample(t.Elem(), false)
+		}
+		return ret
+	case reflect.Map:
+		key := ""
+		switch t.Key().Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			key = "0"
+		}
+		return object{{key, f.sample(t.Elem(), false)}}
+	case reflect.Interface:
+		return nil
+	}
+	return nil
+}
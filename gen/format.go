@@ -0,0 +1,152 @@
+package gen
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Formats of the json/v2 'format' tag option of byte slice and byte array fields.
+const (
+	bytesFormatBase64    = "base64"
+	bytesFormatBase64URL = "base64url"
+	bytesFormatBase16    = "base16"
+	bytesFormatHex       = "hex"
+	bytesFormatArray     = "array"
+)
+
+// bytesEncoders and bytesDecoders hold the code marshaling and unmarshaling byte slices in the
+// formats of the 'format' tag option, except for arrays of numbers.
+var bytesEncoders = map[string]string{
+	bytesFormatBase64:    "out.Base64Bytes(%v)",
+	bytesFormatBase64URL: "out.Base64URLBytes(%v)",
+	bytesFormatBase16:    "out.Base16Bytes(%v)",
+}
+
+var bytesDecoders = map[string]string{
+	bytesFormatBase64:    "in.Bytes()",
+	bytesFormatBase64URL: "in.BytesBase64URL()",
+	bytesFormatBase16:    "in.BytesBase16()",
+}
+
+// timeLayouts maps the names of the layout constants of the time package, accepted by the 'format'
+// tag option of json/v2, to the layouts.
+var timeLayouts = map[string]string{
+	"ANSIC":       time.ANSIC,
+	"UnixDate":    time.UnixDate,
+	"RubyDate":    time.RubyDate,
+	"RFC822":      time.RFC822,
+	"RFC822Z":     time.RFC822Z,
+	"RFC850":      time.RFC850,
+	"RFC1123":     time.RFC1123,
+	"RFC1123Z":    time.RFC1123Z,
+	"RFC3339":     time.RFC3339,
+	"RFC3339Nano": time.RFC3339Nano,
+	"Kitchen":     time.Kitchen,
+	"Stamp":       time.Stamp,
+	"StampMilli":  time.StampMilli,
+	"StampMicro":  time.StampMicro,
+	"StampNano":   time.StampNano,
+	"DateTime":    "2006-01-02 15:04:05",
+	"DateOnly":    "2006-01-02",
+	"TimeOnly":    "15:04:05",
+}
+
+// isBytesType returns true if the byte formats of the 'format' tag option apply to the type of a
+// field: byte slices and byte arrays, and pointers to them.
+func isBytesType(t reflect.Type) bool {
+	if t.Name() == "" && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array:
+		return t.Elem().Kind() == reflect.Uint8 && t.Elem().Name() == "uint8"
+	}
+	return false
+}
+
+// splitTagOptions splits the json tag at the commas outside of the single-quoted values of json/v2
+// options, e.g. format:'Jan 2, 2006'.
+func splitTagOptions(tag string) []string {
+	var ret []string
+	quoted := false
+	start := 0
+	for i := 0; i < len(tag); i++ {
+		switch c := tag[i]; {
+		case c == '\\' && quoted:
+			i++
+		case c == '\'':
+			quoted = !quoted
+		case c == ',' && !quoted:
+			ret = append(ret, tag[start:i])
+			start = i + 1
+		}
+	}
+	return append(ret, tag[start:])
+}
+
+// formatOptionValue returns the value of the 'format' tag option opt, unquoting single-quoted
+// values like json/v2 does.
+func formatOptionValue(opt string) string {
+	v := strings.TrimPrefix(opt, "format:")
+	if u, ok := unquoteTagValue(v); ok {
+		return u
+	}
+	return v
+}
+
+// unquoteTagName returns the json name of the single-quoted name of a json tag, which json/v2
+// accepts for names with characters not allowed otherwise, e.g. json:"'@id,x'" or json:"'#text'".
+func unquoteTagName(name string) (string, bool) {
+	u, ok := unquoteTagValue(name)
+	if !ok || !utf8.ValidString(u) {
+		return "", false
+	}
+	return u, true
+}
+
+// unquoteTagValue unquotes v, a string literal with the Go syntax of double-quoted strings but
+// enclosed in single quotes, like json/v2 does. It returns false if v is not such a literal.
+func unquoteTagValue(v string) (string, bool) {
+	if len(v) < 2 || v[0] != '\'' || v[len(v)-1] != '\'' {
+		return "", false
+	}
+	s := strings.Replace(v[1:len(v)-1], `"`, `\"`, -1)
+	s = strings.Replace(s, `\'`, `'`, -1)
+	u, err := strconv.Unquote(`"` + s + `"`)
+	return u, err == nil
+}
+
+// applyFormatOption sets the time layout or format, or the byte format, of the json/v2 'format' tag
+// option with value format in the tags of a field of type t. It returns false if the format does
+// not apply to the type, or is neither a known time format nor a layout with time elements.
+func applyFormatOption(t reflect.Type, format string, tags *fieldTags) bool {
+	switch {
+	case format == "":
+		return false
+	case isTimeType(t):
+		if timeFormatMethods[format] != "" {
+			tags.timeFormat = format
+		} else if layout, ok := timeLayouts[format]; ok {
+			tags.timeLayout = layout
+		} else if isTimeLayout(format) {
+			tags.timeLayout = format
+		} else {
+			return false
+		}
+		return true
+	case isBytesType(t):
+		switch format {
+		case bytesFormatBase64, bytesFormatBase64URL, bytesFormatBase16, bytesFormatArray:
+			tags.bytesFormat = format
+		case bytesFormatHex:
+			tags.bytesFormat = bytesFormatBase16
+		default:
+			return false
+		}
+		return true
+	}
+	return false
+}
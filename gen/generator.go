@@ -3,14 +3,19 @@ package gen
 import (
 	"bytes"
 	"fmt"
+	"go/scanner"
+	"go/token"
 	"hash/fnv"
 	"io"
 	"path"
 	"reflect"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
 	"unicode"
+
+	"github.com/mailru/easyjson"
 )
 
 const pkgWriter = "github.com/mailru/easyjson/jwriter"
@@ -31,6 +36,9 @@ type Generator struct {
 	buildTags  string
 	hashString string
 
+	// user-defined text written before and after the generated code
+	header, footer string
+
 	varCounter int
 
 	noStdMarshalers          bool
@@ -39,6 +47,23 @@ type Generator struct {
 	fieldNamer               FieldNamer
 	simpleBytes              bool
 	skipMemberNameUnescaping bool
+	stdlibCompat             bool
+	taggedOnly               bool
+	foldKeys                 bool
+	decimals                 bool
+	knownTypes               bool
+	noSQLNull                bool
+	typeInfo                 bool
+	gojayAdapters            bool
+	standalone               bool
+	protobuf                 bool
+	metricsComments          bool
+	validators               bool
+	nilGuards                bool
+	slabSize                 int
+
+	// emitters writing other artifacts from the generated types
+	emitters []emitter
 
 	// package path to local alias map for tracking imports
 	imports map[string]string
@@ -46,6 +71,25 @@ type Generator struct {
 	// types that marshalers were requested for by user
 	marshalers map[reflect.Type]bool
 
+	// instantiations of generic types getting specialized encoders and decoders
+	instances []reflect.Type
+
+	// oneof wrapper types of protoc-gen-go structs
+	oneofWrapperTypes []reflect.Type
+
+	// metrics of the generated types, and of the one being generated
+	metrics    map[reflect.Type]*TypeMetrics
+	curMetrics *TypeMetrics
+
+	// hand-written marshaling methods of the types, not to be generated
+	kept map[reflect.Type]map[string]bool
+
+	// enum types with their tables of values
+	enums map[reflect.Type][]EnumValue
+
+	// doc comments of the struct types, for their type info
+	docs map[reflect.Type]typeDoc
+
 	// types that encoders were already generated for
 	typesSeen map[reflect.Type]bool
 
@@ -55,6 +99,25 @@ type Generator struct {
 	// function name to relevant type maps to track names of de-/encoders in
 	// case of a name clash or unnamed structs
 	functionNames map[string]reflect.Type
+	typeFunctions map[typeFunction]string
+
+	// names of the types of the package referred to by the generated code, and the aliases
+	// of the ones named like its identifiers
+	localTypes  map[string]bool
+	typeAliases map[string]string
+
+	// names of the variables of the package given to keyorder easyjson directives, and of the
+	// variables of the generated code holding their easyjson.KeyOrder functions
+	keyOrders map[string]string
+
+	// names of the type parameters the placeholder types, identified by their qualified
+	// names, stand for, and the constraints of the type parameters
+	typeParamNames       map[string]string
+	typeParamConstraints map[string]string
+
+	// problems found during generation that do not prevent it
+	warnings     []string
+	warningsSeen map[string]bool
 }
 
 // NewGenerator initializes and returns a Generator.
@@ -68,8 +131,20 @@ func NewGenerator(filename string) *Generator {
 		},
 		fieldNamer:    DefaultFieldNamer{},
 		marshalers:    make(map[reflect.Type]bool),
+		enums:         make(map[reflect.Type][]EnumValue),
+		docs:          make(map[reflect.Type]typeDoc),
+		kept:          make(map[reflect.Type]map[string]bool),
+		metrics:       make(map[reflect.Type]*TypeMetrics),
 		typesSeen:     make(map[reflect.Type]bool),
 		functionNames: make(map[string]reflect.Type),
+		typeFunctions: make(map[typeFunction]string),
+		localTypes:    make(map[string]bool),
+		typeAliases:   make(map[string]string),
+		keyOrders:     make(map[string]string),
+		warningsSeen:  make(map[string]bool),
+
+		typeParamNames:       make(map[string]string),
+		typeParamConstraints: make(map[string]string),
 	}
 
 	// Use a file-unique prefix on all auxiliary funcs to avoid
@@ -92,6 +167,49 @@ func (g *Generator) SetBuildTags(tags string) {
 	g.buildTags = tags
 }
 
+// BuildConstraint returns the //go:build and // +build lines restricting a file to the build
+// tags given in the // +build syntax, e.g. "linux,amd64 darwin". Both lines are written so
+// that gofmt of any Go version leaves them unchanged.
+func BuildConstraint(tags string) string {
+	options := strings.Fields(tags)
+	exprs := make([]string, len(options))
+	for i, option := range options {
+		terms := strings.Split(option, ",")
+		exprs[i] = strings.Join(terms, " && ")
+		if len(terms) > 1 && len(options) > 1 {
+			exprs[i] = "(" + exprs[i] + ")"
+		}
+	}
+	return "//go:build " + strings.Join(exprs, " || ") + "\n// +build " + strings.Join(options, " ") + "\n"
+}
+
+// SetHeader sets the text written at the top of the output file, e.g. a license header or
+// build constraints. The text may only consist of comments.
+func (g *Generator) SetHeader(text string) {
+	g.header = text
+}
+
+// SetFooter sets the code appended to the output file.
+func (g *Generator) SetFooter(text string) {
+	g.footer = text
+}
+
+// checkHeader returns an error if the header is not made of comments only.
+func (g *Generator) checkHeader() error {
+	fset := token.NewFileSet()
+	var s scanner.Scanner
+	s.Init(fset.AddFile("header", -1, len(g.header)), []byte(g.header), nil, scanner.ScanComments)
+	for {
+		pos, tok, lit := s.Scan()
+		switch {
+		case tok == token.EOF:
+			return nil
+		case tok != token.COMMENT && !(tok == token.SEMICOLON && lit == "\n"):
+			return fmt.Errorf("header %v: only comments are allowed, found %v", fset.Position(pos), tok)
+		}
+	}
+}
+
 // SetFieldNamer sets field naming strategy.
 func (g *Generator) SetFieldNamer(n FieldNamer) {
 	g.fieldNamer = n
@@ -133,6 +251,133 @@ func (g *Generator) SimpleBytes() {
 	g.simpleBytes = true
 }
 
+// SlabAlloc instructs to allocate the elements of decoded slices of pointers in blocks of up
+// to n elements instead of one by one. Zero disables it.
+func (g *Generator) SlabAlloc(n int) {
+	g.slabSize = n
+}
+
+// StdlibCompat switches to the stdlib-compat profile: the generated code marshals data
+// byte-for-byte like encoding/json and accepts the same input on unmarshaling.
+func (g *Generator) StdlibCompat() {
+	g.stdlibCompat = true
+	g.foldKeys = true
+}
+
+// TaggedOnly instructs to skip the fields without json tags instead of marshaling them under
+// the names given by the FieldNamer. The fields of embedded structs without tags are still
+// promoted if they have tags themselves.
+func (g *Generator) TaggedOnly() {
+	g.taggedOnly = true
+}
+
+// FoldKeys instructs to match member names to field names case-insensitively on unmarshaling
+// if there is no exact match, as encoding/json does it. It is implied by StdlibCompat.
+func (g *Generator) FoldKeys() {
+	g.foldKeys = true
+}
+
+// Decimals instructs to marshal and unmarshal the shopspring decimal.Decimal values directly
+// instead of calling their MarshalJSON and UnmarshalJSON methods, as strings by default like the
+// methods, or as numbers with the easyjson 'number' directive. The MarshalJSONWithoutQuotes
+// variable of the decimal package is not taken into account.
+func (g *Generator) Decimals() {
+	g.decimals = true
+}
+
+// KnownTypes instructs to marshal and unmarshal the types of the known types table, e.g. the
+// google/uuid.UUID values, directly without allocating instead of calling their MarshalText and
+// UnmarshalText methods.
+func (g *Generator) KnownTypes() {
+	g.knownTypes = true
+}
+
+// NoSQLNull instructs to marshal and unmarshal the database/sql Null values, e.g. sql.NullString,
+// as objects of their fields instead of as their values or null.
+func (g *Generator) NoSQLNull() {
+	g.noSQLNull = true
+}
+
+// NilGuards instructs to generate MarshalEasyJSON methods with pointer receivers writing null
+// for nil pointers, and unmarshaling methods returning an error for them instead of panicking.
+func (g *Generator) NilGuards() {
+	g.nilGuards = true
+}
+
+// TypeInfo instructs to generate an easyjson.TypeInfo describing the fields of each struct
+// type that marshalers are generated for, registered on package initialization.
+func (g *Generator) TypeInfo() {
+	g.typeInfo = true
+}
+
+// GojayAdapters instructs to generate methods making struct types satisfy the object
+// marshaler and unmarshaler interfaces of github.com/francoispqt/gojay.
+func (g *Generator) GojayAdapters() {
+	g.gojayAdapters = true
+}
+
+// Standalone instructs to generate code that does not depend on easyjson: the lexer and writer
+// are imported from copies of the easyjson runtime packages under runtimePath, and only the
+// encoding/json interfaces are implemented.
+func (g *Generator) Standalone(runtimePath string) {
+	g.standalone = true
+	delete(g.imports, pkgWriter)
+	delete(g.imports, pkgLexer)
+	delete(g.imports, pkgEasyJSON)
+	g.imports[runtimePath+"/jwriter"] = "jwriter"
+	g.imports[runtimePath+"/jlexer"] = "jlexer"
+}
+
+// Protobuf instructs to follow the conventions of structs generated by protoc-gen-go: the XXX_
+// fields are skipped, the fields are named like protojson names them, the oneof fields are
+// marshaled as the members of the wrapper types registered with AddOneofWrapper, and the
+// timestamps, durations and wrappers of the well-known types with their JSON mapping.
+func (g *Generator) Protobuf() {
+	g.protobuf = true
+}
+
+// AddOneofWrapper registers the oneof wrapper type of the given object, e.g.
+// (*pb.Value_NumberValue)(nil), for the oneof fields it can be assigned to.
+func (g *Generator) AddOneofWrapper(obj interface{}) {
+	t := reflect.TypeOf(obj)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	g.oneofWrapperTypes = append(g.oneofWrapperTypes, t)
+}
+
+// KeepMethods tells that the type of obj has the named marshaling methods, e.g. MarshalJSON,
+// written by hand. They are not generated, and the generated methods of the same kind, e.g.
+// MarshalEasyJSON, delegate to them.
+func (g *Generator) KeepMethods(obj interface{}, names ...string) {
+	t := reflect.TypeOf(obj)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if g.kept[t] == nil {
+		g.kept[t] = map[string]bool{}
+	}
+	for _, name := range names {
+		g.kept[t][name] = true
+	}
+}
+
+// Warnings returns the problems found during the last Run that did not prevent generation,
+// e.g. malformed struct tags.
+func (g *Generator) Warnings() []string {
+	return g.warnings
+}
+
+// warnf records a generation warning, skipping duplicates.
+func (g *Generator) warnf(format string, args ...interface{}) {
+	w := fmt.Sprintf(format, args...)
+	if g.warningsSeen[w] {
+		return
+	}
+	g.warningsSeen[w] = true
+	g.warnings = append(g.warnings, w)
+}
+
 // addTypes requests to generate encoding/decoding funcs for the given type.
 func (g *Generator) addType(t reflect.Type) {
 	if g.typesSeen[t] {
@@ -157,16 +402,20 @@ func (g *Generator) Add(obj interface{}) {
 	g.marshalers[t] = true
 }
 
-// printHeader prints package declaration and imports.
-func (g *Generator) printHeader() {
+// printHeader writes package declaration and imports to w.
+func (g *Generator) printHeader(w io.Writer) {
+	if header := strings.TrimSpace(g.header); header != "" {
+		fmt.Fprintln(w, header)
+		fmt.Fprintln(w)
+	}
 	if g.buildTags != "" {
-		fmt.Println("// +build ", g.buildTags)
-		fmt.Println()
+		fmt.Fprint(w, BuildConstraint(g.buildTags))
+		fmt.Fprintln(w)
 	}
-	fmt.Println("// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.")
-	fmt.Println()
-	fmt.Println("package ", g.pkgName)
-	fmt.Println()
+	fmt.Fprintln(w, "// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "package ", g.pkgName)
+	fmt.Fprintln(w)
 
 	byAlias := make(map[string]string, len(g.imports))
 	aliases := make([]string, 0, len(g.imports))
@@ -177,32 +426,81 @@ func (g *Generator) printHeader() {
 	}
 
 	sort.Strings(aliases)
-	fmt.Println("import (")
+	fmt.Fprintln(w, "import (")
 	for _, alias := range aliases {
-		fmt.Printf("  %s %q\n", alias, byAlias[alias])
+		fmt.Fprintf(w, "  %s %q\n", alias, byAlias[alias])
 	}
 
-	fmt.Println(")")
-	fmt.Println("")
-	fmt.Println("// suppress unused package warning")
-	fmt.Println("var (")
-	fmt.Println("   _ *json.RawMessage")
-	fmt.Println("   _ *jlexer.Lexer")
-	fmt.Println("   _ *jwriter.Writer")
-	fmt.Println("   _ easyjson.Marshaler")
-	fmt.Println(")")
+	fmt.Fprintln(w, ")")
+	fmt.Fprintln(w, "")
+	fmt.Fprintln(w, "// suppress unused package warning")
+	fmt.Fprintln(w, "var (")
+	fmt.Fprintln(w, "   _ *json.RawMessage")
+	fmt.Fprintln(w, "   _ *jlexer.Lexer")
+	fmt.Fprintln(w, "   _ *jwriter.Writer")
+	if !g.standalone {
+		fmt.Fprintln(w, "   _ easyjson.Marshaler")
+	}
+	fmt.Fprintln(w, ")")
+	if !g.standalone {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "// fail on initialization if the easyjson runtime can not run this code")
+		fmt.Fprintf(w, "var _ = easyjson.CheckGeneratedVersion(%d)\n", easyjson.RuntimeVersion)
+	}
+
+	fmt.Fprintln(w)
+	if len(g.typeAliases) > 0 {
+		names := make([]string, 0, len(g.typeAliases))
+		for alias := range g.typeAliases {
+			names = append(names, alias)
+		}
+		sort.Strings(names)
 
-	fmt.Println()
+		fmt.Fprintln(w, "// aliases of the types named like identifiers of the generated code")
+		fmt.Fprintln(w, "type (")
+		for _, alias := range names {
+			fmt.Fprintf(w, "  %v = %v\n", alias, g.typeAliases[alias])
+		}
+		fmt.Fprintln(w, ")")
+		fmt.Fprintln(w)
+	}
+	if g.metricsComments {
+		g.printMetrics(w)
+	}
 }
 
 // Run runs the generator and outputs generated code to out.
 func (g *Generator) Run(out io.Writer) error {
 	g.out = &bytes.Buffer{}
+	if err := g.checkHeader(); err != nil {
+		return err
+	}
+
+	if g.standalone {
+		switch {
+		case g.noStdMarshalers:
+			return fmt.Errorf("standalone mode requires the encoding/json marshalers")
+		case g.typeInfo:
+			return fmt.Errorf("type info is not supported in standalone mode")
+		case g.validators:
+			return fmt.Errorf("validators are not supported in standalone mode")
+		}
+	}
+
+	if g.protobuf {
+		g.fieldNamer = protobufFieldNamer{g.fieldNamer}
+	}
+	for _, inst := range g.instances {
+		if g.genericOf(inst) == nil {
+			return fmt.Errorf("%v is not an instantiation of a generic type marshalers are generated for", inst)
+		}
+	}
 
 	for len(g.typesUnseen) > 0 {
 		t := g.typesUnseen[len(g.typesUnseen)-1]
 		g.typesUnseen = g.typesUnseen[:len(g.typesUnseen)-1]
 		g.typesSeen[t] = true
+		g.startMetrics(t)
 
 		if err := g.genDecoder(t); err != nil {
 			return err
@@ -210,8 +508,14 @@ func (g *Generator) Run(out io.Writer) error {
 		if err := g.genEncoder(t); err != nil {
 			return err
 		}
+		if g.validators && (g.hasValidator(t) || !g.marshalers[t] && t.Kind() == reflect.Struct) {
+			if err := g.genValidator(t); err != nil {
+				return err
+			}
+		}
 
 		if !g.marshalers[t] {
+			g.endMetrics()
 			continue
 		}
 
@@ -221,8 +525,38 @@ func (g *Generator) Run(out io.Writer) error {
 		if err := g.genStructUnmarshaler(t); err != nil {
 			return err
 		}
+		if g.validators && g.enums[t] == nil {
+			g.genValidatorMethod(t)
+		}
+		if g.enums[t] != nil {
+			g.genEnumTextMarshalers(t)
+			g.endMetrics()
+			continue
+		}
+		if g.typeInfo {
+			if err := g.genTypeInfo(t); err != nil {
+				return err
+			}
+		}
+		if g.gojayAdapters {
+			if err := g.genGojayAdapters(t); err != nil {
+				return err
+			}
+		}
+		g.endMetrics()
+	}
+	g.genKeyOrders()
+	if err := g.checkImportConflicts(); err != nil {
+		return err
+	}
+	if err := g.runEmitters(); err != nil {
+		return err
+	}
+	g.printHeader(out)
+	if g.footer != "" {
+		fmt.Fprintln(g.out)
+		fmt.Fprintln(g.out, strings.TrimSpace(g.footer))
 	}
-	g.printHeader()
 	_, err := out.Write(g.out.Bytes())
 	return err
 }
@@ -263,7 +597,7 @@ func (g *Generator) pkgAlias(pkgPath string) string {
 			alias += fmt.Sprint(i)
 		}
 
-		exists := false
+		exists := g.localTypes[alias]
 		for _, v := range g.imports {
 			if v == alias {
 				exists = true
@@ -290,6 +624,8 @@ func (g *Generator) getType(t reflect.Type) string {
 			return "[" + strconv.Itoa(t.Len()) + "]" + g.getType(t.Elem())
 		case reflect.Map:
 			return "map[" + g.getType(t.Key()) + "]" + g.getType(t.Elem())
+		case reflect.Func:
+			return g.getFuncType(t)
 		}
 	}
 
@@ -317,10 +653,96 @@ func (g *Generator) getType(t reflect.Type) string {
 			return strings.Join([]string{"struct { ", strings.Join(lines, "; "), " }"}, "")
 		}
 		return t.String()
-	} else if t.PkgPath() == g.pkgPath {
-		return t.Name()
 	}
-	return g.pkgAlias(t.PkgPath()) + "." + t.Name()
+
+	name := t.Name()
+	if i := strings.IndexByte(name, '['); i >= 0 {
+		// instantiations of generic types name their type arguments with the import paths
+		name = name[:i] + qualifiedTypeName.ReplaceAllStringFunc(name[i:], func(s string) string {
+			dot := strings.LastIndexByte(s, '.')
+			return g.qualifiedType(s[:dot], s[dot+1:])
+		})
+	}
+	return g.qualifiedType(t.PkgPath(), name)
+}
+
+// qualifiedTypeName matches the import path qualified type names, e.g. "example.com/pkg.Type".
+var qualifiedTypeName = regexp.MustCompile(`[\w.~/-]+\.\w+`)
+
+// qualifiedType returns the name the generated code refers to the type name of package pkgPath
+// by.
+func (g *Generator) qualifiedType(pkgPath, name string) string {
+	if param, ok := g.typeParamNames[pkgPath+"."+name]; ok {
+		return param
+	}
+	if pkgPath == g.pkgPath {
+		return g.localTypeName(name)
+	}
+	return g.pkgAlias(pkgPath) + "." + name
+}
+
+// getFuncType returns the literal of the unnamed function type t.
+func (g *Generator) getFuncType(t reflect.Type) string {
+	in := make([]string, t.NumIn())
+	for i := range in {
+		if t.IsVariadic() && i == len(in)-1 {
+			in[i] = "..." + g.getType(t.In(i).Elem())
+		} else {
+			in[i] = g.getType(t.In(i))
+		}
+	}
+	out := make([]string, t.NumOut())
+	for i := range out {
+		out[i] = g.getType(t.Out(i))
+	}
+
+	ret := "func(" + strings.Join(in, ", ") + ")"
+	switch len(out) {
+	case 0:
+	case 1:
+		ret += " " + out[0]
+	default:
+		ret += " (" + strings.Join(out, ", ") + ")"
+	}
+	return ret
+}
+
+// generatedIdentifiers are the names of the parameters and variables of the generated code.
+var generatedIdentifiers = map[string]bool{
+	"in": true, "out": true, "w": true, "l": true, "r": true, "v": true, "m": true,
+	"key": true, "data": true, "err": true, "ok": true, "pos": true, "first": true,
+	"isTopLevel": true, "enc": true, "dec": true, "n": true, "i": true, "yield": true,
+}
+
+// localTypeName returns the name the generated code refers to the type of the package by.
+// Types named like the identifiers of the generated code would be shadowed by them, so they
+// are referred to by aliases declared in the output.
+func (g *Generator) localTypeName(name string) string {
+	g.localTypes[name] = true
+	if !generatedIdentifiers[name] && !(len(name) > 1 && name[0] == 'v' && unicode.IsDigit(rune(name[1]))) {
+		return name
+	}
+
+	alias := joinFunctionNameParts(true, "easyjson", g.hashString, "type", name)
+	g.typeAliases[alias] = name
+	return alias
+}
+
+// checkImportConflicts returns an error if a type of the package has the name of a package
+// imported into the output, which Go does not allow.
+func (g *Generator) checkImportConflicts() error {
+	var paths []string
+	for path := range g.imports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		if alias := g.imports[path]; g.localTypes[alias] {
+			return fmt.Errorf("type %v of package %v conflicts with the import of %v into the generated code: rename the type", alias, g.pkgPath, path)
+		}
+	}
+	return nil
 }
 
 // escape a struct field tag string back to source code
@@ -346,7 +768,13 @@ func (g *Generator) safeName(t reflect.Type) string {
 	if t.Name() == "" {
 		name += "anonymous"
 	} else {
-		name += "." + t.Name()
+		// the placeholders of type arguments are replaced with the type parameter names
+		name += "." + qualifiedTypeName.ReplaceAllStringFunc(t.Name(), func(s string) string {
+			if param, ok := g.typeParamNames[s]; ok {
+				return param
+			}
+			return s
+		})
 	}
 
 	parts := []string{}
@@ -362,43 +790,40 @@ func (g *Generator) safeName(t reflect.Type) string {
 	return joinFunctionNameParts(false, parts...)
 }
 
+// typeFunction identifies a function generated for a type.
+type typeFunction struct {
+	prefix string
+	t      reflect.Type
+}
+
 // functionName returns a function name for a given type with a given prefix. If a function
 // with this prefix already exists for a type, it is returned.
 //
 // Method is used to track encoder/decoder names for the type.
 func (g *Generator) functionName(prefix string, t reflect.Type) string {
-	prefix = joinFunctionNameParts(true, "easyjson", g.hashString, prefix)
-	name := joinFunctionNameParts(true, prefix, g.safeName(t))
-
-	// Most of the names will be unique, try a shortcut first.
-	if e, ok := g.functionNames[name]; !ok || e == t {
-		g.functionNames[name] = t
+	key := typeFunction{prefix, t}
+	if name, ok := g.typeFunctions[key]; ok {
 		return name
 	}
 
-	// Search if the function already exists.
-	for name1, t1 := range g.functionNames {
-		if t1 == t && strings.HasPrefix(name1, prefix) {
-			return name1
-		}
-	}
+	prefix = joinFunctionNameParts(true, "easyjson", g.hashString, prefix)
+	base := joinFunctionNameParts(true, prefix, g.safeName(t))
 
-	// Create a new name in the case of a clash.
-	for i := 1; ; i++ {
-		nm := fmt.Sprint(name, i)
-		if _, ok := g.functionNames[nm]; ok {
-			continue
-		}
-		g.functionNames[nm] = t
-		return nm
+	// Number the names in the case of a clash.
+	name := base
+	for i := 1; g.functionNames[name] != nil; i++ {
+		name = fmt.Sprint(base, i)
 	}
+	g.functionNames[name] = t
+	g.typeFunctions[key] = name
+	return name
 }
 
 // DefaultFieldsNamer implements trivial naming policy equivalent to encoding/json.
 type DefaultFieldNamer struct{}
 
 func (DefaultFieldNamer) GetJSONFieldName(t reflect.Type, f reflect.StructField) string {
-	jsonName := strings.Split(f.Tag.Get("json"), ",")[0]
+	jsonName := parseFieldTags(f).name
 	if jsonName != "" {
 		return jsonName
 	}
@@ -464,7 +889,7 @@ func lowerFirst(s string) string {
 }
 
 func (LowerCamelCaseFieldNamer) GetJSONFieldName(t reflect.Type, f reflect.StructField) string {
-	jsonName := strings.Split(f.Tag.Get("json"), ",")[0]
+	jsonName := parseFieldTags(f).name
 	if jsonName != "" {
 		return jsonName
 	}
@@ -521,7 +946,7 @@ func camelToSnake(name string) string {
 }
 
 func (SnakeCaseFieldNamer) GetJSONFieldName(t reflect.Type, f reflect.StructField) string {
-	jsonName := strings.Split(f.Tag.Get("json"), ",")[0]
+	jsonName := parseFieldTags(f).name
 	if jsonName != "" {
 		return jsonName
 	}
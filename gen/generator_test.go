@@ -1,6 +1,8 @@
 package gen
 
 import (
+	"bytes"
+	"go/format"
 	"testing"
 )
 
@@ -85,3 +87,101 @@ func TestFixVendorPath(t *testing.T) {
 	}
 
 }
+
+func TestBuildConstraint(t *testing.T) {
+	for i, test := range []struct {
+		In, Out string
+	}{
+		{"linux", "//go:build linux\n// +build linux\n"},
+		{" linux,!cgo ", "//go:build linux && !cgo\n// +build linux,!cgo\n"},
+		{"linux,amd64  darwin", "//go:build (linux && amd64) || darwin\n// +build linux,amd64 darwin\n"},
+	} {
+		got := BuildConstraint(test.In)
+		if got != test.Out {
+			t.Errorf("[%d] BuildConstraint(%q) = %q; want %q", i, test.In, got, test.Out)
+		}
+	}
+}
+
+type deterministicStruct struct {
+	Counts map[string]int
+	A      struct{ X, Y int }
+	B      struct{ Z string }
+	C      []struct{ X, Y int }
+	Nested *deterministicStruct
+}
+
+func TestRunDeterministic(t *testing.T) {
+	run := func() []byte {
+		g := NewGenerator("deterministic_easyjson.go")
+		g.SetPkg("gen", "github.com/mailru/easyjson/gen")
+		g.SetBuildTags("linux,amd64 darwin")
+		g.Metrics()
+		g.Add(deterministicStruct{})
+
+		var out bytes.Buffer
+		if err := g.Run(&out); err != nil {
+			t.Fatalf("Run() error: %v", err)
+		}
+		return out.Bytes()
+	}
+
+	want := run()
+	for i := 0; i < 20; i++ {
+		if got := run(); !bytes.Equal(got, want) {
+			t.Fatalf("run %d output differs from the first one", i+1)
+		}
+	}
+}
+
+type out struct{ X int }
+
+type jlexer struct{ X int }
+
+func TestRunLocalTypeNames(t *testing.T) {
+	g := NewGenerator("local_types_easyjson.go")
+	g.SetPkg("gen", "github.com/mailru/easyjson/gen")
+	g.Add(struct{ Out out }{})
+
+	var buf bytes.Buffer
+	if err := g.Run(&buf); err != nil {
+		t.Fatalf("Run() error: %v", err)
+	}
+	if !bytes.Contains(buf.Bytes(), []byte("TypeOut = out\n")) {
+		t.Errorf("Run() output does not declare an alias of type out:\n%s", buf.Bytes())
+	}
+
+	g = NewGenerator("local_types_easyjson.go")
+	g.SetPkg("gen", "github.com/mailru/easyjson/gen")
+	g.Add(struct{ J jlexer }{})
+	if err := g.Run(&buf); err == nil {
+		t.Errorf("Run() of a type named like the jlexer import succeeded; want error")
+	}
+}
+
+func TestRunHeaderFooter(t *testing.T) {
+	g := NewGenerator("header_easyjson.go")
+	g.SetPkg("gen", "github.com/mailru/easyjson/gen")
+	g.SetHeader("// Copyright 2020 The Authors.\n\n/* Licensed under the MIT license. */\n")
+	g.SetFooter("var _ = 1\n")
+	g.Add(deterministicStruct{})
+
+	var buf bytes.Buffer
+	if err := g.Run(&buf); err != nil {
+		t.Fatalf("Run() error: %v", err)
+	}
+	if want := "// Copyright 2020 The Authors.\n\n/* Licensed under the MIT license. */\n\n// Code generated"; !bytes.HasPrefix(buf.Bytes(), []byte(want)) {
+		t.Errorf("Run() output does not start with %q:\n%s", want, buf.Bytes())
+	}
+	if !bytes.HasSuffix(buf.Bytes(), []byte("\nvar _ = 1\n")) {
+		t.Errorf("Run() output does not end with the footer:\n%s", buf.Bytes())
+	}
+	if _, err := format.Source(buf.Bytes()); err != nil {
+		t.Errorf("Run() output is not valid Go code: %v", err)
+	}
+
+	g.SetHeader("// Copyright\npackage other\n")
+	if err := g.Run(&buf); err == nil {
+		t.Error("Run() with code in the header succeeded; want error")
+	}
+}
@@ -0,0 +1,171 @@
+package gen
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// AddTypeParam registers the type of obj as a placeholder for a type parameter with the given
+// constraint, "any" or "comparable". The generic types added instantiated with placeholders get
+// generic marshalers, e.g. the ones of Page[T] for Add((*Page[P])(nil)) after AddTypeParam(P{}, "any").
+func (g *Generator) AddTypeParam(obj interface{}, constraint string) {
+	t := reflect.TypeOf(obj)
+	name := fmt.Sprint("easyjsonT", len(g.typeParamNames))
+	g.typeParamNames[t.PkgPath()+"."+t.Name()] = name
+	g.typeParamConstraints[name] = constraint
+}
+
+// AddInstance adds the instantiation of a generic type added with placeholders, e.g. Page[User]
+// for Add((*Page[User])(nil)), which gets encoder and decoder functions specialized for its type
+// arguments. The generic functions hand the values of the instantiation over to them, and the
+// code generated for other types calls them directly.
+func (g *Generator) AddInstance(obj interface{}) {
+	t := reflect.TypeOf(obj)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	g.addType(t)
+	g.instances = append(g.instances, t)
+}
+
+// isInstance tells if t is one of the instantiations added with AddInstance.
+func (g *Generator) isInstance(t reflect.Type) bool {
+	for _, inst := range g.instances {
+		if inst == t {
+			return true
+		}
+	}
+	return false
+}
+
+// genericOf returns the generic type added with placeholders that t instantiates, or nil.
+func (g *Generator) genericOf(t reflect.Type) reflect.Type {
+	for m := range g.marshalers {
+		if m.PkgPath() == t.PkgPath() && genericName(m) != "" && genericName(m) == genericName(t) {
+			return m
+		}
+	}
+	return nil
+}
+
+// instancesOf returns the added instantiations of the generic type t.
+func (g *Generator) instancesOf(t reflect.Type) []reflect.Type {
+	if g.isInstance(t) {
+		return nil
+	}
+	var ret []reflect.Type
+	for _, inst := range g.instances {
+		if inst.PkgPath() == t.PkgPath() && genericName(inst) == genericName(t) {
+			ret = append(ret, inst)
+		}
+	}
+	return ret
+}
+
+// genericName returns the name of the generic type t is an instantiation of, e.g. Page for
+// Page[User], or "" if t is not one.
+func genericName(t reflect.Type) string {
+	if i := strings.IndexByte(t.Name(), '['); i > 0 {
+		return t.Name()[:i]
+	}
+	return ""
+}
+
+// genInstanceEncoderDispatch generates code that encodes in of the generic type t with the
+// encoder of its instantiation if one was added for its type arguments.
+func (g *Generator) genInstanceEncoderDispatch(t reflect.Type) {
+	instances := g.instancesOf(t)
+	if len(instances) == 0 {
+		return
+	}
+	fmt.Fprintln(g.out, "  switch in := interface{}(&in).(type) {")
+	for _, inst := range instances {
+		fmt.Fprintln(g.out, "  case *"+g.getType(inst)+":")
+		fmt.Fprintln(g.out, "    "+g.getEncoderName(inst)+"(out, *in)")
+		fmt.Fprintln(g.out, "    return")
+	}
+	fmt.Fprintln(g.out, "  }")
+}
+
+// genInstanceDecoderDispatch generates code that decodes into out of the generic type t with the
+// decoder of its instantiation if one was added for its type arguments.
+func (g *Generator) genInstanceDecoderDispatch(t reflect.Type) {
+	instances := g.instancesOf(t)
+	if len(instances) == 0 {
+		return
+	}
+	fmt.Fprintln(g.out, "  switch out := interface{}(out).(type) {")
+	for _, inst := range instances {
+		fmt.Fprintln(g.out, "  case *"+g.getType(inst)+":")
+		fmt.Fprintln(g.out, "    "+g.getDecoderName(inst)+"(in, out)")
+		fmt.Fprintln(g.out, "    return")
+	}
+	fmt.Fprintln(g.out, "  }")
+}
+
+// isTypeParam tells if t is a placeholder for a type parameter.
+func (g *Generator) isTypeParam(t reflect.Type) bool {
+	_, ok := g.typeParamNames[t.PkgPath()+"."+t.Name()]
+	return ok && t.Name() != ""
+}
+
+// typeParamName matches the names the type parameters are given in the generated code.
+var typeParamName = regexp.MustCompile(`\beasyjsonT(\d+)\b`)
+
+// typeParamLists returns the declaration and the argument lists of the type parameters the
+// type typ of the generated code refers to, e.g. "[easyjsonT0 any]" and "[easyjsonT0]". Both
+// are empty if typ is not generic.
+func (g *Generator) typeParamLists(typ string) (decl, args string) {
+	var indexes []int
+	seen := map[string]bool{}
+	for _, m := range typeParamName.FindAllStringSubmatch(typ, -1) {
+		if _, ok := g.typeParamConstraints[m[0]]; ok && !seen[m[0]] {
+			seen[m[0]] = true
+			i, _ := strconv.Atoi(m[1])
+			indexes = append(indexes, i)
+		}
+	}
+	if len(indexes) == 0 {
+		return "", ""
+	}
+	sort.Ints(indexes)
+
+	decls := make([]string, len(indexes))
+	names := make([]string, len(indexes))
+	for i, index := range indexes {
+		names[i] = fmt.Sprint("easyjsonT", index)
+		decls[i] = names[i] + " " + g.typeParamConstraints[names[i]]
+	}
+	return "[" + strings.Join(decls, ", ") + "]", "[" + strings.Join(names, ", ") + "]"
+}
+
+// genTypeParamEncoder generates code that encodes in, a value of a type parameter, with the
+// marshaler of its type argument if there is one, and with encoding/json otherwise.
+func (g *Generator) genTypeParamEncoder(in string, tags fieldTags, indent int, assumeNonEmpty bool) error {
+	return g.genTypeEncoderNoCheck(reflect.TypeOf((*interface{})(nil)).Elem(), "interface{}("+in+")", tags, indent, assumeNonEmpty)
+}
+
+// genTypeParamDecoder generates code that decodes into out, a value of a type parameter, with
+// the unmarshaler of its type argument if there is one, and with encoding/json otherwise.
+func (g *Generator) genTypeParamDecoder(out string, indent int) {
+	ws := strings.Repeat("  ", indent)
+
+	if !g.standalone {
+		fmt.Fprintln(g.out, ws+"if m, ok := interface{}(&"+out+").(easyjson.Unmarshaler); ok {")
+		fmt.Fprintln(g.out, ws+"  m.UnmarshalEasyJSON(in)")
+		fmt.Fprint(g.out, ws+"} else ")
+	} else {
+		fmt.Fprint(g.out, ws)
+	}
+	fmt.Fprintln(g.out, "if m, ok := interface{}(&"+out+").(json.Unmarshaler); ok {")
+	fmt.Fprintln(g.out, ws+"  if data := in.Raw(); in.Ok() {")
+	fmt.Fprintln(g.out, ws+"    in.AddError(m.UnmarshalJSON(data))")
+	fmt.Fprintln(g.out, ws+"  }")
+	fmt.Fprintln(g.out, ws+"} else if data := in.Raw(); in.Ok() {")
+	fmt.Fprintln(g.out, ws+"  in.AddError(json.Unmarshal(data, &"+out+"))")
+	fmt.Fprintln(g.out, ws+"}")
+}
@@ -0,0 +1,34 @@
+package gen
+
+import (
+	"reflect"
+	"testing"
+)
+
+type placeholder0 struct{}
+type placeholder1 struct{}
+
+func TestTypeParamLists(t *testing.T) {
+	g := NewGenerator("out.go")
+	g.SetPkg("gen", "github.com/mailru/easyjson/gen")
+	g.AddTypeParam(placeholder0{}, "any")
+	g.AddTypeParam(placeholder1{}, "comparable")
+
+	if got := g.getType(reflect.TypeOf(map[string][]placeholder1{})); got != "map[string][]easyjsonT1" {
+		t.Errorf("getType() = %v; want map[string][]easyjsonT1", got)
+	}
+
+	for _, test := range []struct {
+		typ, decl, args string
+	}{
+		{"Page[int]", "", ""},
+		{"Page[easyjsonT0]", "[easyjsonT0 any]", "[easyjsonT0]"},
+		{"Pair[easyjsonT1, Page[easyjsonT0]]", "[easyjsonT0 any, easyjsonT1 comparable]", "[easyjsonT0, easyjsonT1]"},
+		{"Pair[easyjsonT1, easyjsonT1]", "[easyjsonT1 comparable]", "[easyjsonT1]"},
+	} {
+		decl, args := g.typeParamLists(test.typ)
+		if decl != test.decl || args != test.args {
+			t.Errorf("typeParamLists(%v) = %q, %q; want %q, %q", test.typ, decl, args, test.decl, test.args)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package gen
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const pkgGojay = "github.com/francoispqt/gojay"
+
+// genGojayAdapters generates methods making struct type t satisfy the gojay.MarshalerJSONObject
+// and gojay.UnmarshalerJSONObject interfaces. gojay drives the object encoding and decoding
+// itself, so the field values are translated with the easyjson encoders and decoders and
+// passed to gojay as embedded JSON.
+func (g *Generator) genGojayAdapters(t reflect.Type) error {
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	fs, err := g.structFields(t)
+	if err != nil {
+		return fmt.Errorf("cannot generate gojay adapters for %v: %v", t, err)
+	}
+
+	gojay := g.pkgAlias(pkgGojay)
+	typ := g.getType(t)
+
+	fmt.Fprintln(g.out, "// MarshalJSONObject supports gojay.MarshalerJSONObject interface")
+	fmt.Fprintln(g.out, "func (v *"+typ+") MarshalJSONObject(enc *"+gojay+".Encoder) {")
+	fmt.Fprintln(g.out, "  out := &jwriter.Writer{}")
+	for _, f := range fs {
+		if err := g.genGojayFieldEncoder(t, f, gojay); err != nil {
+			return err
+		}
+	}
+	fmt.Fprintln(g.out, "}")
+	fmt.Fprintln(g.out)
+
+	fmt.Fprintln(g.out, "// IsNil supports gojay.MarshalerJSONObject interface")
+	fmt.Fprintln(g.out, "func (v *"+typ+") IsNil() bool {")
+	fmt.Fprintln(g.out, "  return v == nil")
+	fmt.Fprintln(g.out, "}")
+	fmt.Fprintln(g.out)
+
+	fmt.Fprintln(g.out, "// UnmarshalJSONObject supports gojay.UnmarshalerJSONObject interface")
+	fmt.Fprintln(g.out, "func (v *"+typ+") UnmarshalJSONObject(dec *"+gojay+".Decoder, key string) error {")
+	fmt.Fprintln(g.out, "  var data "+gojay+".EmbeddedJSON")
+	fmt.Fprintln(g.out, "  if err := dec.EmbeddedJSON(&data); err != nil {")
+	fmt.Fprintln(g.out, "    return err")
+	fmt.Fprintln(g.out, "  }")
+	fmt.Fprintln(g.out, "  in := &jlexer.Lexer{Data: data}")
+	fmt.Fprintln(g.out, "  switch key {")
+	for _, f := range fs {
+		tags := parseFieldTags(f)
+		if tags.omit {
+			continue
+		}
+
+		fmt.Fprintf(g.out, "  case %q:\n", g.fieldNamer.GetJSONFieldName(t, f))
+		fmt.Fprint(g.out, g.embeddedAlloc("v", t, f, "    "))
+		if tags.transform != "" {
+			err = g.genTransformDecoder(f.Type, "v."+f.Name, tags, 2)
+		} else {
+			err = g.genTypeDecoder(f.Type, "v."+f.Name, tags, 2)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	fmt.Fprintln(g.out, "  }")
+	fmt.Fprintln(g.out, "  return in.Error()")
+	fmt.Fprintln(g.out, "}")
+	fmt.Fprintln(g.out)
+
+	fmt.Fprintln(g.out, "// NKeys supports gojay.UnmarshalerJSONObject interface")
+	fmt.Fprintln(g.out, "func (v *"+typ+") NKeys() int {")
+	fmt.Fprintln(g.out, "  return 0")
+	fmt.Fprintln(g.out, "}")
+	return nil
+}
+
+// genGojayFieldEncoder generates code that encodes field f with the writer out and adds the
+// result to the gojay encoder enc.
+func (g *Generator) genGojayFieldEncoder(t reflect.Type, f reflect.StructField, gojay string) error {
+	tags := parseFieldTags(f)
+	if tags.omit {
+		return nil
+	}
+
+	noOmitEmpty := (!tags.omitEmpty && !g.omitEmpty) || tags.noOmitEmpty
+
+	// The gojay encoder has no API version, so since and until directives do not apply.
+	conditions := embeddedNotNilChecks("v", t, f)
+	if !noOmitEmpty {
+		conditions = append(conditions, g.notEmptyCheck(f.Type, "v."+f.Name))
+	}
+	if len(conditions) == 0 {
+		fmt.Fprintln(g.out, "  {")
+	} else {
+		fmt.Fprintln(g.out, "  if", strings.Join(conditions, " && "), "{")
+	}
+	if tags.transform != "" {
+		if err := g.genTransformEncoder(f.Type, "v."+f.Name, tags, 2); err != nil {
+			return err
+		}
+	} else if err := g.genTypeEncoder(f.Type, "v."+f.Name, tags, 2, !noOmitEmpty); err != nil {
+		return err
+	}
+	fmt.Fprintln(g.out, "    data := "+gojay+".EmbeddedJSON(out.Buffer.BuildBytes())")
+	fmt.Fprintf(g.out, "    enc.AddEmbeddedJSONKey(%q, &data)\n", g.fieldNamer.GetJSONFieldName(t, f))
+	fmt.Fprintln(g.out, "  }")
+	return nil
+}
@@ -0,0 +1,50 @@
+package gen
+
+import (
+	"bytes"
+	"go/format"
+	"strings"
+	"testing"
+)
+
+type gojayTestStruct struct {
+	Name  string   `json:"name"`
+	Tags  []string `json:"tags,omitempty"`
+	Skip  int      `json:"-"`
+	Inner *gojayTestStruct
+}
+
+func TestGojayAdapters(t *testing.T) {
+	g := NewGenerator("gojay_test.go")
+	g.SetPkg("gen", "github.com/mailru/easyjson/gen")
+	g.GojayAdapters()
+	g.Add(gojayTestStruct{})
+
+	var out bytes.Buffer
+	if err := g.Run(&out); err != nil {
+		t.Fatalf("Run() error: %v", err)
+	}
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		t.Fatalf("generated code does not parse: %v\n%s", err, out.Bytes())
+	}
+
+	for _, want := range []string{
+		"func (v *gojayTestStruct) MarshalJSONObject(enc *gojay.Encoder) {",
+		"func (v *gojayTestStruct) IsNil() bool {",
+		"func (v *gojayTestStruct) UnmarshalJSONObject(dec *gojay.Decoder, key string) error {",
+		"func (v *gojayTestStruct) NKeys() int {",
+		`enc.AddEmbeddedJSONKey("tags", &data)`,
+		`case "Inner":`,
+	} {
+		if !bytes.Contains(src, []byte(want)) {
+			t.Errorf("generated code does not contain %q", want)
+		}
+	}
+	if g.imports[pkgGojay] != "gojay" {
+		t.Errorf("gojay is imported as %q", g.imports[pkgGojay])
+	}
+	if strings.Contains(string(src), `"Skip"`) {
+		t.Errorf("generated code contains the skipped field")
+	}
+}
@@ -0,0 +1,27 @@
+package gen
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Names of the jwriter.Writer and jlexer.Lexer methods of net.IP, netip.Addr and netip.Prefix,
+// which are only added since Go 1.18.
+var ipTypes = map[reflect.Type]string{}
+
+// genIPEncoder generates code that encodes in of an IP address type t as a string, like its
+// MarshalText method does it, but without allocating.
+func (g *Generator) genIPEncoder(t reflect.Type, in string, indent int) {
+	ws := strings.Repeat("  ", indent)
+
+	fmt.Fprintln(g.out, ws+"out."+ipTypes[t]+"("+in+")")
+}
+
+// genIPDecoder generates code that decodes out of an IP address type t from a string, leaving it
+// unchanged on null.
+func (g *Generator) genIPDecoder(t reflect.Type, out string, indent int) {
+	ws := strings.Repeat("  ", indent)
+
+	fmt.Fprintln(g.out, ws+"in."+ipTypes[t]+"(&"+out+")")
+}
@@ -0,0 +1,16 @@
+//go:build go1.18
+// +build go1.18
+
+package gen
+
+import (
+	"net"
+	"net/netip"
+	"reflect"
+)
+
+func init() {
+	ipTypes[reflect.TypeOf(net.IP(nil))] = "IP"
+	ipTypes[reflect.TypeOf(netip.Addr{})] = "IPAddr"
+	ipTypes[reflect.TypeOf(netip.Prefix{})] = "IPPrefix"
+}
@@ -0,0 +1,132 @@
+package gen
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// iteratorTypes returns the key and the element types of the iterator function type t, with
+// the shape of iter.Seq or iter.Seq2. The key is nil for the iter.Seq ones.
+func iteratorTypes(t reflect.Type) (key, elem reflect.Type, ok bool) {
+	if t.Kind() != reflect.Func || t.NumIn() != 1 || t.NumOut() != 0 || t.IsVariadic() {
+		return nil, nil, false
+	}
+	yield := t.In(0)
+	if yield.Kind() != reflect.Func || yield.NumOut() != 1 || yield.Out(0) != reflect.TypeOf(false) || yield.IsVariadic() {
+		return nil, nil, false
+	}
+
+	switch yield.NumIn() {
+	case 1:
+		return nil, yield.In(0), true
+	case 2:
+		return yield.In(0), yield.In(1), true
+	}
+	return nil, nil, false
+}
+
+// genIteratorEncoder generates code that drains the iterator in of type t, encoding the
+// values of iter.Seq functions as an array and the pairs of iter.Seq2 ones as an object.
+func (g *Generator) genIteratorEncoder(t reflect.Type, in string, tags fieldTags, indent int, assumeNonEmpty bool) error {
+	ws := strings.Repeat("  ", indent)
+	key, elem, _ := iteratorTypes(t)
+	tmpVar := g.uniqueVarName()
+
+	open, close := "'['", "']'"
+	if key != nil {
+		open, close = "'{'", "'}'"
+	}
+
+	if !assumeNonEmpty {
+		fmt.Fprintln(g.out, ws+"if "+in+" == nil {")
+		fmt.Fprintln(g.out, ws+"  out.RawString(\"null\")")
+		fmt.Fprintln(g.out, ws+"} else {")
+	} else {
+		fmt.Fprintln(g.out, ws+"{")
+	}
+	fmt.Fprintln(g.out, ws+"  out.RawByte("+open+")")
+	fmt.Fprintln(g.out, ws+"  "+tmpVar+"First := true")
+	if key != nil {
+		fmt.Fprintln(g.out, ws+"  ("+in+")(func("+tmpVar+"Name "+g.getType(key)+", "+tmpVar+"Value "+g.getType(elem)+") bool {")
+	} else {
+		fmt.Fprintln(g.out, ws+"  ("+in+")(func("+tmpVar+"Value "+g.getType(elem)+") bool {")
+	}
+	fmt.Fprintln(g.out, ws+"    if "+tmpVar+"First { "+tmpVar+"First = false } else { out.RawByte(',') }")
+	if key != nil {
+		if err := g.genMapKeyEncoder(key, tmpVar+"Name", tags, indent+2); err != nil {
+			return err
+		}
+		fmt.Fprintln(g.out, ws+"    out.RawByte(':')")
+	}
+	if err := g.genTypeEncoder(elem, tmpVar+"Value", tags, indent+2, false); err != nil {
+		return err
+	}
+	fmt.Fprintln(g.out, ws+"    return true")
+	fmt.Fprintln(g.out, ws+"  })")
+	fmt.Fprintln(g.out, ws+"  out.RawByte("+close+")")
+	fmt.Fprintln(g.out, ws+"}")
+	return nil
+}
+
+// genIteratorDecoder generates code that decodes an array or an object into the values or
+// the pairs of the iterator out of type t, yielding them in the input order.
+func (g *Generator) genIteratorDecoder(t reflect.Type, out string, tags fieldTags, indent int) error {
+	ws := strings.Repeat("  ", indent)
+	key, elem, _ := iteratorTypes(t)
+	tmpVar := g.uniqueVarName()
+	elemType := g.getType(elem)
+
+	if key == nil {
+		fmt.Fprintln(g.out, ws+"{")
+		fmt.Fprintln(g.out, ws+"  var "+tmpVar+" []"+elemType)
+		if err := g.genTypeDecoder(reflect.SliceOf(elem), tmpVar, tags, indent+1); err != nil {
+			return err
+		}
+		fmt.Fprintln(g.out, ws+"  if "+tmpVar+" == nil {")
+		fmt.Fprintln(g.out, ws+"    "+out+" = nil")
+		fmt.Fprintln(g.out, ws+"  } else {")
+		fmt.Fprintln(g.out, ws+"    "+out+" = func(yield func("+elemType+") bool) {")
+		fmt.Fprintln(g.out, ws+"      for _, "+tmpVar+"Value := range "+tmpVar+" {")
+		fmt.Fprintln(g.out, ws+"        if !yield("+tmpVar+"Value) {")
+		fmt.Fprintln(g.out, ws+"          return")
+		fmt.Fprintln(g.out, ws+"        }")
+		fmt.Fprintln(g.out, ws+"      }")
+		fmt.Fprintln(g.out, ws+"    }")
+		fmt.Fprintln(g.out, ws+"  }")
+		fmt.Fprintln(g.out, ws+"}")
+		return nil
+	}
+
+	keyType := g.getType(key)
+	fmt.Fprintln(g.out, ws+"if in.IsNull() {")
+	fmt.Fprintln(g.out, ws+"  in.Skip()")
+	fmt.Fprintln(g.out, ws+"  "+out+" = nil")
+	fmt.Fprintln(g.out, ws+"} else {")
+	fmt.Fprintln(g.out, ws+"  var "+tmpVar+"Names []"+keyType)
+	fmt.Fprintln(g.out, ws+"  var "+tmpVar+"Values []"+elemType)
+	fmt.Fprintln(g.out, ws+"  in.Delim('{')")
+	fmt.Fprintln(g.out, ws+"  for !in.IsDelim('}') {")
+	if err := g.genMapKeyDecoder(key, tags, indent+2); err != nil {
+		return err
+	}
+	fmt.Fprintln(g.out, ws+"    in.WantColon()")
+	fmt.Fprintln(g.out, ws+"    var "+tmpVar+" "+elemType)
+	if err := g.genTypeDecoder(elem, tmpVar, tags, indent+2); err != nil {
+		return err
+	}
+	fmt.Fprintln(g.out, ws+"    "+tmpVar+"Names = append("+tmpVar+"Names, key)")
+	fmt.Fprintln(g.out, ws+"    "+tmpVar+"Values = append("+tmpVar+"Values, "+tmpVar+")")
+	fmt.Fprintln(g.out, ws+"    in.WantComma()")
+	fmt.Fprintln(g.out, ws+"  }")
+	fmt.Fprintln(g.out, ws+"  in.Delim('}')")
+	fmt.Fprintln(g.out, ws+"  "+out+" = func(yield func("+keyType+", "+elemType+") bool) {")
+	fmt.Fprintln(g.out, ws+"    for i := range "+tmpVar+"Names {")
+	fmt.Fprintln(g.out, ws+"      if !yield("+tmpVar+"Names[i], "+tmpVar+"Values[i]) {")
+	fmt.Fprintln(g.out, ws+"        return")
+	fmt.Fprintln(g.out, ws+"      }")
+	fmt.Fprintln(g.out, ws+"    }")
+	fmt.Fprintln(g.out, ws+"  }")
+	fmt.Fprintln(g.out, ws+"}")
+	return nil
+}
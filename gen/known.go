@@ -0,0 +1,54 @@
+package gen
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// knownType is a type of another package marshaled and unmarshaled with the KnownTypes option
+// by the jwriter.Writer and jlexer.Lexer methods named method, taking the underlying type of
+// the values, instead of its own methods.
+type knownType struct {
+	pkgPath, name string
+	method        string
+}
+
+// knownTypes are the types marshaled directly with the KnownTypes option: the UUID types of the
+// common packages, all of them 16-byte arrays marshaled in the canonical form by their
+// MarshalText methods.
+var knownTypes = []knownType{
+	{"github.com/google/uuid", "UUID", "UUID"},
+	{"github.com/gofrs/uuid", "UUID", "UUID"},
+	{"github.com/gofrs/uuid/v5", "UUID", "UUID"},
+	{"github.com/satori/go.uuid", "UUID", "UUID"},
+}
+
+// knownTypeOf returns the entry of the known types table for t, or nil if t is not in it.
+func knownTypeOf(t reflect.Type) *knownType {
+	if t.Name() == "" || t.Kind() != reflect.Array || t.Len() != 16 || t.Elem().Kind() != reflect.Uint8 {
+		return nil
+	}
+	pkgPath := fixPkgPathVendoring(t.PkgPath())
+	for i, k := range knownTypes {
+		if k.name == t.Name() && k.pkgPath == pkgPath {
+			return &knownTypes[i]
+		}
+	}
+	return nil
+}
+
+// genKnownEncoder generates code that encodes in of the known type k.
+func (g *Generator) genKnownEncoder(k *knownType, in string, indent int) {
+	ws := strings.Repeat("  ", indent)
+
+	fmt.Fprintln(g.out, ws+"out."+k.method+"([16]byte("+in+"))")
+}
+
+// genKnownDecoder generates code that decodes out of the known type k, leaving it unchanged on
+// null.
+func (g *Generator) genKnownDecoder(k *knownType, out string, indent int) {
+	ws := strings.Repeat("  ", indent)
+
+	fmt.Fprintln(g.out, ws+"in."+k.method+"((*[16]byte)(&"+out+"))")
+}
@@ -0,0 +1,75 @@
+package gen
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+
+	"github.com/mailru/easyjson"
+)
+
+// TypeMetrics describes the code generated for a type, e.g. to find structs so large that
+// their decoders would benefit from restructuring.
+type TypeMetrics struct {
+	Type           string // Type name as used in the generated code.
+	Lines          int    // Lines of the generated funcs and methods of the type.
+	Keys           int    // Cases of the object member dispatch switch of the decoder.
+	FallbackFields int    // Fields marshaled by their encoding/json or text methods.
+}
+
+// Metrics instructs to add per-type metrics of the generated code to the output as comments.
+func (g *Generator) Metrics() {
+	g.metricsComments = true
+}
+
+// TypeMetrics returns the metrics of the code generated for each type during the last Run,
+// sorted by type name.
+func (g *Generator) TypeMetrics() []TypeMetrics {
+	ret := make([]TypeMetrics, 0, len(g.metrics))
+	for _, m := range g.metrics {
+		ret = append(ret, *m)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Type < ret[j].Type })
+	return ret
+}
+
+// startMetrics starts collecting the metrics of the code generated for type t.
+func (g *Generator) startMetrics(t reflect.Type) {
+	g.curMetrics = &TypeMetrics{Type: g.getType(t), Lines: g.out.Len()}
+	g.metrics[t] = g.curMetrics
+}
+
+// endMetrics finishes collecting the metrics started by startMetrics.
+func (g *Generator) endMetrics() {
+	m := g.curMetrics
+	m.Lines = bytes.Count(g.out.Bytes()[m.Lines:], []byte{'\n'})
+	g.curMetrics = nil
+}
+
+// isFallbackType tells if the values of type t are marshaled by their encoding/json or text
+// methods rather than by easyjson code.
+func (g *Generator) isFallbackType(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	p := reflect.PtrTo(t)
+	if !g.standalone && p.Implements(reflect.TypeOf((*easyjson.Marshaler)(nil)).Elem()) {
+		return false
+	}
+	return p.Implements(reflect.TypeOf((*json.Marshaler)(nil)).Elem()) ||
+		p.Implements(reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()) ||
+		p.Implements(reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem())
+}
+
+// printMetrics writes the metrics of the generated types as comments to w.
+func (g *Generator) printMetrics(w io.Writer) {
+	fmt.Fprintln(w, "// Generated code metrics:")
+	for _, m := range g.TypeMetrics() {
+		fmt.Fprintf(w, "//   %v: %d lines, %d keys, %d fallback fields\n", m.Type, m.Lines, m.Keys, m.FallbackFields)
+	}
+	fmt.Fprintln(w)
+}
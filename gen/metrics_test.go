@@ -0,0 +1,42 @@
+package gen
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+	"time"
+)
+
+type metricsInner struct {
+	A int
+}
+
+type metricsStruct struct {
+	Name    string
+	Time    time.Time
+	Raw     json.RawMessage
+	Inner   metricsInner
+	Omitted int `json:"-"`
+}
+
+func TestTypeMetrics(t *testing.T) {
+	g := NewGenerator("metrics_test.go")
+	g.Add(metricsStruct{})
+	if err := g.Run(ioutil.Discard); err != nil {
+		t.Fatalf("Run() error: %v", err)
+	}
+
+	got := g.TypeMetrics()
+	if len(got) != 2 || got[0].Type != "gen.metricsInner" || got[1].Type != "gen.metricsStruct" {
+		t.Fatalf("TypeMetrics() = %+v; want metricsInner and metricsStruct", got)
+	}
+	if got[0].Keys != 1 || got[0].FallbackFields != 0 {
+		t.Errorf("metricsInner metrics = %+v; want 1 key, 0 fallback fields", got[0])
+	}
+	if got[1].Keys != 4 || got[1].FallbackFields != 2 {
+		t.Errorf("metricsStruct metrics = %+v; want 4 keys, 2 fallback fields", got[1])
+	}
+	if got[0].Lines == 0 || got[1].Lines <= got[0].Lines {
+		t.Errorf("TypeMetrics() = %+v; want more lines for the larger type", got)
+	}
+}
@@ -0,0 +1,129 @@
+package gen
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// protobufFieldNamer names the fields of protoc-gen-go structs by the json option of their
+// protobuf tags, like protojson does it, and the other fields with the underlying namer.
+type protobufFieldNamer struct {
+	FieldNamer
+}
+
+func (n protobufFieldNamer) GetJSONFieldName(t reflect.Type, f reflect.StructField) string {
+	if _, jsonName := protobufNames(f); jsonName != "" {
+		return jsonName
+	}
+	return n.FieldNamer.GetJSONFieldName(t, f)
+}
+
+// protobufNames returns the name of the field in the .proto file and its JSON name, taken
+// from the name and json options of the protobuf tag. Both are empty if there is no tag.
+func protobufNames(f reflect.StructField) (name, jsonName string) {
+	for _, opt := range strings.Split(f.Tag.Get("protobuf"), ",") {
+		switch {
+		case strings.HasPrefix(opt, "name="):
+			name = opt[len("name="):]
+		case strings.HasPrefix(opt, "json="):
+			jsonName = opt[len("json="):]
+		}
+	}
+	if jsonName == "" {
+		jsonName = name
+	}
+	return name, jsonName
+}
+
+// fieldKeys returns the object member names the field is unmarshaled from: the JSON name
+// and, in protobuf mode, also the name of the field in the .proto file, as protojson does.
+func (g *Generator) fieldKeys(t reflect.Type, f reflect.StructField) []string {
+	keys := []string{g.fieldNamer.GetJSONFieldName(t, f)}
+	if g.protobuf {
+		if name, _ := protobufNames(f); name != "" && name != keys[0] {
+			keys = append(keys, name)
+		}
+	}
+	return keys
+}
+
+// isOneofField tells if f is a oneof field of a protoc-gen-go struct.
+func (g *Generator) isOneofField(f reflect.StructField) bool {
+	return g.protobuf && f.Type.Kind() == reflect.Interface && f.Tag.Get("protobuf_oneof") != ""
+}
+
+// oneofWrappers returns the pointers to the wrapper types that can be assigned to the oneof
+// field f of type t.
+func (g *Generator) oneofWrappers(t reflect.Type, f reflect.StructField) ([]reflect.Type, error) {
+	var ret []reflect.Type
+	for _, w := range g.oneofWrapperTypes {
+		if !reflect.PtrTo(w).Implements(f.Type) {
+			continue
+		}
+		if w.NumField() != 1 {
+			return nil, fmt.Errorf("oneof wrapper %v of %v.%v has %d fields; expected 1", w, t, f.Name, w.NumField())
+		}
+		ret = append(ret, reflect.PtrTo(w))
+	}
+	if len(ret) == 0 {
+		return nil, fmt.Errorf("no wrapper types of oneof field %v.%v found", t, f.Name)
+	}
+	return ret, nil
+}
+
+// oneofKeys returns the object member names of all wrappers of the oneof field f.
+func (g *Generator) oneofKeys(t reflect.Type, f reflect.StructField) []string {
+	// The errors are reported when generating the decoder.
+	wrappers, _ := g.oneofWrappers(t, f)
+
+	var keys []string
+	for _, w := range wrappers {
+		keys = append(keys, g.fieldKeys(w.Elem(), w.Elem().Field(0))...)
+	}
+	return keys
+}
+
+// genOneofFieldEncoder generates the code writing the member of the wrapper assigned to the
+// oneof field f, if any.
+func (g *Generator) genOneofFieldEncoder(t reflect.Type, f reflect.StructField, first, firstCondition bool) (bool, error) {
+	wrappers, err := g.oneofWrappers(t, f)
+	if err != nil {
+		return firstCondition, err
+	}
+
+	v := g.uniqueVarName()
+	fmt.Fprintf(g.out, "  switch %v := in.%v.(type) {\n", v, f.Name)
+	for _, w := range wrappers {
+		wf := w.Elem().Field(0)
+		fmt.Fprintf(g.out, "  case %v:\n", g.getType(w))
+		g.genFieldPrefix(g.fieldNamer.GetJSONFieldName(w.Elem(), wf), first, firstCondition, true)
+		if err := g.genTypeEncoder(wf.Type, v+"."+wf.Name, parseFieldTags(wf), 2, false); err != nil {
+			return firstCondition, err
+		}
+	}
+	fmt.Fprintln(g.out, "  }")
+	return firstCondition, nil
+}
+
+// genOneofFieldDecoder generates the cases of the members of all wrappers of the oneof
+// field f, assigning the decoded wrapper to the field.
+func (g *Generator) genOneofFieldDecoder(t reflect.Type, f reflect.StructField) error {
+	wrappers, err := g.oneofWrappers(t, f)
+	if err != nil {
+		return err
+	}
+
+	for _, w := range wrappers {
+		wf := w.Elem().Field(0)
+		g.genFieldCase(g.fieldKeys(w.Elem(), wf))
+
+		v := g.uniqueVarName()
+		fmt.Fprintf(g.out, "      %v := new(%v)\n", v, g.getType(w.Elem()))
+		if err := g.genTypeDecoder(wf.Type, v+"."+wf.Name, parseFieldTags(wf), 3); err != nil {
+			return err
+		}
+		fmt.Fprintf(g.out, "      out.%v = %v\n", f.Name, v)
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package gen
+
+import (
+	"encoding/json"
+	"io"
+	"reflect"
+)
+
+var jsonNumberType = reflect.TypeOf(json.Number(""))
+
+// SchemaEmitter writes a JSON Schema (draft 2020-12) document with the definitions of the named
+// types in $defs, where the members of structs refer to them. Types with their own marshalers
+// are described by the values they are marshaled as if known, e.g. times, and by the empty
+// schema otherwise.
+type SchemaEmitter struct {
+	// ID is the $id of the document, left out if empty.
+	ID string
+}
+
+func (e SchemaEmitter) Emit(out io.Writer, types []TypeDesc) error {
+	s := schemaWriter{descs: map[reflect.Type]*TypeDesc{}}
+	for i := range types {
+		s.descs[types[i].Type] = &types[i]
+	}
+
+	defs := object{}
+	for _, d := range types {
+		if d.Name != "" {
+			defs = append(defs, member{d.Name, s.describe(&d)})
+		}
+	}
+	doc := object{{"$schema", "https://json-schema.org/draft/2020-12/schema"}}
+	if e.ID != "" {
+		doc = append(doc, member{"$id", e.ID})
+	}
+	doc = append(doc, member{"$defs", defs})
+	return writeIndented(out, doc)
+}
+
+type schemaWriter struct {
+	descs map[reflect.Type]*TypeDesc
+}
+
+// describe returns the schema of the type described by d.
+func (s schemaWriter) describe(d *TypeDesc) object {
+	var ret object
+	switch {
+	case d.Enum != nil:
+		ret = object{{"type", "string"}, {"enum", d.Enum}}
+	case d.Fields != nil || d.Type.Kind() == reflect.Struct:
+		ret = object{{"type", "object"}}
+		props := object{}
+		var required []string
+		for _, f := range d.Fields {
+			p := s.schema(f.Type, f.AsString)
+			if f.Doc != "" {
+				p = append(p, member{"description", f.Doc})
+			}
+			props = append(props, member{f.JSONName, p})
+			if f.Required {
+				required = append(required, f.JSONName)
+			}
+		}
+		ret = append(ret, member{"properties", props})
+		if required != nil {
+			ret = append(ret, member{"required", required})
+		}
+	default:
+		ret = s.schemaOfKind(d.Type, false)
+	}
+	if d.Doc != "" {
+		ret = append(ret, member{"description", d.Doc})
+	}
+	return ret
+}
+
+// schema returns the schema of the values of type t, referring to the definitions of named
+// types. If asString is set, numbers and bools are strings.
+func (s schemaWriter) schema(t reflect.Type, asString bool) object {
+	switch t {
+	case timeType:
+		return object{{"type", "string"}, {"format", "date-time"}}
+	case jsonNumberType:
+		return object{{"type", "number"}}
+	}
+	if bigTypes[t] == "BigInt" && !asString {
+		return object{{"type", "integer"}}
+	}
+	if bigTypes[t] != "" || isDecimalType(t) || ipTypes[t] != "" {
+		return object{{"type", "string"}}
+	}
+	if knownTypeOf(t) != nil {
+		return object{{"type", "string"}, {"format", "uuid"}}
+	}
+	if isSQLNullType(t) {
+		return s.schema(t.Field(0).Type, asString)
+	}
+	switch name := protoWellKnownType(t); name {
+	case "":
+	case "Timestamp":
+		return object{{"type", "string"}, {"format", "date-time"}}
+	case "Duration":
+		return object{{"type", "string"}, {"pattern", "^-?[0-9]+(\\.[0-9]{1,9})?s$"}}
+	default:
+		v, _ := t.FieldByName("Value")
+		return s.schema(v.Type, v.Type.Kind() == reflect.Int64 || v.Type.Kind() == reflect.Uint64)
+	}
+	if d := s.descs[t]; d != nil {
+		if d.Name != "" {
+			return object{{"$ref", "#/$defs/" + d.Name}}
+		}
+		return s.describe(d)
+	}
+	if t.Kind() == reflect.Struct || hasCustomMarshaler(t) {
+		// A type with its own marshaler, or not generated.
+		return object{}
+	}
+	return s.schemaOfKind(t, asString)
+}
+
+// schemaOfKind returns the schema of the values of type t by its kind.
+func (s schemaWriter) schemaOfKind(t reflect.Type, asString bool) object {
+	switch t.Kind() {
+	case reflect.Bool:
+		if asString {
+			return object{{"type", "string"}, {"enum", []string{"true", "false"}}}
+		}
+		return object{{"type", "boolean"}}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if asString {
+			return object{{"type", "string"}, {"pattern", "^-?[0-9]+$"}}
+		}
+		return object{{"type", "integer"}}
+	case reflect.Float32, reflect.Float64:
+		if asString {
+			return object{{"type", "string"}}
+		}
+		return object{{"type", "number"}}
+	case reflect.String:
+		return object{{"type", "string"}}
+	case reflect.Ptr:
+		return s.schema(t.Elem(), asString)
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 && t.Elem().Name() == "uint8" {
+			return object{{"type", "string"}, {"contentEncoding", "base64"}}
+		}
+		ret := object{{"type", "array"}, {"items", s.schema(t.Elem(), false)}}
+		if t.Kind() == reflect.Array {
+			ret = append(ret, member{"minItems", t.Len()}, member{"maxItems", t.Len()})
+		}
+		return ret
+	case reflect.Map:
+		return object{{"type", "object"}, {"additionalProperties", s.schema(t.Elem(), false)}}
+	}
+	return object{}
+}
@@ -0,0 +1,70 @@
+package gen
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// isSQLNullType returns true if t is one of the database/sql Null types, e.g. sql.NullString or
+// sql.Null[T], structs of a value and its Valid flag.
+func isSQLNullType(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t.PkgPath() == "database/sql" && strings.HasPrefix(t.Name(), "Null") &&
+		t.NumField() == 2 && t.Field(1).Name == "Valid" && t.Field(1).Type.Kind() == reflect.Bool
+}
+
+// sqlNulls returns true if the database/sql Null values are marshaled as their values or null.
+// The stdlib-compat mode marshals them as objects like encoding/json does it.
+func (g *Generator) sqlNulls() bool {
+	return !g.noSQLNull && !g.stdlibCompat
+}
+
+// genSQLNullEncoder generates code that encodes in of a database/sql Null type t as its value, or
+// as null if it is not valid. The field tags apply to the value, e.g. the time layout to the
+// time of sql.NullTime.
+func (g *Generator) genSQLNullEncoder(t reflect.Type, in string, tags fieldTags, indent int) error {
+	ws := strings.Repeat("  ", indent)
+
+	fmt.Fprintln(g.out, ws+"if !("+in+").Valid {")
+	fmt.Fprintln(g.out, ws+`  out.RawString("null")`)
+	fmt.Fprintln(g.out, ws+"} else {")
+	v := t.Field(0)
+	if err := g.genTypeEncoder(v.Type, "("+in+")."+v.Name, tags, indent+1, false); err != nil {
+		return err
+	}
+	fmt.Fprintln(g.out, ws+"}")
+	return nil
+}
+
+// genSQLNullDecoder generates code that decodes out of a database/sql Null type t from its value,
+// setting it valid, or resets it on null.
+func (g *Generator) genSQLNullDecoder(t reflect.Type, out string, tags fieldTags, indent int) error {
+	ws := strings.Repeat("  ", indent)
+
+	fmt.Fprintln(g.out, ws+"if in.IsNull() {")
+	fmt.Fprintln(g.out, ws+"  in.Skip()")
+	fmt.Fprintln(g.out, ws+"  "+out+" = "+g.getType(t)+"{}")
+	fmt.Fprintln(g.out, ws+"} else {")
+	v := t.Field(0)
+	if err := g.genTypeDecoder(v.Type, "("+out+")."+v.Name, tags, indent+1); err != nil {
+		return err
+	}
+	fmt.Fprintln(g.out, ws+"  ("+out+").Valid = true")
+	fmt.Fprintln(g.out, ws+"}")
+	return nil
+}
+
+// genSQLNullValidator generates code that validates a value of a database/sql Null type t: null
+// or its value.
+func (g *Generator) genSQLNullValidator(t reflect.Type, tags fieldTags, indent int) error {
+	ws := strings.Repeat("  ", indent)
+
+	fmt.Fprintln(g.out, ws+"if in.IsNull() {")
+	fmt.Fprintln(g.out, ws+"  in.Skip()")
+	fmt.Fprintln(g.out, ws+"} else {")
+	if err := g.genTypeValidator(t.Field(0).Type, tags, indent+1); err != nil {
+		return err
+	}
+	fmt.Fprintln(g.out, ws+"}")
+	return nil
+}
@@ -0,0 +1,101 @@
+package gen
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// Formats of the easyjson 'format' directive of time fields.
+const (
+	timeFormatUnix      = "unix"
+	timeFormatUnixMilli = "unixmilli"
+	timeFormatUnixMicro = "unixmicro"
+	timeFormatUnixNano  = "unixnano"
+)
+
+// timeFormatMethods maps the time formats to the names of the jwriter and jlexer methods
+// marshaling times in them.
+var timeFormatMethods = map[string]string{
+	timeFormatUnix:      "UnixTime",
+	timeFormatUnixMilli: "UnixMilliTime",
+	timeFormatUnixMicro: "UnixMicroTime",
+	timeFormatUnixNano:  "UnixNanoTime",
+}
+
+// layoutProbe is a time that every element of a time.Format layout formats differently from the
+// element itself.
+var layoutProbe = time.Date(1999, time.December, 31, 10, 58, 59, 987654321, time.FixedZone("X", 3600))
+
+// isTimeLayout returns true if the layout contains at least one element of time.Format layouts,
+// unlike mistyped format names.
+func isTimeLayout(layout string) bool {
+	return layoutProbe.Format(layout) != layout
+}
+
+// isTimeType returns true if the easyjson 'layout' and 'format' directives apply to the type of
+// a field: time.Time and sql.NullTime, and the pointers, slices, arrays and maps of them.
+func isTimeType(t reflect.Type) bool {
+	for t != timeType {
+		switch t.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+			t = t.Elem()
+		case reflect.Struct:
+			if !isSQLNullType(t) {
+				return false
+			}
+			t = t.Field(0).Type
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// splitTimeLayout splits the easyjson tag into the directives before the 'layout' directive and
+// its value, which takes the rest of the tag, so that layouts may contain commas.
+func splitTimeLayout(tag string) (directives, layout string) {
+	for i := 0; i < len(tag); {
+		if strings.HasPrefix(tag[i:], "layout=") {
+			return tag[:i], tag[i+len("layout="):]
+		}
+		j := strings.IndexByte(tag[i:], ',')
+		if j < 0 {
+			break
+		}
+		i += j + 1
+	}
+	return tag, ""
+}
+
+// genTimeEncoder generates code that encodes in of type time.Time with the layout or the format
+// set by the easyjson directives.
+func (g *Generator) genTimeEncoder(in string, tags fieldTags, indent int) {
+	ws := strings.Repeat("  ", indent)
+
+	if method := timeFormatMethods[tags.timeFormat]; method != "" {
+		fmt.Fprintln(g.out, ws+"out."+method+"("+in+")")
+	} else {
+		fmt.Fprintln(g.out, ws+"out.Time("+in+", "+strconv.Quote(tags.timeLayout)+")")
+	}
+}
+
+// genTimeDecoder generates code that decodes out of type time.Time with the layout or the format
+// set by the easyjson directives, leaving it unchanged on null like time.Time.UnmarshalJSON.
+func (g *Generator) genTimeDecoder(out string, tags fieldTags, indent int) {
+	ws := strings.Repeat("  ", indent)
+
+	fmt.Fprintln(g.out, ws+"if in.IsNull() {")
+	fmt.Fprintln(g.out, ws+"  in.Skip()")
+	fmt.Fprintln(g.out, ws+"} else {")
+	if method := timeFormatMethods[tags.timeFormat]; method != "" {
+		fmt.Fprintln(g.out, ws+"  "+out+" = in."+method+"()")
+	} else {
+		fmt.Fprintln(g.out, ws+"  "+out+" = in.Time("+strconv.Quote(tags.timeLayout)+")")
+	}
+	fmt.Fprintln(g.out, ws+"}")
+}
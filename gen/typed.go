@@ -0,0 +1,54 @@
+package gen
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/mailru/easyjson"
+)
+
+// isTypedInterface returns true if values of the non-empty interface type t are marshaled with
+// the names of their types registered with easyjson.RegisterType: if t has none of the easyjson,
+// encoding/json and encoding text marshaling methods.
+func isTypedInterface(t reflect.Type) bool {
+	return t.NumMethod() != 0 &&
+		!t.Implements(reflect.TypeOf((*easyjson.Marshaler)(nil)).Elem()) &&
+		!t.Implements(reflect.TypeOf((*easyjson.Unmarshaler)(nil)).Elem()) &&
+		!t.Implements(reflect.TypeOf((*json.Marshaler)(nil)).Elem()) &&
+		!t.Implements(reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()) &&
+		!t.Implements(reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()) &&
+		!t.Implements(reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem())
+}
+
+// genTypedEncoder generates code that encodes in of the interface type t as an object with the
+// registered name of its dynamic type and its value.
+func (g *Generator) genTypedEncoder(t reflect.Type, in string, indent int) error {
+	if g.standalone {
+		return fmt.Errorf("interface type %v not supported in standalone mode: only interface{} and interfaces that implement json Marshaling are allowed", t)
+	}
+	ws := strings.Repeat("  ", indent)
+	fmt.Fprintln(g.out, ws+g.pkgAlias(pkgEasyJSON)+".MarshalTyped(out, "+in+")")
+	return nil
+}
+
+// genTypedDecoder generates code that decodes an object with a registered type name and a value
+// into out of the interface type t as a new value of the registered type.
+func (g *Generator) genTypedDecoder(t reflect.Type, out string, indent int) error {
+	if g.standalone {
+		return fmt.Errorf("interface type %v not supported in standalone mode: only interface{} and json Unmarshaler are allowed", t)
+	}
+	ws := strings.Repeat("  ", indent)
+	typ := g.getType(t)
+	tmpVar := g.uniqueVarName()
+
+	fmt.Fprintln(g.out, ws+"if in.IsNull() {")
+	fmt.Fprintln(g.out, ws+"  in.Skip()")
+	fmt.Fprintln(g.out, ws+"  "+out+" = nil")
+	fmt.Fprintln(g.out, ws+"} else if "+tmpVar+" := "+g.pkgAlias(pkgEasyJSON)+".UnmarshalTyped(in, (*"+typ+")(nil)); "+tmpVar+" != nil {")
+	fmt.Fprintln(g.out, ws+"  "+out+" = "+tmpVar+".("+typ+")")
+	fmt.Fprintln(g.out, ws+"}")
+	return nil
+}
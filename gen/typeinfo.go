@@ -0,0 +1,123 @@
+package gen
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// typeDoc holds the doc comments of a struct type and of its fields.
+type typeDoc struct {
+	doc    string
+	fields map[string]string
+}
+
+// AddDoc sets the doc comment of the struct type of obj and the ones of its fields by name,
+// used as the descriptions of its type info.
+func (g *Generator) AddDoc(obj interface{}, doc string, fields map[string]string) {
+	t := reflect.TypeOf(obj)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	g.docs[t] = typeDoc{doc: doc, fields: fields}
+}
+
+// fieldDoc returns the doc comment of the field f of t, which may be promoted from an embedded
+// struct type.
+func (g *Generator) fieldDoc(t reflect.Type, f reflect.StructField) string {
+	if sf, ok := t.FieldByName(f.Name); ok && len(sf.Index) == 1 {
+		return g.docs[t].fields[f.Name]
+	}
+	for i := 0; i < t.NumField(); i++ {
+		ef := t.Field(i)
+		if !ef.Anonymous || parseFieldTags(ef).name != "" {
+			continue
+		}
+		et := ef.Type
+		if et.Kind() == reflect.Ptr {
+			et = et.Elem()
+		}
+		if et.Kind() == reflect.Struct {
+			if d := g.fieldDoc(et, f); d != "" {
+				return d
+			}
+		}
+	}
+	return ""
+}
+
+// genTypeInfo generates an easyjson.TypeInfo describing the fields of struct type t, along
+// with the code registering it and an EasyJSONTypeInfo method returning it.
+func (g *Generator) genTypeInfo(t reflect.Type) error {
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	fs, err := g.structFields(t)
+	if err != nil {
+		return fmt.Errorf("cannot generate type info for %v: %v", t, err)
+	}
+
+	vname := g.functionName("type_info", t)
+	typ := g.getType(t)
+	if params, _ := g.typeParamLists(typ); params != "" {
+		return fmt.Errorf("cannot generate type info for the generic type %v", t)
+	}
+
+	fmt.Fprintln(g.out, "var "+vname+" = &easyjson.TypeInfo{")
+	fmt.Fprintf(g.out, "  Name: %q,\n", t.PkgPath()+"."+t.Name())
+	doc := g.docs[t]
+	if doc.doc != "" {
+		fmt.Fprintf(g.out, "  Description: %q,\n", doc.doc)
+	}
+	fmt.Fprintln(g.out, "  Fields: []easyjson.FieldInfo{")
+	for _, f := range fs {
+		tags := parseFieldTags(f)
+		if tags.omit {
+			continue
+		}
+
+		fmt.Fprintln(g.out, "    {")
+		fmt.Fprintf(g.out, "      Name: %q,\n", f.Name)
+		fmt.Fprintf(g.out, "      JSONName: %q,\n", g.fieldNamer.GetJSONFieldName(t, f))
+		fmt.Fprintf(g.out, "      Type: %q,\n", f.Type.String())
+		fmt.Fprintf(g.out, "      Tag: %q,\n", string(f.Tag))
+		if d := g.fieldDoc(t, f); d != "" {
+			fmt.Fprintf(g.out, "      Description: %q,\n", d)
+		}
+		fmt.Fprintf(g.out, "      OmitEmpty: %v,\n", (tags.omitEmpty || g.omitEmpty) && !tags.noOmitEmpty)
+		fmt.Fprintf(g.out, "      OmitZero: %v,\n", tags.omitZero)
+		fmt.Fprintf(g.out, "      Required: %v,\n", tags.required)
+		if ps := embeddedPointers(t, f); len(ps) != 0 {
+			// the fields promoted through nil embedded pointers have no address
+			var nilChecks []string
+			for _, p := range ps {
+				nilChecks = append(nilChecks, "p."+p.path+" == nil")
+			}
+			fmt.Fprintln(g.out, "      Addr: func(v interface{}) interface{} {")
+			fmt.Fprintln(g.out, "        p := v.(*"+typ+")")
+			fmt.Fprintln(g.out, "        if "+strings.Join(nilChecks, " || ")+" {")
+			fmt.Fprintln(g.out, "          return nil")
+			fmt.Fprintln(g.out, "        }")
+			fmt.Fprintln(g.out, "        return &p."+f.Name)
+			fmt.Fprintln(g.out, "      },")
+		} else {
+			fmt.Fprintln(g.out, "      Addr: func(v interface{}) interface{} { return &v.(*"+typ+")."+f.Name+" },")
+		}
+		fmt.Fprintln(g.out, "    },")
+	}
+	fmt.Fprintln(g.out, "  },")
+	fmt.Fprintln(g.out, "}")
+	fmt.Fprintln(g.out)
+
+	fmt.Fprintln(g.out, "func init() {")
+	fmt.Fprintln(g.out, "  easyjson.RegisterTypeInfo("+vname+")")
+	fmt.Fprintln(g.out, "}")
+	fmt.Fprintln(g.out)
+
+	fmt.Fprintln(g.out, "// EasyJSONTypeInfo supports easyjson.TypeInfoProvider interface")
+	fmt.Fprintln(g.out, "func ("+typ+") EasyJSONTypeInfo() *easyjson.TypeInfo {")
+	fmt.Fprintln(g.out, "  return "+vname)
+	fmt.Fprintln(g.out, "}")
+	return nil
+}
@@ -0,0 +1,131 @@
+package gen
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/mailru/easyjson"
+)
+
+// unknownFieldsField returns the field of the struct t tagged with the unknown easyjson
+// directive, which collects the members not matching other fields. It reports if there is one.
+func (g *Generator) unknownFieldsField(t reflect.Type) (reflect.StructField, bool, error) {
+	fs, err := getStructFields(t)
+	if err != nil {
+		return reflect.StructField{}, false, err
+	}
+
+	var found []reflect.StructField
+	for _, f := range fs {
+		if parseFieldTags(f).unknownFields {
+			found = append(found, f)
+		}
+	}
+	switch {
+	case len(found) == 0:
+		return reflect.StructField{}, false, nil
+	case len(found) > 1:
+		return reflect.StructField{}, false, fmt.Errorf("fields %v and %v are both tagged easyjson:\"unknown\"", found[0].Name, found[1].Name)
+	case !isUnknownFieldsType(found[0].Type):
+		return reflect.StructField{}, false, fmt.Errorf("field %v tagged easyjson:\"unknown\" is of type %v: only map[string]json.RawMessage and map[string]easyjson.RawMessage are allowed", found[0].Name, found[0].Type)
+	case hasUnknownsUnmarshaler(t) || hasUnknownsMarshaler(t):
+		return reflect.StructField{}, false, fmt.Errorf("field %v tagged easyjson:\"unknown\" can not be used with unknown fields marshalers", found[0].Name)
+	}
+	return found[0], true, nil
+}
+
+// isUnknownFieldsType tells if t can collect unknown members as raw JSON values by name.
+func isUnknownFieldsType(t reflect.Type) bool {
+	if t.Kind() != reflect.Map || t.Key().Kind() != reflect.String {
+		return false
+	}
+	elem := t.Elem()
+	return elem == reflect.TypeOf(json.RawMessage(nil)) || elem == reflect.TypeOf(easyjson.RawMessage(nil))
+}
+
+// genUnknownFieldDecoder generates code that collects the current member into the field f.
+// The value is null if isNull is set, otherwise it is read from the input.
+func (g *Generator) genUnknownFieldDecoder(f reflect.StructField, isNull bool, indent int) {
+	ws := strings.Repeat("  ", indent)
+	out := "out." + f.Name
+	elem := g.getType(f.Type.Elem())
+
+	fmt.Fprintln(g.out, ws+"if "+out+" == nil {")
+	fmt.Fprintln(g.out, ws+"  "+out+" = make("+g.getType(f.Type)+")")
+	fmt.Fprintln(g.out, ws+"}")
+	// The key and the raw value refer to the input, which may be reused by the caller.
+	if isNull {
+		fmt.Fprintln(g.out, ws+out+"[string([]byte(key))] = "+elem+"(\"null\")")
+	} else {
+		fmt.Fprintln(g.out, ws+out+"[string([]byte(key))] = append("+elem+"(nil), in.Raw()...)")
+	}
+}
+
+// genUnknownNullFieldDecoder generates code that collects the null members not matching the
+// fields fs into the field f. Merge patches remove them instead.
+func (g *Generator) genUnknownNullFieldDecoder(t reflect.Type, fs []reflect.StructField, f reflect.StructField) {
+	keys := g.knownKeys(t, fs)
+
+	fmt.Fprintln(g.out, "       switch key {")
+	if len(keys) > 0 {
+		fmt.Fprintf(g.out, "       case %v:\n", quoteKeys(keys))
+	}
+	fmt.Fprintln(g.out, "       default:")
+	fmt.Fprintln(g.out, "         if in.MergePatch && !in.KeepOnNull {")
+	fmt.Fprintln(g.out, "           delete(out."+f.Name+", key)")
+	fmt.Fprintln(g.out, "         } else {")
+	g.genUnknownFieldDecoder(f, true, 6)
+	fmt.Fprintln(g.out, "         }")
+	fmt.Fprintln(g.out, "       }")
+}
+
+// knownKeys returns the member names matching the fields fs of the struct t.
+func (g *Generator) knownKeys(t reflect.Type, fs []reflect.StructField) []string {
+	var keys []string
+	for _, f := range fs {
+		if parseFieldTags(f).omit {
+			continue
+		}
+		if g.isOneofField(f) {
+			keys = append(keys, g.oneofKeys(t, f)...)
+		} else {
+			keys = append(keys, g.fieldKeys(t, f)...)
+		}
+	}
+	return keys
+}
+
+// genUnknownFieldsEncoder generates code that writes the members collected in the field f after
+// the other ones, in the order of their names, skipping the names of the fields fs of the struct
+// t. firstCondition is set if no member may have been written yet.
+func (g *Generator) genUnknownFieldsEncoder(t reflect.Type, fs []reflect.StructField, f reflect.StructField, firstCondition bool) {
+	fmt.Fprintln(g.out, "  {")
+	fmt.Fprintln(g.out, "    keys := make([]string, 0, len(in."+f.Name+"))")
+	fmt.Fprintln(g.out, "    for key := range in."+f.Name+" {")
+	if keys := g.knownKeys(t, fs); len(keys) > 0 {
+		fmt.Fprintln(g.out, "      switch key {")
+		fmt.Fprintf(g.out, "      case %v:\n", quoteKeys(keys))
+		fmt.Fprintln(g.out, "        continue")
+		fmt.Fprintln(g.out, "      }")
+	}
+	fmt.Fprintln(g.out, "      keys = append(keys, key)")
+	fmt.Fprintln(g.out, "    }")
+	fmt.Fprintln(g.out, "    "+g.pkgAlias("sort")+".Strings(keys)")
+	fmt.Fprintln(g.out, "    for _, key := range keys {")
+	if firstCondition {
+		fmt.Fprintln(g.out, "      if first {")
+		fmt.Fprintln(g.out, "        first = false")
+		fmt.Fprintln(g.out, "      } else {")
+		fmt.Fprintln(g.out, "        out.RawByte(',')")
+		fmt.Fprintln(g.out, "      }")
+	} else {
+		fmt.Fprintln(g.out, "      out.RawByte(',')")
+	}
+	fmt.Fprintln(g.out, "      out.String(key)")
+	fmt.Fprintln(g.out, "      out.RawByte(':')")
+	fmt.Fprintln(g.out, "      out.Raw(in."+f.Name+"[key], nil)")
+	fmt.Fprintln(g.out, "    }")
+	fmt.Fprintln(g.out, "  }")
+}
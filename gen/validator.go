@@ -0,0 +1,362 @@
+package gen
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/mailru/easyjson"
+)
+
+// Validators instructs to generate ValidateEasyJSON methods checking the structure and the
+// types of the input without building Go values.
+func (g *Generator) Validators() {
+	g.validators = true
+}
+
+func (g *Generator) getValidatorName(t reflect.Type) string {
+	return g.functionName("validate", t)
+}
+
+// hasValidator tells if a validator function is generated for t in this run.
+func (g *Generator) hasValidator(t reflect.Type) bool {
+	if !g.marshalers[t] || g.kept[t]["UnmarshalJSON"] || g.kept[t]["UnmarshalEasyJSON"] {
+		return false
+	}
+	switch t.Kind() {
+	case reflect.Struct, reflect.Slice, reflect.Array, reflect.Map:
+		return true
+	}
+	return g.enums[t] != nil
+}
+
+// genValidator generates the validator function of type t.
+func (g *Generator) genValidator(t reflect.Type) error {
+	if t.Kind() == reflect.Struct && g.enums[t] == nil {
+		return g.genStructValidator(t)
+	}
+
+	params, _ := g.typeParamLists(g.getType(t))
+	fmt.Fprintln(g.out, "func "+g.getValidatorName(t)+params+"(in *jlexer.Lexer) {")
+	fmt.Fprintln(g.out, "  isTopLevel := in.IsStart()")
+	if g.enums[t] != nil {
+		fmt.Fprintln(g.out, "  var v "+g.getType(t))
+		fmt.Fprintln(g.out, "  "+g.getDecoderName(t)+"(in, &v)")
+	} else if err := g.genTypeValidatorNoCheck(t, fieldTags{}, 1); err != nil {
+		return err
+	}
+	fmt.Fprintln(g.out, "  if isTopLevel {")
+	fmt.Fprintln(g.out, "    in.Consumed()")
+	fmt.Fprintln(g.out, "  }")
+	fmt.Fprintln(g.out, "}")
+	return nil
+}
+
+func (g *Generator) genStructValidator(t reflect.Type) error {
+	fs, err := g.structFields(t)
+	if err != nil {
+		return fmt.Errorf("cannot generate validator for %v: %v", t, err)
+	}
+
+	params, _ := g.typeParamLists(g.getType(t))
+	fmt.Fprintln(g.out, "func "+g.getValidatorName(t)+params+"(in *jlexer.Lexer) {")
+	fmt.Fprintln(g.out, "  isTopLevel := in.IsStart()")
+	fmt.Fprintln(g.out, "  if in.IsNull() {")
+	fmt.Fprintln(g.out, "    if isTopLevel {")
+	fmt.Fprintln(g.out, "      in.Consumed()")
+	fmt.Fprintln(g.out, "    }")
+	fmt.Fprintln(g.out, "    in.Skip()")
+	fmt.Fprintln(g.out, "    return")
+	fmt.Fprintln(g.out, "  }")
+	for _, f := range fs {
+		g.genRequiredFieldSet(t, f)
+	}
+
+	fmt.Fprintln(g.out, "  in.Delim('{')")
+	fmt.Fprintln(g.out, "  for !in.IsDelim('}') {")
+	fmt.Fprintf(g.out, "    key := in.UnsafeFieldName(%v)\n", g.skipMemberNameUnescaping)
+	if g.foldKeys {
+		g.genFieldNameFolding(t, fs)
+	}
+	fmt.Fprintln(g.out, "    in.WantColon()")
+	fmt.Fprintln(g.out, "    if in.IsNull() {")
+	fmt.Fprintln(g.out, "      in.Skip()")
+	fmt.Fprintln(g.out, "      in.WantComma()")
+	fmt.Fprintln(g.out, "      continue")
+	fmt.Fprintln(g.out, "    }")
+
+	fmt.Fprintln(g.out, "    switch key {")
+	for _, f := range fs {
+		if err := g.genStructFieldValidator(t, f); err != nil {
+			return err
+		}
+	}
+	fmt.Fprintln(g.out, "    default:")
+	if g.disallowUnknownFields {
+		fmt.Fprintln(g.out, `      in.AddError(&jlexer.LexerError{
+          Offset: in.GetPos(),
+          Reason: "unknown field",
+          Data: key,
+      })`)
+	} else {
+		fmt.Fprintln(g.out, "      in.SkipRecursive()")
+	}
+	fmt.Fprintln(g.out, "    }")
+	fmt.Fprintln(g.out, "    in.WantComma()")
+	fmt.Fprintln(g.out, "  }")
+	fmt.Fprintln(g.out, "  in.Delim('}')")
+	fmt.Fprintln(g.out, "  if isTopLevel {")
+	fmt.Fprintln(g.out, "    in.Consumed()")
+	fmt.Fprintln(g.out, "  }")
+	for _, f := range fs {
+		g.genRequiredFieldCheck(t, f)
+	}
+	fmt.Fprintln(g.out, "}")
+	return nil
+}
+
+func (g *Generator) genStructFieldValidator(t reflect.Type, f reflect.StructField) error {
+	tags := parseFieldTags(f)
+	if tags.omit {
+		return nil
+	}
+
+	if g.isOneofField(f) {
+		// The member names tell the wrapper types, any of their values is accepted.
+		fmt.Fprintf(g.out, "    case %v:\n", quoteKeys(g.oneofKeys(t, f)))
+		fmt.Fprintln(g.out, "      in.SkipRecursive()")
+		return nil
+	}
+
+	fmt.Fprintf(g.out, "    case %v:\n", quoteKeys(g.fieldKeys(t, f)))
+	if tags.transform != "" {
+		tmpVar := g.uniqueVarName()
+		fmt.Fprintln(g.out, "      var "+tmpVar+" "+g.getType(f.Type))
+		if err := g.genTransformDecoder(f.Type, tmpVar, tags, 3); err != nil {
+			return err
+		}
+		fmt.Fprintln(g.out, "      _ = "+tmpVar)
+	} else if err := g.genTypeValidator(f.Type, tags, 3); err != nil {
+		return err
+	}
+
+	if tags.required {
+		fmt.Fprintf(g.out, "%sSet = true\n", f.Name)
+	}
+	return nil
+}
+
+// genTypeValidator generates code validating a value of type t, decoding it into a temporary
+// variable if t has its own unmarshaler.
+func (g *Generator) genTypeValidator(t reflect.Type, tags fieldTags, indent int) error {
+	ws := strings.Repeat("  ", indent)
+
+	if tags.unknown != "" && g.enums[t] != nil {
+		return g.genTempDecoder(t, tags, indent)
+	}
+	if g.sqlNulls() && isSQLNullType(t) {
+		return g.genSQLNullValidator(t, tags, indent)
+	}
+	if g.protoWellKnown(t) {
+		return g.genProtoWellKnownValidator(t, indent)
+	}
+	if g.hasValidator(t) {
+		_, args := g.typeParamLists(g.getType(t))
+		fmt.Fprintln(g.out, ws+g.getValidatorName(t)+args+"(in)")
+		return nil
+	}
+	if reflect.PtrTo(t).Implements(reflect.TypeOf((*easyjson.Validator)(nil)).Elem()) {
+		fmt.Fprintln(g.out, ws+"_ = (*"+g.getType(t)+")(nil).ValidateEasyJSON(in)")
+		return nil
+	}
+	if hasCustomUnmarshaler(t) {
+		return g.genTempDecoder(t, tags, indent)
+	}
+	return g.genTypeValidatorNoCheck(t, tags, indent)
+}
+
+// genTempDecoder generates code decoding a value of type t into a temporary variable.
+func (g *Generator) genTempDecoder(t reflect.Type, tags fieldTags, indent int) error {
+	ws := strings.Repeat("  ", indent)
+	tmpVar := g.uniqueVarName()
+
+	fmt.Fprintln(g.out, ws+"{")
+	fmt.Fprintln(g.out, ws+"  var "+tmpVar+" "+g.getType(t))
+	if err := g.genTypeDecoder(t, tmpVar, tags, indent+1); err != nil {
+		return err
+	}
+	fmt.Fprintln(g.out, ws+"  _ = "+tmpVar)
+	fmt.Fprintln(g.out, ws+"}")
+	return nil
+}
+
+// genTypeValidatorNoCheck generates code validating a value of type t.
+func (g *Generator) genTypeValidatorNoCheck(t reflect.Type, tags fieldTags, indent int) error {
+	ws := strings.Repeat("  ", indent)
+
+	if g.isTypeParam(t) {
+		// The type argument is only known at run time, so any valid JSON value is accepted.
+		fmt.Fprintln(g.out, ws+"in.SkipRecursive()")
+		return nil
+	}
+	if tags.hex && isHexType(t) && t.Kind() != reflect.Ptr {
+		switch t.Kind() {
+		case reflect.Array:
+			// The length of the bytes is checked by the decoder.
+			return g.genTempDecoder(t, tags, indent)
+		case reflect.Slice:
+			fmt.Fprintln(g.out, ws+"if in.IsNull() {")
+			fmt.Fprintln(g.out, ws+"  in.Skip()")
+			fmt.Fprintln(g.out, ws+"} else {")
+			fmt.Fprintln(g.out, ws+"  _ = in.BytesHex()")
+			fmt.Fprintln(g.out, ws+"}")
+		default:
+			fmt.Fprintln(g.out, ws+"_ = in.Uint64Hex()")
+		}
+		return nil
+	}
+
+	if dec := customDecoders[t.String()]; dec != "" {
+		fmt.Fprintln(g.out, ws+"_ = "+dec)
+		return nil
+	}
+	if t.Kind() == reflect.String && !tags.asString {
+		fmt.Fprintln(g.out, ws+"_ = in.UnsafeString()")
+		return nil
+	}
+	dec := primitiveDecoders[t.Kind()]
+	if tags.asString {
+		dec = primitiveStringDecoders[t.Kind()]
+	}
+	if dec != "" {
+		if t.Kind() == reflect.String {
+			// The 'string' option on strings needs a JSON string literal in the value.
+			return g.genTempDecoder(t, tags, indent)
+		}
+		fmt.Fprintln(g.out, ws+"_ = "+dec)
+		return nil
+	}
+
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array:
+		elem := t.Elem()
+		if elem.Kind() == reflect.Uint8 && elem.Name() == "uint8" && (t.Kind() == reflect.Slice || !g.stdlibCompat) {
+			fmt.Fprintln(g.out, ws+"if in.IsNull() {")
+			fmt.Fprintln(g.out, ws+"  in.Skip()")
+			fmt.Fprintln(g.out, ws+"} else {")
+			if g.simpleBytes && !g.stdlibCompat && t.Kind() == reflect.Slice {
+				fmt.Fprintln(g.out, ws+"  _ = in.UnsafeString()")
+			} else {
+				fmt.Fprintln(g.out, ws+"  _ = in.Bytes()")
+			}
+			fmt.Fprintln(g.out, ws+"}")
+			return nil
+		}
+
+		iterVar := g.uniqueVarName()
+		fmt.Fprintln(g.out, ws+"if in.IsNull() {")
+		fmt.Fprintln(g.out, ws+"  in.Skip()")
+		fmt.Fprintln(g.out, ws+"} else {")
+		fmt.Fprintln(g.out, ws+"  in.Delim('[')")
+		if t.Kind() == reflect.Array {
+			// The elements beyond the length of the array are skipped by the decoders.
+			fmt.Fprintln(g.out, ws+"  "+iterVar+" := 0")
+			fmt.Fprintln(g.out, ws+"  for ; !in.IsDelim(']'); "+iterVar+"++ {")
+			fmt.Fprintln(g.out, ws+"    if "+iterVar+" >= "+fmt.Sprint(t.Len())+" {")
+			fmt.Fprintln(g.out, ws+"      in.SkipRecursive()")
+			fmt.Fprintln(g.out, ws+"    } else {")
+			if err := g.genTypeValidator(elem, tags, indent+3); err != nil {
+				return err
+			}
+			fmt.Fprintln(g.out, ws+"    }")
+		} else {
+			fmt.Fprintln(g.out, ws+"  for !in.IsDelim(']') {")
+			if err := g.genTypeValidator(elem, tags, indent+2); err != nil {
+				return err
+			}
+		}
+		fmt.Fprintln(g.out, ws+"    in.WantComma()")
+		fmt.Fprintln(g.out, ws+"  }")
+		fmt.Fprintln(g.out, ws+"  in.Delim(']')")
+		fmt.Fprintln(g.out, ws+"}")
+
+	case reflect.Struct:
+		g.addType(t)
+		_, args := g.typeParamLists(g.getType(t))
+		fmt.Fprintln(g.out, ws+g.getValidatorName(t)+args+"(in)")
+
+	case reflect.Ptr:
+		fmt.Fprintln(g.out, ws+"if in.IsNull() {")
+		fmt.Fprintln(g.out, ws+"  in.Skip()")
+		fmt.Fprintln(g.out, ws+"} else {")
+		if err := g.genTypeValidator(t.Elem(), tags, indent+1); err != nil {
+			return err
+		}
+		fmt.Fprintln(g.out, ws+"}")
+
+	case reflect.Map:
+		key := t.Key()
+		fmt.Fprintln(g.out, ws+"if in.IsNull() {")
+		fmt.Fprintln(g.out, ws+"  in.Skip()")
+		fmt.Fprintln(g.out, ws+"} else {")
+		fmt.Fprintln(g.out, ws+"  in.Delim('{')")
+		fmt.Fprintln(g.out, ws+"  for !in.IsDelim('}') {")
+		fmt.Fprintln(g.out, ws+"    in.MarkKey()")
+		if keyDec, ok := primitiveStringDecoders[key.Kind()]; ok && !hasCustomUnmarshaler(key) {
+			if key.Kind() == reflect.String {
+				keyDec = "in.UnsafeString()"
+			}
+			fmt.Fprintln(g.out, ws+"    _ = "+keyDec)
+		} else if err := g.genTempDecoder(key, tags, indent+2); err != nil {
+			return err
+		}
+		fmt.Fprintln(g.out, ws+"    in.WantColon()")
+		if err := g.genTypeValidator(t.Elem(), tags, indent+2); err != nil {
+			return err
+		}
+		fmt.Fprintln(g.out, ws+"    in.WantComma()")
+		fmt.Fprintln(g.out, ws+"  }")
+		fmt.Fprintln(g.out, ws+"  in.Delim('}')")
+		fmt.Fprintln(g.out, ws+"}")
+
+	case reflect.Interface:
+		// Any valid JSON value is accepted, the syntax is checked before validating.
+		fmt.Fprintln(g.out, ws+"in.SkipRecursive()")
+
+	case reflect.Func:
+		key, elem, ok := iteratorTypes(t)
+		switch {
+		case !ok:
+			return fmt.Errorf("don't know how to validate %v", t)
+		case key == nil:
+			return g.genTypeValidator(reflect.SliceOf(elem), tags, indent)
+		case !key.Comparable():
+			return fmt.Errorf("iterator key type %v not supported: only string and integer keys and types implementing Unmarshaler interfaces are allowed", key)
+		}
+		return g.genTypeValidator(reflect.MapOf(key, elem), tags, indent)
+
+	default:
+		return fmt.Errorf("don't know how to validate %v", t)
+	}
+	return nil
+}
+
+// genValidatorMethod generates the ValidateEasyJSON method of type t.
+func (g *Generator) genValidatorMethod(t reflect.Type) {
+	if g.kept[t]["ValidateEasyJSON"] {
+		return
+	}
+
+	fmt.Fprintln(g.out, "// ValidateEasyJSON supports easyjson.Validator interface")
+	fmt.Fprintln(g.out, "func (*"+g.getType(t)+") ValidateEasyJSON(l *jlexer.Lexer) error {")
+	fmt.Fprintln(g.out, "  l.CheckValid()")
+	if g.hasValidator(t) {
+		_, args := g.typeParamLists(g.getType(t))
+		fmt.Fprintln(g.out, "  "+g.getValidatorName(t)+args+"(l)")
+	} else {
+		fmt.Fprintln(g.out, "  var v "+g.getType(t))
+		fmt.Fprintln(g.out, "  v.UnmarshalEasyJSON(l)")
+	}
+	fmt.Fprintln(g.out, "  return l.Error()")
+	fmt.Fprintln(g.out, "}")
+}
@@ -0,0 +1,117 @@
+package gen
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// isVariantType returns true if the easyjson 'discriminator' tag option applies to the type of
+// a field: interfaces, and the pointers, slices, arrays and maps of interfaces.
+func isVariantType(t reflect.Type) bool {
+	for t.Kind() != reflect.Interface {
+		switch t.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+			t = t.Elem()
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// genVariantEncoder generates code that encodes in of the interface type t with the marshaler of
+// its dynamic type, adding the discriminator member if it lacks it.
+func (g *Generator) genVariantEncoder(t reflect.Type, in string, tags fieldTags, indent int) error {
+	if g.standalone {
+		return fmt.Errorf("interface type %v with a discriminator is not supported in standalone mode", t)
+	}
+	ws := strings.Repeat("  ", indent)
+	fmt.Fprintln(g.out, ws+g.pkgAlias(pkgEasyJSON)+".MarshalVariant(out, "+strconv.Quote(tags.discriminator)+", (*"+g.getType(t)+")(nil), "+in+")")
+	return nil
+}
+
+// genVariantDecoder generates code that decodes an object into out of the interface type t as a
+// new value of the variant type registered for its discriminator member.
+func (g *Generator) genVariantDecoder(t reflect.Type, out string, tags fieldTags, indent int) error {
+	if g.standalone {
+		return fmt.Errorf("interface type %v with a discriminator is not supported in standalone mode", t)
+	}
+	ws := strings.Repeat("  ", indent)
+	typ := g.getType(t)
+	tmpVar := g.uniqueVarName()
+
+	fmt.Fprintln(g.out, ws+"if in.IsNull() {")
+	fmt.Fprintln(g.out, ws+"  in.Skip()")
+	fmt.Fprintln(g.out, ws+"  "+out+" = nil")
+	fmt.Fprintln(g.out, ws+"} else if "+tmpVar+" := "+g.pkgAlias(pkgEasyJSON)+".UnmarshalVariant(in, "+strconv.Quote(tags.discriminator)+", (*"+typ+")(nil)); "+tmpVar+" != nil {")
+	fmt.Fprintln(g.out, ws+"  "+out+" = "+tmpVar+".("+typ+")")
+	fmt.Fprintln(g.out, ws+"}")
+	return nil
+}
+
+// isTypeField returns true if f is an interface field with the easyjson 'typefield' directive,
+// whose concrete type is selected by a sibling member of the object.
+func isTypeField(f reflect.StructField, tags fieldTags) bool {
+	return tags.typeField != "" && tags.customDecoder == "" && f.Type.Kind() == reflect.Interface
+}
+
+// genTypeFieldRawVar declares the variable buffering the raw value of the interface field f with
+// the 'typefield' directive until the whole object is read.
+func (g *Generator) genTypeFieldRawVar(f reflect.StructField) {
+	if isTypeField(f, parseFieldTags(f)) {
+		fmt.Fprintf(g.out, "  var %sRaw []byte\n", f.Name)
+	}
+}
+
+// genTypeFieldDecoder generates code that decodes the buffered raw value of the interface field f
+// with the 'typefield' directive as the variant registered for the value of the string field of
+// t named by the directive, once the whole object is read.
+func (g *Generator) genTypeFieldDecoder(t reflect.Type, fs []reflect.StructField, f reflect.StructField) error {
+	tags := parseFieldTags(f)
+	if !isTypeField(f, tags) {
+		return nil
+	}
+	if g.standalone {
+		return fmt.Errorf("interface type %v with a type field is not supported in standalone mode", f.Type)
+	}
+
+	var sibling *reflect.StructField
+	for i := range fs {
+		if fs[i].Type.Kind() == reflect.String && g.fieldNamer.GetJSONFieldName(t, fs[i]) == tags.typeField {
+			sibling = &fs[i]
+			break
+		}
+	}
+	if sibling == nil {
+		return fmt.Errorf("cannot generate decoder for %v: no string field %q selecting the type of field %v", t, tags.typeField, f.Name)
+	}
+
+	typ := g.getType(f.Type)
+	tmpVar := g.uniqueVarName()
+	fmt.Fprintf(g.out, "  if %sRaw != nil {\n", f.Name)
+	fmt.Fprintf(g.out, "    if %v := %v.UnmarshalVariantOf(in, %sRaw, %q, string(out.%v), (*%v)(nil)); %v != nil {\n",
+		tmpVar, g.pkgAlias(pkgEasyJSON), f.Name, tags.typeField, sibling.Name, typ, tmpVar)
+	fmt.Fprintf(g.out, "      out.%v = %v.(%v)\n", f.Name, tmpVar, typ)
+	fmt.Fprintln(g.out, "    }")
+	fmt.Fprintln(g.out, "  }")
+	return nil
+}
+
+// genTypeFieldEncoder generates code that encodes in of the interface type t with the marshaler
+// of its dynamic type, leaving the type to the sibling member of the object.
+func (g *Generator) genTypeFieldEncoder(t reflect.Type, in string, indent int) error {
+	if g.standalone {
+		return fmt.Errorf("interface type %v with a type field is not supported in standalone mode", t)
+	}
+	ws := strings.Repeat("  ", indent)
+	fmt.Fprintln(g.out, ws+"if m, ok := "+in+".("+g.pkgAlias(pkgEasyJSON)+".Marshaler); ok {")
+	fmt.Fprintln(g.out, ws+"  m.MarshalEasyJSON(out)")
+	fmt.Fprintln(g.out, ws+"} else if "+in+" == nil {")
+	fmt.Fprintln(g.out, ws+`  out.RawString("null")`)
+	fmt.Fprintln(g.out, ws+"} else {")
+	fmt.Fprintln(g.out, ws+"  out.Raw("+g.pkgAlias("encoding/json")+".Marshal("+in+"))")
+	fmt.Fprintln(g.out, ws+"}")
+	return nil
+}
@@ -0,0 +1,130 @@
+package gen
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// protoKnownPkgPath is the parent of the packages of the protobuf well-known types.
+const protoKnownPkgPath = "google.golang.org/protobuf/types/known/"
+
+// protoWellKnownType returns the name of t if it is one of the protobuf well-known types
+// marshaled with their canonical protobuf JSON mapping: timestamppb.Timestamp,
+// durationpb.Duration and the wrapperspb types, e.g. "StringValue". It returns "" for other
+// types.
+func protoWellKnownType(t reflect.Type) string {
+	if t.Kind() != reflect.Struct {
+		return ""
+	}
+	switch pkgPath := fixPkgPathVendoring(t.PkgPath()); {
+	case pkgPath == protoKnownPkgPath+"timestamppb" && t.Name() == "Timestamp",
+		pkgPath == protoKnownPkgPath+"durationpb" && t.Name() == "Duration":
+		return t.Name()
+	case pkgPath == protoKnownPkgPath+"wrapperspb" && strings.HasSuffix(t.Name(), "Value"):
+		if _, ok := t.FieldByName("Value"); ok {
+			return t.Name()
+		}
+	}
+	return ""
+}
+
+// protoWellKnown returns true if t is a protobuf well-known type marshaled with its JSON mapping,
+// which is done for the structs of protoc-gen-go conventions, unless in the stdlib-compat mode.
+func (g *Generator) protoWellKnown(t reflect.Type) bool {
+	return g.protobuf && !g.stdlibCompat && protoWellKnownType(t) != ""
+}
+
+// genProtoWellKnownEncoder generates code that encodes in of the protobuf well-known type t:
+// timestamps and durations as strings, and wrappers as the wrapped values, 64-bit integers
+// quoted.
+func (g *Generator) genProtoWellKnownEncoder(t reflect.Type, in string, indent int) error {
+	ws := strings.Repeat("  ", indent)
+
+	switch name := protoWellKnownType(t); name {
+	case "Timestamp", "Duration":
+		fmt.Fprintln(g.out, ws+"out.Proto"+name+"(("+in+").Seconds, ("+in+").Nanos)")
+		return nil
+	}
+	f, _ := t.FieldByName("Value")
+	switch f.Type.Kind() {
+	case reflect.Int64:
+		fmt.Fprintln(g.out, ws+"out.Int64Str(("+in+").Value)")
+	case reflect.Uint64:
+		fmt.Fprintln(g.out, ws+"out.Uint64Str(("+in+").Value)")
+	default:
+		return g.genTypeEncoder(f.Type, "("+in+").Value", fieldTags{}, indent, false)
+	}
+	return nil
+}
+
+// genProtoWellKnownDecoder generates code that decodes out of the protobuf well-known type t.
+// The 64-bit integers of wrappers are accepted both quoted and not, like protojson does it.
+func (g *Generator) genProtoWellKnownDecoder(t reflect.Type, out string, indent int) error {
+	ws := strings.Repeat("  ", indent)
+
+	switch name := protoWellKnownType(t); name {
+	case "Timestamp", "Duration":
+		fmt.Fprintln(g.out, ws+"("+out+").Seconds, ("+out+").Nanos = in.Proto"+name+"()")
+		return nil
+	}
+	f, _ := t.FieldByName("Value")
+	var parse string
+	switch f.Type.Kind() {
+	case reflect.Int64:
+		parse = "ParseInt"
+	case reflect.Uint64:
+		parse = "ParseUint"
+	default:
+		return g.genTypeDecoder(f.Type, "("+out+").Value", fieldTags{}, indent)
+	}
+	g.genProtoIntDecoder(parse, "("+out+").Value", indent)
+	return nil
+}
+
+// genProtoIntDecoder generates code that decodes the 64-bit integer out of a wrapper, quoted or
+// not, parsing it with the strconv function parse.
+func (g *Generator) genProtoIntDecoder(parse, out string, indent int) {
+	ws := strings.Repeat("  ", indent)
+
+	numVar := g.uniqueVarName()
+	valVar := g.uniqueVarName()
+	errVar := g.uniqueVarName()
+	fmt.Fprintln(g.out, ws+"if "+numVar+" := in.JsonNumber(); "+numVar+" != \"\" {")
+	fmt.Fprintln(g.out, ws+"  if "+valVar+", "+errVar+" := "+g.pkgAlias("strconv")+"."+parse+"(string("+numVar+"), 10, 64); "+errVar+" != nil {")
+	fmt.Fprintln(g.out, ws+"    in.AddValueError("+errVar+")")
+	fmt.Fprintln(g.out, ws+"  } else {")
+	fmt.Fprintln(g.out, ws+"    "+out+" = "+valVar)
+	fmt.Fprintln(g.out, ws+"  }")
+	fmt.Fprintln(g.out, ws+"}")
+}
+
+// genProtoWellKnownValidator generates code that validates a value of the protobuf well-known
+// type t, decoding the timestamps, durations and 64-bit integers into temporaries.
+func (g *Generator) genProtoWellKnownValidator(t reflect.Type, indent int) error {
+	ws := strings.Repeat("  ", indent)
+
+	switch name := protoWellKnownType(t); name {
+	case "Timestamp", "Duration":
+		fmt.Fprintln(g.out, ws+"_, _ = in.Proto"+name+"()")
+		return nil
+	}
+	f, _ := t.FieldByName("Value")
+	var parse string
+	switch f.Type.Kind() {
+	case reflect.Int64:
+		parse = "ParseInt"
+	case reflect.Uint64:
+		parse = "ParseUint"
+	default:
+		return g.genTypeValidator(f.Type, fieldTags{}, indent)
+	}
+
+	tmpVar := g.uniqueVarName()
+	fmt.Fprintln(g.out, ws+"{")
+	fmt.Fprintln(g.out, ws+"  var "+tmpVar+" "+f.Type.String())
+	g.genProtoIntDecoder(parse, tmpVar, indent+1)
+	fmt.Fprintln(g.out, ws+"  _ = "+tmpVar)
+	fmt.Fprintln(g.out, ws+"}")
+	return nil
+}
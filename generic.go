@@ -0,0 +1,45 @@
+//go:build go1.18
+// +build go1.18
+
+package easyjson
+
+import (
+	"time"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+// UnmarshalNew decodes the JSON in data into a new value of type T, e.g.
+//
+//	v, err := easyjson.UnmarshalNew[Event](data)
+//
+// The second type parameter is inferred: it is the pointer type implementing Unmarshaler.
+func UnmarshalNew[T any, PT interface {
+	*T
+	Unmarshaler
+}](data []byte) (*T, error) {
+	v := PT(new(T))
+	l := jlexer.Lexer{Data: data}
+	if err := unmarshal(&l, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// MarshalAny is like Marshal, but calls the methods of T directly rather than through the
+// Marshaler interface, so the calls can be inlined.
+func MarshalAny[T Marshaler](v T) ([]byte, error) {
+	if isNilInterface(v) {
+		return nullBytes, nil
+	}
+
+	o, start := observeStart()
+	w := jwriter.Writer{}
+	v.MarshalEasyJSON(&w)
+	data, err := w.BuildBytes()
+	if o != nil {
+		o.ObserveMarshal(observedType(v), time.Since(start), len(data), err)
+	}
+	return data, err
+}
@@ -0,0 +1,49 @@
+//go:build go1.18
+// +build go1.18
+
+package easyjson
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUnmarshalNew(t *testing.T) {
+	v, err := UnmarshalNew[Int128]([]byte(`"-42"`))
+	if err != nil || *v != Int128From64(-42) {
+		t.Errorf("UnmarshalNew(\"-42\") = %#v, %v; want %#v", v, err, Int128From64(-42))
+	}
+
+	if v, err := UnmarshalNew[Int128]([]byte(`true`)); err == nil || v != nil {
+		t.Errorf("UnmarshalNew(true) = %#v, %v; want error", v, err)
+	}
+}
+
+func TestGenericObserved(t *testing.T) {
+	var o testObserver
+	SetObserver(&o)
+	defer SetObserver(nil)
+
+	UnmarshalNew[Int128]([]byte(`"1"`))
+	MarshalAny(Int128From64(-42))
+	want := []observation{
+		{"unmarshal", "easyjson.Int128", 3, nil},
+		{"marshal", "easyjson.Int128", 5, nil},
+	}
+	if !reflect.DeepEqual([]observation(o), want) {
+		t.Errorf("observed %v; want %v", o, want)
+	}
+}
+
+func TestMarshalAny(t *testing.T) {
+	data, err := MarshalAny(Int128From64(-42))
+	if err != nil || string(data) != `"-42"` {
+		t.Errorf("MarshalAny(-42) = %s, %v; want \"-42\"", data, err)
+	}
+
+	var raw *RawMessage
+	data, err = MarshalAny(raw)
+	if err != nil || string(data) != "null" {
+		t.Errorf("MarshalAny(nil) = %s, %v; want null", data, err)
+	}
+}
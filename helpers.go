@@ -2,10 +2,12 @@
 package easyjson
 
 import (
+	"encoding/json"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"strconv"
+	"time"
 	"unsafe"
 
 	"github.com/mailru/easyjson/jlexer"
@@ -28,6 +30,13 @@ type MarshalerUnmarshaler interface {
 	Unmarshaler
 }
 
+// Validator is implemented by types generated with the -validate flag.
+type Validator interface {
+	// ValidateEasyJSON checks that the input is a valid JSON encoding of the type without
+	// building its value.
+	ValidateEasyJSON(l *jlexer.Lexer) error
+}
+
 // Optional defines an undefined-test method for a type to integrate with 'omitempty' logic.
 type Optional interface {
 	IsDefined() bool
@@ -54,9 +63,30 @@ func Marshal(v Marshaler) ([]byte, error) {
 		return nullBytes, nil
 	}
 
+	o, start := observeStart()
 	w := jwriter.Writer{}
 	v.MarshalEasyJSON(&w)
-	return w.BuildBytes()
+	data, err := w.BuildBytes()
+	if o != nil {
+		o.ObserveMarshal(observedType(v), time.Since(start), len(data), err)
+	}
+	return data, err
+}
+
+// MarshalRaw returns data as a json.RawMessage, ready to be embedded into a larger document.
+// Data that fits into a single chunk is returned without copying, and larger data is copied
+// once, so there is no need to copy the result once more. Unlike the result of Marshal, the null
+// of a nil v is not shared, so the result can always be modified by the caller.
+func MarshalRaw(v Marshaler) (json.RawMessage, error) {
+	if isNilInterface(v) {
+		return json.RawMessage("null"), nil
+	}
+
+	data, err := Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(data), nil
 }
 
 // MarshalToWriter marshals the data to an io.Writer.
@@ -65,9 +95,14 @@ func MarshalToWriter(v Marshaler, w io.Writer) (written int, err error) {
 		return w.Write(nullBytes)
 	}
 
+	o, start := observeStart()
 	jw := jwriter.Writer{}
 	v.MarshalEasyJSON(&jw)
-	return jw.DumpTo(w)
+	written, err = jw.DumpTo(w)
+	if o != nil {
+		o.ObserveMarshal(observedType(v), time.Since(start), written, err)
+	}
+	return written, err
 }
 
 // MarshalToHTTPResponseWriter sets Content-Length and Content-Type headers for the
@@ -82,6 +117,13 @@ func MarshalToHTTPResponseWriter(v Marshaler, w http.ResponseWriter) (started bo
 		return true, written, err
 	}
 
+	o, start := observeStart()
+	if o != nil {
+		defer func() {
+			o.ObserveMarshal(observedType(v), time.Since(start), written, err)
+		}()
+	}
+
 	jw := jwriter.Writer{}
 	v.MarshalEasyJSON(&jw)
 	if jw.Error != nil {
@@ -95,11 +137,34 @@ func MarshalToHTTPResponseWriter(v Marshaler, w http.ResponseWriter) (started bo
 	return
 }
 
+// Validate checks that data is a valid JSON encoding of the type of v, which may be a nil
+// pointer, e.g. (*Request)(nil).
+func Validate(data []byte, v Validator) error {
+	l := jlexer.Lexer{Data: data}
+	return v.ValidateEasyJSON(&l)
+}
+
 // Unmarshal decodes the JSON in data into the object.
 func Unmarshal(data []byte, v Unmarshaler) error {
 	l := jlexer.Lexer{Data: data}
-	v.UnmarshalEasyJSON(&l)
-	return l.Error()
+	return unmarshal(&l, v)
+}
+
+// UnmarshalFromString decodes the JSON in s into the object without copying s to a byte slice.
+// Strings and byte slices the object gets when unmarshaling without copying (e.g.
+// RawMessage values) refer to the memory of s, and must never be modified. Set the build tag
+// easyjson_nounsafe to copy s instead.
+func UnmarshalFromString(s string, v Unmarshaler) error {
+	l := jlexer.Lexer{Data: strToBytes(s)}
+	return unmarshal(&l, v)
+}
+
+// ApplyMergePatch applies a JSON Merge Patch (RFC 7386) directly to the object: members set
+// to null in the patch are reset to zero values, objects and maps are merged recursively and
+// all other values are replaced.
+func ApplyMergePatch(v MarshalerUnmarshaler, patch []byte) error {
+	l := jlexer.Lexer{Data: patch, MergePatch: true}
+	return unmarshal(&l, v)
 }
 
 // UnmarshalFromReader reads all the data in the reader and decodes as JSON into the object.
@@ -109,6 +174,21 @@ func UnmarshalFromReader(r io.Reader, v Unmarshaler) error {
 		return err
 	}
 	l := jlexer.Lexer{Data: data}
-	v.UnmarshalEasyJSON(&l)
-	return l.Error()
+	return unmarshal(&l, v)
+}
+
+// unmarshal unmarshals the input of l into v, notifying the observer if there is one.
+func unmarshal(l *jlexer.Lexer, v Unmarshaler) error {
+	o, start := observeStart()
+	v.UnmarshalEasyJSON(l)
+	return observeUnmarshal(o, start, v, len(l.Data), l.Error())
+}
+
+// observeUnmarshal notifies o, if not nil, that size bytes were unmarshaled into v since start,
+// and returns the error of the unmarshaling.
+func observeUnmarshal(o Observer, start time.Time, v interface{}, size int, err error) error {
+	if o != nil {
+		o.ObserveUnmarshal(observedType(v), time.Since(start), size, err)
+	}
+	return err
 }
@@ -0,0 +1,259 @@
+package easyjson
+
+import (
+	"math/bits"
+	"strconv"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+// Uint128 is an unsigned 128-bit integer. It is marshaled as a decimal string, as most JSON
+// implementations can not represent such numbers exactly. Both strings and numbers are
+// accepted on unmarshaling.
+type Uint128 struct {
+	Hi, Lo uint64
+}
+
+// Int128 is a signed 128-bit integer in two's complement representation. It is marshaled
+// as a decimal string, as most JSON implementations can not represent such numbers
+// exactly. Both strings and numbers are accepted on unmarshaling.
+type Int128 struct {
+	Hi int64
+	Lo uint64
+}
+
+// Uint128From64 converts an uint64 to Uint128.
+func Uint128From64(v uint64) Uint128 {
+	return Uint128{Lo: v}
+}
+
+// Int128From64 converts an int64 to Int128.
+func Int128From64(v int64) Int128 {
+	return Int128{Hi: v >> 63, Lo: uint64(v)}
+}
+
+// neg returns the two's complement negation of u.
+func (u Uint128) neg() Uint128 {
+	lo, borrow := bits.Sub64(0, u.Lo, 0)
+	hi, _ := bits.Sub64(0, u.Hi, borrow)
+	return Uint128{Hi: hi, Lo: lo}
+}
+
+// quoRem64 divides u by v, returning the quotient and the remainder.
+func (u Uint128) quoRem64(v uint64) (Uint128, uint64) {
+	var q Uint128
+	var r uint64
+	q.Hi, r = bits.Div64(0, u.Hi, v)
+	q.Lo, r = bits.Div64(r, u.Lo, v)
+	return q, r
+}
+
+// String returns the decimal representation of u.
+func (u Uint128) String() string {
+	if u.Hi == 0 {
+		return strconv.FormatUint(u.Lo, 10)
+	}
+
+	// Split off 19 digits at a time, the most that fit into an uint64.
+	var buf [39]byte
+	i := len(buf)
+	for u.Hi != 0 {
+		var r uint64
+		u, r = u.quoRem64(1e19)
+		for j := 0; j < 19; j++ {
+			i--
+			buf[i] = byte('0' + r%10)
+			r /= 10
+		}
+	}
+	return strconv.FormatUint(u.Lo, 10) + string(buf[i:])
+}
+
+// String returns the decimal representation of v.
+func (v Int128) String() string {
+	u := Uint128{Hi: uint64(v.Hi), Lo: v.Lo}
+	if v.Hi < 0 {
+		return "-" + u.neg().String()
+	}
+	return u.String()
+}
+
+// ParseUint128 parses a decimal representation of an unsigned 128-bit integer. The errors
+// returned are of *strconv.NumError type.
+func ParseUint128(s string) (Uint128, error) {
+	u, ok := parseUint128(s)
+	if !ok {
+		return Uint128{}, &strconv.NumError{Func: "ParseUint128", Num: s, Err: strconv.ErrSyntax}
+	}
+	if u == nil {
+		return Uint128{}, &strconv.NumError{Func: "ParseUint128", Num: s, Err: strconv.ErrRange}
+	}
+	return *u, nil
+}
+
+// ParseInt128 parses a decimal representation of a signed 128-bit integer, with an optional
+// minus sign. The errors returned are of *strconv.NumError type.
+func ParseInt128(s string) (Int128, error) {
+	neg := len(s) > 0 && s[0] == '-'
+	digits := s
+	if neg {
+		digits = s[1:]
+	}
+
+	u, ok := parseUint128(digits)
+	if !ok {
+		return Int128{}, &strconv.NumError{Func: "ParseInt128", Num: s, Err: strconv.ErrSyntax}
+	}
+
+	// The magnitude may be at most 2^127 for negative numbers and 2^127-1 for positive ones.
+	if u == nil || u.Hi > 1<<63 || u.Hi == 1<<63 && (u.Lo != 0 || !neg) {
+		return Int128{}, &strconv.NumError{Func: "ParseInt128", Num: s, Err: strconv.ErrRange}
+	}
+	if neg {
+		*u = u.neg()
+	}
+	return Int128{Hi: int64(u.Hi), Lo: u.Lo}, nil
+}
+
+// parseUint128 parses decimal digits. It returns false if s is not a sequence of digits, and
+// nil if the number does not fit into 128 bits.
+func parseUint128(s string) (*Uint128, bool) {
+	if s == "" {
+		return nil, false
+	}
+
+	var u Uint128
+	overflow := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c < '0' || c > '9' {
+			return nil, false
+		}
+		if overflow {
+			continue
+		}
+
+		// u = u*10 + c
+		hi, lo := bits.Mul64(u.Lo, 10)
+		carryHi, hi10 := bits.Mul64(u.Hi, 10)
+		hi, carry := bits.Add64(hi, hi10, 0)
+		lo, carryLo := bits.Add64(lo, uint64(c-'0'), 0)
+		hi, carryHi2 := bits.Add64(hi, 0, carryLo)
+		if carryHi != 0 || carry != 0 || carryHi2 != 0 {
+			overflow = true
+			continue
+		}
+		u = Uint128{Hi: hi, Lo: lo}
+	}
+	if overflow {
+		return nil, true
+	}
+	return &u, true
+}
+
+// MarshalEasyJSON does JSON marshaling using easyjson interface.
+func (u Uint128) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('"')
+	w.RawString(u.String())
+	w.RawByte('"')
+}
+
+// UnmarshalEasyJSON does JSON unmarshaling using easyjson interface.
+func (u *Uint128) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	if l.IsNull() {
+		l.Skip()
+		*u = Uint128{}
+		return
+	}
+
+	v, err := ParseUint128(string(l.JsonNumber()))
+	if err != nil {
+		l.AddError(err)
+		return
+	}
+	*u = v
+}
+
+// MarshalJSON implements encoding/json.Marshaler interface.
+func (u Uint128) MarshalJSON() ([]byte, error) {
+	return Marshal(u)
+}
+
+// UnmarshalJSON implements encoding/json.Unmarshaler interface.
+func (u *Uint128) UnmarshalJSON(data []byte) error {
+	return Unmarshal(data, u)
+}
+
+// MarshalText implements encoding.TextMarshaler interface, allowing use as a map key.
+func (u Uint128) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler interface.
+func (u *Uint128) UnmarshalText(data []byte) error {
+	v, err := ParseUint128(string(data))
+	if err != nil {
+		return err
+	}
+	*u = v
+	return nil
+}
+
+// IsDefined is required for integration with omitempty easyjson logic.
+func (u *Uint128) IsDefined() bool {
+	return *u != Uint128{}
+}
+
+// MarshalEasyJSON does JSON marshaling using easyjson interface.
+func (v Int128) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('"')
+	w.RawString(v.String())
+	w.RawByte('"')
+}
+
+// UnmarshalEasyJSON does JSON unmarshaling using easyjson interface.
+func (v *Int128) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	if l.IsNull() {
+		l.Skip()
+		*v = Int128{}
+		return
+	}
+
+	n, err := ParseInt128(string(l.JsonNumber()))
+	if err != nil {
+		l.AddError(err)
+		return
+	}
+	*v = n
+}
+
+// MarshalJSON implements encoding/json.Marshaler interface.
+func (v Int128) MarshalJSON() ([]byte, error) {
+	return Marshal(v)
+}
+
+// UnmarshalJSON implements encoding/json.Unmarshaler interface.
+func (v *Int128) UnmarshalJSON(data []byte) error {
+	return Unmarshal(data, v)
+}
+
+// MarshalText implements encoding.TextMarshaler interface, allowing use as a map key.
+func (v Int128) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler interface.
+func (v *Int128) UnmarshalText(data []byte) error {
+	n, err := ParseInt128(string(data))
+	if err != nil {
+		return err
+	}
+	*v = n
+	return nil
+}
+
+// IsDefined is required for integration with omitempty easyjson logic.
+func (v *Int128) IsDefined() bool {
+	return *v != Int128{}
+}
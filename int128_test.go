@@ -0,0 +1,135 @@
+package easyjson
+
+import (
+	"math/big"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+func TestUint128String(t *testing.T) {
+	for i, test := range []struct {
+		In   Uint128
+		Want string
+	}{
+		{Uint128{}, "0"},
+		{Uint128From64(12345), "12345"},
+		{Uint128{Hi: 1}, "18446744073709551616"},
+		{Uint128{Hi: 1, Lo: 1}, "18446744073709551617"},
+		{Uint128{Hi: 0x5, Lo: 0x6bc75e2d63100000}, "100000000000000000000"},
+		{Uint128{Hi: ^uint64(0), Lo: ^uint64(0)}, "340282366920938463463374607431768211455"},
+	} {
+		if got := test.In.String(); got != test.Want {
+			t.Errorf("[%d] %#v.String() = %v; want %v", i, test.In, got, test.Want)
+		}
+
+		u, err := ParseUint128(test.Want)
+		if err != nil {
+			t.Errorf("[%d] ParseUint128(%v) error: %v", i, test.Want, err)
+		} else if u != test.In {
+			t.Errorf("[%d] ParseUint128(%v) = %#v; want %#v", i, test.Want, u, test.In)
+		}
+	}
+}
+
+func TestInt128String(t *testing.T) {
+	for i, test := range []struct {
+		In   Int128
+		Want string
+	}{
+		{Int128{}, "0"},
+		{Int128From64(-1), "-1"},
+		{Int128From64(-9223372036854775808), "-9223372036854775808"},
+		{Int128{Hi: 1}, "18446744073709551616"},
+		{Int128{Hi: -1}, "-18446744073709551616"},
+		{Int128{Hi: 1<<63 - 1, Lo: ^uint64(0)}, "170141183460469231731687303715884105727"},
+		{Int128{Hi: -1 << 63}, "-170141183460469231731687303715884105728"},
+	} {
+		if got := test.In.String(); got != test.Want {
+			t.Errorf("[%d] %#v.String() = %v; want %v", i, test.In, got, test.Want)
+		}
+
+		v, err := ParseInt128(test.Want)
+		if err != nil {
+			t.Errorf("[%d] ParseInt128(%v) error: %v", i, test.Want, err)
+		} else if v != test.In {
+			t.Errorf("[%d] ParseInt128(%v) = %#v; want %#v", i, test.Want, v, test.In)
+		}
+	}
+}
+
+func TestParseInt128Errors(t *testing.T) {
+	for _, test := range []struct {
+		In       string
+		Unsigned bool
+		Err      error
+	}{
+		{"", true, strconv.ErrSyntax},
+		{"-1", true, strconv.ErrSyntax},
+		{"1.5", true, strconv.ErrSyntax},
+		{"340282366920938463463374607431768211456", true, strconv.ErrRange},
+		{"3402823669209384634633746074317682114550", true, strconv.ErrRange},
+		{"-", false, strconv.ErrSyntax},
+		{"+1", false, strconv.ErrSyntax},
+		{"170141183460469231731687303715884105728", false, strconv.ErrRange},
+		{"-170141183460469231731687303715884105729", false, strconv.ErrRange},
+	} {
+		var err error
+		if test.Unsigned {
+			_, err = ParseUint128(test.In)
+		} else {
+			_, err = ParseInt128(test.In)
+		}
+
+		numErr, ok := err.(*strconv.NumError)
+		if !ok || numErr.Err != test.Err {
+			t.Errorf("parsing %q (unsigned %v) error: %v; want %v", test.In, test.Unsigned, err, test.Err)
+		}
+	}
+}
+
+func TestInt128Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		v := Int128{Hi: int64(r.Uint64()) >> uint(r.Intn(64)), Lo: r.Uint64()}
+
+		want := new(big.Int).Lsh(big.NewInt(v.Hi), 64)
+		want.Add(want, new(big.Int).SetUint64(v.Lo))
+		if got := v.String(); got != want.String() {
+			t.Fatalf("%#v.String() = %v; want %v", v, got, want)
+		}
+
+		u := Uint128{Hi: uint64(v.Hi), Lo: v.Lo}
+		want.SetUint64(u.Hi).Lsh(want, 64).Add(want, new(big.Int).SetUint64(u.Lo))
+		if got := u.String(); got != want.String() {
+			t.Fatalf("%#v.String() = %v; want %v", u, got, want)
+		}
+	}
+}
+
+func TestInt128JSON(t *testing.T) {
+	v := Int128From64(-42)
+	data, err := Marshal(v)
+	if err != nil || string(data) != `"-42"` {
+		t.Errorf("Marshal(%#v) = %s, %v; want \"-42\"", v, data, err)
+	}
+
+	for _, in := range []string{`"-42"`, `-42`} {
+		var got Int128
+		if err := Unmarshal([]byte(in), &got); err != nil || got != v {
+			t.Errorf("Unmarshal(%s) = %#v, %v; want %#v", in, got, err, v)
+		}
+	}
+
+	var u Uint128
+	for _, in := range []string{`"-1"`, `1e3`, `true`} {
+		if err := Unmarshal([]byte(in), &u); err == nil {
+			t.Errorf("Unmarshal(%s) = %#v; want error", in, u)
+		}
+	}
+
+	u = Uint128From64(1)
+	if err := Unmarshal([]byte(`null`), &u); err != nil || u != (Uint128{}) {
+		t.Errorf("Unmarshal(null) = %#v, %v; want zero value", u, err)
+	}
+}
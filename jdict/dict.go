@@ -0,0 +1,151 @@
+// Package jdict implements a dictionary framing of JSON documents for internal services
+// exchanging documents with many repeated member names, e.g. large arrays of objects.
+//
+// A frame is a two element array of a dictionary and of the document, in which the member
+// names found in the dictionary are replaced by their indexes in it:
+//
+//	[["id","name"],[{0:1,1:"a"},{0:2,1:"b"}]]
+//
+// Frames are not valid JSON, so they must only be exchanged by peers using this package.
+package jdict
+
+import (
+	"strconv"
+
+	"github.com/mailru/easyjson"
+	"github.com/mailru/easyjson/jlexer"
+)
+
+// Marshal marshals v and encodes the result into a frame.
+func Marshal(v easyjson.Marshaler) ([]byte, error) {
+	data, err := easyjson.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return Encode(data)
+}
+
+// Unmarshal decodes the frame and unmarshals the document into v.
+func Unmarshal(frame []byte, v easyjson.Unmarshaler) error {
+	data, err := Decode(frame)
+	if err != nil {
+		return err
+	}
+	return easyjson.Unmarshal(data, v)
+}
+
+// Encode encodes the JSON document in data into a frame. The member names occurring more than
+// once are put into the dictionary, in the order of their first occurrence. The document is
+// compacted.
+func Encode(data []byte) ([]byte, error) {
+	if _, err := jlexer.NewIndex(data); err != nil {
+		return nil, err
+	}
+	toks := tokens(data)
+
+	counts := map[string]int{}
+	for i, tok := range toks {
+		if isName(toks, i, jlexer.TokenString) {
+			counts[string(tok.Bytes(data))]++
+		}
+	}
+
+	out := make([]byte, 0, len(data)+2)
+	out = append(out, "[["...)
+	indexes := map[string]int{}
+	for i, tok := range toks {
+		name := tok.Bytes(data)
+		if !isName(toks, i, jlexer.TokenString) || counts[string(name)] < 2 {
+			continue
+		}
+		if _, ok := indexes[string(name)]; ok {
+			continue
+		}
+		if len(indexes) > 0 {
+			out = append(out, ',')
+		}
+		indexes[string(name)] = len(indexes)
+		out = append(out, name...)
+	}
+	out = append(out, "],"...)
+
+	for i, tok := range toks {
+		if n, ok := indexes[string(tok.Bytes(data))]; ok && isName(toks, i, jlexer.TokenString) {
+			out = strconv.AppendInt(out, int64(n), 10)
+		} else {
+			out = append(out, tok.Bytes(data)...)
+		}
+	}
+	return append(out, ']'), nil
+}
+
+// Decode decodes the frame into the JSON document it holds. The document is only checked to be
+// well-formed when it is unmarshaled.
+func Decode(frame []byte) ([]byte, error) {
+	toks := tokens(frame)
+	toks = append(toks, jlexer.Token{Kind: jlexer.TokenEOF, Offset: len(frame)})
+	fail := func(tok jlexer.Token, reason string) ([]byte, error) {
+		return nil, &jlexer.LexerError{Reason: reason, Offset: tok.Offset, Data: string(tok.Bytes(frame))}
+	}
+
+	if toks[0].Kind != jlexer.TokenArrayStart {
+		return fail(toks[0], "frame must start with '['")
+	}
+	if toks[1].Kind != jlexer.TokenArrayStart {
+		return fail(toks[1], "dictionary must be an array")
+	}
+	i := 2
+	var names [][]byte
+	for toks[i].Kind != jlexer.TokenArrayEnd {
+		if len(names) > 0 {
+			if toks[i].Kind != jlexer.TokenComma {
+				return fail(toks[i], "unexpected token in dictionary")
+			}
+			i++
+		}
+		if toks[i].Kind != jlexer.TokenString {
+			return fail(toks[i], "dictionary entries must be strings")
+		}
+		names = append(names, toks[i].Bytes(frame))
+		i++
+	}
+	if toks[i+1].Kind != jlexer.TokenComma {
+		return fail(toks[i+1], "comma expected after dictionary")
+	}
+	doc := toks[i+2 : len(toks)-1]
+	if len(doc) < 2 || doc[len(doc)-1].Kind != jlexer.TokenArrayEnd {
+		return fail(toks[len(toks)-1], "frame must end with ']'")
+	}
+	doc = doc[:len(doc)-1]
+
+	out := make([]byte, 0, len(frame))
+	for i, tok := range doc {
+		if !isName(doc, i, jlexer.TokenNumber) {
+			out = append(out, tok.Bytes(frame)...)
+			continue
+		}
+		n, err := strconv.Atoi(string(tok.Bytes(frame)))
+		if err != nil || n < 0 || n >= len(names) {
+			return fail(tok, "invalid dictionary index")
+		}
+		out = append(out, names[n]...)
+	}
+	return out, nil
+}
+
+// tokens returns the tokens of data, but whitespace and the end of the input.
+func tokens(data []byte) []jlexer.Token {
+	var toks []jlexer.Token
+	tz := jlexer.Tokenizer{Data: data}
+	for tok := tz.Next(); tok.Kind != jlexer.TokenEOF; tok = tz.Next() {
+		if tok.Kind != jlexer.TokenWhitespace {
+			toks = append(toks, tok)
+		}
+	}
+	return toks
+}
+
+// isName tells if the token i is a member name of the given kind.
+func isName(toks []jlexer.Token, i int, kind jlexer.TokenKind) bool {
+	return toks[i].Kind == kind && i+1 < len(toks) && toks[i+1].Kind == jlexer.TokenColon
+}
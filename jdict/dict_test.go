@@ -0,0 +1,71 @@
+package jdict
+
+import (
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	for _, test := range []struct {
+		In    string
+		Frame string
+		Out   string
+	}{
+		{`1`, `[[],1]`, `1`},
+		{`{"a": 1}`, `[[],{"a":1}]`, `{"a":1}`},
+		{`[{"id": 1, "name": "a"}, {"id": 2, "name": "id"}]`,
+			`[["id","name"],[{0:1,1:"a"},{0:2,1:"id"}]]`,
+			`[{"id":1,"name":"a"},{"id":2,"name":"id"}]`},
+		{`{"x": {"y": {}}, "y": ["x"], "z": {"x": null}}`,
+			`[["x","y"],{0:{1:{}},1:["x"],"z":{0:null}}]`,
+			`{"x":{"y":{}},"y":["x"],"z":{"x":null}}`},
+	} {
+		frame, err := Encode([]byte(test.In))
+		if err != nil || string(frame) != test.Frame {
+			t.Errorf("Encode(%s) = %s, %v; want %s", test.In, frame, err, test.Frame)
+			continue
+		}
+		out, err := Decode(frame)
+		if err != nil || string(out) != test.Out {
+			t.Errorf("Decode(%s) = %s, %v; want %s", frame, out, err, test.Out)
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	for _, in := range []string{``, `{"a":}`, `[1,]`} {
+		if _, err := Encode([]byte(in)); err == nil {
+			t.Errorf("Encode(%s) did not fail", in)
+		}
+	}
+	for _, frame := range []string{
+		``,
+		`{}`,
+		`[{},1]`,
+		`[[1],1]`,
+		`[["a" "b"],1]`,
+		`[["a"] 1]`,
+		`[["a"],{1:2}]`,
+		`[["a"],{-1:2}]`,
+		`[["a"],]`,
+		`[["a"],{0:1}`,
+	} {
+		if _, err := Decode([]byte(frame)); err == nil {
+			t.Errorf("Decode(%s) did not fail", frame)
+		}
+	}
+}
+
+func TestMarshalUnmarshal(t *testing.T) {
+	in := easyjson.RawMessage(`[{"id":1},{"id":2}]`)
+	frame, err := Marshal(&in)
+	if want := `[["id"],[{0:1},{0:2}]]`; err != nil || string(frame) != want {
+		t.Errorf("Marshal() = %s, %v; want %s", frame, err, want)
+	}
+
+	var out easyjson.RawMessage
+	if err := Unmarshal(frame, &out); err != nil || string(out) != string(in) {
+		t.Errorf("Unmarshal() = %s, %v; want %s", out, err, in)
+	}
+}
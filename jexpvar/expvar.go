@@ -0,0 +1,171 @@
+// Package jexpvar publishes variables like the standard expvar package does, marshaling them
+// with easyjson instead of formatting them with fmt.
+//
+// The variables are served by Handler as a single JSON object in the expvar format, so the
+// handler can replace the one of expvar at /debug/vars. Unlike expvar, the package does not
+// register any handler or variables on its own.
+package jexpvar
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/mailru/easyjson"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+var (
+	varsMu sync.RWMutex
+	vars   = map[string]easyjson.Marshaler{}
+	names  []string // sorted
+)
+
+// Publish makes v available under the name. It panics if the name is already published.
+func Publish(name string, v easyjson.Marshaler) {
+	varsMu.Lock()
+	defer varsMu.Unlock()
+
+	if _, ok := vars[name]; ok {
+		panic(fmt.Sprintf("jexpvar: reuse of the variable name %q", name))
+	}
+	vars[name] = v
+	i := sort.SearchStrings(names, name)
+	names = append(names, "")
+	copy(names[i+1:], names[i:])
+	names[i] = name
+}
+
+// Get returns the variable published under the name, or nil if there is none.
+func Get(name string) easyjson.Marshaler {
+	varsMu.RLock()
+	defer varsMu.RUnlock()
+	return vars[name]
+}
+
+// Do calls f for each published variable, in the order of names. The variables can not be
+// published while f runs.
+func Do(f func(name string, v easyjson.Marshaler)) {
+	varsMu.RLock()
+	defer varsMu.RUnlock()
+	for _, name := range names {
+		f(name, vars[name])
+	}
+}
+
+// WriteVars marshals all the published variables into w as a JSON object.
+func WriteVars(w *jwriter.Writer) {
+	w.RawString("{\n")
+	first := true
+	Do(func(name string, v easyjson.Marshaler) {
+		if !first {
+			w.RawString(",\n")
+		}
+		first = false
+		w.String(name)
+		w.RawString(": ")
+		v.MarshalEasyJSON(w)
+	})
+	w.RawString("\n}\n")
+}
+
+// Handler returns the handler serving the published variables.
+func Handler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		w := jwriter.Writer{}
+		WriteVars(&w)
+		if w.Error != nil {
+			http.Error(rw, w.Error.Error(), http.StatusInternalServerError)
+			return
+		}
+		rw.Header().Set("Content-Type", "application/json; charset=utf-8")
+		rw.Header().Set("Content-Length", strconv.Itoa(w.Size()))
+		w.DumpTo(rw)
+	})
+}
+
+// Func is a variable computed on marshaling by calling the function.
+type Func func() easyjson.Marshaler
+
+// MarshalEasyJSON supports easyjson.Marshaler interface.
+func (f Func) MarshalEasyJSON(w *jwriter.Writer) {
+	if v := f(); v != nil {
+		v.MarshalEasyJSON(w)
+	} else {
+		w.RawString("null")
+	}
+}
+
+// Int is an integer variable safe for concurrent use.
+type Int struct {
+	i int64
+}
+
+// Value returns the value of the variable.
+func (v *Int) Value() int64 {
+	return atomic.LoadInt64(&v.i)
+}
+
+// Add adds delta to the variable.
+func (v *Int) Add(delta int64) {
+	atomic.AddInt64(&v.i, delta)
+}
+
+// Set sets the variable to value.
+func (v *Int) Set(value int64) {
+	atomic.StoreInt64(&v.i, value)
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface.
+func (v *Int) MarshalEasyJSON(w *jwriter.Writer) {
+	w.Int64(v.Value())
+}
+
+// Float is a floating-point variable safe for concurrent use.
+type Float struct {
+	f uint64
+}
+
+// Value returns the value of the variable.
+func (v *Float) Value() float64 {
+	return math.Float64frombits(atomic.LoadUint64(&v.f))
+}
+
+// Add adds delta to the variable.
+func (v *Float) Add(delta float64) {
+	for {
+		cur := atomic.LoadUint64(&v.f)
+		next := math.Float64bits(math.Float64frombits(cur) + delta)
+		if atomic.CompareAndSwapUint64(&v.f, cur, next) {
+			return
+		}
+	}
+}
+
+// Set sets the variable to value.
+func (v *Float) Set(value float64) {
+	atomic.StoreUint64(&v.f, math.Float64bits(value))
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface.
+func (v *Float) MarshalEasyJSON(w *jwriter.Writer) {
+	w.Float64(v.Value())
+}
+
+// NewInt publishes a new integer variable under the name.
+func NewInt(name string) *Int {
+	v := new(Int)
+	Publish(name, v)
+	return v
+}
+
+// NewFloat publishes a new floating-point variable under the name.
+func NewFloat(name string) *Float {
+	v := new(Float)
+	Publish(name, v)
+	return v
+}
@@ -0,0 +1,48 @@
+package jexpvar
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestHandler(t *testing.T) {
+	requests := NewInt("requests")
+	requests.Add(2)
+	requests.Add(1)
+	load := NewFloat("load")
+	load.Set(0.5)
+	config := easyjson.RawMessage(`{"debug":true}`)
+	Publish("config", &config)
+	Publish("uptime", Func(func() easyjson.Marshaler {
+		uptime := easyjson.RawMessage(`12`)
+		return &uptime
+	}))
+
+	if Get("requests") != requests || Get("missing") != nil {
+		t.Errorf("Get() does not return the published variables")
+	}
+
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/debug/vars", nil))
+
+	want := "{\n\"config\": {\"debug\":true},\n\"load\": 0.5,\n\"requests\": 3,\n\"uptime\": 12\n}\n"
+	if got := rec.Body.String(); got != want {
+		t.Errorf("Handler() body = %q; want %q", got, want)
+	}
+	if got := rec.Header().Get("Content-Type"); got != "application/json; charset=utf-8" {
+		t.Errorf("Handler() Content-Type = %q", got)
+	}
+	if !json.Valid(rec.Body.Bytes()) {
+		t.Errorf("Handler() body is not valid JSON")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Publish() of a reused name did not panic")
+		}
+	}()
+	Publish("load", load)
+}
@@ -0,0 +1,73 @@
+package jlexer
+
+import "strconv"
+
+// EventKind is a kind of a decoding event reported to a Hook.
+type EventKind byte
+
+const (
+	EventObjectStart EventKind = iota // Opening brace of an object.
+	EventObjectEnd                    // Closing brace of an object.
+	EventArrayStart                   // Opening bracket of an array.
+	EventArrayEnd                     // Closing bracket of an array.
+	EventKey                          // Object member name.
+	EventValue                        // Scalar value, or a whole object or array skipped or fetched raw.
+)
+
+var eventKindNames = [...]string{
+	EventObjectStart: "ObjectStart",
+	EventObjectEnd:   "ObjectEnd",
+	EventArrayStart:  "ArrayStart",
+	EventArrayEnd:    "ArrayEnd",
+	EventKey:         "Key",
+	EventValue:       "Value",
+}
+
+func (k EventKind) String() string {
+	if int(k) < len(eventKindNames) {
+		return eventKindNames[k]
+	}
+	return "EventKind(" + strconv.Itoa(int(k)) + ")"
+}
+
+// Hook receives decoding events from a Lexer, e.g. to trace decoding for debugging or to
+// index parts of the input, without changes to the generated code.
+type Hook interface {
+	// Event is called for each token consumed by the lexer, in input order. The offset is
+	// the position of the token in the input, and data is its raw JSON (e.g. a quoted and
+	// escaped string for keys). data refers to the input buffer and must not be modified.
+	Event(kind EventKind, offset int, data []byte)
+}
+
+// HookFunc is an adapter to use an ordinary function as a Hook.
+type HookFunc func(kind EventKind, offset int, data []byte)
+
+// Event calls f(kind, offset, data).
+func (f HookFunc) Event(kind EventKind, offset int, data []byte) {
+	f(kind, offset, data)
+}
+
+// emitToken reports the current token to the hook.
+func (r *Lexer) emitToken() {
+	if r.token.kind == tokenUndef || !r.Ok() {
+		return
+	}
+
+	kind := EventValue
+	switch {
+	case r.token.kind == tokenDelim:
+		switch r.token.delimValue {
+		case '{':
+			kind = EventObjectStart
+		case '}':
+			kind = EventObjectEnd
+		case '[':
+			kind = EventArrayStart
+		case ']':
+			kind = EventArrayEnd
+		}
+	case r.isKey:
+		kind = EventKey
+	}
+	r.Hook.Event(kind, r.start, r.Data[r.start:r.pos])
+}
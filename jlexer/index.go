@@ -0,0 +1,237 @@
+package jlexer
+
+import "strconv"
+
+// Index is a structural index of a JSON document: the offsets of all of its values and member
+// names, found in a single pass over the document. Values are looked up in the index without
+// rescanning the document, so many fields can be extracted cheaply from a large document, e.g.
+// by the stages of a middleware chain inspecting the same request.
+//
+// The document is referred to, not copied, and must not be modified while the index is used.
+// Like with the Tokenizer, the escape sequences of string literals are only validated when the
+// values are unmarshaled.
+type Index struct {
+	Data []byte // Indexed document.
+
+	values []indexValue
+}
+
+// indexValue is an entry of the index, in the order of the values in the document.
+type indexValue struct {
+	kind             TokenKind
+	start, end       int // offsets of the value
+	keyStart, keyEnd int // offsets of the member name literal, if the value is a member
+	next             int // index of the next value that does not belong to this one
+}
+
+// Value is a value of an indexed document.
+type Value struct {
+	ix *Index
+	i  int
+}
+
+// NewIndex indexes the document in data, which must consist of a single JSON value.
+func NewIndex(data []byte) (*Index, error) {
+	ix := &Index{Data: data}
+	tz := Tokenizer{Data: data}
+	next := func() Token {
+		for {
+			if tok := tz.Next(); tok.Kind != TokenWhitespace {
+				return tok
+			}
+		}
+	}
+	fail := func(tok Token, reason string) (*Index, error) {
+		return nil, &LexerError{Reason: reason, Offset: tok.Offset, Data: string(tok.Bytes(data))}
+	}
+
+	// The containers being indexed, as the indexes of their values.
+	var open []int
+	keyStart, keyEnd := -1, -1
+	tok := next()
+	for {
+		// tok starts a value.
+		v := indexValue{kind: tok.Kind, start: tok.Offset, end: tok.Offset + tok.Length, keyStart: keyStart, keyEnd: keyEnd}
+		switch tok.Kind {
+		case TokenObjectStart, TokenArrayStart:
+			open = append(open, len(ix.values))
+			ix.values = append(ix.values, v)
+		case TokenString, TokenNumber, TokenTrue, TokenFalse, TokenNull:
+			v.next = len(ix.values) + 1
+			ix.values = append(ix.values, v)
+		default:
+			return fail(tok, "unexpected token, a value expected")
+		}
+
+		// Close the containers ending after the value, up to the next value to index.
+		tok = next()
+		for {
+			if len(open) > 0 {
+				c := &ix.values[open[len(open)-1]]
+				opened := open[len(open)-1] == len(ix.values)-1
+				endKind := TokenArrayEnd
+				if c.kind == TokenObjectStart {
+					endKind = TokenObjectEnd
+				}
+				if opened && tok.Kind != endKind {
+					// The first element or member of the container starts at tok.
+					break
+				}
+				if tok.Kind == endKind {
+					c.end = tok.Offset + tok.Length
+					c.next = len(ix.values)
+					open = open[:len(open)-1]
+					v = *c
+					tok = next()
+					continue
+				}
+				if tok.Kind != TokenComma {
+					return fail(tok, "unexpected token, a comma or the end of the container expected")
+				}
+				tok = next()
+				break
+			}
+			if tok.Kind != TokenEOF {
+				return fail(tok, "unexpected token after the value")
+			}
+			return ix, nil
+		}
+
+		keyStart, keyEnd = -1, -1
+		if c := ix.values[open[len(open)-1]]; c.kind == TokenObjectStart {
+			if tok.Kind != TokenString {
+				return fail(tok, "unexpected token, a member name expected")
+			}
+			keyStart, keyEnd = tok.Offset, tok.Offset+tok.Length
+			if tok = next(); tok.Kind != TokenColon {
+				return fail(tok, "unexpected token, a colon expected")
+			}
+			tok = next()
+		}
+	}
+}
+
+// Root returns the indexed document.
+func (ix *Index) Root() Value {
+	return Value{ix: ix}
+}
+
+// Get returns the value at the path from the root of the document, see Value.Get.
+func (ix *Index) Get(path ...string) (Value, bool) {
+	return ix.Root().Get(path...)
+}
+
+// Kind returns the kind of the value: TokenObjectStart for objects, TokenArrayStart for
+// arrays, or the kind of the token of other values.
+func (v Value) Kind() TokenKind {
+	return v.ix.values[v.i].kind
+}
+
+// Raw returns the bytes of the value in the document, e.g. to unmarshal it.
+func (v Value) Raw() []byte {
+	e := v.ix.values[v.i]
+	return v.ix.Data[e.start:e.end]
+}
+
+// Len returns the number of the members of an object or of the elements of an array, and 0
+// for other values.
+func (v Value) Len() int {
+	n := 0
+	for i := v.i + 1; i < v.ix.values[v.i].next; i = v.ix.values[i].next {
+		n++
+	}
+	return n
+}
+
+// Get returns the value at the path from v, made of member names for objects and of decimal
+// indexes for arrays, e.g. Get("items", "0", "id"). It reports if there is such a value. If
+// an object has several members of the same name, the first one is returned.
+func (v Value) Get(path ...string) (Value, bool) {
+	for _, name := range path {
+		e := v.ix.values[v.i]
+		switch e.kind {
+		case TokenObjectStart:
+			found := false
+			for i := v.i + 1; i < e.next; i = v.ix.values[i].next {
+				if v.ix.keyIs(i, name) {
+					v.i, found = i, true
+					break
+				}
+			}
+			if !found {
+				return Value{}, false
+			}
+		case TokenArrayStart:
+			n, err := strconv.Atoi(name)
+			if err != nil || n < 0 {
+				return Value{}, false
+			}
+			i := v.i + 1
+			for ; n > 0 && i < e.next; n-- {
+				i = v.ix.values[i].next
+			}
+			if i >= e.next {
+				return Value{}, false
+			}
+			v.i = i
+		default:
+			return Value{}, false
+		}
+	}
+	return v, true
+}
+
+// PathAt returns the path from the root of the document to the value starting at offset, made of
+// member names and decimal indexes like the paths of Get, e.g. to locate the value of an error
+// reported by the lexer at that offset. It reports if a value starts there.
+func (ix *Index) PathAt(offset int) ([]string, bool) {
+	path := []string{}
+	i := 0
+	for {
+		e := ix.values[i]
+		if e.start == offset {
+			return path, true
+		}
+		if (e.kind != TokenObjectStart && e.kind != TokenArrayStart) || offset < e.start || offset >= e.end {
+			return nil, false
+		}
+
+		// Find the member or element ending after offset.
+		n, j := 0, i+1
+		for j < e.next && ix.values[j].end <= offset {
+			n, j = n+1, ix.values[j].next
+		}
+		if j >= e.next {
+			return nil, false
+		}
+		if e.kind == TokenObjectStart {
+			path = append(path, ix.key(j))
+		} else {
+			path = append(path, strconv.Itoa(n))
+		}
+		i = j
+	}
+}
+
+// key returns the member name of the value i.
+func (ix *Index) key(i int) string {
+	e := ix.values[i]
+	l := Lexer{Data: ix.Data[e.keyStart:e.keyEnd]}
+	return l.String()
+}
+
+// keyIs tells if the member name of the value i is name.
+func (ix *Index) keyIs(i int, name string) bool {
+	e := ix.values[i]
+	lit := ix.Data[e.keyStart:e.keyEnd]
+	if raw := lit[1 : len(lit)-1]; len(raw) == len(name) && string(raw) == name {
+		return true
+	}
+	for _, c := range lit {
+		if c == '\\' {
+			l := Lexer{Data: lit}
+			return l.UnsafeString() == name && l.Ok()
+		}
+	}
+	return false
+}
@@ -0,0 +1,140 @@
+package jlexer
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestIndex(t *testing.T) {
+	data := []byte(` {"id": 1, "items": [{"name": "a"}, {"name": "b", "tags": []}], "abc": "x", "meta": {}, "id": 2} `)
+	ix, err := NewIndex(data)
+	if err != nil {
+		t.Fatalf("NewIndex() error: %v", err)
+	}
+
+	for _, test := range []struct {
+		Path []string
+		Want string
+		Len  int
+	}{
+		{nil, `{"id": 1, "items": [{"name": "a"}, {"name": "b", "tags": []}], "abc": "x", "meta": {}, "id": 2}`, 5},
+		{[]string{"id"}, `1`, 0},
+		{[]string{"items"}, `[{"name": "a"}, {"name": "b", "tags": []}]`, 2},
+		{[]string{"items", "1"}, `{"name": "b", "tags": []}`, 2},
+		{[]string{"items", "1", "name"}, `"b"`, 0},
+		{[]string{"items", "1", "tags"}, `[]`, 0},
+		{[]string{"abc"}, `"x"`, 0},
+		{[]string{"meta"}, `{}`, 0},
+		{[]string{"missing"}, ``, 0},
+		{[]string{"items", "2"}, ``, 0},
+		{[]string{"items", "-1"}, ``, 0},
+		{[]string{"items", "x"}, ``, 0},
+		{[]string{"id", "x"}, ``, 0},
+		{[]string{"meta", "x"}, ``, 0},
+	} {
+		v, ok := ix.Get(test.Path...)
+		if ok != (test.Want != "") {
+			t.Errorf("Get(%q) found %v; want %v", test.Path, ok, !ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if got := string(v.Raw()); got != test.Want {
+			t.Errorf("Get(%q) = %s; want %s", test.Path, got, test.Want)
+		}
+		if got := v.Len(); got != test.Len {
+			t.Errorf("Get(%q).Len() = %d; want %d", test.Path, got, test.Len)
+		}
+	}
+
+	if v, _ := ix.Get("items", "0"); v.Kind() != TokenObjectStart {
+		t.Errorf("Get(items, 0).Kind() = %v; want %v", v.Kind(), TokenObjectStart)
+	}
+	if v, _ := ix.Get("items", "0", "name"); v.Kind() != TokenString {
+		t.Errorf("Get(items, 0, name).Kind() = %v; want %v", v.Kind(), TokenString)
+	}
+	if v, _ := ix.Get("items"); string(mustGet(t, v, "0", "name").Raw()) != `"a"` {
+		t.Errorf("Get(items).Get(0, name) = %s; want %s", mustGet(t, v, "0", "name").Raw(), `"a"`)
+	}
+}
+
+func mustGet(t *testing.T, v Value, path ...string) Value {
+	v, ok := v.Get(path...)
+	if !ok {
+		t.Fatalf("Get(%q) found nothing", path)
+	}
+	return v
+}
+
+func TestIndexPathAt(t *testing.T) {
+	data := []byte(` {"id": 1, "items": [{"name": "a"}, {"n\u0061me": "b", "tags": [true]}], "meta": {}} `)
+	ix, err := NewIndex(data)
+	if err != nil {
+		t.Fatalf("NewIndex() error: %v", err)
+	}
+
+	for _, test := range []struct {
+		At   string
+		Path []string
+	}{
+		{`{"id"`, []string{}},
+		{`1,`, []string{"id"}},
+		{`[{`, []string{"items"}},
+		{`{"name"`, []string{"items", "0"}},
+		{`"a"`, []string{"items", "0", "name"}},
+		{`"b"`, []string{"items", "1", "name"}},
+		{`true`, []string{"items", "1", "tags", "0"}},
+		{`{}}`, []string{"meta"}},
+	} {
+		offset := bytes.Index(data, []byte(test.At))
+		path, ok := ix.PathAt(offset)
+		if !ok || !reflect.DeepEqual(path, test.Path) {
+			t.Errorf("PathAt(%d) = %q, %v; want %q", offset, path, ok, test.Path)
+		}
+	}
+
+	for _, offset := range []int{0, 3, 5, len(data) - 1, len(data)} {
+		if path, ok := ix.PathAt(offset); ok {
+			t.Errorf("PathAt(%d) = %q; want nothing", offset, path)
+		}
+	}
+}
+
+func TestIndexErrors(t *testing.T) {
+	for _, test := range []struct {
+		In     string
+		Offset int
+	}{
+		{``, 0},
+		{` `, 1},
+		{`{`, 1},
+		{`[1,]`, 3},
+		{`[1 2]`, 3},
+		{`{"a" 1}`, 5},
+		{`{1: 2}`, 1},
+		{`{"a": 1,}`, 8},
+		{`{"a": 1]`, 7},
+		{`[}`, 1},
+		{`1 2`, 2},
+		{`[nul]`, 1},
+		{`,`, 0},
+	} {
+		_, err := NewIndex([]byte(test.In))
+		lerr, ok := err.(*LexerError)
+		if !ok {
+			t.Errorf("NewIndex(%q) error = %v; want a *LexerError", test.In, err)
+			continue
+		}
+		if lerr.Offset != test.Offset {
+			t.Errorf("NewIndex(%q) error at %d; want %d (%v)", test.In, lerr.Offset, test.Offset, lerr)
+		}
+	}
+
+	for _, in := range []string{`1`, ` "x" `, `[]`, `[[],{}]`, `{"a":{"b":[null,true,false]}}`} {
+		if _, err := NewIndex([]byte(in)); err != nil {
+			t.Errorf("NewIndex(%q) error: %v", in, err)
+		}
+	}
+}
@@ -7,16 +7,21 @@ package jlexer
 import (
 	"bytes"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"math/big"
 	"strconv"
+	"strings"
+	"time"
 	"unicode"
 	"unicode/utf16"
 	"unicode/utf8"
 
 	"github.com/josharian/intern"
+	"github.com/mailru/easyjson/jwriter"
 )
 
 // tokenKind determines type of a token.
@@ -37,6 +42,7 @@ type token struct {
 
 	boolValue       bool   // Value if a boolean literal token.
 	byteValueCloned bool   // true if byteValue was allocated and does not refer to original json body
+	escaped         bool   // true if byteValue of a string literal has escape sequences left to unescape
 	byteValue       []byte // Raw value of a token.
 	delimValue      byte
 }
@@ -52,9 +58,16 @@ type Lexer struct {
 	firstElement bool // Whether current element is the first in array or an object.
 	wantSep      byte // A comma or a colon character, which need to occur before a token.
 
-	UseMultipleErrors bool          // If we want to use multiple errors.
-	fatalError        error         // Fatal error occurred during lexing. It is usually a syntax error.
-	multipleErrors    []*LexerError // Semantic errors occurred during lexing. Marshalling will be continued after finding this errors.
+	UseMultipleErrors  bool          // If we want to use multiple errors.
+	MergePatch         bool          // If the input is a JSON Merge Patch: nulls reset values, objects are merged.
+	KeepOnNull         bool          // If null members leave struct fields unchanged, also in merge patches and the stdlib-compat mode.
+	UseInt64           bool          // If integers are decoded into interface{} as int64 (json.Number if too big) rather than float64.
+	IntegralFloats     bool          // If integer types accept integral numbers with a fraction or exponent part like 3.0 or 1e3, and reject other ones like 3.5 with a clear error.
+	NoIntegerExponents bool          // If integer types reject all numbers with a fraction or exponent part with a clear error, even if IntegralFloats is set.
+	Hook               Hook          // If set, receives an event for each token consumed.
+	isKey              bool          // Whether the token being consumed is an object member name.
+	fatalError         error         // Fatal error occurred during lexing. It is usually a syntax error.
+	multipleErrors     []*LexerError // Semantic errors occurred during lexing. Marshalling will be continued after finding this errors.
 }
 
 // FetchToken scans the input for the next token.
@@ -210,12 +223,24 @@ func (r *Lexer) fetchFalse() {
 
 // fetchNumber scans a number literal token.
 func (r *Lexer) fetchNumber() {
+	length, ok := scanNumber(r.Data[r.pos:])
+	r.pos += length
+	if !ok {
+		r.errSyntax()
+	} else {
+		r.token.byteValue = r.Data[r.start:r.pos]
+	}
+}
+
+// scanNumber returns the length of the number literal at the start of data, and false if the
+// literal is followed by a character that can not end a token.
+func scanNumber(data []byte) (length int, ok bool) {
 	hasE := false
 	afterE := false
 	hasDot := false
 
-	r.pos++
-	for i, c := range r.Data[r.pos:] {
+	for i := 1; i < len(data); i++ {
+		c := data[i]
 		switch {
 		case c >= '0' && c <= '9':
 			afterE = false
@@ -228,56 +253,73 @@ func (r *Lexer) fetchNumber() {
 		case (c == '+' || c == '-') && afterE:
 			afterE = false
 		default:
-			r.pos += i
-			if !isTokenEnd(c) {
-				r.errSyntax()
-			} else {
-				r.token.byteValue = r.Data[r.start:r.pos]
-			}
-			return
+			return i, isTokenEnd(c)
 		}
 	}
-
-	r.pos = len(r.Data)
-	r.token.byteValue = r.Data[r.start:]
+	return len(data), true
 }
 
-// findStringLen tries to scan into the string literal for ending quote char to determine required size.
-// The size will be exact if no escapes are present and may be inexact if there are escaped chars.
-func findStringLen(data []byte) (isValid bool, length int) {
+// findStringLen scans the string literal for the closing quote, returning the length of the
+// literal and whether it contains escape sequences. Escape sequences are skipped in a single
+// forward pass, so the scan stays linear however densely the literal is escaped.
+func findStringLen(data []byte) (isValid bool, length int, escaped bool) {
 	for {
-		idx := bytes.IndexByte(data, '"')
+		idx := bytes.IndexByte(data[length:], '"')
 		if idx == -1 {
-			return false, len(data)
-		}
-		if idx == 0 || (idx > 0 && data[idx-1] != '\\') {
-			return true, length + idx
+			return false, len(data), escaped
 		}
+		end := length + idx
 
-		// count \\\\\\\ sequences. even number of slashes means quote is not really escaped
-		cnt := 1
-		for idx-cnt-1 >= 0 && data[idx-cnt-1] == '\\' {
-			cnt++
+		i := bytes.IndexByte(data[length:end], '\\')
+		if i == -1 {
+			return true, end, escaped
 		}
-		if cnt%2 == 0 {
-			return true, length + idx
+		escaped = true
+
+		// Skip the escape sequences before the quote: it is escaped itself if the last one
+		// ends past it.
+		i += length
+		for i < end {
+			i += 2
+			if i >= end {
+				break
+			}
+			j := bytes.IndexByte(data[i:end], '\\')
+			if j == -1 {
+				i = end
+				break
+			}
+			i += j
 		}
-
-		length += idx + 1
-		data = data[idx+1:]
+		if i == end {
+			return true, end, escaped
+		}
+		length = end + 1
 	}
 }
 
 // unescapeStringToken performs unescaping of string token.
 // if no escaping is needed, original string is returned, otherwise - a new one allocated
 func (r *Lexer) unescapeStringToken() (err error) {
+	if !r.token.escaped {
+		return nil
+	}
 	data := r.token.byteValue
-	var unescapedData []byte
 
-	for {
-		i := bytes.IndexByte(data, '\\')
-		if i == -1 {
-			break
+	// The unescaped string is never longer than the literal, so the buffer is allocated once
+	// and the unescaped parts are copied in one pass, a run of plain bytes at a time.
+	i := bytes.IndexByte(data, '\\')
+	unescapedData := make([]byte, i, len(data))
+	copy(unescapedData, data[:i])
+	for i < len(data) {
+		if data[i] != '\\' {
+			j := bytes.IndexByte(data[i:], '\\')
+			if j == -1 {
+				j = len(data) - i
+			}
+			unescapedData = append(unescapedData, data[i:i+j]...)
+			i += j
+			continue
 		}
 
 		escapedRune, escapedBytes, err := decodeEscape(data[i:])
@@ -285,23 +327,19 @@ func (r *Lexer) unescapeStringToken() (err error) {
 			r.errParse(err.Error())
 			return err
 		}
-
-		if unescapedData == nil {
-			unescapedData = make([]byte, 0, len(r.token.byteValue))
+		if escapedRune < utf8.RuneSelf {
+			unescapedData = append(unescapedData, byte(escapedRune))
+		} else {
+			var d [4]byte
+			s := utf8.EncodeRune(d[:], escapedRune)
+			unescapedData = append(unescapedData, d[:s]...)
 		}
-
-		var d [4]byte
-		s := utf8.EncodeRune(d[:], escapedRune)
-		unescapedData = append(unescapedData, data[:i]...)
-		unescapedData = append(unescapedData, d[:s]...)
-
-		data = data[i+escapedBytes:]
+		i += escapedBytes
 	}
 
-	if unescapedData != nil {
-		r.token.byteValue = append(unescapedData, data...)
-		r.token.byteValueCloned = true
-	}
+	r.token.byteValue = unescapedData
+	r.token.byteValueCloned = true
+	r.token.escaped = false
 	return
 }
 
@@ -379,13 +417,14 @@ func (r *Lexer) fetchString() {
 	r.pos++
 	data := r.Data[r.pos:]
 
-	isValid, length := findStringLen(data)
+	isValid, length, escaped := findStringLen(data)
 	if !isValid {
 		r.pos += length
 		r.errParse("unterminated string literal")
 		return
 	}
 	r.token.byteValue = data[:length]
+	r.token.escaped = escaped
 	r.pos += length + 1 // skip closing '"' as well
 }
 
@@ -400,8 +439,18 @@ func (r *Lexer) scanToken() {
 
 // consume resets the current token to allow scanning the next one.
 func (r *Lexer) consume() {
+	if r.Hook != nil {
+		r.emitToken()
+	}
+	r.isKey = false
+	r.reset()
+}
+
+// reset resets the current token without reporting it to the hook.
+func (r *Lexer) reset() {
 	r.token.kind = tokenUndef
 	r.token.byteValueCloned = false
+	r.token.escaped = false
 	r.token.delimValue = 0
 }
 
@@ -541,7 +590,8 @@ func (r *Lexer) SkipRecursive() {
 		return
 	}
 
-	r.consume()
+	// The whole object or array is reported to the hook as a single value.
+	r.reset()
 
 	level := 1
 	inQuotes := false
@@ -562,6 +612,8 @@ func (r *Lexer) SkipRecursive() {
 						Offset: r.pos,
 						Data:   string(r.Data[r.pos:]),
 					}
+				} else if r.Hook != nil {
+					r.Hook.Event(EventValue, startPos, r.Data[startPos:r.pos])
 				}
 				return
 			}
@@ -583,7 +635,11 @@ func (r *Lexer) SkipRecursive() {
 	}
 }
 
-// Raw fetches the next item recursively as a data slice
+// Raw fetches the next value recursively as a slice of Data, e.g. to defer its parsing or to
+// verify a signature over its bytes. The slice starts at the first byte of the value and ends
+// after its last one, without the whitespace around it, and the bytes are exactly the ones of
+// the input, escapes and all. It refers to Data, so it must be copied to be kept after Data is
+// reused. Raw returns nil on errors.
 func (r *Lexer) Raw() []byte {
 	r.SkipRecursive()
 	if !r.Ok() {
@@ -592,12 +648,42 @@ func (r *Lexer) Raw() []byte {
 	return r.Data[r.start:r.pos]
 }
 
+// RawUntrimmed is like Raw, but the slice also holds the whitespace between the value and the
+// neighbouring tokens or separators, e.g. " [1, 2]\n" of the member {"a": [1, 2]\n}, so that
+// proxies passing the input through keep its layout. The trailing whitespace is consumed.
+func (r *Lexer) RawUntrimmed() []byte {
+	r.SkipRecursive()
+	if !r.Ok() {
+		return nil
+	}
+	start := r.start
+	for start > 0 && isWhitespace(r.Data[start-1]) {
+		start--
+	}
+	for r.pos < len(r.Data) && isWhitespace(r.Data[r.pos]) {
+		r.pos++
+	}
+	return r.Data[start:r.pos]
+}
+
 // IsStart returns whether the lexer is positioned at the start
 // of an input string.
 func (r *Lexer) IsStart() bool {
 	return r.pos == 0
 }
 
+// CheckValid verifies that the whole input is valid JSON, like encoding/json does it before
+// unmarshaling, and publishes a syntax error otherwise. It does nothing if the lexer is not
+// positioned at the start of the input.
+func (r *Lexer) CheckValid() {
+	if !r.IsStart() || json.Valid(r.Data) {
+		return
+	}
+	// json.RawMessage accepts any valid input, so this only reports the syntax error.
+	var m json.RawMessage
+	r.AddError(json.Unmarshal(r.Data, &m))
+}
+
 // Consumed reads all remaining bytes from the input, publishing an error if
 // there is anything but whitespace remaining.
 func (r *Lexer) Consumed() {
@@ -658,10 +744,18 @@ func (r *Lexer) UnsafeBytes() []byte {
 
 // UnsafeFieldName returns current member name string token
 func (r *Lexer) UnsafeFieldName(skipUnescape bool) string {
+	r.isKey = true
 	ret, _ := r.unsafeString(skipUnescape)
+	r.isKey = false
 	return ret
 }
 
+// MarkKey marks the next token consumed as an object member name for the Hook, e.g. a map key
+// read with String or IntStr.
+func (r *Lexer) MarkKey() {
+	r.isKey = true
+}
+
 // String reads a string literal.
 func (r *Lexer) String() string {
 	if r.token.kind == tokenUndef && r.Ok() {
@@ -685,6 +779,12 @@ func (r *Lexer) String() string {
 	return ret
 }
 
+// StringCompat reads a string literal like String, but replaces each invalid UTF-8 byte
+// with the Unicode replacement character, like encoding/json does it.
+func (r *Lexer) StringCompat() string {
+	return jwriter.ValidUTF8(r.String())
+}
+
 // StringIntern reads a string literal, and performs string interning on it.
 func (r *Lexer) StringIntern() string {
 	if r.token.kind == tokenUndef && r.Ok() {
@@ -705,6 +805,24 @@ func (r *Lexer) StringIntern() string {
 
 // Bytes reads a string literal and base64 decodes it into a byte slice.
 func (r *Lexer) Bytes() []byte {
+	return r.decodedBytes(base64.StdEncoding.DecodedLen, base64.StdEncoding.Decode)
+}
+
+// BytesBase64URL reads a string literal and decodes it into a byte slice with the URL and file
+// name safe base64 alphabet of RFC 4648, with padding.
+func (r *Lexer) BytesBase64URL() []byte {
+	return r.decodedBytes(base64.URLEncoding.DecodedLen, base64.URLEncoding.Decode)
+}
+
+// BytesBase16 reads a string literal of two hex digits per byte, without a prefix, e.g. "00ff",
+// into a byte slice.
+func (r *Lexer) BytesBase16() []byte {
+	return r.decodedBytes(hex.DecodedLen, hex.Decode)
+}
+
+// decodedBytes reads a string literal and decodes it into a byte slice with the functions of an
+// encoding.
+func (r *Lexer) decodedBytes(decodedLen func(int) int, decode func(dst, src []byte) (int, error)) []byte {
 	if r.token.kind == tokenUndef && r.Ok() {
 		r.FetchToken()
 	}
@@ -712,8 +830,8 @@ func (r *Lexer) Bytes() []byte {
 		r.errInvalidToken("string")
 		return nil
 	}
-	ret := make([]byte, base64.StdEncoding.DecodedLen(len(r.token.byteValue)))
-	n, err := base64.StdEncoding.Decode(ret, r.token.byteValue)
+	ret := make([]byte, decodedLen(len(r.token.byteValue)))
+	n, err := decode(ret, r.token.byteValue)
 	if err != nil {
 		r.fatalError = &LexerError{
 			Reason: err.Error(),
@@ -725,6 +843,301 @@ func (r *Lexer) Bytes() []byte {
 	return ret[:n]
 }
 
+// hexDigits returns the digits of a string literal of hex digits prefixed with 0x, along with
+// the literal. It reports if the literal was read and has the prefix.
+func (r *Lexer) hexDigits() (string, []byte, bool) {
+	s, b := r.unsafeString(false)
+	if !r.Ok() {
+		return "", nil, false
+	}
+	if len(s) < 2 || s[0] != '0' || (s[1] != 'x' && s[1] != 'X') {
+		r.addNonfatalError(&LexerError{
+			Offset: r.start,
+			Reason: "hex string without the 0x prefix",
+			Data:   string(b),
+		})
+		return "", nil, false
+	}
+	return s[2:], b, true
+}
+
+// Uint64Hex reads a string literal of hex digits prefixed with 0x, e.g. "0x1f".
+func (r *Lexer) Uint64Hex() uint64 {
+	s, b, ok := r.hexDigits()
+	if !ok {
+		return 0
+	}
+
+	n, err := strconv.ParseUint(s, 16, 64)
+	if err != nil {
+		r.addNonfatalError(&LexerError{
+			Offset: r.start,
+			Reason: err.Error(),
+			Data:   string(b),
+		})
+	}
+	return n
+}
+
+// BytesHex reads a string literal of two hex digits per byte prefixed with 0x, e.g. "0x00ff",
+// into a byte slice.
+func (r *Lexer) BytesHex() []byte {
+	s, b, ok := r.hexDigits()
+	if !ok {
+		return nil
+	}
+
+	ret, err := hex.DecodeString(s)
+	if err != nil {
+		r.addNonfatalError(&LexerError{
+			Offset: r.start,
+			Reason: err.Error(),
+			Data:   string(b),
+		})
+		return nil
+	}
+	return ret
+}
+
+// BytesHexInto reads a string literal like BytesHex into b, e.g. a fixed-size array, which the
+// bytes have to fill exactly. b is left unchanged on errors.
+func (r *Lexer) BytesHexInto(b []byte) {
+	data := r.BytesHex()
+	if data == nil {
+		return
+	}
+	if len(data) != len(b) {
+		r.addNonfatalError(&LexerError{
+			Offset: r.start,
+			Reason: fmt.Sprintf("hex string of %d bytes, expected %d", len(data), len(b)),
+			Data:   string(r.Data[r.start:r.pos]),
+		})
+		return
+	}
+	copy(b, data)
+}
+
+// UUID reads a string literal with a UUID into u, in the forms accepted by the UnmarshalText
+// methods of the UUID types: "f47ac10b-58cc-4372-a567-0e02b2c3d479" or the 32 hex digits alone,
+// optionally prefixed with "urn:uuid:" or enclosed in braces. u is left unchanged on null.
+func (r *Lexer) UUID(u *[16]byte) {
+	if r.token.kind == tokenUndef && r.Ok() {
+		r.FetchToken()
+	}
+	if r.Ok() && r.token.kind == tokenNull {
+		r.Null()
+		return
+	}
+	b := r.UnsafeBytes()
+	if !r.Ok() {
+		return
+	}
+	if !parseUUID(u, b) {
+		r.addNonfatalError(&LexerError{
+			Offset: r.start,
+			Reason: "invalid UUID",
+			Data:   string(b),
+		})
+	}
+}
+
+// parseUUID parses b into u, leaving it unchanged if b is not a valid UUID.
+func parseUUID(u *[16]byte, b []byte) bool {
+	if len(b) >= 9 && bytes.EqualFold(b[:9], []byte("urn:uuid:")) {
+		b = b[9:]
+	} else if len(b) >= 2 && b[0] == '{' && b[len(b)-1] == '}' {
+		b = b[1 : len(b)-1]
+	}
+
+	var ret [16]byte
+	switch len(b) {
+	case 32:
+		if _, err := hex.Decode(ret[:], b); err != nil {
+			return false
+		}
+	case 36:
+		if b[8] != '-' || b[13] != '-' || b[18] != '-' || b[23] != '-' {
+			return false
+		}
+		// the offsets of the groups of hex digits and of their bytes
+		for _, g := range [...][3]int{{0, 8, 0}, {9, 13, 4}, {14, 18, 6}, {19, 23, 8}, {24, 36, 10}} {
+			if _, err := hex.Decode(ret[g[2]:], b[g[0]:g[1]]); err != nil {
+				return false
+			}
+		}
+	default:
+		return false
+	}
+	*u = ret
+	return true
+}
+
+// Time reads a string literal of a time formatted with the layout, as accepted by time.Parse.
+func (r *Lexer) Time(layout string) time.Time {
+	s := r.String()
+	if !r.Ok() {
+		return time.Time{}
+	}
+
+	t, err := time.Parse(layout, s)
+	if err != nil {
+		r.addNonfatalError(&LexerError{
+			Offset: r.start,
+			Reason: err.Error(),
+			Data:   s,
+		})
+	}
+	return t
+}
+
+// UnixTime reads a number of seconds since the Unix epoch into a UTC time. The number may have a
+// fraction, e.g. 1700000000.5 as encoding/json/v2 writes it, which is truncated to nanoseconds.
+func (r *Lexer) UnixTime() time.Time {
+	return r.unixTime(0)
+}
+
+// UnixMilliTime reads a number of milliseconds since the Unix epoch into a UTC time, like
+// UnixTime.
+func (r *Lexer) UnixMilliTime() time.Time {
+	return r.unixTime(3)
+}
+
+// UnixMicroTime reads a number of microseconds since the Unix epoch into a UTC time, like
+// UnixTime.
+func (r *Lexer) UnixMicroTime() time.Time {
+	return r.unixTime(6)
+}
+
+// UnixNanoTime reads a number of nanoseconds since the Unix epoch into a UTC time, like
+// UnixTime.
+func (r *Lexer) UnixNanoTime() time.Time {
+	return r.unixTime(9)
+}
+
+// unixTime reads a number of units of 10^-digits seconds since the Unix epoch into a UTC time.
+// The digits of a fraction beyond nanoseconds are dropped.
+func (r *Lexer) unixTime(digits int) time.Time {
+	s := r.number()
+	if !r.Ok() {
+		return time.Time{}
+	}
+	if strings.IndexAny(s, "eE") != -1 {
+		r.addNonfatalError(&LexerError{
+			Offset: r.start,
+			Reason: "number " + s + " has an exponent part, expected a Unix time",
+			Data:   s,
+		})
+		return time.Time{}
+	}
+
+	// The number is shifted to nanoseconds as a string of digits, and split into seconds.
+	n, frac := s, ""
+	if i := strings.IndexByte(s, '.'); i != -1 {
+		n, frac = s[:i], s[i+1:]
+	}
+	neg := n[0] == '-'
+	if neg {
+		n = n[1:]
+	}
+	if fracDigits := 9 - digits; len(frac) > fracDigits {
+		frac = frac[:fracDigits]
+	} else {
+		frac += "000000000"[:fracDigits-len(frac)]
+	}
+	n += frac
+	sec, nsec := "0", n
+	if len(n) > 9 {
+		sec, nsec = n[:len(n)-9], n[len(n)-9:]
+	}
+
+	secs, err := strconv.ParseInt(sec, 10, 64)
+	if err != nil {
+		r.addNonfatalError(&LexerError{
+			Offset: r.start,
+			Reason: err.Error(),
+			Data:   s,
+		})
+		return time.Time{}
+	}
+	nsecs, _ := strconv.ParseInt(nsec, 10, 64)
+	if neg {
+		secs, nsecs = -secs, -nsecs
+	}
+	return time.Unix(secs, nsecs).UTC()
+}
+
+// bigNumber reads a number or a string literal holding one for the math/big types, returning
+// false on null and errors.
+func (r *Lexer) bigNumber(what string) (string, bool) {
+	if r.token.kind == tokenUndef && r.Ok() {
+		r.FetchToken()
+	}
+	if !r.Ok() {
+		r.errInvalidToken(what)
+		return "", false
+	}
+
+	switch r.token.kind {
+	case tokenString:
+		s := r.String()
+		return s, r.Ok()
+	case tokenNumber:
+		return string(r.Raw()), r.Ok()
+	case tokenNull:
+		r.Null()
+		return "", false
+	default:
+		r.errInvalidToken(what)
+		return "", false
+	}
+}
+
+// bigError reports the number s that can not be parsed into the math/big type what.
+func (r *Lexer) bigError(what, s string) {
+	r.addNonfatalError(&LexerError{
+		Offset: r.start,
+		Reason: "invalid " + what,
+		Data:   s,
+	})
+}
+
+// BigInt reads a decimal integer, either a number or a string literal, into z. Like
+// big.Int.UnmarshalJSON, z is left unchanged on null.
+func (r *Lexer) BigInt(z *big.Int) {
+	s, ok := r.bigNumber("big.Int")
+	if !ok {
+		return
+	}
+	if _, ok := z.SetString(s, 10); !ok {
+		r.bigError("big.Int", s)
+	}
+}
+
+// BigFloat reads a floating-point number, either a number or a string literal in a format
+// accepted by big.Float.Parse, into z. Like big.Float.UnmarshalText, the precision of z is kept
+// unless it is 0, which is changed to 64. z is left unchanged on null.
+func (r *Lexer) BigFloat(z *big.Float) {
+	s, ok := r.bigNumber("big.Float")
+	if !ok {
+		return
+	}
+	if _, _, err := z.Parse(s, 0); err != nil {
+		r.bigError("big.Float", s)
+	}
+}
+
+// BigRat reads a rational number, either a number or a string literal in a format accepted by
+// big.Rat.SetString such as "3/4", into z. z is left unchanged on null.
+func (r *Lexer) BigRat(z *big.Rat) {
+	s, ok := r.bigNumber("big.Rat")
+	if !ok {
+		return
+	}
+	if _, ok := z.SetString(s); !ok {
+		r.bigError("big.Rat", s)
+	}
+}
+
 // Bool reads a true or false boolean keyword.
 func (r *Lexer) Bool() bool {
 	if r.token.kind == tokenUndef && r.Ok() {
@@ -739,6 +1152,26 @@ func (r *Lexer) Bool() bool {
 	return ret
 }
 
+// BoolStr reads a "true" or "false" string literal, as written for the 'string' tag option.
+func (r *Lexer) BoolStr() bool {
+	s, b := r.unsafeString(false)
+	if !r.Ok() {
+		return false
+	}
+	switch s {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	r.addNonfatalError(&LexerError{
+		Offset: r.start,
+		Reason: "invalid bool string",
+		Data:   string(b),
+	})
+	return false
+}
+
 func (r *Lexer) number() string {
 	if r.token.kind == tokenUndef && r.Ok() {
 		r.FetchToken()
@@ -752,9 +1185,78 @@ func (r *Lexer) number() string {
 	return ret
 }
 
-func (r *Lexer) Uint8() uint8 {
+// intNumber fetches a number literal for an integer type. With IntegralFloats, numbers with
+// a fraction or exponent part are converted to plain integers if they are integral. It
+// returns false if a valid integer literal can not be returned.
+func (r *Lexer) intNumber() (string, bool) {
 	s := r.number()
 	if !r.Ok() {
+		return "", false
+	}
+	if !r.IntegralFloats && !r.NoIntegerExponents || strings.IndexAny(s, ".eE") == -1 {
+		return s, true
+	}
+
+	reason := "number " + s + " is not an integer"
+	if r.NoIntegerExponents {
+		reason = "number " + s + " has a fraction or exponent part, expected an integer"
+	} else if n, ok := integralNumber(s); ok {
+		return n, true
+	}
+	r.addNonfatalError(&LexerError{
+		Offset: r.start,
+		Reason: reason,
+		Data:   s,
+	})
+	return "", false
+}
+
+// integralNumber converts a number literal with a fraction or exponent part to a plain
+// integer literal, returning false if the number is not integral. Huge exponents are capped,
+// as the result is out of range for any integer type then anyway.
+func integralNumber(s string) (string, bool) {
+	sign := ""
+	if s[0] == '-' {
+		sign, s = "-", s[1:]
+	}
+
+	exp := 0
+	if i := strings.IndexAny(s, "eE"); i != -1 {
+		e, err := strconv.Atoi(strings.TrimPrefix(s[i+1:], "+"))
+		if err != nil {
+			// The exponent does not fit into int, so it is huge either way.
+			e = 1 << 20
+			if s[i+1] == '-' {
+				e = -e
+			}
+		}
+		s, exp = s[:i], e
+	}
+
+	digits := s
+	if i := strings.IndexByte(s, '.'); i != -1 {
+		digits = s[:i] + s[i+1:]
+		exp -= len(s) - i - 1
+	}
+	digits = strings.TrimLeft(digits, "0")
+
+	switch {
+	case digits == "":
+		return "0", true
+	case exp >= 0:
+		if exp > 40 {
+			exp = 40
+		}
+		return sign + digits + strings.Repeat("0", exp), true
+	case -exp >= len(digits) || strings.TrimRight(digits[len(digits)+exp:], "0") != "":
+		return "", false
+	}
+	return sign + digits[:len(digits)+exp], true
+}
+
+func (r *Lexer) Uint8() uint8 {
+	s, ok := r.intNumber()
+	if !ok {
 		return 0
 	}
 
@@ -770,8 +1272,8 @@ func (r *Lexer) Uint8() uint8 {
 }
 
 func (r *Lexer) Uint16() uint16 {
-	s := r.number()
-	if !r.Ok() {
+	s, ok := r.intNumber()
+	if !ok {
 		return 0
 	}
 
@@ -787,8 +1289,8 @@ func (r *Lexer) Uint16() uint16 {
 }
 
 func (r *Lexer) Uint32() uint32 {
-	s := r.number()
-	if !r.Ok() {
+	s, ok := r.intNumber()
+	if !ok {
 		return 0
 	}
 
@@ -804,8 +1306,8 @@ func (r *Lexer) Uint32() uint32 {
 }
 
 func (r *Lexer) Uint64() uint64 {
-	s := r.number()
-	if !r.Ok() {
+	s, ok := r.intNumber()
+	if !ok {
 		return 0
 	}
 
@@ -825,8 +1327,8 @@ func (r *Lexer) Uint() uint {
 }
 
 func (r *Lexer) Int8() int8 {
-	s := r.number()
-	if !r.Ok() {
+	s, ok := r.intNumber()
+	if !ok {
 		return 0
 	}
 
@@ -842,8 +1344,8 @@ func (r *Lexer) Int8() int8 {
 }
 
 func (r *Lexer) Int16() int16 {
-	s := r.number()
-	if !r.Ok() {
+	s, ok := r.intNumber()
+	if !ok {
 		return 0
 	}
 
@@ -859,8 +1361,8 @@ func (r *Lexer) Int16() int16 {
 }
 
 func (r *Lexer) Int32() int32 {
-	s := r.number()
-	if !r.Ok() {
+	s, ok := r.intNumber()
+	if !ok {
 		return 0
 	}
 
@@ -876,8 +1378,8 @@ func (r *Lexer) Int32() int32 {
 }
 
 func (r *Lexer) Int64() int64 {
-	s := r.number()
-	if !r.Ok() {
+	s, ok := r.intNumber()
+	if !ok {
 		return 0
 	}
 
@@ -1128,6 +1630,36 @@ func (r *Lexer) AddNonFatalError(e error) {
 	})
 }
 
+// AddValueError records the error of the value just consumed, e.g. the one returned by the
+// UnmarshalJSON method of its type. With UseMultipleErrors it is a non-fatal error, so that
+// decoding goes on with the next value, otherwise it is added like with AddError. nil errors are
+// ignored.
+func (r *Lexer) AddValueError(e error) {
+	if e == nil {
+		return
+	}
+	if !r.UseMultipleErrors {
+		r.AddError(e)
+		return
+	}
+	r.AddNonFatalError(e)
+}
+
+// ValueStart returns the offset of the next value, for ValueSkipped once it is decoded.
+func (r *Lexer) ValueStart() int {
+	if r.token.kind == tokenUndef && r.Ok() {
+		r.FetchToken()
+	}
+	return r.start
+}
+
+// ValueSkipped reports whether the value at offset, as returned by ValueStart, was skipped for an
+// error with UseMultipleErrors, e.g. so that it is not added to a map as a zero entry.
+func (r *Lexer) ValueSkipped(offset int) bool {
+	n := len(r.multipleErrors)
+	return n != 0 && r.multipleErrors[n-1].Offset == offset
+}
+
 func (r *Lexer) addNonfatalError(err *LexerError) {
 	if r.UseMultipleErrors {
 		// We don't want to add errors with the same offset.
@@ -1182,6 +1714,9 @@ func (r *Lexer) Interface() interface{} {
 	case tokenString:
 		return r.String()
 	case tokenNumber:
+		if r.UseInt64 {
+			return r.integer()
+		}
 		return r.Float64()
 	case tokenBool:
 		return r.Bool()
@@ -1195,7 +1730,9 @@ func (r *Lexer) Interface() interface{} {
 
 		ret := map[string]interface{}{}
 		for !r.IsDelim('}') {
+			r.isKey = true
 			key := r.String()
+			r.isKey = false
 			r.WantColon()
 			ret[key] = r.Interface()
 			r.WantComma()
@@ -1227,6 +1764,21 @@ func (r *Lexer) Interface() interface{} {
 	return nil
 }
 
+// integer reads a number literal as int64 if it is an integer, as json.Number if the integer
+// does not fit into int64 and as float64 otherwise.
+func (r *Lexer) integer() interface{} {
+	data := r.token.byteValue
+	if bytes.IndexAny(data, ".eE") != -1 {
+		return r.Float64()
+	}
+
+	n, err := strconv.ParseInt(r.number(), 10, 64)
+	if err != nil {
+		return json.Number(string(data))
+	}
+	return n
+}
+
 // WantComma requires a comma to be present before fetching next token.
 func (r *Lexer) WantComma() {
 	r.wantSep = ','
@@ -3,8 +3,12 @@ package jlexer
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
+	"math/big"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestString(t *testing.T) {
@@ -109,6 +113,111 @@ func TestBytes(t *testing.T) {
 	}
 }
 
+func TestBytesFormats(t *testing.T) {
+	for i, test := range []struct {
+		toParse     string
+		wantURL     []byte
+		wantBase16  []byte
+		urlError    bool
+		base16Error bool
+	}{
+		{toParse: `"-_8="`, wantURL: []byte{0xfb, 0xff}, base16Error: true},
+		{toParse: `"00ff"`, wantURL: []byte{0xd3, 0x47, 0xdf}, wantBase16: []byte{0, 0xff}},
+		{toParse: `""`, wantURL: []byte{}, wantBase16: []byte{}},
+
+		{toParse: `"+/8="`, urlError: true, base16Error: true},                    // standard alphabet
+		{toParse: `"0x00"`, wantURL: []byte{0xd3, 0x1d, 0x34}, base16Error: true}, // 0x prefix
+		{toParse: `12`, urlError: true, base16Error: true},                        // not a JSON string
+	} {
+		l := Lexer{Data: []byte(test.toParse)}
+		if got := l.BytesBase64URL(); !bytes.Equal(got, test.wantURL) || (l.Error() != nil) != test.urlError {
+			t.Errorf("[%d, %q] BytesBase64URL() = %v, %v; want %v", i, test.toParse, got, l.Error(), test.wantURL)
+		}
+
+		l = Lexer{Data: []byte(test.toParse)}
+		if got := l.BytesBase16(); !bytes.Equal(got, test.wantBase16) || (l.Error() != nil) != test.base16Error {
+			t.Errorf("[%d, %q] BytesBase16() = %v, %v; want %v", i, test.toParse, got, l.Error(), test.wantBase16)
+		}
+	}
+}
+
+func TestHex(t *testing.T) {
+	for i, test := range []struct {
+		toParse    string
+		wantUint   uint64
+		wantBytes  []byte
+		uintError  bool
+		bytesError bool
+	}{
+		{toParse: `"0x1f"`, wantUint: 0x1f, wantBytes: []byte{0x1f}},
+		{toParse: `"0X00ff"`, wantUint: 0xff, wantBytes: []byte{0, 0xff}},
+		{toParse: `"0x"`, wantBytes: []byte{}, uintError: true},
+		{toParse: `"0x1"`, wantUint: 1, bytesError: true}, // odd number of digits
+
+		{toParse: `31`, uintError: true, bytesError: true},     // not a JSON string
+		{toParse: `"1f"`, uintError: true, bytesError: true},   // no 0x prefix
+		{toParse: `"0xfg"`, uintError: true, bytesError: true}, // not hex digits
+	} {
+		l := Lexer{Data: []byte(test.toParse)}
+		if got := l.Uint64Hex(); got != test.wantUint || (l.Error() != nil) != test.uintError {
+			t.Errorf("[%d, %q] Uint64Hex() = %v, %v; want %v", i, test.toParse, got, l.Error(), test.wantUint)
+		}
+
+		l = Lexer{Data: []byte(test.toParse)}
+		if got := l.BytesHex(); !bytes.Equal(got, test.wantBytes) || (l.Error() != nil) != test.bytesError {
+			t.Errorf("[%d, %q] BytesHex() = %v, %v; want %v", i, test.toParse, got, l.Error(), test.wantBytes)
+		}
+	}
+}
+
+func TestBytesHexInto(t *testing.T) {
+	for i, test := range []struct {
+		toParse string
+		want    [2]byte
+		wantErr bool
+	}{
+		{toParse: `"0x00ff"`, want: [2]byte{0, 0xff}},
+		{toParse: `"0x00"`, want: [2]byte{1, 2}, wantErr: true},
+		{toParse: `"0x000102"`, want: [2]byte{1, 2}, wantErr: true},
+		{toParse: `"0xzz"`, want: [2]byte{1, 2}, wantErr: true},
+	} {
+		l := Lexer{Data: []byte(test.toParse)}
+		got := [2]byte{1, 2}
+		if l.BytesHexInto(got[:]); got != test.want || (l.Error() != nil) != test.wantErr {
+			t.Errorf("[%d, %q] BytesHexInto() = %v, %v; want %v", i, test.toParse, got, l.Error(), test.want)
+		}
+	}
+}
+
+func TestUnixTime(t *testing.T) {
+	for i, test := range []struct {
+		toParse string
+		read    func(*Lexer) time.Time
+		want    time.Time
+		wantErr bool
+	}{
+		{toParse: `1700000000`, read: (*Lexer).UnixTime, want: time.Unix(1700000000, 0)},
+		{toParse: `1700000000.5`, read: (*Lexer).UnixTime, want: time.Unix(1700000000, 5e8)},
+		{toParse: `1700000000.1234567891`, read: (*Lexer).UnixTime, want: time.Unix(1700000000, 123456789)},
+		{toParse: `-1.5`, read: (*Lexer).UnixTime, want: time.Unix(-2, 5e8)},
+		{toParse: `0.000000001`, read: (*Lexer).UnixTime, want: time.Unix(0, 1)},
+		{toParse: `1700000000123.25`, read: (*Lexer).UnixMilliTime, want: time.Unix(1700000000, 123250000)},
+		{toParse: `-1`, read: (*Lexer).UnixMilliTime, want: time.Unix(0, -1e6)},
+		{toParse: `1700000000123456.5`, read: (*Lexer).UnixMicroTime, want: time.Unix(1700000000, 123456500)},
+		{toParse: `1700000000123456789.9`, read: (*Lexer).UnixNanoTime, want: time.Unix(1700000000, 123456789)},
+
+		{toParse: `1e3`, read: (*Lexer).UnixTime, wantErr: true},
+		{toParse: `"1"`, read: (*Lexer).UnixTime, wantErr: true},
+		{toParse: `99999999999999999999`, read: (*Lexer).UnixTime, wantErr: true},
+	} {
+		l := Lexer{Data: []byte(test.toParse)}
+		got := test.read(&l)
+		if err := l.Error(); (err != nil) != test.wantErr || !test.wantErr && !got.Equal(test.want) {
+			t.Errorf("[%d, %q] = %v, %v; want %v", i, test.toParse, got, err, test.want)
+		}
+	}
+}
+
 func TestNumber(t *testing.T) {
 	for i, test := range []struct {
 		toParse   string
@@ -223,6 +332,59 @@ func TestSkipRecursive(t *testing.T) {
 	}
 }
 
+func TestRaw(t *testing.T) {
+	for _, test := range []struct {
+		toParse   string
+		raw       string
+		untrimmed string
+		left      string
+	}{
+		{toParse: `5`, raw: `5`, untrimmed: `5`},
+		{toParse: " \"a\\u0041\" \n", raw: `"a\u0041"`, untrimmed: " \"a\\u0041\" \n"},
+		{toParse: " [1, {\"b\": 2}]\t, 4", raw: `[1, {"b": 2}]`, untrimmed: " [1, {\"b\": 2}]\t", left: ", 4"},
+		{toParse: `{"a":1}, 4`, raw: `{"a":1}`, untrimmed: `{"a":1}`, left: ", 4"},
+	} {
+		l := Lexer{Data: []byte(test.toParse)}
+		if got := string(l.Raw()); got != test.raw || l.Error() != nil {
+			t.Errorf("Raw(%q) = %q, %v; want %q", test.toParse, got, l.Error(), test.raw)
+		}
+
+		l = Lexer{Data: []byte(test.toParse)}
+		got := string(l.RawUntrimmed())
+		if got != test.untrimmed || l.Error() != nil {
+			t.Errorf("RawUntrimmed(%q) = %q, %v; want %q", test.toParse, got, l.Error(), test.untrimmed)
+		}
+		if left := string(l.Data[l.pos:]); left != test.left {
+			t.Errorf("RawUntrimmed(%q) left %q; want %q", test.toParse, left, test.left)
+		}
+	}
+
+	// the whitespace around a member value stops at the colon and the comma
+	l := Lexer{Data: []byte(`{"a": [1, 2]` + "\n" + `, "b":3}`)}
+	l.Delim('{')
+	_ = l.UnsafeFieldName(false)
+	l.WantColon()
+	if got, want := string(l.RawUntrimmed()), " [1, 2]\n"; got != want {
+		t.Errorf("RawUntrimmed() of a member = %q; want %q", got, want)
+	}
+	l.WantComma()
+	_ = l.UnsafeFieldName(false)
+	l.WantColon()
+	if got, want := string(l.Raw()), "3"; got != want {
+		t.Errorf("Raw() of the next member = %q; want %q", got, want)
+	}
+	l.WantComma()
+	l.Delim('}')
+	l.Consumed()
+	if err := l.Error(); err != nil {
+		t.Errorf("Lexer error after RawUntrimmed(): %v", err)
+	}
+
+	if raw := (&Lexer{Data: []byte(`[1`)}).Raw(); raw != nil {
+		t.Errorf("Raw() of an unterminated array = %q; want nil", raw)
+	}
+}
+
 func TestInterface(t *testing.T) {
 	for i, test := range []struct {
 		toParse   string
@@ -268,6 +430,161 @@ func TestInterface(t *testing.T) {
 	}
 }
 
+func TestInterfaceUseInt64(t *testing.T) {
+	for i, test := range []struct {
+		toParse string
+		want    interface{}
+	}{
+		{toParse: "5", want: int64(5)},
+		{toParse: "-0", want: int64(0)},
+		{toParse: "9007199254740993", want: int64(9007199254740993)},
+		{toParse: "-9223372036854775808", want: int64(-9223372036854775808)},
+		{toParse: "9223372036854775808", want: json.Number("9223372036854775808")},
+		{toParse: "5.0", want: float64(5)},
+		{toParse: "1e3", want: float64(1000)},
+		{toParse: `{"a":[1,1.5]}`, want: map[string]interface{}{"a": []interface{}{int64(1), 1.5}}},
+	} {
+		l := Lexer{Data: []byte(test.toParse), UseInt64: true}
+
+		got := l.Interface()
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("[%d, %q] Interface() = %#v; want %#v", i, test.toParse, got, test.want)
+		}
+		if err := l.Error(); err != nil {
+			t.Errorf("[%d, %q] Interface() error: %v", i, test.toParse, err)
+		}
+	}
+}
+
+func TestIntegralFloats(t *testing.T) {
+	for i, test := range []struct {
+		toParse     string
+		noExponents bool
+		want        int64
+		wantError   string
+	}{
+		{toParse: "3", want: 3},
+		{toParse: "3.0", want: 3},
+		{toParse: "-1.50e1", want: -15},
+		{toParse: "1e3", want: 1000},
+		{toParse: "1E+3", want: 1000},
+		{toParse: "100e-2", want: 1},
+		{toParse: "0.0e-5", want: 0},
+		{toParse: "-0.000", want: 0},
+		{toParse: "3.5", wantError: "number 3.5 is not an integer"},
+		{toParse: "1e-3", wantError: "number 1e-3 is not an integer"},
+		{toParse: "0.5", wantError: "number 0.5 is not an integer"},
+		{toParse: "1e19", wantError: "value out of range"},
+		{toParse: "1e99999999999999999999", wantError: "value out of range"},
+		{toParse: "3.0", noExponents: true, wantError: "number 3.0 has a fraction or exponent part, expected an integer"},
+		{toParse: "1e3", noExponents: true, wantError: "number 1e3 has a fraction or exponent part, expected an integer"},
+		{toParse: "7", noExponents: true, want: 7},
+	} {
+		l := Lexer{Data: []byte(test.toParse), IntegralFloats: true, NoIntegerExponents: test.noExponents}
+
+		got := l.Int64()
+		err := l.Error()
+		if test.wantError != "" {
+			if err == nil || !strings.Contains(err.Error(), test.wantError) {
+				t.Errorf("[%d, %q] Int64() error: %v; want %q", i, test.toParse, err, test.wantError)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("[%d, %q] Int64() error: %v", i, test.toParse, err)
+		}
+		if got != test.want {
+			t.Errorf("[%d, %q] Int64() = %v; want %v", i, test.toParse, got, test.want)
+		}
+	}
+
+	l := Lexer{Data: []byte("2e2"), IntegralFloats: true}
+	if got := l.Uint8(); got != 200 || l.Error() != nil {
+		t.Errorf("Uint8() = %v, %v; want 200", got, l.Error())
+	}
+	for _, data := range []string{"3.5", "3.0", "1e3"} {
+		l = Lexer{Data: []byte(data)}
+		if l.Int(); l.Error() == nil {
+			t.Errorf("Int() of %v without IntegralFloats succeeded; want error", data)
+		}
+	}
+
+	// NoIntegerExponents works on its own.
+	l = Lexer{Data: []byte("3.0"), NoIntegerExponents: true}
+	want := "number 3.0 has a fraction or exponent part, expected an integer"
+	if l.Int(); l.Error() == nil || !strings.Contains(l.Error().Error(), want) {
+		t.Errorf("Int() error: %v; want %q", l.Error(), want)
+	}
+}
+
+func TestHook(t *testing.T) {
+	var got []string
+	l := Lexer{
+		Data: []byte(`{"a": [1, "x"], "b": {"c": null}, "d": true}`),
+		Hook: HookFunc(func(kind EventKind, offset int, data []byte) {
+			got = append(got, fmt.Sprintf("%v@%d:%s", kind, offset, data))
+		}),
+	}
+
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "a":
+			l.Delim('[')
+			l.Int()
+			l.WantComma()
+			l.Skip()
+			l.WantComma()
+			l.Delim(']')
+		case "d":
+			l.Bool()
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	l.Consumed()
+	if err := l.Error(); err != nil {
+		t.Fatalf("decoding error: %v", err)
+	}
+
+	want := []string{
+		`ObjectStart@0:{`,
+		`Key@1:"a"`,
+		`ArrayStart@6:[`,
+		`Value@7:1`,
+		`Value@10:"x"`,
+		`ArrayEnd@13:]`,
+		`Key@16:"b"`,
+		`Value@21:{"c": null}`,
+		`Key@34:"d"`,
+		`Value@39:true`,
+		`ObjectEnd@43:}`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got events %q; want %q", got, want)
+	}
+}
+
+func TestHookInterface(t *testing.T) {
+	var got []EventKind
+	l := Lexer{
+		Data: []byte(`{"a":[null]}`),
+		Hook: HookFunc(func(kind EventKind, offset int, data []byte) {
+			got = append(got, kind)
+		}),
+	}
+	l.Interface()
+
+	want := []EventKind{EventObjectStart, EventKey, EventArrayStart, EventValue, EventArrayEnd, EventObjectEnd}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got events %v; want %v", got, want)
+	}
+}
+
 func TestConsumed(t *testing.T) {
 	for i, test := range []struct {
 		toParse   string
@@ -292,6 +609,53 @@ func TestConsumed(t *testing.T) {
 	}
 }
 
+func TestCheckValid(t *testing.T) {
+	for i, test := range []struct {
+		toParse   string
+		wantError bool
+	}{
+		{toParse: `{"a":[1,"b",null]}`, wantError: false},
+		{toParse: ` 1 `, wantError: false},
+
+		{toParse: ``, wantError: true},
+		{toParse: `{"a":1,}`, wantError: true},
+		{toParse: `{"a":1} {}`, wantError: true},
+		{toParse: "\"raw\tcontrol\"", wantError: true},
+	} {
+		l := Lexer{Data: []byte(test.toParse)}
+		l.CheckValid()
+
+		err := l.Error()
+		if err != nil && !test.wantError {
+			t.Errorf("[%d, %q] CheckValid() error: %v", i, test.toParse, err)
+		} else if err == nil && test.wantError {
+			t.Errorf("[%d, %q] CheckValid() ok; want error", i, test.toParse)
+		}
+	}
+}
+
+func TestStringCompat(t *testing.T) {
+	for i, test := range []struct {
+		toParse string
+		want    string
+	}{
+		{toParse: `"simple string"`, want: "simple string"},
+		{toParse: "\"invalid \xff\xfe\"", want: "invalid \ufffd\ufffd"},
+		{toParse: "\"cut \xe2\x82\"", want: "cut \ufffd\ufffd"},
+		{toParse: `"\ud800"`, want: "\ufffd"},
+	} {
+		l := Lexer{Data: []byte(test.toParse)}
+
+		got := l.StringCompat()
+		if got != test.want {
+			t.Errorf("[%d, %q] StringCompat() = %q; want %q", i, test.toParse, got, test.want)
+		}
+		if err := l.Error(); err != nil {
+			t.Errorf("[%d, %q] StringCompat() error: %v", i, test.toParse, err)
+		}
+	}
+}
+
 func TestJsonNumber(t *testing.T) {
 	for i, test := range []struct {
 		toParse        string
@@ -354,6 +718,101 @@ func TestJsonNumber(t *testing.T) {
 	}
 }
 
+func TestUUID(t *testing.T) {
+	const id = "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+	for i, test := range []struct {
+		toParse   string
+		want      string
+		wantError bool
+	}{
+		{toParse: `"` + id + `"`, want: id},
+		{toParse: `"F47AC10B-58CC-4372-A567-0E02B2C3D479"`, want: id},
+		{toParse: `"urn:uuid:` + id + `"`, want: id},
+		{toParse: `"{` + id + `}"`, want: id},
+		{toParse: `"f47ac10b58cc4372a5670e02b2c3d479"`, want: id},
+		{toParse: `null`, want: "01000000-0000-0000-0000-000000000000"},
+		{toParse: `"f47ac10b-58cc-4372-a567-0e02b2c3d47"`, wantError: true},
+		{toParse: `"f47ac10b-58cc-4372-a567_0e02b2c3d479"`, wantError: true},
+		{toParse: `"x47ac10b-58cc-4372-a567-0e02b2c3d479"`, wantError: true},
+		{toParse: `""`, wantError: true},
+		{toParse: `1`, wantError: true},
+	} {
+		u := [16]byte{1}
+		l := Lexer{Data: []byte(test.toParse)}
+		l.UUID(&u)
+		err := l.Error()
+
+		if (err != nil) != test.wantError {
+			t.Errorf("[%d, %q] UUID() error: %v; want error %v", i, test.toParse, err, test.wantError)
+		}
+		if got := fmt.Sprintf("%x-%x-%x-%x-%x", u[:4], u[4:6], u[6:8], u[8:10], u[10:]); err == nil && got != test.want {
+			t.Errorf("[%d, %q] UUID() = %v; want %v", i, test.toParse, got, test.want)
+		}
+	}
+}
+
+func TestBigNumbers(t *testing.T) {
+	for i, test := range []struct {
+		toParse   string
+		want      string
+		wantError bool
+	}{
+		{toParse: `123456789012345678901234567890`, want: "123456789012345678901234567890/123456789012345678901234567890/1.23456789e+29"},
+		{toParse: `"-42"`, want: "-42/-42/-42"},
+		{toParse: `0.25`, want: "/1/4/0.25", wantError: true},
+		{toParse: `"3/4"`, want: "/3/4/", wantError: true},
+		{toParse: `1e3`, want: "/1000/1000", wantError: true},
+		{toParse: `null`, want: "7/7/7"},
+		{toParse: `true`, want: "//", wantError: true},
+		{toParse: `[1]`, want: "//", wantError: true},
+	} {
+		var got []string
+
+		n := big.NewInt(7)
+		l := Lexer{Data: []byte(test.toParse)}
+		l.BigInt(n)
+		err := l.Error()
+		if l.Ok() {
+			got = append(got, n.String())
+		} else {
+			got = append(got, "")
+		}
+
+		r := big.NewRat(7, 1)
+		l = Lexer{Data: []byte(test.toParse)}
+		l.BigRat(r)
+		if err == nil {
+			err = l.Error()
+		}
+		if l.Ok() {
+			got = append(got, r.RatString())
+		} else {
+			got = append(got, "")
+		}
+
+		f := big.NewFloat(7).SetPrec(30)
+		l = Lexer{Data: []byte(test.toParse)}
+		l.BigFloat(f)
+		if err == nil {
+			err = l.Error()
+		}
+		if l.Ok() {
+			got = append(got, f.Text('g', -1))
+		} else {
+			got = append(got, "")
+		}
+
+		if s := strings.Join(got, "/"); s != test.want {
+			t.Errorf("[%d, %q] BigInt/BigRat/BigFloat() = %v; want %v", i, test.toParse, s, test.want)
+		}
+		if err != nil && !test.wantError {
+			t.Errorf("[%d, %q] BigInt/BigRat/BigFloat() error: %v", i, test.toParse, err)
+		} else if err == nil && test.wantError {
+			t.Errorf("[%d, %q] BigInt/BigRat/BigFloat() ok; want error", i, test.toParse)
+		}
+	}
+}
+
 func TestFetchStringUnterminatedString(t *testing.T) {
 	for _, test := range []struct {
 		data []byte
@@ -372,3 +831,48 @@ func TestFetchStringUnterminatedString(t *testing.T) {
 		}
 	}
 }
+
+// adversarialStrings are string literals with dense or long runs of escape sequences.
+var adversarialStrings = map[string]string{
+	"plain":        `"` + strings.Repeat("a", 1<<16) + `"`,
+	"quotes":       `"` + strings.Repeat(`\"`, 1<<15) + `"`,
+	"backslashes":  `"` + strings.Repeat(`\\`, 1<<15) + `"`,
+	"backslashRun": `"` + strings.Repeat(`\\`, 1<<14) + `\"` + strings.Repeat("a", 1<<15) + `"`,
+	"unicode":      `"` + strings.Repeat(`é😀`, 1<<12) + `"`,
+	"sparse":       `"` + strings.Repeat(strings.Repeat("a", 63)+`\n`, 1<<10) + `"`,
+}
+
+func TestStringAdversarial(t *testing.T) {
+	for name, in := range adversarialStrings {
+		var want string
+		if err := json.Unmarshal([]byte(in), &want); err != nil {
+			t.Fatalf("%v: json.Unmarshal() error: %v", name, err)
+		}
+
+		l := Lexer{Data: []byte(in + ` "next"`)}
+		if got := l.String(); got != want {
+			t.Errorf("%v: String() differs from encoding/json", name)
+		}
+		if got := l.String(); got != "next" {
+			t.Errorf("%v: String() after the literal = %q; want \"next\"", name, got)
+		}
+		if err := l.Error(); err != nil {
+			t.Errorf("%v: error: %v", name, err)
+		}
+	}
+}
+
+func BenchmarkStringAdversarial(b *testing.B) {
+	for name, in := range adversarialStrings {
+		data := []byte(in)
+		b.Run(name, func(b *testing.B) {
+			b.SetBytes(int64(len(data)))
+			for i := 0; i < b.N; i++ {
+				l := Lexer{Data: data}
+				if _ = l.String(); l.Error() != nil {
+					b.Fatal(l.Error())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+//go:build go1.18
+// +build go1.18
+
+package jlexer
+
+import (
+	"bytes"
+	"net"
+	"net/netip"
+)
+
+// ipText returns the bytes of a string literal with an IP address or prefix, or false if the
+// literal is null or invalid.
+func (r *Lexer) ipText() ([]byte, bool) {
+	if r.token.kind == tokenUndef && r.Ok() {
+		r.FetchToken()
+	}
+	if r.Ok() && r.token.kind == tokenNull {
+		r.Null()
+		return nil, false
+	}
+	b := r.UnsafeBytes()
+	return b, r.Ok()
+}
+
+// ipError reports an invalid IP address or prefix s.
+func (r *Lexer) ipError(what string, s []byte) {
+	r.addNonfatalError(&LexerError{
+		Offset: r.start,
+		Reason: "invalid " + what,
+		Data:   string(s),
+	})
+}
+
+// IP reads a string literal with an IPv4 or IPv6 address into ip like net.IP.UnmarshalText,
+// setting it to nil for an empty string. ip is left unchanged on null.
+func (r *Lexer) IP(ip *net.IP) {
+	b, ok := r.ipText()
+	if !ok {
+		return
+	}
+	if len(b) == 0 {
+		*ip = nil
+		return
+	}
+	v := net.ParseIP(bytesToStr(b))
+	if v == nil {
+		r.ipError("IP address", b)
+		return
+	}
+	*ip = v
+}
+
+// IPAddr reads a string literal with an IP address into addr like netip.Addr.UnmarshalText,
+// setting it to the zero Addr for an empty string. addr is left unchanged on null.
+func (r *Lexer) IPAddr(addr *netip.Addr) {
+	b, ok := r.ipText()
+	if !ok {
+		return
+	}
+	if len(b) == 0 {
+		*addr = netip.Addr{}
+		return
+	}
+	s := bytesToStr(b)
+	if bytes.IndexByte(b, '%') >= 0 {
+		// The zone is kept by the Addr.
+		s = string(b)
+	}
+	v, err := netip.ParseAddr(s)
+	if err != nil {
+		r.ipError("IP address", b)
+		return
+	}
+	*addr = v
+}
+
+// IPPrefix reads a string literal with an IP prefix into p like netip.Prefix.UnmarshalText,
+// setting it to the zero Prefix for an empty string. p is left unchanged on null.
+func (r *Lexer) IPPrefix(p *netip.Prefix) {
+	b, ok := r.ipText()
+	if !ok {
+		return
+	}
+	if len(b) == 0 {
+		*p = netip.Prefix{}
+		return
+	}
+	v, err := netip.ParsePrefix(bytesToStr(b))
+	if err != nil {
+		r.ipError("IP prefix", b)
+		return
+	}
+	*p = v
+}
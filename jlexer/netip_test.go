@@ -0,0 +1,60 @@
+//go:build go1.18
+// +build go1.18
+
+package jlexer
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+)
+
+func TestIP(t *testing.T) {
+	for i, test := range []struct {
+		toParse string
+		want    string
+	}{
+		{toParse: `"192.0.2.1"`, want: "192.0.2.1/192.0.2.1/"},
+		{toParse: `"2001:db8::1"`, want: "2001:db8::1/2001:db8::1/"},
+		{toParse: `"10.0.0.0/8"`, want: "//10.0.0.0/8"},
+		{toParse: `"fe80::1%eth0"`, want: "/fe80::1%eth0/"},
+		{toParse: `""`, want: "<nil>/invalid IP/invalid Prefix"},
+		{toParse: `null`, want: "127.0.0.1/127.0.0.1/127.0.0.0/8"},
+		{toParse: `"x"`, want: "//"},
+		{toParse: `1`, want: "//"},
+	} {
+		// An empty result means an error.
+		var got []string
+
+		ip := net.IPv4(127, 0, 0, 1)
+		l := Lexer{Data: []byte(test.toParse)}
+		l.IP(&ip)
+		if l.Ok() {
+			got = append(got, ip.String())
+		} else {
+			got = append(got, "")
+		}
+
+		addr := netip.MustParseAddr("127.0.0.1")
+		l = Lexer{Data: []byte(test.toParse)}
+		l.IPAddr(&addr)
+		if l.Ok() {
+			got = append(got, addr.String())
+		} else {
+			got = append(got, "")
+		}
+
+		p := netip.MustParsePrefix("127.0.0.0/8")
+		l = Lexer{Data: []byte(test.toParse)}
+		l.IPPrefix(&p)
+		if l.Ok() {
+			got = append(got, p.String())
+		} else {
+			got = append(got, "")
+		}
+
+		if s := got[0] + "/" + got[1] + "/" + got[2]; s != test.want {
+			t.Errorf("[%d, %q] IP, IPAddr, IPPrefix = %v; want %v", i, test.toParse, s, test.want)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package jlexer
+
+import (
+	"errors"
+	"time"
+)
+
+// Bounds of the protobuf Timestamp and Duration values, the years 1 to 9999 and about 10000
+// years.
+const (
+	minProtoTimestampSeconds = -62135596800
+	maxProtoTimestampSeconds = 253402300799
+	maxProtoDurationSeconds  = 315576000000
+)
+
+// ProtoTimestamp reads the canonical JSON mapping of a protobuf Timestamp, an RFC 3339 string
+// with up to 9 fractional digits and either "Z" or an offset, into its seconds and nanoseconds
+// since the Unix epoch.
+func (r *Lexer) ProtoTimestamp() (seconds int64, nanos int32) {
+	s := r.String()
+	if !r.Ok() {
+		return 0, 0
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err == nil && (t.Unix() < minProtoTimestampSeconds || t.Unix() > maxProtoTimestampSeconds) {
+		err = errProtoRange
+	}
+	if err != nil {
+		r.addNonfatalError(&LexerError{
+			Offset: r.start,
+			Reason: "invalid protobuf Timestamp: " + err.Error(),
+			Data:   s,
+		})
+		return 0, 0
+	}
+	return t.Unix(), int32(t.Nanosecond())
+}
+
+// ProtoDuration reads the canonical JSON mapping of a protobuf Duration, a string of the
+// seconds with up to 9 fractional digits and the suffix "s", e.g. "-1.5s", into its seconds and
+// nanoseconds, of the same sign.
+func (r *Lexer) ProtoDuration() (seconds int64, nanos int32) {
+	s := r.String()
+	if !r.Ok() {
+		return 0, 0
+	}
+
+	seconds, nanos, ok := parseProtoDuration(s)
+	if !ok {
+		r.addNonfatalError(&LexerError{
+			Offset: r.start,
+			Reason: "invalid protobuf Duration",
+			Data:   s,
+		})
+		return 0, 0
+	}
+	return seconds, nanos
+}
+
+var errProtoRange = errors.New("out of range")
+
+// parseProtoDuration parses the string of a protobuf Duration.
+func parseProtoDuration(s string) (seconds int64, nanos int32, ok bool) {
+	if len(s) < 2 || s[len(s)-1] != 's' {
+		return 0, 0, false
+	}
+	s = s[:len(s)-1]
+	neg := s[0] == '-'
+	if neg {
+		s = s[1:]
+	}
+
+	i := 0
+	for ; i < len(s) && s[i] >= '0' && s[i] <= '9'; i++ {
+		seconds = seconds*10 + int64(s[i]-'0')
+		if seconds > maxProtoDurationSeconds {
+			return 0, 0, false
+		}
+	}
+	if i == 0 {
+		return 0, 0, false
+	}
+	if i < len(s) {
+		frac := s[i+1:]
+		if s[i] != '.' || len(frac) == 0 || len(frac) > 9 {
+			return 0, 0, false
+		}
+		for j := 0; j < 9; j++ {
+			nanos *= 10
+			if j >= len(frac) {
+				continue
+			}
+			if frac[j] < '0' || frac[j] > '9' {
+				return 0, 0, false
+			}
+			nanos += int32(frac[j] - '0')
+		}
+	}
+
+	if neg {
+		seconds, nanos = -seconds, -nanos
+	}
+	return seconds, nanos, true
+}
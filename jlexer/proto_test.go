@@ -0,0 +1,62 @@
+package jlexer
+
+import "testing"
+
+func TestProtoTimestamp(t *testing.T) {
+	for _, test := range []struct {
+		toParse string
+		seconds int64
+		nanos   int32
+		wantErr bool
+	}{
+		{toParse: `"1970-01-01T00:00:00Z"`},
+		{toParse: `"1972-01-01T10:00:20.021Z"`, seconds: 63108020, nanos: 21e6},
+		{toParse: `"1972-01-01T12:00:20.021+02:00"`, seconds: 63108020, nanos: 21e6},
+		{toParse: `"1969-12-31T23:59:59.999999999Z"`, seconds: -1, nanos: 999999999},
+		{toParse: `"0000-12-31T23:59:59Z"`, wantErr: true},
+		{toParse: `"1972-01-01"`, wantErr: true},
+		{toParse: `1`, wantErr: true},
+	} {
+		l := Lexer{Data: []byte(test.toParse)}
+		seconds, nanos := l.ProtoTimestamp()
+		if err := l.Error(); (err != nil) != test.wantErr {
+			t.Errorf("ProtoTimestamp() of %s error: %v; want error %v", test.toParse, err, test.wantErr)
+		}
+		if seconds != test.seconds || nanos != test.nanos {
+			t.Errorf("ProtoTimestamp() of %s = %d, %d; want %d, %d", test.toParse, seconds, nanos, test.seconds, test.nanos)
+		}
+	}
+}
+
+func TestProtoDuration(t *testing.T) {
+	for _, test := range []struct {
+		toParse string
+		seconds int64
+		nanos   int32
+		wantErr bool
+	}{
+		{toParse: `"0s"`},
+		{toParse: `"1.5s"`, seconds: 1, nanos: 500e6},
+		{toParse: `"-1.500s"`, seconds: -1, nanos: -500e6},
+		{toParse: `"-0.000000001s"`, nanos: -1},
+		{toParse: `"315576000000s"`, seconds: 315576000000},
+		{toParse: `"315576000001s"`, wantErr: true},
+		{toParse: `"1.5"`, wantErr: true},
+		{toParse: `"1.s"`, wantErr: true},
+		{toParse: `".5s"`, wantErr: true},
+		{toParse: `"+1s"`, wantErr: true},
+		{toParse: `"1.0000000001s"`, wantErr: true},
+		{toParse: `"1x5s"`, wantErr: true},
+		{toParse: `"s"`, wantErr: true},
+		{toParse: `1`, wantErr: true},
+	} {
+		l := Lexer{Data: []byte(test.toParse)}
+		seconds, nanos := l.ProtoDuration()
+		if err := l.Error(); (err != nil) != test.wantErr {
+			t.Errorf("ProtoDuration() of %s error: %v; want error %v", test.toParse, err, test.wantErr)
+		}
+		if seconds != test.seconds || nanos != test.nanos {
+			t.Errorf("ProtoDuration() of %s = %d, %d; want %d, %d", test.toParse, seconds, nanos, test.seconds, test.nanos)
+		}
+	}
+}
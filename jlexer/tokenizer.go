@@ -0,0 +1,159 @@
+package jlexer
+
+import "strconv"
+
+// TokenKind is a kind of a token returned by a Tokenizer.
+type TokenKind byte
+
+const (
+	TokenEOF         TokenKind = iota // End of the input.
+	TokenInvalid                      // Bytes that do not form a token, up to the next delimiter or whitespace.
+	TokenObjectStart                  // Opening brace.
+	TokenObjectEnd                    // Closing brace.
+	TokenArrayStart                   // Opening bracket.
+	TokenArrayEnd                     // Closing bracket.
+	TokenComma                        // Comma separating elements and members.
+	TokenColon                        // Colon separating member names and values.
+	TokenString                       // String literal, including the quotes.
+	TokenNumber                       // Number literal.
+	TokenTrue                         // true keyword.
+	TokenFalse                        // false keyword.
+	TokenNull                         // null keyword.
+	TokenWhitespace                   // A run of spaces, tabs and line breaks.
+)
+
+var tokenKindNames = [...]string{
+	TokenEOF:         "EOF",
+	TokenInvalid:     "Invalid",
+	TokenObjectStart: "ObjectStart",
+	TokenObjectEnd:   "ObjectEnd",
+	TokenArrayStart:  "ArrayStart",
+	TokenArrayEnd:    "ArrayEnd",
+	TokenComma:       "Comma",
+	TokenColon:       "Colon",
+	TokenString:      "String",
+	TokenNumber:      "Number",
+	TokenTrue:        "True",
+	TokenFalse:       "False",
+	TokenNull:        "Null",
+	TokenWhitespace:  "Whitespace",
+}
+
+func (k TokenKind) String() string {
+	if int(k) < len(tokenKindNames) {
+		return tokenKindNames[k]
+	}
+	return "TokenKind(" + strconv.Itoa(int(k)) + ")"
+}
+
+// Token is a token of the input of a Tokenizer.
+type Token struct {
+	Kind   TokenKind
+	Offset int // Position of the token in the input.
+	Length int // Length of the token in bytes.
+}
+
+// Bytes returns the bytes of the token in data, which must be the input of the tokenizer
+// that returned it.
+func (tok Token) Bytes(data []byte) []byte {
+	return data[tok.Offset : tok.Offset+tok.Length]
+}
+
+// Tokenizer iterates over the tokens of JSON input without parsing it, e.g. for syntax
+// highlighting or linting tools. It recognizes tokens the same way the Lexer does, but does
+// not check how they are combined, reports whitespace as tokens, and continues after invalid
+// bytes, so every byte of the input belongs to exactly one token. It does not allocate.
+//
+// String literals are only checked to be terminated: their escape sequences are validated when
+// they are unescaped by the Lexer.
+type Tokenizer struct {
+	Data []byte // Input data given to the tokenizer.
+
+	pos int
+}
+
+// Next returns the next token, or a token of TokenEOF kind at the end of the input.
+func (t *Tokenizer) Next() Token {
+	data := t.Data[t.pos:]
+	if len(data) == 0 {
+		return Token{Kind: TokenEOF, Offset: t.pos}
+	}
+	tok := Token{Offset: t.pos, Length: 1}
+
+	switch c := data[0]; c {
+	case '{':
+		tok.Kind = TokenObjectStart
+	case '}':
+		tok.Kind = TokenObjectEnd
+	case '[':
+		tok.Kind = TokenArrayStart
+	case ']':
+		tok.Kind = TokenArrayEnd
+	case ',':
+		tok.Kind = TokenComma
+	case ':':
+		tok.Kind = TokenColon
+
+	case ' ', '\t', '\r', '\n':
+		tok.Kind = TokenWhitespace
+		for tok.Length < len(data) && isWhitespace(data[tok.Length]) {
+			tok.Length++
+		}
+
+	case '"':
+		isValid, length, _ := findStringLen(data[1:])
+		if isValid {
+			tok.Kind = TokenString
+			tok.Length = length + 2
+		} else {
+			tok.Kind = TokenInvalid
+			tok.Length = len(data)
+		}
+
+	case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9', '-':
+		var ok bool
+		if tok.Length, ok = scanNumber(data); ok {
+			tok.Kind = TokenNumber
+		} else {
+			tok = t.invalid(data)
+		}
+
+	case 't', 'f', 'n':
+		switch {
+		case isLiteral(data, "true"):
+			tok.Kind, tok.Length = TokenTrue, 4
+		case isLiteral(data, "false"):
+			tok.Kind, tok.Length = TokenFalse, 5
+		case isLiteral(data, "null"):
+			tok.Kind, tok.Length = TokenNull, 4
+		default:
+			tok = t.invalid(data)
+		}
+
+	default:
+		tok = t.invalid(data)
+	}
+
+	t.pos += tok.Length
+	return tok
+}
+
+// invalid returns an invalid token spanning data up to the next character that can end a token.
+func (t *Tokenizer) invalid(data []byte) Token {
+	length := 1
+	for length < len(data) && !isTokenEnd(data[length]) {
+		length++
+	}
+	return Token{Kind: TokenInvalid, Offset: t.pos, Length: length}
+}
+
+// isLiteral tells if data starts with the keyword lit followed by a character that can end
+// a token.
+func isLiteral(data []byte, lit string) bool {
+	return len(data) >= len(lit) && string(data[:len(lit)]) == lit &&
+		(len(data) == len(lit) || isTokenEnd(data[len(lit)]))
+}
+
+func isWhitespace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
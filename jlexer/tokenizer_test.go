@@ -0,0 +1,65 @@
+package jlexer
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTokenizer(t *testing.T) {
+	for _, test := range []struct {
+		In   string
+		Want string
+	}{
+		{``, ``},
+		{`{"a": [1, -2.5e3, true, false, null]}`,
+			`ObjectStart({) String("a") Colon(:) Whitespace( ) ArrayStart([) Number(1) Comma(,) Whitespace( ) Number(-2.5e3) Comma(,) ` +
+				`Whitespace( ) True(true) Comma(,) Whitespace( ) False(false) Comma(,) Whitespace( ) Null(null) ArrayEnd(]) ObjectEnd(})`},
+		{"\t\r\n \"x\\\"y\"", "Whitespace(\t\r\n ) String(\"x\\\"y\")"},
+		{`[nul,truex,1x]`, `ArrayStart([) Invalid(nul) Comma(,) Invalid(truex) Comma(,) Invalid(1x) ArrayEnd(])`},
+		{`{@ 'a'}`, `ObjectStart({) Invalid(@) Whitespace( ) Invalid('a') ObjectEnd(})`},
+		{`["abc`, `ArrayStart([) Invalid("abc)`},
+		{`}}:,`, `ObjectEnd(}) ObjectEnd(}) Colon(:) Comma(,)`},
+	} {
+		tz := Tokenizer{Data: []byte(test.In)}
+
+		var got []string
+		pos := 0
+		for {
+			tok := tz.Next()
+			if tok.Offset != pos {
+				t.Errorf("%q: token %v at offset %d; want %d", test.In, tok.Kind, tok.Offset, pos)
+			}
+			if tok.Kind == TokenEOF {
+				break
+			}
+			pos += tok.Length
+			got = append(got, tok.Kind.String()+"("+string(tok.Bytes(tz.Data))+")")
+		}
+		if want := test.Want; strings.Join(got, " ") != want {
+			t.Errorf("%q: tokens\n%v\nwant\n%v", test.In, strings.Join(got, " "), want)
+		}
+	}
+}
+
+func TestTokenizerAllocs(t *testing.T) {
+	data := []byte(`{"key": ["value\n", 1.5, true, null, {}], "other": "ሴ"}`)
+	allocs := testing.AllocsPerRun(100, func() {
+		tz := Tokenizer{Data: data}
+		for tz.Next().Kind != TokenEOF {
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("Tokenizer allocates %v times per input; want 0", allocs)
+	}
+}
+
+func BenchmarkTokenizer(b *testing.B) {
+	data := []byte(`{"key": ["value\n", 1.5, true, null, {}], "other": "ሴ"}`)
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		tz := Tokenizer{Data: data}
+		for tz.Next().Kind != TokenEOF {
+		}
+	}
+}
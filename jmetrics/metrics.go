@@ -0,0 +1,171 @@
+// Package jmetrics collects per type metrics of the values marshaled and unmarshaled by the
+// easyjson helper functions, and serves them in the Prometheus text exposition format.
+//
+// The package does not depend on the Prometheus client library: the handler of a Collector is
+// scraped directly, or the metrics are exported with a custom easyjson.Observer instead.
+package jmetrics
+
+import (
+	"bufio"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultBuckets are the upper bounds of the duration histogram buckets in seconds used if none
+// are given to NewCollector.
+var DefaultBuckets = []float64{.00001, .00005, .0001, .0005, .001, .005, .01, .05, .1, .5, 1}
+
+// Collector is an easyjson.Observer counting the marshaled and unmarshaled values, their sizes
+// and errors, and the histograms of the durations, by type and operation.
+type Collector struct {
+	buckets []float64
+
+	mu     sync.Mutex
+	series map[seriesKey]*series
+}
+
+type seriesKey struct {
+	typ, op string
+}
+
+type series struct {
+	buckets  []uint64 // counts of durations up to each bucket bound, not cumulative
+	count    uint64
+	duration float64
+	bytes    uint64
+	errors   uint64
+}
+
+// NewCollector returns a collector recording durations in buckets with the given upper bounds
+// in seconds, in increasing order, or in DefaultBuckets if there are none.
+func NewCollector(buckets ...float64) *Collector {
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+	return &Collector{
+		buckets: append([]float64(nil), buckets...),
+		series:  map[seriesKey]*series{},
+	}
+}
+
+// ObserveMarshal supports easyjson.Observer interface.
+func (c *Collector) ObserveMarshal(typ string, d time.Duration, size int, err error) {
+	c.observe(seriesKey{typ, "marshal"}, d, size, err)
+}
+
+// ObserveUnmarshal supports easyjson.Observer interface.
+func (c *Collector) ObserveUnmarshal(typ string, d time.Duration, size int, err error) {
+	c.observe(seriesKey{typ, "unmarshal"}, d, size, err)
+}
+
+func (c *Collector) observe(key seriesKey, d time.Duration, size int, err error) {
+	seconds := d.Seconds()
+	i := sort.SearchFloat64s(c.buckets, seconds)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s := c.series[key]
+	if s == nil {
+		s = &series{buckets: make([]uint64, len(c.buckets))}
+		c.series[key] = s
+	}
+	if i < len(s.buckets) {
+		s.buckets[i]++
+	}
+	s.count++
+	s.duration += seconds
+	s.bytes += uint64(size)
+	if err != nil {
+		s.errors++
+	}
+}
+
+// WriteTo writes the metrics into w in the Prometheus text exposition format, sorted by type
+// and operation.
+func (c *Collector) WriteTo(w io.Writer) (int64, error) {
+	c.mu.Lock()
+	keys := make([]seriesKey, 0, len(c.series))
+	snapshot := make(map[seriesKey]series, len(c.series))
+	for key, s := range c.series {
+		keys = append(keys, key)
+		copied := *s
+		copied.buckets = append([]uint64(nil), s.buckets...)
+		snapshot[key] = copied
+	}
+	c.mu.Unlock()
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].typ != keys[j].typ {
+			return keys[i].typ < keys[j].typ
+		}
+		return keys[i].op < keys[j].op
+	})
+
+	cw := &countingWriter{w: w}
+	bw := bufio.NewWriter(cw)
+
+	bw.WriteString("# HELP easyjson_duration_seconds Duration of marshaling and unmarshaling values.\n")
+	bw.WriteString("# TYPE easyjson_duration_seconds histogram\n")
+	for _, key := range keys {
+		s := snapshot[key]
+		labels := `type="` + escapeLabel(key.typ) + `",op="` + key.op + `"`
+		var cumulative uint64
+		for i, bound := range c.buckets {
+			cumulative += s.buckets[i]
+			bw.WriteString("easyjson_duration_seconds_bucket{" + labels + `,le="` + formatFloat(bound) + `"} ` + strconv.FormatUint(cumulative, 10) + "\n")
+		}
+		bw.WriteString("easyjson_duration_seconds_bucket{" + labels + `,le="+Inf"} ` + strconv.FormatUint(s.count, 10) + "\n")
+		bw.WriteString("easyjson_duration_seconds_sum{" + labels + "} " + formatFloat(s.duration) + "\n")
+		bw.WriteString("easyjson_duration_seconds_count{" + labels + "} " + strconv.FormatUint(s.count, 10) + "\n")
+	}
+
+	bw.WriteString("# HELP easyjson_bytes_total Size of the marshaled and unmarshaled JSON.\n")
+	bw.WriteString("# TYPE easyjson_bytes_total counter\n")
+	for _, key := range keys {
+		bw.WriteString(`easyjson_bytes_total{type="` + escapeLabel(key.typ) + `",op="` + key.op + `"} ` + strconv.FormatUint(snapshot[key].bytes, 10) + "\n")
+	}
+
+	bw.WriteString("# HELP easyjson_errors_total Number of failed marshalings and unmarshalings.\n")
+	bw.WriteString("# TYPE easyjson_errors_total counter\n")
+	for _, key := range keys {
+		bw.WriteString(`easyjson_errors_total{type="` + escapeLabel(key.typ) + `",op="` + key.op + `"} ` + strconv.FormatUint(snapshot[key].errors, 10) + "\n")
+	}
+
+	err := bw.Flush()
+	return cw.n, err
+}
+
+// ServeHTTP serves the metrics for Prometheus to scrape.
+func (c *Collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	c.WriteTo(w)
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// escapeLabel escapes a label value as the exposition format requires.
+func escapeLabel(s string) string {
+	return labelEscaper.Replace(s)
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// countingWriter counts the bytes written to w.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,58 @@
+package jmetrics
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mailru/easyjson"
+)
+
+var _ easyjson.Observer = (*Collector)(nil)
+
+func TestCollector(t *testing.T) {
+	c := NewCollector(0.001, 0.01)
+	c.ObserveMarshal("pkg.User", 500*time.Microsecond, 10, nil)
+	c.ObserveMarshal("pkg.User", 5*time.Millisecond, 20, nil)
+	c.ObserveUnmarshal("pkg.User", time.Second, 3, errors.New("bad"))
+	c.ObserveMarshal(`pkg."odd"`, time.Millisecond, 1, nil)
+
+	rec := httptest.NewRecorder()
+	c.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	want := `# HELP easyjson_duration_seconds Duration of marshaling and unmarshaling values.
+# TYPE easyjson_duration_seconds histogram
+easyjson_duration_seconds_bucket{type="pkg.\"odd\"",op="marshal",le="0.001"} 1
+easyjson_duration_seconds_bucket{type="pkg.\"odd\"",op="marshal",le="0.01"} 1
+easyjson_duration_seconds_bucket{type="pkg.\"odd\"",op="marshal",le="+Inf"} 1
+easyjson_duration_seconds_sum{type="pkg.\"odd\"",op="marshal"} 0.001
+easyjson_duration_seconds_count{type="pkg.\"odd\"",op="marshal"} 1
+easyjson_duration_seconds_bucket{type="pkg.User",op="marshal",le="0.001"} 1
+easyjson_duration_seconds_bucket{type="pkg.User",op="marshal",le="0.01"} 2
+easyjson_duration_seconds_bucket{type="pkg.User",op="marshal",le="+Inf"} 2
+easyjson_duration_seconds_sum{type="pkg.User",op="marshal"} 0.0055
+easyjson_duration_seconds_count{type="pkg.User",op="marshal"} 2
+easyjson_duration_seconds_bucket{type="pkg.User",op="unmarshal",le="0.001"} 0
+easyjson_duration_seconds_bucket{type="pkg.User",op="unmarshal",le="0.01"} 0
+easyjson_duration_seconds_bucket{type="pkg.User",op="unmarshal",le="+Inf"} 1
+easyjson_duration_seconds_sum{type="pkg.User",op="unmarshal"} 1
+easyjson_duration_seconds_count{type="pkg.User",op="unmarshal"} 1
+# HELP easyjson_bytes_total Size of the marshaled and unmarshaled JSON.
+# TYPE easyjson_bytes_total counter
+easyjson_bytes_total{type="pkg.\"odd\"",op="marshal"} 1
+easyjson_bytes_total{type="pkg.User",op="marshal"} 30
+easyjson_bytes_total{type="pkg.User",op="unmarshal"} 3
+# HELP easyjson_errors_total Number of failed marshalings and unmarshalings.
+# TYPE easyjson_errors_total counter
+easyjson_errors_total{type="pkg.\"odd\"",op="marshal"} 0
+easyjson_errors_total{type="pkg.User",op="marshal"} 0
+easyjson_errors_total{type="pkg.User",op="unmarshal"} 1
+`
+	if got := rec.Body.String(); got != want {
+		t.Errorf("ServeHTTP() body =\n%s\nwant\n%s", got, want)
+	}
+	if got := rec.Header().Get("Content-Type"); got != "text/plain; version=0.0.4; charset=utf-8" {
+		t.Errorf("ServeHTTP() Content-Type = %q", got)
+	}
+}
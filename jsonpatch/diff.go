@@ -0,0 +1,77 @@
+package jsonpatch
+
+import (
+	"strconv"
+
+	"github.com/mailru/easyjson"
+)
+
+// CreatePatch returns a patch that transforms the original JSON document into the
+// modified one.
+func CreatePatch(original, modified []byte) (Patch, error) {
+	a, err := parseNode(original)
+	if err != nil {
+		return nil, err
+	}
+	b, err := parseNode(modified)
+	if err != nil {
+		return nil, err
+	}
+	return diff(nil, "", a, b), nil
+}
+
+// Diff returns a patch that transforms the marshaled original value into the marshaled
+// modified one.
+func Diff(original, modified easyjson.Marshaler) (Patch, error) {
+	a, err := easyjson.Marshal(original)
+	if err != nil {
+		return nil, err
+	}
+	b, err := easyjson.Marshal(modified)
+	if err != nil {
+		return nil, err
+	}
+	return CreatePatch(a, b)
+}
+
+// diff appends operations transforming a into b at the given location to the patch.
+func diff(p Patch, path string, a, b *node) Patch {
+	if equal(a, b) {
+		return p
+	}
+	if a.kind != b.kind || a.kind == kindScalar {
+		return append(p, Operation{Op: OpReplace, Path: path, Value: b.bytes()})
+	}
+
+	if a.kind == kindObject {
+		for _, k := range a.keys {
+			memberPath := path + "/" + escapePointerToken(k)
+			if v, ok := b.fields[k]; ok {
+				p = diff(p, memberPath, a.fields[k], v)
+			} else {
+				p = append(p, Operation{Op: OpRemove, Path: memberPath})
+			}
+		}
+		for _, k := range b.keys {
+			if _, ok := a.fields[k]; !ok {
+				p = append(p, Operation{Op: OpAdd, Path: path + "/" + escapePointerToken(k), Value: b.fields[k].bytes()})
+			}
+		}
+		return p
+	}
+
+	common := len(a.elems)
+	if len(b.elems) < common {
+		common = len(b.elems)
+	}
+	for i := 0; i < common; i++ {
+		p = diff(p, path+"/"+strconv.Itoa(i), a.elems[i], b.elems[i])
+	}
+	for i := common; i < len(b.elems); i++ {
+		p = append(p, Operation{Op: OpAdd, Path: path + "/" + strconv.Itoa(i), Value: b.elems[i].bytes()})
+	}
+	for i := len(a.elems) - 1; i >= common; i-- {
+		p = append(p, Operation{Op: OpRemove, Path: path + "/" + strconv.Itoa(i)})
+	}
+	return p
+}
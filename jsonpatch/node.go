@@ -0,0 +1,197 @@
+package jsonpatch
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+// nodeKind determines type of a document node.
+type nodeKind byte
+
+const (
+	kindScalar nodeKind = iota // String, number, bool or null.
+	kindObject
+	kindArray
+)
+
+// node is a parsed JSON value. Scalars are kept as raw data, objects keep the order of
+// members so that the documents are written back as close to the original as possible.
+type node struct {
+	kind nodeKind
+
+	raw    []byte           // Raw value of a scalar.
+	keys   []string         // Object member names in document order.
+	fields map[string]*node // Object members.
+	elems  []*node          // Array elements.
+}
+
+// parseNode parses a complete JSON document.
+func parseNode(data []byte) (*node, error) {
+	in := jlexer.Lexer{Data: data}
+	n := readNode(&in)
+	in.Consumed()
+	if err := in.Error(); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// readNode reads the next value from the lexer.
+func readNode(in *jlexer.Lexer) *node {
+	switch {
+	case !in.Ok():
+		return nil
+	case in.IsDelim('{'):
+		n := &node{kind: kindObject, fields: map[string]*node{}}
+		in.Delim('{')
+		for !in.IsDelim('}') {
+			key := in.String()
+			in.WantColon()
+			n.set(key, readNode(in))
+			in.WantComma()
+		}
+		in.Delim('}')
+		return n
+	case in.IsDelim('['):
+		n := &node{kind: kindArray}
+		in.Delim('[')
+		for !in.IsDelim(']') {
+			n.elems = append(n.elems, readNode(in))
+			in.WantComma()
+		}
+		in.Delim(']')
+		return n
+	default:
+		raw := in.Raw()
+		return &node{kind: kindScalar, raw: append([]byte(nil), raw...)}
+	}
+}
+
+// set adds or replaces an object member.
+func (n *node) set(key string, v *node) {
+	if _, ok := n.fields[key]; !ok {
+		n.keys = append(n.keys, key)
+	}
+	n.fields[key] = v
+}
+
+// delete removes an object member.
+func (n *node) delete(key string) {
+	delete(n.fields, key)
+	for i, k := range n.keys {
+		if k == key {
+			n.keys = append(n.keys[:i], n.keys[i+1:]...)
+			return
+		}
+	}
+}
+
+// clone returns a deep copy of the node.
+func (n *node) clone() *node {
+	ret := &node{kind: n.kind, raw: n.raw}
+	switch n.kind {
+	case kindObject:
+		ret.fields = make(map[string]*node, len(n.fields))
+		for _, k := range n.keys {
+			ret.set(k, n.fields[k].clone())
+		}
+	case kindArray:
+		ret.elems = make([]*node, len(n.elems))
+		for i, e := range n.elems {
+			ret.elems[i] = e.clone()
+		}
+	}
+	return ret
+}
+
+// write outputs the node to the writer.
+func (n *node) write(w *jwriter.Writer) {
+	switch n.kind {
+	case kindObject:
+		w.RawByte('{')
+		for i, k := range n.keys {
+			if i > 0 {
+				w.RawByte(',')
+			}
+			w.String(k)
+			w.RawByte(':')
+			n.fields[k].write(w)
+		}
+		w.RawByte('}')
+	case kindArray:
+		w.RawByte('[')
+		for i, e := range n.elems {
+			if i > 0 {
+				w.RawByte(',')
+			}
+			e.write(w)
+		}
+		w.RawByte(']')
+	default:
+		w.Raw(n.raw, nil)
+	}
+}
+
+// bytes returns the node marshaled as a single byte slice.
+func (n *node) bytes() []byte {
+	w := jwriter.Writer{}
+	n.write(&w)
+	return w.Buffer.BuildBytes()
+}
+
+// equal compares nodes as JSON values: members order, string escaping and number
+// formatting do not matter.
+func equal(a, b *node) bool {
+	if a.kind != b.kind {
+		return false
+	}
+
+	switch a.kind {
+	case kindObject:
+		if len(a.fields) != len(b.fields) {
+			return false
+		}
+		for k, v := range a.fields {
+			v1, ok := b.fields[k]
+			if !ok || !equal(v, v1) {
+				return false
+			}
+		}
+		return true
+	case kindArray:
+		if len(a.elems) != len(b.elems) {
+			return false
+		}
+		for i := range a.elems {
+			if !equal(a.elems[i], b.elems[i]) {
+				return false
+			}
+		}
+		return true
+	}
+
+	if bytes.Equal(a.raw, b.raw) {
+		return true
+	}
+	if len(a.raw) == 0 || len(b.raw) == 0 {
+		return false
+	}
+
+	switch {
+	case a.raw[0] == '"' && b.raw[0] == '"':
+		la, lb := jlexer.Lexer{Data: a.raw}, jlexer.Lexer{Data: b.raw}
+		return la.String() == lb.String() && la.Ok() && lb.Ok()
+	case isNumber(a.raw[0]) && isNumber(b.raw[0]):
+		fa, erra := strconv.ParseFloat(string(a.raw), 64)
+		fb, errb := strconv.ParseFloat(string(b.raw), 64)
+		return erra == nil && errb == nil && fa == fb
+	}
+	return false
+}
+
+func isNumber(c byte) bool {
+	return c == '-' || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,388 @@
+// Package jsonpatch implements JSON Patch (RFC 6902) on top of the easyjson lexer and writer.
+//
+// A patch can be created as a difference between two marshaled documents, or between two
+// values implementing easyjson.Marshaler, and applied to a marshaled document.
+package jsonpatch
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mailru/easyjson"
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+// Operation names.
+const (
+	OpAdd     = "add"
+	OpRemove  = "remove"
+	OpReplace = "replace"
+	OpMove    = "move"
+	OpCopy    = "copy"
+	OpTest    = "test"
+)
+
+// ErrTestFailed is returned by Apply if a "test" operation does not match the document.
+var ErrTestFailed = errors.New("jsonpatch: test operation failed")
+
+// Operation is a single JSON Patch operation.
+type Operation struct {
+	Op    string
+	Path  string
+	From  string              // Source location for "move" and "copy".
+	Value easyjson.RawMessage // Value for "add", "replace" and "test".
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface.
+func (o Operation) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawString(`{"op":`)
+	w.String(o.Op)
+	w.RawString(`,"path":`)
+	w.String(o.Path)
+	if o.Op == OpMove || o.Op == OpCopy {
+		w.RawString(`,"from":`)
+		w.String(o.From)
+	}
+	if o.Op == OpAdd || o.Op == OpReplace || o.Op == OpTest {
+		w.RawString(`,"value":`)
+		o.Value.MarshalEasyJSON(w)
+	}
+	w.RawByte('}')
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface.
+func (o *Operation) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if in.IsNull() {
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "op":
+			o.Op = in.String()
+		case "path":
+			o.Path = in.String()
+		case "from":
+			o.From = in.String()
+		case "value":
+			o.Value = append(easyjson.RawMessage(nil), in.Raw()...)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+}
+
+// Patch is a JSON Patch document: a list of operations applied in order.
+type Patch []Operation
+
+// MarshalEasyJSON supports easyjson.Marshaler interface.
+func (p Patch) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('[')
+	for i, o := range p {
+		if i > 0 {
+			w.RawByte(',')
+		}
+		o.MarshalEasyJSON(w)
+	}
+	w.RawByte(']')
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface.
+func (p *Patch) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if in.IsNull() {
+		in.Skip()
+		*p = nil
+		return
+	}
+	*p = (*p)[:0]
+	in.Delim('[')
+	for !in.IsDelim(']') {
+		var o Operation
+		o.UnmarshalEasyJSON(in)
+		*p = append(*p, o)
+		in.WantComma()
+	}
+	in.Delim(']')
+}
+
+// MarshalJSON supports json.Marshaler interface.
+func (p Patch) MarshalJSON() ([]byte, error) {
+	return easyjson.Marshal(p)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface.
+func (p *Patch) UnmarshalJSON(data []byte) error {
+	return easyjson.Unmarshal(data, p)
+}
+
+// DecodePatch parses a JSON Patch document.
+func DecodePatch(data []byte) (Patch, error) {
+	var p Patch
+	if err := easyjson.Unmarshal(data, &p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Apply applies the patch to a JSON document and returns the patched document. The
+// original document is not modified.
+func (p Patch) Apply(doc []byte) ([]byte, error) {
+	root, err := parseNode(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	d := document{root: root}
+	for i, o := range p {
+		if err := d.apply(o); err != nil {
+			return nil, fmt.Errorf("jsonpatch: operation %d (%s %q): %v", i, o.Op, o.Path, err)
+		}
+	}
+	return d.root.bytes(), nil
+}
+
+// document holds the root of a document being patched, so that the root can be replaced.
+type document struct {
+	root *node
+}
+
+func (d *document) apply(o Operation) error {
+	path, err := parsePointer(o.Path)
+	if err != nil {
+		return err
+	}
+
+	switch o.Op {
+	case OpAdd, OpReplace, OpTest:
+		if len(o.Value) == 0 {
+			return errors.New("missing value")
+		}
+		v, err := parseNode(o.Value)
+		if err != nil {
+			return err
+		}
+
+		switch o.Op {
+		case OpAdd:
+			return d.add(path, v)
+		case OpReplace:
+			return d.replace(path, v)
+		}
+
+		cur, err := d.get(path)
+		if err != nil {
+			return err
+		}
+		if !equal(cur, v) {
+			return ErrTestFailed
+		}
+		return nil
+
+	case OpRemove:
+		_, err := d.remove(path)
+		return err
+
+	case OpMove, OpCopy:
+		from, err := parsePointer(o.From)
+		if err != nil {
+			return err
+		}
+		if o.Op == OpMove {
+			if isPrefix(from, path) && len(from) < len(path) {
+				return errors.New("cannot move a value into one of its children")
+			}
+			v, err := d.remove(from)
+			if err != nil {
+				return err
+			}
+			return d.add(path, v)
+		}
+
+		v, err := d.get(from)
+		if err != nil {
+			return err
+		}
+		return d.add(path, v.clone())
+	}
+	return fmt.Errorf("unknown operation %q", o.Op)
+}
+
+// get returns the value at the given location.
+func (d *document) get(path []string) (*node, error) {
+	n := d.root
+	for _, token := range path {
+		switch n.kind {
+		case kindObject:
+			v, ok := n.fields[token]
+			if !ok {
+				return nil, fmt.Errorf("member %q not found", token)
+			}
+			n = v
+		case kindArray:
+			i, err := arrayIndex(token, len(n.elems)-1)
+			if err != nil {
+				return nil, err
+			}
+			n = n.elems[i]
+		default:
+			return nil, fmt.Errorf("cannot look up %q in a scalar value", token)
+		}
+	}
+	return n, nil
+}
+
+// add inserts the value into an array or sets an object member at the given location.
+func (d *document) add(path []string, v *node) error {
+	if len(path) == 0 {
+		d.root = v
+		return nil
+	}
+
+	parent, err := d.get(path[:len(path)-1])
+	if err != nil {
+		return err
+	}
+
+	token := path[len(path)-1]
+	switch parent.kind {
+	case kindObject:
+		parent.set(token, v)
+	case kindArray:
+		i := len(parent.elems)
+		if token != "-" {
+			if i, err = arrayIndex(token, len(parent.elems)); err != nil {
+				return err
+			}
+		}
+		parent.elems = append(parent.elems, nil)
+		copy(parent.elems[i+1:], parent.elems[i:])
+		parent.elems[i] = v
+	default:
+		return fmt.Errorf("cannot add %q to a scalar value", token)
+	}
+	return nil
+}
+
+// replace replaces an existing value at the given location.
+func (d *document) replace(path []string, v *node) error {
+	if len(path) == 0 {
+		d.root = v
+		return nil
+	}
+
+	parent, err := d.get(path[:len(path)-1])
+	if err != nil {
+		return err
+	}
+
+	token := path[len(path)-1]
+	switch parent.kind {
+	case kindObject:
+		if _, ok := parent.fields[token]; !ok {
+			return fmt.Errorf("member %q not found", token)
+		}
+		parent.fields[token] = v
+	case kindArray:
+		i, err := arrayIndex(token, len(parent.elems)-1)
+		if err != nil {
+			return err
+		}
+		parent.elems[i] = v
+	default:
+		return fmt.Errorf("cannot replace %q in a scalar value", token)
+	}
+	return nil
+}
+
+// remove removes and returns the value at the given location.
+func (d *document) remove(path []string) (*node, error) {
+	if len(path) == 0 {
+		v := d.root
+		d.root = &node{kind: kindScalar, raw: []byte("null")}
+		return v, nil
+	}
+
+	parent, err := d.get(path[:len(path)-1])
+	if err != nil {
+		return nil, err
+	}
+
+	token := path[len(path)-1]
+	switch parent.kind {
+	case kindObject:
+		v, ok := parent.fields[token]
+		if !ok {
+			return nil, fmt.Errorf("member %q not found", token)
+		}
+		parent.delete(token)
+		return v, nil
+	case kindArray:
+		i, err := arrayIndex(token, len(parent.elems)-1)
+		if err != nil {
+			return nil, err
+		}
+		v := parent.elems[i]
+		parent.elems = append(parent.elems[:i], parent.elems[i+1:]...)
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot remove %q from a scalar value", token)
+}
+
+// arrayIndex parses an array index token, which must be in the [0, max] range.
+func arrayIndex(token string, max int) (int, error) {
+	if token == "" || (len(token) > 1 && token[0] == '0') || strings.TrimLeft(token, "0123456789") != "" {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	i, err := strconv.Atoi(token)
+	if err != nil || i > max {
+		return 0, fmt.Errorf("array index %q out of range", token)
+	}
+	return i, nil
+}
+
+// parsePointer splits a JSON Pointer (RFC 6901) into unescaped reference tokens.
+func parsePointer(p string) ([]string, error) {
+	if p == "" {
+		return nil, nil
+	}
+	if p[0] != '/' {
+		return nil, fmt.Errorf("invalid JSON pointer %q", p)
+	}
+
+	tokens := strings.Split(p[1:], "/")
+	for i, t := range tokens {
+		if strings.IndexByte(t, '~') != -1 {
+			tokens[i] = strings.Replace(strings.Replace(t, "~1", "/", -1), "~0", "~", -1)
+		}
+	}
+	return tokens, nil
+}
+
+// escapePointerToken escapes a reference token to be used in a JSON Pointer.
+func escapePointerToken(t string) string {
+	if strings.IndexAny(t, "~/") == -1 {
+		return t
+	}
+	return strings.Replace(strings.Replace(t, "~", "~0", -1), "/", "~1", -1)
+}
+
+// isPrefix returns true if path starts with prefix.
+func isPrefix(prefix, path []string) bool {
+	if len(prefix) > len(path) {
+		return false
+	}
+	for i := range prefix {
+		if prefix[i] != path[i] {
+			return false
+		}
+	}
+	return true
+}
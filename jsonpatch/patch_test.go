@@ -0,0 +1,142 @@
+package jsonpatch
+
+import (
+	"testing"
+)
+
+func TestApply(t *testing.T) {
+	for i, test := range []struct {
+		Doc, Patch, Want string
+		WantErr          bool
+	}{
+		{
+			Doc:   `{"foo":"bar"}`,
+			Patch: `[{"op":"add","path":"/baz","value":"qux"}]`,
+			Want:  `{"foo":"bar","baz":"qux"}`,
+		},
+		{
+			Doc:   `{"foo":["bar","baz"]}`,
+			Patch: `[{"op":"add","path":"/foo/1","value":"qux"}]`,
+			Want:  `{"foo":["bar","qux","baz"]}`,
+		},
+		{
+			Doc:   `{"foo":["bar"]}`,
+			Patch: `[{"op":"add","path":"/foo/-","value":{"a":1}}]`,
+			Want:  `{"foo":["bar",{"a":1}]}`,
+		},
+		{
+			Doc:   `{"baz":"qux","foo":"bar"}`,
+			Patch: `[{"op":"remove","path":"/baz"}]`,
+			Want:  `{"foo":"bar"}`,
+		},
+		{
+			Doc:   `{"foo":["bar","qux","baz"]}`,
+			Patch: `[{"op":"remove","path":"/foo/1"}]`,
+			Want:  `{"foo":["bar","baz"]}`,
+		},
+		{
+			Doc:   `{"baz":"qux","foo":"bar"}`,
+			Patch: `[{"op":"replace","path":"/baz","value":"boo"}]`,
+			Want:  `{"baz":"boo","foo":"bar"}`,
+		},
+		{
+			Doc:   `{"foo":{"bar":"baz","waldo":"fred"},"qux":{"corge":"grault"}}`,
+			Patch: `[{"op":"move","from":"/foo/waldo","path":"/qux/thud"}]`,
+			Want:  `{"foo":{"bar":"baz"},"qux":{"corge":"grault","thud":"fred"}}`,
+		},
+		{
+			Doc:   `{"foo":{"bar":1}}`,
+			Patch: `[{"op":"copy","from":"/foo","path":"/baz"},{"op":"replace","path":"/baz/bar","value":2}]`,
+			Want:  `{"foo":{"bar":1},"baz":{"bar":2}}`,
+		},
+		{
+			Doc:   `{"a/b":1,"m~n":2}`,
+			Patch: `[{"op":"remove","path":"/a~1b"},{"op":"test","path":"/m~0n","value":2.0}]`,
+			Want:  `{"m~n":2}`,
+		},
+		{
+			Doc:   `{"foo":"bar"}`,
+			Patch: `[{"op":"replace","path":"","value":[1,2]}]`,
+			Want:  `[1,2]`,
+		},
+		{
+			Doc:     `{"baz":"qux"}`,
+			Patch:   `[{"op":"test","path":"/baz","value":"bar"}]`,
+			WantErr: true,
+		},
+		{
+			Doc:     `{"foo":"bar"}`,
+			Patch:   `[{"op":"remove","path":"/baz"}]`,
+			WantErr: true,
+		},
+		{
+			Doc:     `{"foo":[1]}`,
+			Patch:   `[{"op":"add","path":"/foo/01","value":2}]`,
+			WantErr: true,
+		},
+		{
+			Doc:     `{"foo":{"bar":1}}`,
+			Patch:   `[{"op":"move","from":"/foo","path":"/foo/bar/baz"}]`,
+			WantErr: true,
+		},
+	} {
+		p, err := DecodePatch([]byte(test.Patch))
+		if err != nil {
+			t.Errorf("[%d] DecodePatch(%s) error: %v", i, test.Patch, err)
+			continue
+		}
+
+		got, err := p.Apply([]byte(test.Doc))
+		if test.WantErr {
+			if err == nil {
+				t.Errorf("[%d] Apply(%s) = %s; want error", i, test.Patch, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("[%d] Apply(%s) error: %v", i, test.Patch, err)
+		} else if string(got) != test.Want {
+			t.Errorf("[%d] Apply(%s) = %s; want %s", i, test.Patch, got, test.Want)
+		}
+	}
+}
+
+func TestCreatePatch(t *testing.T) {
+	for i, test := range []struct {
+		Original, Modified, Want string
+	}{
+		{`{"a":1}`, `{"a":1}`, `[]`},
+		{`{"a":1,"b":"x"}`, `{"b":"x","a":1.0}`, `[]`},
+		{`{"a":1,"b":2}`, `{"a":3,"c":4}`, `[{"op":"replace","path":"/a","value":3},{"op":"remove","path":"/b"},{"op":"add","path":"/c","value":4}]`},
+		{`{"a":[1,2,3]}`, `{"a":[1,5]}`, `[{"op":"replace","path":"/a/1","value":5},{"op":"remove","path":"/a/2"}]`},
+		{`{"a":[1]}`, `{"a":[1,{"b":null}]}`, `[{"op":"add","path":"/a/1","value":{"b":null}}]`},
+		{`{"a/b":{"c":1}}`, `{"a/b":[]}`, `[{"op":"replace","path":"/a~1b","value":[]}]`},
+		{`[1]`, `"s"`, `[{"op":"replace","path":"","value":"s"}]`},
+	} {
+		p, err := CreatePatch([]byte(test.Original), []byte(test.Modified))
+		if err != nil {
+			t.Errorf("[%d] CreatePatch() error: %v", i, err)
+			continue
+		}
+
+		data, err := p.MarshalJSON()
+		if err != nil {
+			t.Errorf("[%d] MarshalJSON() error: %v", i, err)
+		}
+		if string(data) != test.Want {
+			t.Errorf("[%d] CreatePatch(%s, %s) = %s; want %s", i, test.Original, test.Modified, data, test.Want)
+		}
+
+		patched, err := p.Apply([]byte(test.Original))
+		if err != nil {
+			t.Errorf("[%d] Apply() error: %v", i, err)
+			continue
+		}
+
+		a, _ := parseNode(patched)
+		b, _ := parseNode([]byte(test.Modified))
+		if !equal(a, b) {
+			t.Errorf("[%d] Apply(CreatePatch(%s, %s)) = %s", i, test.Original, test.Modified, patched)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package jwriter
+
+import (
+	"errors"
+	"unsafe"
+)
+
+// Marshaler is implemented by types with easyjson marshaling methods, the same as
+// easyjson.Marshaler.
+type Marshaler interface {
+	MarshalEasyJSON(w *Writer)
+}
+
+// Builder assembles a JSON document in a Writer from parts, e.g. values marshaled by the
+// generated code and raw fragments taken from a cache, writing the commas and colons between
+// them. Nothing is decoded: raw fragments must be valid JSON values.
+//
+// Errors, including calls out of order like a member name outside of an object, are recorded
+// in the Error of the writer, after which the calls do nothing.
+type Builder struct {
+	W *Writer
+
+	open   []container // innermost last
+	named  bool        // a member name is waiting for its value
+	values int         // top-level values written
+}
+
+// container is an object or an array open in a Builder.
+type container struct {
+	end   byte
+	count int
+}
+
+// NewBuilder returns a Builder writing to w.
+func NewBuilder(w *Writer) *Builder {
+	return &Builder{W: w}
+}
+
+func (b *Builder) fail(reason string) {
+	if b.W.Error == nil {
+		b.W.Error = errors.New("jwriter: " + reason)
+	}
+}
+
+// startValue writes the comma before a value and reports if the value can be written.
+func (b *Builder) startValue() bool {
+	if b.W.Error != nil {
+		return false
+	}
+	if len(b.open) == 0 {
+		if b.values > 0 {
+			b.fail("more than one top-level value")
+			return false
+		}
+		b.values++
+		return true
+	}
+
+	c := &b.open[len(b.open)-1]
+	if c.end == '}' {
+		if !b.named {
+			b.fail("value without a member name in an object")
+			return false
+		}
+		b.named = false
+		return true
+	}
+	if c.count > 0 {
+		b.W.RawByte(',')
+	}
+	c.count++
+	return true
+}
+
+// StartObject starts an object value, closed by End.
+func (b *Builder) StartObject() {
+	if b.startValue() {
+		b.W.RawByte('{')
+		b.open = append(b.open, container{end: '}'})
+	}
+}
+
+// StartArray starts an array value, closed by End.
+func (b *Builder) StartArray() {
+	if b.startValue() {
+		b.W.RawByte('[')
+		b.open = append(b.open, container{end: ']'})
+	}
+}
+
+// End closes the innermost object or array.
+func (b *Builder) End() {
+	switch {
+	case b.W.Error != nil:
+		return
+	case len(b.open) == 0:
+		b.fail("end without an open object or array")
+		return
+	case b.named:
+		b.fail("member name without a value")
+		return
+	}
+	b.W.RawByte(b.open[len(b.open)-1].end)
+	b.open = b.open[:len(b.open)-1]
+}
+
+// Key writes the name of the next member of the innermost object, whose value is written by
+// the next call.
+func (b *Builder) Key(name string) {
+	switch {
+	case b.W.Error != nil:
+		return
+	case len(b.open) == 0 || b.open[len(b.open)-1].end != '}':
+		b.fail("member name outside of an object")
+		return
+	case b.named:
+		b.fail("member name without a value")
+		return
+	}
+
+	c := &b.open[len(b.open)-1]
+	if c.count > 0 {
+		b.W.RawByte(',')
+	}
+	c.count++
+	b.W.String(name)
+	b.W.RawByte(':')
+	b.named = true
+}
+
+// Value writes v marshaled as the next value, or null if v is nil or a nil pointer, like
+// easyjson.Marshal does it.
+func (b *Builder) Value(v Marshaler) {
+	if !b.startValue() {
+		return
+	}
+	if isNilInterface(v) {
+		b.W.RawString("null")
+		return
+	}
+	v.MarshalEasyJSON(b.W)
+}
+
+// isNilInterface tells if i is nil or holds a nil pointer, the same as in package easyjson.
+func isNilInterface(i interface{}) bool {
+	return (*[2]uintptr)(unsafe.Pointer(&i))[1] == 0
+}
+
+// Raw writes data as the next value as is, or null if data is empty.
+func (b *Builder) Raw(data []byte) {
+	if b.startValue() {
+		b.W.Raw(data, nil)
+	}
+}
+
+// Field writes a member of the innermost object with v marshaled as the value.
+func (b *Builder) Field(name string, v Marshaler) {
+	b.Key(name)
+	b.Value(v)
+}
+
+// RawField writes a member of the innermost object with data as the value as is.
+func (b *Builder) RawField(name string, data []byte) {
+	b.Key(name)
+	b.Raw(data)
+}
+
+// BuildBytes returns the document, or an error if it is incomplete or any error happened.
+func (b *Builder) BuildBytes(reuse ...[]byte) ([]byte, error) {
+	switch {
+	case b.W.Error != nil:
+	case len(b.open) > 0:
+		b.fail("unclosed object or array")
+	case b.values == 0:
+		b.fail("empty document")
+	}
+	return b.W.BuildBytes(reuse...)
+}
@@ -0,0 +1,65 @@
+package jwriter
+
+import (
+	"strings"
+	"testing"
+)
+
+type builderUser struct {
+	id int
+}
+
+func (u builderUser) MarshalEasyJSON(w *Writer) {
+	w.RawString(`{"id":`)
+	w.Int(u.id)
+	w.RawByte('}')
+}
+
+func TestBuilder(t *testing.T) {
+	var w Writer
+	b := NewBuilder(&w)
+	b.StartObject()
+	b.Field("user", builderUser{1})
+	b.RawField("profile", []byte(`{"cached":true}`))
+	b.Key("friends")
+	b.StartArray()
+	b.Value(builderUser{2})
+	b.Raw([]byte(`{"id":3}`))
+	b.Value(nil)
+	b.Value((*builderUser)(nil))
+	b.StartObject()
+	b.End()
+	b.End()
+	b.RawField("empty", nil)
+	b.End()
+
+	data, err := b.BuildBytes()
+	want := `{"user":{"id":1},"profile":{"cached":true},"friends":[{"id":2},{"id":3},null,null,{}],"empty":null}`
+	if err != nil || string(data) != want {
+		t.Errorf("BuildBytes() = %s, %v; want %s", data, err, want)
+	}
+}
+
+func TestBuilderErrors(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		build func(b *Builder)
+		want  string
+	}{
+		{"key outside object", func(b *Builder) { b.StartArray(); b.Key("a") }, "member name outside of an object"},
+		{"value without key", func(b *Builder) { b.StartObject(); b.Value(builderUser{}) }, "value without a member name"},
+		{"key without value", func(b *Builder) { b.StartObject(); b.Key("a"); b.End() }, "member name without a value"},
+		{"two keys", func(b *Builder) { b.StartObject(); b.Key("a"); b.Key("b") }, "member name without a value"},
+		{"unbalanced end", func(b *Builder) { b.StartArray(); b.End(); b.End() }, "end without an open"},
+		{"unclosed", func(b *Builder) { b.StartObject() }, "unclosed object or array"},
+		{"two values", func(b *Builder) { b.Raw([]byte("1")); b.Raw([]byte("2")) }, "more than one top-level value"},
+		{"empty", func(b *Builder) {}, "empty document"},
+	} {
+		var w Writer
+		b := NewBuilder(&w)
+		test.build(b)
+		if _, err := b.BuildBytes(); err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("%s: BuildBytes() error = %v; want %q", test.name, err, test.want)
+		}
+	}
+}
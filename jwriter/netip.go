@@ -0,0 +1,53 @@
+//go:build go1.18
+// +build go1.18
+
+package jwriter
+
+import (
+	"encoding/hex"
+	"net"
+	"net/netip"
+)
+
+// maxIPLen is the length of the longest IP prefix without a zone.
+const maxIPLen = len("ffff:ffff:ffff:ffff:ffff:ffff:255.255.255.255/128")
+
+// IP writes ip as a string like net.IP.MarshalText does it, that is an empty string for an
+// empty ip, without allocating.
+func (w *Writer) IP(ip net.IP) {
+	if len(ip) == 0 {
+		w.RawString(`""`)
+		return
+	}
+	addr, ok := netip.AddrFromSlice(ip)
+	if !ok {
+		if w.Error == nil {
+			w.Error = &net.AddrError{Err: "invalid IP address", Addr: hex.EncodeToString(ip)}
+		}
+		return
+	}
+	w.IPAddr(addr.Unmap())
+}
+
+// IPAddr writes addr as a string like netip.Addr.MarshalText does it, that is an empty string
+// for the zero Addr.
+func (w *Writer) IPAddr(addr netip.Addr) {
+	if addr.Zone() != "" {
+		// Zones are arbitrary strings, possibly to be escaped.
+		w.String(addr.String())
+		return
+	}
+	w.Buffer.EnsureSpace(maxIPLen + 2)
+	w.Buffer.Buf = append(w.Buffer.Buf, '"')
+	w.Buffer.Buf = addr.AppendTo(w.Buffer.Buf)
+	w.Buffer.Buf = append(w.Buffer.Buf, '"')
+}
+
+// IPPrefix writes p as a string like netip.Prefix.MarshalText does it, that is an empty string
+// for the zero Prefix.
+func (w *Writer) IPPrefix(p netip.Prefix) {
+	w.Buffer.EnsureSpace(maxIPLen + 2)
+	w.Buffer.Buf = append(w.Buffer.Buf, '"')
+	w.Buffer.Buf = p.AppendTo(w.Buffer.Buf)
+	w.Buffer.Buf = append(w.Buffer.Buf, '"')
+}
@@ -0,0 +1,52 @@
+//go:build go1.18
+// +build go1.18
+
+package jwriter
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+)
+
+func TestIP(t *testing.T) {
+	w := Writer{}
+	w.IP(net.IPv4(192, 0, 2, 1))
+	w.RawByte(',')
+	w.IP(net.ParseIP("2001:db8::1"))
+	w.RawByte(',')
+	w.IP(nil)
+	w.RawByte(',')
+	w.IPAddr(netip.MustParseAddr("fe80::1%eth\"0"))
+	w.RawByte(',')
+	w.IPAddr(netip.Addr{})
+	w.RawByte(',')
+	w.IPPrefix(netip.MustParsePrefix("10.0.0.0/8"))
+	w.RawByte(',')
+	w.IPPrefix(netip.Prefix{})
+
+	want := `"192.0.2.1","2001:db8::1","","fe80::1%eth\"0","","10.0.0.0/8",""`
+	if got, err := w.BuildBytes(); err != nil || string(got) != want {
+		t.Errorf("BuildBytes() = %s, %v; want %s", got, err, want)
+	}
+
+	w = Writer{}
+	w.IP(net.IP{1, 2})
+	if _, err := w.BuildBytes(); err == nil {
+		t.Error("IP() of a 2 byte address succeeded; want an error")
+	}
+}
+
+func TestIPAllocs(t *testing.T) {
+	ip := net.ParseIP("ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff")
+	p := netip.MustParsePrefix("2001:db8::/32")
+	w := Writer{}
+	w.Buffer.EnsureSpace(1024)
+	if n := testing.AllocsPerRun(100, func() {
+		w.Buffer.Buf = w.Buffer.Buf[:0]
+		w.IP(ip)
+		w.IPPrefix(p)
+	}); n != 0 {
+		t.Errorf("IP() and IPPrefix() allocate %v times; want 0", n)
+	}
+}
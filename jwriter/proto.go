@@ -0,0 +1,80 @@
+package jwriter
+
+import (
+	"errors"
+	"strconv"
+	"time"
+)
+
+// Bounds of the protobuf Timestamp and Duration values, the years 1 to 9999 and about 10000
+// years.
+const (
+	minProtoTimestampSeconds = -62135596800
+	maxProtoTimestampSeconds = 253402300799
+	maxProtoDurationSeconds  = 315576000000
+)
+
+// ProtoTimestamp writes the protobuf Timestamp of the given seconds and nanoseconds since the
+// Unix epoch as its canonical JSON mapping: an RFC 3339 string in UTC with 0, 3, 6 or 9
+// fractional digits, e.g. "1972-01-01T10:00:20.021Z".
+func (w *Writer) ProtoTimestamp(seconds int64, nanos int32) {
+	if seconds < minProtoTimestampSeconds || seconds > maxProtoTimestampSeconds || nanos < 0 || nanos >= 1e9 {
+		if w.Error == nil {
+			w.Error = errors.New("jwriter: protobuf Timestamp out of range")
+		}
+		return
+	}
+	t := time.Unix(seconds, 0).UTC()
+
+	w.Buffer.EnsureSpace(len(`"9999-12-31T23:59:59.999999999Z"`))
+	w.Buffer.Buf = append(w.Buffer.Buf, '"')
+	w.Buffer.Buf = t.AppendFormat(w.Buffer.Buf, "2006-01-02T15:04:05")
+	w.Buffer.Buf = appendProtoNanos(w.Buffer.Buf, nanos)
+	w.Buffer.Buf = append(w.Buffer.Buf, 'Z', '"')
+}
+
+// ProtoDuration writes the protobuf Duration of the given seconds and nanoseconds, of the same
+// sign, as its canonical JSON mapping: a string of the seconds with 0, 3, 6 or 9 fractional
+// digits and the suffix "s", e.g. "-1.500s".
+func (w *Writer) ProtoDuration(seconds int64, nanos int32) {
+	if seconds < -maxProtoDurationSeconds || seconds > maxProtoDurationSeconds || nanos <= -1e9 || nanos >= 1e9 ||
+		(seconds > 0 && nanos < 0) || (seconds < 0 && nanos > 0) {
+		if w.Error == nil {
+			w.Error = errors.New("jwriter: protobuf Duration out of range")
+		}
+		return
+	}
+
+	w.Buffer.EnsureSpace(len(`"-315576000000.000000000s"`))
+	w.Buffer.Buf = append(w.Buffer.Buf, '"')
+	if seconds < 0 || nanos < 0 {
+		w.Buffer.Buf = append(w.Buffer.Buf, '-')
+		seconds, nanos = -seconds, -nanos
+	}
+	w.Buffer.Buf = strconv.AppendInt(w.Buffer.Buf, seconds, 10)
+	w.Buffer.Buf = appendProtoNanos(w.Buffer.Buf, nanos)
+	w.Buffer.Buf = append(w.Buffer.Buf, 's', '"')
+}
+
+// appendProtoNanos appends the fraction of a second of nanos to buf, with as few of 3, 6 or 9
+// digits as needed, or nothing if nanos is 0.
+func appendProtoNanos(buf []byte, nanos int32) []byte {
+	if nanos == 0 {
+		return buf
+	}
+	digits := 9
+	for nanos%1000 == 0 {
+		nanos /= 1000
+		digits -= 3
+	}
+
+	buf = append(buf, '.')
+	for i := 0; i < digits; i++ {
+		buf = append(buf, '0')
+	}
+	for i := len(buf) - 1; nanos > 0; i-- {
+		buf[i] = byte('0' + nanos%10)
+		nanos /= 10
+	}
+	return buf
+}
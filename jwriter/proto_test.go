@@ -0,0 +1,53 @@
+package jwriter
+
+import "testing"
+
+func TestProtoTimestamp(t *testing.T) {
+	w := Writer{}
+	w.ProtoTimestamp(0, 0)
+	w.RawByte(',')
+	w.ProtoTimestamp(63108020, 21e6)
+	w.RawByte(',')
+	w.ProtoTimestamp(-1, 999999999)
+	w.RawByte(',')
+	w.ProtoTimestamp(253402300799, 1000)
+
+	want := `"1970-01-01T00:00:00Z","1972-01-01T10:00:20.021Z","1969-12-31T23:59:59.999999999Z","9999-12-31T23:59:59.000001Z"`
+	if got, err := w.BuildBytes(); err != nil || string(got) != want {
+		t.Errorf("BuildBytes() = %s, %v; want %s", got, err, want)
+	}
+
+	for _, v := range [][2]int64{{253402300800, 0}, {-62135596801, 0}, {0, -1}, {0, 1e9}} {
+		w := Writer{}
+		w.ProtoTimestamp(v[0], int32(v[1]))
+		if _, err := w.BuildBytes(); err == nil {
+			t.Errorf("ProtoTimestamp(%d, %d) succeeded; want an error", v[0], v[1])
+		}
+	}
+}
+
+func TestProtoDuration(t *testing.T) {
+	w := Writer{}
+	w.ProtoDuration(0, 0)
+	w.RawByte(',')
+	w.ProtoDuration(1, 500e6)
+	w.RawByte(',')
+	w.ProtoDuration(-1, -500e6)
+	w.RawByte(',')
+	w.ProtoDuration(0, -1)
+	w.RawByte(',')
+	w.ProtoDuration(315576000000, 10e3)
+
+	want := `"0s","1.500s","-1.500s","-0.000000001s","315576000000.000010s"`
+	if got, err := w.BuildBytes(); err != nil || string(got) != want {
+		t.Errorf("BuildBytes() = %s, %v; want %s", got, err, want)
+	}
+
+	for _, v := range [][2]int64{{315576000001, 0}, {1, -1}, {-1, 1}, {0, 1e9}} {
+		w := Writer{}
+		w.ProtoDuration(v[0], int32(v[1]))
+		if _, err := w.BuildBytes(); err == nil {
+			t.Errorf("ProtoDuration(%d, %d) succeeded; want an error", v[0], v[1])
+		}
+	}
+}
@@ -2,8 +2,16 @@
 package jwriter
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"io"
+	"io/ioutil"
+	"math"
+	"math/big"
 	"strconv"
+	"strings"
+	"time"
 	"unicode/utf8"
 
 	"github.com/mailru/easyjson/buffer"
@@ -16,6 +24,8 @@ type Flags int
 const (
 	NilMapAsEmpty   Flags = 1 << iota // Encode nil map as '{}' rather than 'null'.
 	NilSliceAsEmpty                   // Encode nil slice as '[]' rather than 'null'.
+	NilElemSkip                       // Leave out nil pointer elements of slices and arrays rather than encode them as 'null'.
+	NilElemError                      // Fail on nil pointer elements of slices and arrays rather than encode them as 'null'.
 )
 
 // Writer is a JSON writer.
@@ -25,16 +35,112 @@ type Writer struct {
 	Error        error
 	Buffer       buffer.Buffer
 	NoEscapeHTML bool
+
+	version   string
+	transform func(io.Writer) io.WriteCloser
+}
+
+// SetTransform makes the writer pass the data it outputs through the writer returned by
+// wrap, e.g. one encrypting, compressing or signing it. With DumpTo, the data is transformed
+// chunk by chunk as it is written out, without being copied into a single buffer first.
+// The writer returned by wrap is closed after all of the data is written to it, and should
+// not close the underlying writer.
+func (w *Writer) SetTransform(wrap func(io.Writer) io.WriteCloser) {
+	w.transform = wrap
+}
+
+// SetVersion sets the API version to marshal data for. Fields tagged with
+// `easyjson:"since=v2"` are only written for versions v2 and later, while fields tagged with
+// `easyjson:"until=v3"` are only written for versions before v3. If no version is set, all
+// fields are written.
+func (w *Writer) SetVersion(v string) {
+	w.version = v
+}
+
+// Version returns the API version set with SetVersion.
+func (w *Writer) Version() string {
+	return w.version
+}
+
+// VersionAtLeast returns true if no version is set or the version is not older than v.
+func (w *Writer) VersionAtLeast(v string) bool {
+	return w.version == "" || compareVersions(w.version, v) >= 0
+}
+
+// VersionBefore returns true if no version is set or the version is older than v.
+func (w *Writer) VersionBefore(v string) bool {
+	return w.version == "" || compareVersions(w.version, v) < 0
 }
 
-// Size returns the size of the data that was written out.
+// compareVersions compares versions like "v1", "2.1" or "v1.10.2" component by component,
+// numerically if possible.
+func compareVersions(a, b string) int {
+	as := strings.Split(strings.TrimPrefix(a, "v"), ".")
+	bs := strings.Split(strings.TrimPrefix(b, "v"), ".")
+
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y string
+		if i < len(as) {
+			x = as[i]
+		}
+		if i < len(bs) {
+			y = bs[i]
+		}
+
+		xn, xerr := strconv.Atoi(x)
+		yn, yerr := strconv.Atoi(y)
+		switch {
+		case x == y:
+			continue
+		case (xerr == nil || x == "") && (yerr == nil || y == ""):
+			if xn < yn {
+				return -1
+			} else if xn > yn {
+				return 1
+			}
+		case x < y:
+			return -1
+		default:
+			return 1
+		}
+	}
+	return 0
+}
+
+// Size returns the size of the data that was written out, before any transform is applied.
+// It takes constant time, so it can be used e.g. to set Content-Length before DumpTo or to
+// record payload sizes.
 func (w *Writer) Size() int {
 	return w.Buffer.Size()
 }
 
-// DumpTo outputs the data to given io.Writer, resetting the buffer.
+// DumpTo outputs the data to given io.Writer, resetting the buffer. If a transform is set,
+// written is the number of bytes passed to it.
 func (w *Writer) DumpTo(out io.Writer) (written int, err error) {
-	return w.Buffer.DumpTo(out)
+	if w.transform == nil {
+		return w.Buffer.DumpTo(out)
+	}
+
+	tw := w.transform(out)
+	written, err = w.Buffer.DumpTo(tw)
+	if cerr := tw.Close(); err == nil {
+		err = cerr
+	}
+	return written, err
+}
+
+// transformed returns the data passed through the transform, resetting the buffer.
+func (w *Writer) transformed(reuse ...[]byte) ([]byte, error) {
+	var out *bytes.Buffer
+	if len(reuse) != 0 {
+		out = bytes.NewBuffer(reuse[0][:0])
+	} else {
+		out = bytes.NewBuffer(make([]byte, 0, w.Buffer.Size()))
+	}
+	if _, err := w.DumpTo(out); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
 }
 
 // BuildBytes returns writer data as a single byte slice. You can optionally provide one byte slice
@@ -43,6 +149,9 @@ func (w *Writer) BuildBytes(reuse ...[]byte) ([]byte, error) {
 	if w.Error != nil {
 		return nil, w.Error
 	}
+	if w.transform != nil {
+		return w.transformed(reuse...)
+	}
 
 	return w.Buffer.BuildBytes(reuse...), nil
 }
@@ -53,6 +162,13 @@ func (w *Writer) ReadCloser() (io.ReadCloser, error) {
 	if w.Error != nil {
 		return nil, w.Error
 	}
+	if w.transform != nil {
+		data, err := w.transformed()
+		if err != nil {
+			return nil, err
+		}
+		return ioutil.NopCloser(bytes.NewReader(data)), nil
+	}
 
 	return w.Buffer.ReadCloser(), nil
 }
@@ -62,6 +178,22 @@ func (w *Writer) RawByte(c byte) {
 	w.Buffer.AppendByte(c)
 }
 
+// SkipNilElem is called by the generated code for nil pointer elements of slices and arrays.
+// It returns true if the element is to be left out according to NilElemSkip and NilElemError
+// flags, setting the error in the latter case.
+func (w *Writer) SkipNilElem() bool {
+	switch {
+	case w.Flags&NilElemError != 0:
+		if w.Error == nil {
+			w.Error = errors.New("jwriter: nil element in array")
+		}
+		return true
+	case w.Flags&NilElemSkip != 0:
+		return true
+	}
+	return false
+}
+
 // RawByte appends raw binary data to the buffer.
 func (w *Writer) RawString(s string) {
 	w.Buffer.AppendString(s)
@@ -82,6 +214,64 @@ func (w *Writer) Raw(data []byte, err error) {
 	}
 }
 
+// RawCompat appends the result of a MarshalJSON-like function like encoding/json does it:
+// the data is validated, compacted and HTML-escaped unless NoEscapeHTML is set.
+func (w *Writer) RawCompat(data []byte, err error) {
+	switch {
+	case w.Error != nil:
+		return
+	case err != nil:
+		w.Error = err
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, data); err != nil {
+		w.Error = err
+		return
+	}
+	if w.NoEscapeHTML {
+		w.Buffer.AppendBytes(buf.Bytes())
+		return
+	}
+
+	var escaped bytes.Buffer
+	json.HTMLEscape(&escaped, buf.Bytes())
+	w.Buffer.AppendBytes(escaped.Bytes())
+}
+
+// RawTextCompat appends the result of a MarshalText-like function as a string like
+// encoding/json does it.
+func (w *Writer) RawTextCompat(data []byte, err error) {
+	switch {
+	case w.Error != nil:
+		return
+	case err != nil:
+		w.Error = err
+	default:
+		w.StringCompat(string(data))
+	}
+}
+
+// TextKey writes the result of a MarshalText-like function as an object member name or sets
+// the error if it is given. Unlike RawText, empty data is written as an empty string.
+func (w *Writer) TextKey(data []byte, err error) {
+	w.TextString(data, err)
+}
+
+// TextString writes the result of a MarshalText-like function as a string or sets the error if
+// it is given. Unlike RawText, empty data is written as an empty string.
+func (w *Writer) TextString(data []byte, err error) {
+	switch {
+	case w.Error != nil:
+		return
+	case err != nil:
+		w.Error = err
+	default:
+		w.String(string(data))
+	}
+}
+
 // RawText encloses raw binary data in quotes and appends in to the buffer.
 // Useful for calling with results of MarshalText-like functions.
 func (w *Writer) RawText(data []byte, err error) {
@@ -104,10 +294,88 @@ func (w *Writer) Base64Bytes(data []byte) {
 		return
 	}
 	w.Buffer.AppendByte('"')
-	w.base64(data)
+	w.base64(data, encode)
+	w.Buffer.AppendByte('"')
+}
+
+// Base64URLBytes appends data to the buffer after base64 encoding it with the URL and file name
+// safe alphabet of RFC 4648, with padding.
+func (w *Writer) Base64URLBytes(data []byte) {
+	if data == nil {
+		w.Buffer.AppendString("null")
+		return
+	}
+	w.Buffer.AppendByte('"')
+	w.base64(data, encodeURL)
 	w.Buffer.AppendByte('"')
 }
 
+// Base16Bytes appends data as a string of two lowercase hex digits per byte, without a prefix,
+// e.g. "00ff". A nil slice is written as null.
+func (w *Writer) Base16Bytes(data []byte) {
+	if data == nil {
+		w.Buffer.AppendString("null")
+		return
+	}
+	w.Buffer.EnsureSpace(2*len(data) + 2)
+	w.Buffer.Buf = append(w.Buffer.Buf, '"')
+	for _, b := range data {
+		w.Buffer.Buf = append(w.Buffer.Buf, chars[b>>4], chars[b&0xf])
+	}
+	w.Buffer.Buf = append(w.Buffer.Buf, '"')
+}
+
+// Uint64Hex appends n as a string of hex digits prefixed with 0x, without leading zeros,
+// e.g. "0x1f".
+func (w *Writer) Uint64Hex(n uint64) {
+	w.Buffer.EnsureSpace(20)
+	w.Buffer.Buf = append(w.Buffer.Buf, '"', '0', 'x')
+	w.Buffer.Buf = strconv.AppendUint(w.Buffer.Buf, n, 16)
+	w.Buffer.Buf = append(w.Buffer.Buf, '"')
+}
+
+// RawStringHex appends data as a string of two hex digits per byte prefixed with 0x, e.g.
+// "0x00ff", keeping the leading zeros of fixed-width values like hashes. A nil slice is
+// written as null.
+func (w *Writer) RawStringHex(data []byte) {
+	if data == nil {
+		w.Buffer.AppendString("null")
+		return
+	}
+	w.Buffer.EnsureSpace(2*len(data) + 4)
+	w.Buffer.Buf = append(w.Buffer.Buf, '"', '0', 'x')
+	for _, b := range data {
+		w.Buffer.Buf = append(w.Buffer.Buf, chars[b>>4], chars[b&0xf])
+	}
+	w.Buffer.Buf = append(w.Buffer.Buf, '"')
+}
+
+// Time appends t as a string formatted with the layout, as accepted by time.Time.Format.
+func (w *Writer) Time(t time.Time, layout string) {
+	w.String(t.Format(layout))
+}
+
+// UnixTime appends t as the number of seconds since the Unix epoch, rounded down.
+func (w *Writer) UnixTime(t time.Time) {
+	w.Int64(t.Unix())
+}
+
+// UnixMilliTime appends t as the number of milliseconds since the Unix epoch, rounded down.
+func (w *Writer) UnixMilliTime(t time.Time) {
+	w.Int64(t.Unix()*1e3 + int64(t.Nanosecond())/1e6)
+}
+
+// UnixMicroTime appends t as the number of microseconds since the Unix epoch, rounded down.
+func (w *Writer) UnixMicroTime(t time.Time) {
+	w.Int64(t.Unix()*1e6 + int64(t.Nanosecond())/1e3)
+}
+
+// UnixNanoTime appends t as the number of nanoseconds since the Unix epoch, like
+// time.Time.UnixNano.
+func (w *Writer) UnixNanoTime(t time.Time) {
+	w.Int64(t.UnixNano())
+}
+
 func (w *Writer) Uint8(n uint8) {
 	w.Buffer.EnsureSpace(3)
 	w.Buffer.Buf = strconv.AppendUint(w.Buffer.Buf, uint64(n), 10)
@@ -259,6 +527,262 @@ func (w *Writer) Float64Str(n float64) {
 	w.Buffer.Buf = append(w.Buffer.Buf, '"')
 }
 
+// JsonNumber writes the number n as is, like encoding/json does it: an empty number is written as
+// 0, and numbers that are not valid JSON number literals result in an error.
+func (w *Writer) JsonNumber(n json.Number) {
+	w.jsonNumber(n, false)
+}
+
+// JsonNumberStr writes the number n like JsonNumber, but enclosed in quotes.
+func (w *Writer) JsonNumberStr(n json.Number) {
+	w.jsonNumber(n, true)
+}
+
+func (w *Writer) jsonNumber(n json.Number, quoted bool) {
+	s := string(n)
+	if s == "" {
+		s = "0"
+	}
+	if !isValidNumber(s) {
+		if w.Error == nil {
+			w.Error = errors.New("json: invalid number literal " + strconv.Quote(s))
+		}
+		return
+	}
+	if quoted {
+		w.Buffer.AppendByte('"')
+	}
+	w.Buffer.AppendString(s)
+	if quoted {
+		w.Buffer.AppendByte('"')
+	}
+}
+
+// isValidNumber tells if s is a valid JSON number literal.
+func isValidNumber(s string) bool {
+	if s == "" {
+		return false
+	}
+	if s[0] == '-' {
+		s = s[1:]
+	}
+	switch {
+	case s == "":
+		return false
+	case s[0] == '0':
+		s = s[1:]
+	case '1' <= s[0] && s[0] <= '9':
+		s = skipDigits(s[1:])
+	default:
+		return false
+	}
+	if len(s) >= 2 && s[0] == '.' && isDigit(s[1]) {
+		s = skipDigits(s[2:])
+	}
+	if len(s) >= 2 && (s[0] == 'e' || s[0] == 'E') {
+		s = s[1:]
+		if s[0] == '+' || s[0] == '-' {
+			s = s[1:]
+		}
+		if s == "" || !isDigit(s[0]) {
+			return false
+		}
+		s = skipDigits(s)
+	}
+	return s == ""
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func skipDigits(s string) string {
+	for len(s) > 0 && isDigit(s[0]) {
+		s = s[1:]
+	}
+	return s
+}
+
+// BigInt appends n as a number, or null if n is nil.
+func (w *Writer) BigInt(n *big.Int) {
+	if n == nil {
+		w.RawString("null")
+		return
+	}
+	w.Buffer.EnsureSpace(n.BitLen()/3 + 2)
+	w.Buffer.Buf = n.Append(w.Buffer.Buf, 10)
+}
+
+// BigIntStr appends n as a decimal string, or null if n is nil.
+func (w *Writer) BigIntStr(n *big.Int) {
+	if n == nil {
+		w.RawString("null")
+		return
+	}
+	w.Buffer.EnsureSpace(n.BitLen()/3 + 4)
+	w.Buffer.Buf = append(w.Buffer.Buf, '"')
+	w.Buffer.Buf = n.Append(w.Buffer.Buf, 10)
+	w.Buffer.Buf = append(w.Buffer.Buf, '"')
+}
+
+// BigFloat appends f as a number with the fewest digits representing it exactly at its
+// precision, or null if f is nil. Infinities result in an error.
+func (w *Writer) BigFloat(f *big.Float) {
+	if f == nil {
+		w.RawString("null")
+		return
+	}
+	if f.IsInf() {
+		if w.Error == nil {
+			w.Error = errors.New("json: unsupported value: " + f.String())
+		}
+		return
+	}
+	w.Buffer.AppendString(f.Text('g', -1))
+}
+
+// BigFloatStr appends f as a string like big.Float.MarshalText does it, or null if f is nil.
+func (w *Writer) BigFloatStr(f *big.Float) {
+	if f == nil {
+		w.RawString("null")
+		return
+	}
+	w.Buffer.AppendByte('"')
+	w.Buffer.AppendString(f.Text('g', -1))
+	w.Buffer.AppendByte('"')
+}
+
+// BigRat appends r as an integer or a decimal number, or null if r is nil. Numbers without an
+// exact decimal representation, e.g. 1/3, result in an error.
+func (w *Writer) BigRat(r *big.Rat) {
+	if r == nil {
+		w.RawString("null")
+		return
+	}
+	if r.IsInt() {
+		w.BigInt(r.Num())
+		return
+	}
+	prec, ok := decimalPlaces(r.Denom())
+	if !ok {
+		if w.Error == nil {
+			w.Error = errors.New("json: big.Rat " + r.String() + " has no exact decimal representation")
+		}
+		return
+	}
+	w.Buffer.AppendString(r.FloatString(prec))
+}
+
+// BigRatStr appends r as a string like big.Rat.MarshalText does it, e.g. "3/4", or null if r
+// is nil.
+func (w *Writer) BigRatStr(r *big.Rat) {
+	if r == nil {
+		w.RawString("null")
+		return
+	}
+	w.Buffer.AppendByte('"')
+	w.Buffer.AppendString(r.RatString())
+	w.Buffer.AppendByte('"')
+}
+
+// decimalPlaces returns the number of decimal places of the fractions with the positive
+// denominator d, if they are finite: d only has the prime factors 2 and 5.
+func decimalPlaces(d *big.Int) (int, bool) {
+	twos := d.TrailingZeroBits()
+	rest := new(big.Int).Rsh(d, twos)
+
+	fives := 0
+	five := big.NewInt(5)
+	var q, m big.Int
+	for rest.BitLen() > 1 {
+		q.QuoRem(rest, five, &m)
+		if m.Sign() != 0 {
+			return 0, false
+		}
+		rest.Set(&q)
+		fives++
+	}
+	if int(twos) > fives {
+		return int(twos), true
+	}
+	return fives, true
+}
+
+// ValidUTF8 returns s with each invalid UTF-8 byte replaced with the Unicode replacement
+// character, as encoding/json does it when writing and reading strings.
+func ValidUTF8(s string) string {
+	if utf8.ValidString(s) {
+		return s
+	}
+
+	ret := make([]byte, 0, len(s)+8)
+	for i := 0; i < len(s); {
+		c, size := utf8.DecodeRuneInString(s[i:])
+		if c == utf8.RuneError && size == 1 {
+			ret = append(ret, "\ufffd"...)
+		} else {
+			ret = append(ret, s[i:i+size]...)
+		}
+		i += size
+	}
+	return string(ret)
+}
+
+// Float32Compat writes a float32 formatted like encoding/json does it. NaN and infinite
+// values are not supported and set the writer error.
+func (w *Writer) Float32Compat(n float32) {
+	w.floatCompat(float64(n), 32)
+}
+
+// Float32CompatStr writes a float32 formatted like Float32Compat as a quoted string.
+func (w *Writer) Float32CompatStr(n float32) {
+	w.Buffer.AppendByte('"')
+	w.floatCompat(float64(n), 32)
+	w.Buffer.AppendByte('"')
+}
+
+// Float64Compat writes a float64 formatted like encoding/json does it. NaN and infinite
+// values are not supported and set the writer error.
+func (w *Writer) Float64Compat(n float64) {
+	w.floatCompat(n, 64)
+}
+
+// Float64CompatStr writes a float64 formatted like Float64Compat as a quoted string.
+func (w *Writer) Float64CompatStr(n float64) {
+	w.Buffer.AppendByte('"')
+	w.floatCompat(n, 64)
+	w.Buffer.AppendByte('"')
+}
+
+// floatCompat writes a float formatted like encoding/json: 'f' format is used for regular
+// magnitudes and 'e' format with a short exponent for very small and large ones.
+func (w *Writer) floatCompat(n float64, bits int) {
+	if math.IsInf(n, 0) || math.IsNaN(n) {
+		if w.Error == nil {
+			w.Error = errors.New("json: unsupported value: " + strconv.FormatFloat(n, 'g', -1, bits))
+		}
+		return
+	}
+
+	format := byte('f')
+	if abs := math.Abs(n); abs != 0 {
+		if bits == 64 && (abs < 1e-6 || abs >= 1e21) || bits == 32 && (float32(abs) < 1e-6 || float32(abs) >= 1e21) {
+			format = 'e'
+		}
+	}
+
+	w.Buffer.EnsureSpace(24)
+	w.Buffer.Buf = strconv.AppendFloat(w.Buffer.Buf, n, format, -1, bits)
+	if format == 'e' {
+		// clean up e-09 to e-9
+		b := w.Buffer.Buf
+		if l := len(b); l >= 4 && b[l-4] == 'e' && b[l-3] == '-' && b[l-2] == '0' {
+			b[l-2] = b[l-1]
+			w.Buffer.Buf = b[:l-1]
+		}
+	}
+}
+
 func (w *Writer) Bool(v bool) {
 	w.Buffer.EnsureSpace(5)
 	if v {
@@ -268,6 +792,16 @@ func (w *Writer) Bool(v bool) {
 	}
 }
 
+// BoolStr appends v as a quoted "true" or "false" string, as the 'string' tag option does.
+func (w *Writer) BoolStr(v bool) {
+	w.Buffer.EnsureSpace(7)
+	if v {
+		w.Buffer.Buf = append(w.Buffer.Buf, `"true"`...)
+	} else {
+		w.Buffer.Buf = append(w.Buffer.Buf, `"false"`...)
+	}
+}
+
 const chars = "0123456789abcdef"
 
 func getTable(falseValues ...int) [128]bool {
@@ -290,6 +824,39 @@ var (
 )
 
 func (w *Writer) String(s string) {
+	w.string(s, false)
+}
+
+// StringCompat writes a string escaped like encoding/json does it, that is with short
+// escapes for backspace and form feed characters.
+func (w *Writer) StringCompat(s string) {
+	w.string(s, true)
+}
+
+// UUID writes u as a string in the canonical form, e.g. "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+// like the MarshalText methods of the UUID types do it.
+func (w *Writer) UUID(u [16]byte) {
+	w.Buffer.EnsureSpace(38)
+	b := append(w.Buffer.Buf, '"')
+	for i, c := range u {
+		if i == 4 || i == 6 || i == 8 || i == 10 {
+			b = append(b, '-')
+		}
+		b = append(b, chars[c>>4], chars[c&0xf])
+	}
+	w.Buffer.Buf = append(b, '"')
+}
+
+// StringNoEscape writes s as a string literal without looking for characters to escape, which
+// is only valid for strings known to consist of printable ASCII characters other than '"' and
+// '\\', and other than '<', '>' and '&' unless NoEscapeHTML is set, e.g. UUIDs or enum names.
+func (w *Writer) StringNoEscape(s string) {
+	w.Buffer.AppendByte('"')
+	w.Buffer.AppendString(s)
+	w.Buffer.AppendByte('"')
+}
+
+func (w *Writer) string(s string, compat bool) {
 	w.Buffer.AppendByte('"')
 
 	// Portions of the string that contain no escapes are appended as
@@ -324,6 +891,18 @@ func (w *Writer) String(s string) {
 				w.Buffer.AppendString(`\\`)
 			case '"':
 				w.Buffer.AppendString(`\"`)
+			case '\b', '\f':
+				if compat {
+					w.Buffer.AppendByte('\\')
+					if c == '\b' {
+						w.Buffer.AppendByte('b')
+					} else {
+						w.Buffer.AppendByte('f')
+					}
+					break
+				}
+				w.Buffer.AppendString(`\u000`)
+				w.Buffer.AppendByte(chars[c])
 			default:
 				w.Buffer.AppendString(`\u00`)
 				w.Buffer.AppendByte(chars[c>>4])
@@ -339,7 +918,11 @@ func (w *Writer) String(s string) {
 		runeValue, runeWidth := utf8.DecodeRuneInString(s[i:])
 		if runeValue == utf8.RuneError && runeWidth == 1 {
 			w.Buffer.AppendString(s[p:i])
-			w.Buffer.AppendString(`\ufffd`)
+			if compat {
+				w.Buffer.AppendString("\ufffd")
+			} else {
+				w.Buffer.AppendString(`\ufffd`)
+			}
 			i++
 			p = i
 			continue
@@ -361,9 +944,10 @@ func (w *Writer) String(s string) {
 }
 
 const encode = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"
+const encodeURL = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
 const padChar = '='
 
-func (w *Writer) base64(in []byte) {
+func (w *Writer) base64(in []byte, encode string) {
 
 	if len(in) == 0 {
 		return
@@ -0,0 +1,223 @@
+package jwriter
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+// xorWriter is a toy cipher recording the sizes of the chunks it transforms.
+type xorWriter struct {
+	w      io.Writer
+	chunks []int
+	closed bool
+}
+
+func (x *xorWriter) Write(p []byte) (int, error) {
+	x.chunks = append(x.chunks, len(p))
+	buf := make([]byte, len(p))
+	for i, c := range p {
+		buf[i] = c ^ 0x5a
+	}
+	return x.w.Write(buf)
+}
+
+func (x *xorWriter) Close() error {
+	x.closed = true
+	return nil
+}
+
+func TestTransform(t *testing.T) {
+	data := `"` + strings.Repeat("x", 10000) + `"`
+	want := make([]byte, len(data))
+	for i := range data {
+		want[i] = data[i] ^ 0x5a
+	}
+
+	var xw *xorWriter
+	w := Writer{}
+	w.SetTransform(func(out io.Writer) io.WriteCloser {
+		xw = &xorWriter{w: out}
+		return xw
+	})
+	w.String(data[1 : len(data)-1])
+
+	var out bytes.Buffer
+	written, err := w.DumpTo(&out)
+	if err != nil || written != len(data) {
+		t.Errorf("DumpTo() = %v, %v; want %v, nil", written, err, len(data))
+	}
+	if !bytes.Equal(out.Bytes(), want) {
+		t.Errorf("DumpTo() wrote untransformed data")
+	}
+	if !xw.closed || len(xw.chunks) < 2 {
+		t.Errorf("transform got chunks %v, closed %v; want several chunks and closed", xw.chunks, xw.closed)
+	}
+
+	for _, build := range []func(w *Writer) ([]byte, error){
+		func(w *Writer) ([]byte, error) { return w.BuildBytes() },
+		func(w *Writer) ([]byte, error) {
+			r, err := w.ReadCloser()
+			if err != nil {
+				return nil, err
+			}
+			defer r.Close()
+			return ioutil.ReadAll(r)
+		},
+	} {
+		w.String(data[1 : len(data)-1])
+		if got, err := build(&w); err != nil || !bytes.Equal(got, want) {
+			t.Errorf("built data is not transformed (error %v)", err)
+		}
+	}
+}
+
+func TestTransformGzip(t *testing.T) {
+	w := Writer{}
+	w.SetTransform(func(out io.Writer) io.WriteCloser { return gzip.NewWriter(out) })
+	w.String("gzipped")
+
+	data, err := w.BuildBytes()
+	if err != nil {
+		t.Fatalf("BuildBytes() error: %v", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("gzip.NewReader() error: %v", err)
+	}
+	if got, err := ioutil.ReadAll(r); err != nil || string(got) != `"gzipped"` {
+		t.Errorf("decompressed data = %s, %v; want \"gzipped\"", got, err)
+	}
+}
+
+func TestBytesFormats(t *testing.T) {
+	w := Writer{}
+	w.Base64URLBytes([]byte{0xfb, 0xff})
+	w.RawByte(',')
+	w.Base64URLBytes(nil)
+	w.RawByte(',')
+	w.Base16Bytes([]byte{0, 0xab})
+	w.RawByte(',')
+	w.Base16Bytes([]byte{})
+	w.RawByte(',')
+	w.Base16Bytes(nil)
+
+	want := `"-_8=",null,"00ab","",null`
+	if got, err := w.BuildBytes(); err != nil || string(got) != want {
+		t.Errorf("BuildBytes() = %s, %v; want %s", got, err, want)
+	}
+}
+
+func TestJsonNumber(t *testing.T) {
+	w := Writer{}
+	w.JsonNumber("")
+	w.RawByte(',')
+	w.JsonNumber("-12345678901234567890.5e+10")
+	w.RawByte(',')
+	w.JsonNumberStr("0.25")
+
+	want := `0,-12345678901234567890.5e+10,"0.25"`
+	if got, err := w.BuildBytes(); err != nil || string(got) != want {
+		t.Errorf("BuildBytes() = %s, %v; want %s", got, err, want)
+	}
+
+	for _, n := range []json.Number{"1.", "01", ".5", "1e", "1e+", "-", "+1", "0x10", "NaN", "1 "} {
+		w := Writer{}
+		w.JsonNumber(n)
+		if _, err := w.BuildBytes(); err == nil {
+			t.Errorf("JsonNumber(%q) succeeded; want an invalid number literal error", n)
+		}
+	}
+}
+
+func TestBigNumbers(t *testing.T) {
+	n, _ := new(big.Int).SetString("-123456789012345678901234567890", 10)
+	w := Writer{}
+	w.BigInt(n)
+	w.RawByte(',')
+	w.BigIntStr(big.NewInt(0))
+	w.RawByte(',')
+	w.BigInt(nil)
+	w.RawByte(',')
+	w.BigFloat(big.NewFloat(1.5e300))
+	w.RawByte(',')
+	w.BigFloatStr(big.NewFloat(-0.1))
+	w.RawByte(',')
+	w.BigRat(big.NewRat(-7, 40))
+	w.RawByte(',')
+	w.BigRat(big.NewRat(12, 3))
+	w.RawByte(',')
+	w.BigRatStr(big.NewRat(2, 6))
+
+	want := `-123456789012345678901234567890,"0",null,1.5e+300,"-0.1",-0.175,4,"1/3"`
+	if got, err := w.BuildBytes(); err != nil || string(got) != want {
+		t.Errorf("BuildBytes() = %s, %v; want %s", got, err, want)
+	}
+
+	w = Writer{}
+	w.BigRat(big.NewRat(1, 3))
+	if _, err := w.BuildBytes(); err == nil {
+		t.Error("BigRat(1/3) succeeded; want an error")
+	}
+	w = Writer{}
+	w.BigFloat(new(big.Float).SetInf(true))
+	if _, err := w.BuildBytes(); err == nil {
+		t.Error("BigFloat(-Inf) succeeded; want an error")
+	}
+}
+
+func TestUUID(t *testing.T) {
+	w := Writer{}
+	w.UUID([16]byte{0xf4, 0x7a, 0xc1, 0x0b, 0x58, 0xcc, 0x43, 0x72, 0xa5, 0x67, 0x0e, 0x02, 0xb2, 0xc3, 0xd4, 0x79})
+	w.RawByte(',')
+	w.UUID([16]byte{})
+
+	want := `"f47ac10b-58cc-4372-a567-0e02b2c3d479","00000000-0000-0000-0000-000000000000"`
+	if got, err := w.BuildBytes(); err != nil || string(got) != want {
+		t.Errorf("BuildBytes() = %s, %v; want %s", got, err, want)
+	}
+
+	w = Writer{}
+	w.Buffer.EnsureSpace(64)
+	if n := testing.AllocsPerRun(100, func() {
+		w.Buffer.Buf = w.Buffer.Buf[:0]
+		w.UUID([16]byte{1})
+	}); n != 0 {
+		t.Errorf("UUID() allocates %v times; want 0", n)
+	}
+}
+
+func TestStringNoEscape(t *testing.T) {
+	w := Writer{}
+	w.StringNoEscape("123e4567-e89b-12d3-a456-426614174000")
+	w.RawByte(',')
+	w.StringNoEscape("")
+
+	want := `"123e4567-e89b-12d3-a456-426614174000",""`
+	if got, err := w.BuildBytes(); err != nil || string(got) != want {
+		t.Errorf("BuildBytes() = %s, %v; want %s", got, err, want)
+	}
+}
+
+func TestHex(t *testing.T) {
+	w := Writer{}
+	w.Uint64Hex(0)
+	w.RawByte(',')
+	w.Uint64Hex(0x1f)
+	w.RawByte(',')
+	w.RawStringHex([]byte{0, 0xab})
+	w.RawByte(',')
+	w.RawStringHex([]byte{})
+	w.RawByte(',')
+	w.RawStringHex(nil)
+
+	want := `"0x0","0x1f","0x00ab","0x",null`
+	if got, err := w.BuildBytes(); err != nil || string(got) != want {
+		t.Errorf("BuildBytes() = %s, %v; want %s", got, err, want)
+	}
+}
@@ -0,0 +1,37 @@
+package easyjson
+
+import "fmt"
+
+// KeyOrder returns the function ordering the members of the maps of fields with the keyorder
+// easyjson directive. The order is either a func(a, b string) bool reporting if the member named a
+// goes before the one named b, or a []string of the names that go first, in the order of the
+// slice, before the other members in ascending order of their names. The slice is read once, by
+// the package-level variable of the generated code calling KeyOrder. It panics for other types
+// of order.
+func KeyOrder(order interface{}) func(a, b string) bool {
+	switch order := order.(type) {
+	case func(a, b string) bool:
+		return order
+	case []string:
+		ranks := make(map[string]int, len(order))
+		for i, name := range order {
+			if _, ok := ranks[name]; !ok {
+				ranks[name] = i
+			}
+		}
+		rank := func(name string) int {
+			if r, ok := ranks[name]; ok {
+				return r
+			}
+			return len(order)
+		}
+		return func(a, b string) bool {
+			if ra, rb := rank(a), rank(b); ra != rb {
+				return ra < rb
+			}
+			return a < b
+		}
+	default:
+		panic(fmt.Sprintf("easyjson: key order of type %T is neither a []string nor a func(a, b string) bool", order))
+	}
+}
@@ -0,0 +1,33 @@
+package easyjson
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestKeyOrder(t *testing.T) {
+	for _, test := range []struct {
+		Order interface{}
+		Want  []string
+	}{
+		{Order: []string{"id", "name", "id"}, Want: []string{"id", "name", "a", "b", "z"}},
+		{Order: []string(nil), Want: []string{"a", "b", "id", "name", "z"}},
+		{Order: func(a, b string) bool { return a > b }, Want: []string{"z", "name", "id", "b", "a"}},
+	} {
+		keys := []string{"z", "name", "b", "id", "a"}
+		less := KeyOrder(test.Order)
+		sort.Slice(keys, func(i, j int) bool { return less(keys[i], keys[j]) })
+		if !reflect.DeepEqual(keys, test.Want) {
+			t.Errorf("KeyOrder(%v) sorted keys = %q; want %q", test.Order, keys, test.Want)
+		}
+	}
+
+	defer func() {
+		if r, ok := recover().(string); !ok || !strings.Contains(r, "neither a []string") {
+			t.Errorf("KeyOrder(map) panicked with %v; want an invalid order", r)
+		}
+	}()
+	KeyOrder(map[string]int{})
+}
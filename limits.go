@@ -0,0 +1,139 @@
+package easyjson
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/mailru/easyjson/jlexer"
+)
+
+// Limits bound the input of UnmarshalLimited and UnmarshalFromReaderLimited, e.g. request
+// bodies of untrusted clients. Zero values mean no limit.
+type Limits struct {
+	MaxBytes   int // Size of the input in bytes.
+	MaxDepth   int // Nesting depth of objects and arrays.
+	MaxMembers int // Members of each object.
+}
+
+// LimitKind tells which of the Limits a LimitError is about.
+type LimitKind int
+
+const (
+	LimitBytes LimitKind = iota + 1
+	LimitDepth
+	LimitMembers
+)
+
+// LimitError is returned when the input exceeds one of the Limits.
+type LimitError struct {
+	Kind   LimitKind
+	Limit  int
+	Offset int // Position of the input where the limit is exceeded.
+}
+
+func (e *LimitError) Error() string {
+	switch e.Kind {
+	case LimitBytes:
+		return fmt.Sprintf("easyjson: input too large: more than %d bytes", e.Limit)
+	case LimitDepth:
+		return fmt.Sprintf("easyjson: input too deep: more than %d nested objects and arrays at offset %d", e.Limit, e.Offset)
+	default:
+		return fmt.Sprintf("easyjson: too many members: more than %d in an object at offset %d", e.Limit, e.Offset)
+	}
+}
+
+// StatusCode returns the suggested HTTP status code of the responses to requests exceeding
+// the limit: 413 for too large inputs, and 400 for the others.
+func (e *LimitError) StatusCode() int {
+	if e.Kind == LimitBytes {
+		return http.StatusRequestEntityTooLarge
+	}
+	return http.StatusBadRequest
+}
+
+// Check returns a *LimitError if data exceeds the limits. It only scans the tokens of data:
+// syntax errors are left to the lexer.
+func (l Limits) Check(data []byte) error {
+	if l.MaxBytes > 0 && len(data) > l.MaxBytes {
+		return &LimitError{Kind: LimitBytes, Limit: l.MaxBytes, Offset: l.MaxBytes}
+	}
+	if l.MaxDepth <= 0 && l.MaxMembers <= 0 {
+		return nil
+	}
+
+	// members of the open objects, and -1 for the open arrays
+	var open []int
+	t := jlexer.Tokenizer{Data: data}
+	for tok := t.Next(); tok.Kind != jlexer.TokenEOF; tok = t.Next() {
+		switch tok.Kind {
+		case jlexer.TokenObjectStart, jlexer.TokenArrayStart:
+			if l.MaxDepth > 0 && len(open) == l.MaxDepth {
+				return &LimitError{Kind: LimitDepth, Limit: l.MaxDepth, Offset: tok.Offset}
+			}
+			if tok.Kind == jlexer.TokenObjectStart {
+				open = append(open, 0)
+			} else {
+				open = append(open, -1)
+			}
+		case jlexer.TokenObjectEnd, jlexer.TokenArrayEnd:
+			if len(open) > 0 {
+				open = open[:len(open)-1]
+			}
+		case jlexer.TokenColon:
+			if len(open) == 0 || open[len(open)-1] < 0 {
+				continue
+			}
+			open[len(open)-1]++
+			if l.MaxMembers > 0 && open[len(open)-1] > l.MaxMembers {
+				return &LimitError{Kind: LimitMembers, Limit: l.MaxMembers, Offset: tok.Offset}
+			}
+		}
+	}
+	return nil
+}
+
+// UnmarshalLimited decodes the JSON in data into the object, failing with a *LimitError
+// without decoding anything if data exceeds the limits.
+func UnmarshalLimited(data []byte, v Unmarshaler, limits Limits) error {
+	if err := limits.Check(data); err != nil {
+		return err
+	}
+	return Unmarshal(data, v)
+}
+
+// UnmarshalFromReaderLimited reads all the data in the reader, up to the MaxBytes limit, and
+// decodes it as JSON into the object like UnmarshalLimited.
+func UnmarshalFromReaderLimited(r io.Reader, v Unmarshaler, limits Limits) error {
+	if limits.MaxBytes > 0 {
+		r = io.LimitReader(r, int64(limits.MaxBytes)+1)
+	}
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	return UnmarshalLimited(data, v, limits)
+}
+
+// HTTPError maps an error of unmarshaling a request body to the status code and the message
+// of the response: the ones of a *LimitError, and 400 Bad Request for the others. The messages
+// of the other errors only tell the offset of syntax errors, as the reasons of errors may quote
+// the input, so they are safe to send back to clients.
+func HTTPError(err error) (status int, message string) {
+	switch err := err.(type) {
+	case *LimitError:
+		return err.StatusCode(), err.Error()
+	case *jlexer.LexerError:
+		return http.StatusBadRequest, fmt.Sprintf("invalid JSON near offset %d", err.Offset)
+	default:
+		return http.StatusBadRequest, "invalid request body"
+	}
+}
+
+// WriteHTTPError replies to the request with the status code and the message returned by
+// HTTPError for err.
+func WriteHTTPError(w http.ResponseWriter, err error) {
+	status, message := HTTPError(err)
+	http.Error(w, message, status)
+}
@@ -0,0 +1,80 @@
+package easyjson
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mailru/easyjson/jlexer"
+)
+
+func TestLimitsCheck(t *testing.T) {
+	for _, test := range []struct {
+		In     string
+		Limits Limits
+		Kind   LimitKind
+		Offset int
+	}{
+		{`{"a":[1,2]}`, Limits{}, 0, 0},
+		{`{"a":[1,2]}`, Limits{MaxBytes: 11, MaxDepth: 2, MaxMembers: 1}, 0, 0},
+		{`{"a":[1,2]}`, Limits{MaxBytes: 10}, LimitBytes, 10},
+		{`{"a":[1,2]}`, Limits{MaxDepth: 1}, LimitDepth, 5},
+		{`[[],[],{}]`, Limits{MaxDepth: 2}, 0, 0},
+		{`{"a":1,"b":{"c":2},"d":3}`, Limits{MaxMembers: 2}, LimitMembers, 22},
+		{`[{"a":1},{"b":2}]`, Limits{MaxMembers: 1}, 0, 0},
+		{`{"a:b":":"}`, Limits{MaxMembers: 1}, 0, 0},
+	} {
+		err := test.Limits.Check([]byte(test.In))
+		if test.Kind == 0 {
+			if err != nil {
+				t.Errorf("%+v.Check(%s) error: %v", test.Limits, test.In, err)
+			}
+			continue
+		}
+		if le, ok := err.(*LimitError); !ok || le.Kind != test.Kind || le.Offset != test.Offset {
+			t.Errorf("%+v.Check(%s) = %v; want kind %d at offset %d", test.Limits, test.In, err, test.Kind, test.Offset)
+		}
+	}
+}
+
+func TestUnmarshalLimited(t *testing.T) {
+	var raw RawMessage
+	limits := Limits{MaxBytes: 8, MaxDepth: 1}
+	if err := UnmarshalLimited([]byte(`[1,[2]]`), &raw, limits); err == nil {
+		t.Error("UnmarshalLimited() of a too deep input did not fail")
+	}
+	if err := UnmarshalFromReaderLimited(strings.NewReader(`[1,2,3,4,5]`), &raw, limits); err == nil {
+		t.Error("UnmarshalFromReaderLimited() of a too large input did not fail")
+	} else if le, ok := err.(*LimitError); !ok || le.Kind != LimitBytes {
+		t.Errorf("UnmarshalFromReaderLimited() error: %v; want a LimitBytes error", err)
+	}
+	if err := UnmarshalFromReaderLimited(strings.NewReader(`[1,2,3]`), &raw, limits); err != nil || string(raw) != `[1,2,3]` {
+		t.Errorf("UnmarshalFromReaderLimited() = %s, %v", raw, err)
+	}
+}
+
+func TestHTTPError(t *testing.T) {
+	for _, test := range []struct {
+		Err     error
+		Status  int
+		Message string
+	}{
+		{&LimitError{Kind: LimitBytes, Limit: 10}, http.StatusRequestEntityTooLarge, "easyjson: input too large: more than 10 bytes"},
+		{&LimitError{Kind: LimitMembers, Limit: 2, Offset: 5}, http.StatusBadRequest, "easyjson: too many members: more than 2 in an object at offset 5"},
+		{&jlexer.LexerError{Reason: "secret", Offset: 3, Data: "secret"}, http.StatusBadRequest, "invalid JSON near offset 3"},
+		{errors.New("secret"), http.StatusBadRequest, "invalid request body"},
+	} {
+		status, message := HTTPError(test.Err)
+		if status != test.Status || message != test.Message {
+			t.Errorf("HTTPError(%v) = %d, %q; want %d, %q", test.Err, status, message, test.Status, test.Message)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	WriteHTTPError(rec, &LimitError{Kind: LimitDepth, Limit: 1})
+	if rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), "too deep") {
+		t.Errorf("WriteHTTPError() wrote %d %q", rec.Code, rec.Body.String())
+	}
+}
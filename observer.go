@@ -0,0 +1,57 @@
+package easyjson
+
+import (
+	"reflect"
+	"sync/atomic"
+	"time"
+)
+
+// Observer is notified of the values marshaled and unmarshaled by the helper functions of the
+// package, e.g. to export per type metrics. The methods are called concurrently. The marshalers
+// called directly, like the generated MarshalJSON and UnmarshalJSON methods, are not observed.
+type Observer interface {
+	// ObserveMarshal is called after a value of the named type is marshaled into size bytes.
+	ObserveMarshal(typ string, d time.Duration, size int, err error)
+	// ObserveUnmarshal is called after size bytes are unmarshaled into a value of the named type.
+	ObserveUnmarshal(typ string, d time.Duration, size int, err error)
+}
+
+// observerHolder lets atomic.Value store a nil Observer.
+type observerHolder struct {
+	o Observer
+}
+
+var observer atomic.Value
+
+// SetObserver sets the observer of the helper functions, or removes it if o is nil.
+func SetObserver(o Observer) {
+	observer.Store(observerHolder{o})
+}
+
+// currentObserver returns the observer set with SetObserver, if any.
+func currentObserver() Observer {
+	h, _ := observer.Load().(observerHolder)
+	return h.o
+}
+
+// observeStart returns the observer and the start time of an operation, the zero time if there
+// is no observer.
+func observeStart() (Observer, time.Time) {
+	o := currentObserver()
+	if o == nil {
+		return nil, time.Time{}
+	}
+	return o, time.Now()
+}
+
+// observedType returns the name of the type of v reported to observers, without the pointer.
+func observedType(v interface{}) string {
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return "<nil>"
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.String()
+}
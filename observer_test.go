@@ -0,0 +1,106 @@
+package easyjson
+
+import (
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mailru/easyjson/jlexer"
+)
+
+type observation struct {
+	op, typ string
+	size    int
+	err     error
+}
+
+type testObserver []observation
+
+func (o *testObserver) ObserveMarshal(typ string, d time.Duration, size int, err error) {
+	*o = append(*o, observation{"marshal", typ, size, err})
+}
+
+func (o *testObserver) ObserveUnmarshal(typ string, d time.Duration, size int, err error) {
+	*o = append(*o, observation{"unmarshal", typ, size, err})
+}
+
+func TestObserver(t *testing.T) {
+	var o testObserver
+	SetObserver(&o)
+	defer SetObserver(nil)
+
+	raw := RawMessage(`[1,2]`)
+	if _, err := Marshal(&raw); err != nil {
+		t.Fatalf("Marshal() error: %v", err)
+	}
+	if err := Unmarshal([]byte(`{"a":1}`), &raw); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	var b Int128
+	err := UnmarshalFromString(`"x"`, &b)
+
+	want := []observation{
+		{"marshal", "easyjson.RawMessage", 5, nil},
+		{"unmarshal", "easyjson.RawMessage", 7, nil},
+		{"unmarshal", "easyjson.Int128", 3, err},
+	}
+	if err == nil || len(o) != len(want) {
+		t.Fatalf("observed %v; want %v", o, want)
+	}
+	for i := range want {
+		if o[i].op != want[i].op || o[i].typ != want[i].typ || o[i].size != want[i].size || o[i].err != want[i].err {
+			t.Errorf("observation %d = %v; want %v", i, o[i], want[i])
+		}
+	}
+
+	SetObserver(nil)
+	Marshal(&raw)
+	if len(o) != len(want) {
+		t.Errorf("observed %v after the observer is removed", o[len(want):])
+	}
+}
+
+func TestObserverEntryPoints(t *testing.T) {
+	var o testObserver
+	SetObserver(&o)
+	defer SetObserver(nil)
+
+	UnmarshalBatch([][]byte{[]byte(`"1"`)}, func() Unmarshaler { return new(Int128) }, 1)
+
+	d := NewArrayDecoder(&jlexer.Lexer{Data: []byte(`["1", "23"]`)})
+	for {
+		var v Int128
+		if err := d.Decode(&v); err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatalf("Decode() error: %v", err)
+		}
+	}
+
+	var ints []int
+	if err := UnmarshalValue([]byte(`[1,2]`), &ints); err != nil {
+		t.Fatalf("UnmarshalValue() error: %v", err)
+	}
+	var s string
+	if err := UnmarshalValue([]byte(`"s"`), &s); err != nil {
+		t.Fatalf("UnmarshalValue() error: %v", err)
+	}
+
+	raw := RawMessage(`1`)
+	if err := ApplyMergePatch(&raw, []byte(`[3]`)); err != nil {
+		t.Fatalf("ApplyMergePatch() error: %v", err)
+	}
+
+	want := []observation{
+		{"unmarshal", "easyjson.Int128", 3, nil},
+		{"unmarshal", "easyjson.Int128", 4, nil},
+		{"unmarshal", "easyjson.Int128", 6, nil},
+		{"unmarshal", "[]int", 5, nil},
+		{"unmarshal", "string", 3, nil},
+		{"unmarshal", "easyjson.RawMessage", 3, nil},
+	}
+	if !reflect.DeepEqual([]observation(o), want) {
+		t.Errorf("observed %v; want %v", o, want)
+	}
+}
@@ -0,0 +1,91 @@
+package parser
+
+import (
+	"go/ast"
+	"go/token"
+	"strings"
+)
+
+// TypeDoc holds the doc comments of a struct type and of its fields, without the easyjson
+// directives.
+type TypeDoc struct {
+	Doc    string
+	Fields map[string]string // by field name
+}
+
+// parseDocs collects the doc comments of the struct types declared in f into p.Docs.
+func (p *Parser) parseDocs(f *ast.File) {
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			ts := spec.(*ast.TypeSpec)
+			st, ok := ts.Type.(*ast.StructType)
+			if !ok || typeParams(ts) != nil {
+				continue
+			}
+
+			doc := ts.Doc
+			if doc == nil && len(gd.Specs) == 1 {
+				doc = gd.Doc
+			}
+			td := TypeDoc{Doc: docText(doc)}
+			for _, field := range st.Fields.List {
+				text := docText(field.Doc)
+				if text == "" {
+					text = docText(field.Comment)
+				}
+				if text == "" {
+					continue
+				}
+				if td.Fields == nil {
+					td.Fields = map[string]string{}
+				}
+				for _, name := range field.Names {
+					td.Fields[name.Name] = text
+				}
+				if len(field.Names) == 0 {
+					td.Fields[embeddedFieldName(field.Type)] = text
+				}
+			}
+
+			if td.Doc != "" || td.Fields != nil {
+				if p.Docs == nil {
+					p.Docs = map[string]TypeDoc{}
+				}
+				p.Docs[ts.Name.Name] = td
+			}
+		}
+	}
+}
+
+// docText returns the text of the comments without the easyjson directives.
+func docText(comments *ast.CommentGroup) string {
+	var lines []string
+	for _, line := range strings.Split(comments.Text(), "\n") {
+		if !strings.HasPrefix(strings.TrimSpace(line), "easyjson:") {
+			lines = append(lines, line)
+		}
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// embeddedFieldName returns the name of the embedded field of type t, e.g. T for *pkg.T.
+func embeddedFieldName(t ast.Expr) string {
+	for {
+		switch e := t.(type) {
+		case *ast.StarExpr:
+			t = e.X
+		case *ast.SelectorExpr:
+			return e.Sel.Name
+		case *ast.IndexExpr:
+			t = e.X
+		case *ast.Ident:
+			return e.Name
+		default:
+			return ""
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package parser
+
+import (
+	"go/parser"
+	"go/token"
+	"reflect"
+	"testing"
+)
+
+const docSource = `package p
+
+// A is documented.
+//
+//easyjson:json
+type A struct {
+	// X is documented.
+	X, Y int
+	Z    string // Z has a line comment.
+	*B   // B is embedded.
+	W    int
+}
+
+type (
+	// B is grouped.
+	B struct{ V int }
+
+	C struct{}
+)
+
+// D is not a struct.
+type D int
+`
+
+func TestParseDocs(t *testing.T) {
+	f, err := parser.ParseFile(token.NewFileSet(), "p.go", docSource, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var p Parser
+	p.parseDocs(f)
+
+	want := map[string]TypeDoc{
+		"A": {Doc: "A is documented.", Fields: map[string]string{
+			"X": "X is documented.",
+			"Y": "X is documented.",
+			"Z": "Z has a line comment.",
+			"B": "B is embedded.",
+		}},
+		"B": {Doc: "B is grouped."},
+	}
+	if !reflect.DeepEqual(p.Docs, want) {
+		t.Errorf("Docs = %+v; want %+v", p.Docs, want)
+	}
+}
@@ -0,0 +1,92 @@
+package parser
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const enumComment = "easyjson:enum"
+
+// Enum is an integer type marshaled as strings according to a table given with an
+// easyjson:enum directive, e.g.
+//
+//	//easyjson:enum Status 0=unknown 1=active
+type Enum struct {
+	Name   string
+	Values []EnumValue
+}
+
+// EnumValue is an entry of an enum table.
+type EnumValue struct {
+	Value int64
+	Name  string
+}
+
+// parseEnums collects the enums declared in the comments of f.
+func (p *Parser) parseEnums(fset *token.FileSet, f *ast.File) error {
+	for _, group := range f.Comments {
+		for _, c := range group.List {
+			text := strings.TrimSpace(strings.TrimPrefix(c.Text, "//"))
+			if !strings.HasPrefix(text, enumComment+" ") {
+				continue
+			}
+
+			e, err := parseEnum(strings.Fields(text[len(enumComment):]))
+			if err != nil {
+				return fmt.Errorf("%v: %v", fset.Position(c.Pos()), err)
+			}
+			for _, e1 := range p.Enums {
+				if e1.Name == e.Name {
+					return fmt.Errorf("%v: enum %v is declared twice", fset.Position(c.Pos()), e.Name)
+				}
+			}
+			p.Enums = append(p.Enums, e)
+		}
+	}
+	return nil
+}
+
+// parseEnum parses the type name and the value=name pairs of an easyjson:enum directive.
+func parseEnum(fields []string) (Enum, error) {
+	if len(fields) < 2 {
+		return Enum{}, fmt.Errorf("%v directive needs a type name and value=name pairs", enumComment)
+	}
+
+	e := Enum{Name: fields[0]}
+	if !isIdentifier(e.Name) {
+		return Enum{}, fmt.Errorf("invalid enum type name %q", e.Name)
+	}
+
+	values := map[int64]bool{}
+	names := map[string]bool{}
+	for _, pair := range fields[1:] {
+		eq := strings.IndexByte(pair, '=')
+		if eq <= 0 || eq == len(pair)-1 {
+			return Enum{}, fmt.Errorf("enum %v: %q is not a value=name pair", e.Name, pair)
+		}
+		v, err := strconv.ParseInt(pair[:eq], 0, 64)
+		if err != nil {
+			return Enum{}, fmt.Errorf("enum %v: invalid value %q", e.Name, pair[:eq])
+		}
+		name := pair[eq+1:]
+		if values[v] || names[name] {
+			return Enum{}, fmt.Errorf("enum %v: duplicate entry %q", e.Name, pair)
+		}
+		values[v], names[name] = true, true
+		e.Values = append(e.Values, EnumValue{Value: v, Name: name})
+	}
+	return e, nil
+}
+
+func isIdentifier(name string) bool {
+	for i, c := range name {
+		if !unicode.IsLetter(c) && c != '_' && (i == 0 || !unicode.IsDigit(c)) {
+			return false
+		}
+	}
+	return name != ""
+}
@@ -0,0 +1,38 @@
+package parser
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseEnum(t *testing.T) {
+	for _, test := range []struct {
+		In   string
+		Want Enum
+		Err  string
+	}{
+		{
+			In:   "Status 0=unknown 1=active -1=deleted 0x10=hex",
+			Want: Enum{Name: "Status", Values: []EnumValue{{0, "unknown"}, {1, "active"}, {-1, "deleted"}, {16, "hex"}}},
+		},
+		{In: "Status", Err: "needs a type name"},
+		{In: "1Status 0=a", Err: "invalid enum type name"},
+		{In: "Status 0", Err: "not a value=name pair"},
+		{In: "Status 0=", Err: "not a value=name pair"},
+		{In: "Status x=a", Err: "invalid value"},
+		{In: "Status 0=a 1=a", Err: "duplicate entry"},
+		{In: "Status 0=a 0=b", Err: "duplicate entry"},
+	} {
+		e, err := parseEnum(strings.Fields(test.In))
+		if test.Err != "" {
+			if err == nil || !strings.Contains(err.Error(), test.Err) {
+				t.Errorf("parseEnum(%q) error: %v; want %q", test.In, err, test.Err)
+			}
+			continue
+		}
+		if err != nil || !reflect.DeepEqual(e, test.Want) {
+			t.Errorf("parseEnum(%q) = %+v, %v; want %+v", test.In, e, err, test.Want)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+//go:build go1.16
+// +build go1.16
+
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+)
+
+// fsSource reads the files from a file system, using slash-separated paths.
+type fsSource struct {
+	fsys fs.FS
+}
+
+func (s fsSource) readDir(dir string) ([]os.FileInfo, error) {
+	entries, err := fs.ReadDir(s.fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]os.FileInfo, 0, len(entries))
+	for _, e := range entries {
+		info, err := e.Info()
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+func (s fsSource) open(name string) (io.ReadCloser, error) { return s.fsys.Open(name) }
+func (fsSource) join(elem ...string) string                { return path.Join(elem...) }
+
+// ParseFS is like Parse, but reads the sources from fsys, e.g. an embed.FS or a snapshot of
+// the package, fname being a slash-separated path in it. The package path is determined from
+// the nearest go.mod file of fsys up from fname, if there is none it has to be set in PkgPath.
+func (p *Parser) ParseFS(fsys fs.FS, fname string, isDir bool) error {
+	dir := fname
+	if !isDir {
+		dir = path.Dir(fname)
+	}
+
+	pkgPath, err := fsPkgPath(fsys, dir)
+	if err != nil {
+		return err
+	}
+	if pkgPath != "" {
+		p.PkgPath = pkgPath
+	} else if p.PkgPath == "" {
+		return fmt.Errorf("no go.mod file for %v in the file system and no package path given", fname)
+	}
+	return p.parse(fsSource{fsys}, dir, fname, isDir)
+}
+
+// fsPkgPath returns the package path of dir according to the nearest go.mod file of fsys, or
+// an empty path if there is none.
+func fsPkgPath(fsys fs.FS, dir string) (string, error) {
+	for root := dir; ; root = path.Dir(root) {
+		goModPath := path.Join(root, "go.mod")
+		data, err := fs.ReadFile(fsys, goModPath)
+		if err == nil {
+			modulePath := modulePath(data)
+			if modulePath == "" {
+				return "", fmt.Errorf("cannot determine module path from %s", goModPath)
+			}
+			if root == "." {
+				return path.Join(modulePath, dir), nil
+			}
+			return path.Join(modulePath, dir[len(root):]), nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return "", err
+		}
+		if root == "." {
+			return "", nil
+		}
+	}
+}
@@ -0,0 +1,77 @@
+//go:build go1.16
+// +build go1.16
+
+package parser
+
+import (
+	"reflect"
+	"testing"
+	"testing/fstest"
+)
+
+var testFS = fstest.MapFS{
+	"go.mod": {Data: []byte("module example.com/m\n")},
+	"api/types.go": {Data: []byte(`package api
+
+//easyjson:json
+type Request struct{ ID int }
+
+//easyjson:enum Kind 0=none 1=some
+type Kind int
+`)},
+	"api/methods.go": {Data: []byte(`package api
+
+func (r *Request) UnmarshalJSON([]byte) error { return nil }
+`)},
+	"api/ignored.go": {Data: []byte(`// +build ignore
+
+package api
+
+func (r Request) MarshalJSON() ([]byte, error) { return nil, nil }
+`)},
+	"api/types_test.go": {Data: []byte(`package api
+
+//easyjson:json
+type Test struct{}
+`)},
+}
+
+func TestParseFS(t *testing.T) {
+	var p Parser
+	if err := p.ParseFS(testFS, "api", true); err != nil {
+		t.Fatalf("ParseFS() error: %v", err)
+	}
+	if p.PkgPath != "example.com/m/api" || p.PkgName != "api" {
+		t.Errorf("ParseFS() package = %v %v; want example.com/m/api api", p.PkgPath, p.PkgName)
+	}
+	if !reflect.DeepEqual(p.StructNames, []string{"Request"}) || len(p.Enums) != 1 {
+		t.Errorf("ParseFS() types = %v, enums %v; want Request and Kind", p.StructNames, p.Enums)
+	}
+
+	var methods []string
+	for _, m := range p.Methods {
+		methods = append(methods, m.Type+"."+m.Name)
+	}
+	if want := []string{"Request.UnmarshalJSON"}; !reflect.DeepEqual(methods, want) {
+		t.Errorf("ParseFS() methods = %v; want %v", methods, want)
+	}
+}
+
+func TestParseFSPkgPath(t *testing.T) {
+	noModFS := fstest.MapFS{
+		"nomod/types.go": {Data: []byte("package nomod\n\n//easyjson:json\ntype T struct{}\n")},
+	}
+
+	var p Parser
+	if err := p.ParseFS(noModFS, "nomod/types.go", false); err == nil {
+		t.Errorf("ParseFS() without go.mod and PkgPath succeeded")
+	}
+
+	p = Parser{PkgPath: "example.com/nomod"}
+	if err := p.ParseFS(noModFS, "nomod/types.go", false); err != nil {
+		t.Fatalf("ParseFS() error: %v", err)
+	}
+	if p.PkgPath != "example.com/nomod" || !reflect.DeepEqual(p.StructNames, []string{"T"}) {
+		t.Errorf("ParseFS() package = %v, types %v; want example.com/nomod, T", p.PkgPath, p.StructNames)
+	}
+}
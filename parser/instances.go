@@ -0,0 +1,96 @@
+package parser
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
+)
+
+const instancesComment = "easyjson:gen"
+
+// parseInstances collects the instantiations of generic types listed in the easyjson:gen
+// directives of the comments of f, e.g.
+//
+//	//easyjson:gen Page[User] Pair[string, Order]
+func (p *Parser) parseInstances(fset *token.FileSet, f *ast.File) error {
+	for _, group := range f.Comments {
+		for _, c := range group.List {
+			text := strings.TrimSpace(strings.TrimPrefix(c.Text, "//"))
+			if !strings.HasPrefix(text, instancesComment+" ") {
+				continue
+			}
+
+			instances, err := parseInstances(text[len(instancesComment):])
+			if err != nil {
+				return fmt.Errorf("%v: %v", fset.Position(c.Pos()), err)
+			}
+			for _, inst := range instances {
+				if !p.isInstance(inst) {
+					p.Instances = append(p.Instances, inst)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// parseInstances splits the arguments of an easyjson:gen directive into instantiations of generic
+// types, separated by spaces outside of the brackets of type arguments.
+func parseInstances(args string) ([]string, error) {
+	var ret []string
+	depth, start := 0, -1
+	for i := 0; i <= len(args); i++ {
+		c := byte(' ')
+		if i < len(args) {
+			c = args[i]
+		}
+		switch {
+		case c == '[':
+			depth++
+		case c == ']':
+			if depth--; depth < 0 {
+				return nil, fmt.Errorf("unbalanced brackets in %v directive", instancesComment)
+			}
+		case (c == ' ' || c == '\t') && depth == 0:
+			if start >= 0 {
+				inst := args[start:i]
+				if open := strings.IndexByte(inst, '['); open <= 0 || !isIdentifier(inst[:open]) || inst[len(inst)-1] != ']' {
+					return nil, fmt.Errorf("%q is not an instantiation of a generic type, e.g. Page[User]", inst)
+				}
+				ret = append(ret, inst)
+				start = -1
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced brackets in %v directive", instancesComment)
+	}
+	if len(ret) == 0 {
+		return nil, fmt.Errorf("%v directive needs instantiations of generic types, e.g. Page[User]", instancesComment)
+	}
+	return ret, nil
+}
+
+// isInstance tells if inst is one of the Instances.
+func (p *Parser) isInstance(inst string) bool {
+	for _, i := range p.Instances {
+		if i == inst {
+			return true
+		}
+	}
+	return false
+}
+
+// InstanceType returns the name of the generic type instantiated by inst, e.g. Page for
+// Page[User].
+func InstanceType(inst string) string {
+	if i := strings.IndexByte(inst, '['); i >= 0 {
+		return inst[:i]
+	}
+	return inst
+}
@@ -0,0 +1,35 @@
+package parser
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseInstances(t *testing.T) {
+	for _, test := range []struct {
+		In   string
+		Want []string
+		Err  string
+	}{
+		{In: " Page[User]", Want: []string{"Page[User]"}},
+		{In: " Page[User]  Pair[string, []Order]\tPage[map[string]int]", Want: []string{"Page[User]", "Pair[string, []Order]", "Page[map[string]int]"}},
+		{In: " ", Err: "needs instantiations"},
+		{In: " Page", Err: "not an instantiation"},
+		{In: " [User]", Err: "not an instantiation"},
+		{In: " Page[User]x", Err: "not an instantiation"},
+		{In: " Page[User", Err: "unbalanced brackets"},
+		{In: " Page]User[", Err: "unbalanced brackets"},
+	} {
+		instances, err := parseInstances(test.In)
+		if test.Err != "" {
+			if err == nil || !strings.Contains(err.Error(), test.Err) {
+				t.Errorf("parseInstances(%q) error: %v; want %q", test.In, err, test.Err)
+			}
+			continue
+		}
+		if err != nil || !reflect.DeepEqual(instances, test.Want) {
+			t.Errorf("parseInstances(%q) = %q, %v; want %q", test.In, instances, err, test.Want)
+		}
+	}
+}
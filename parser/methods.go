@@ -0,0 +1,90 @@
+package parser
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+)
+
+// Method is a marshaling method declared by hand for a type of the package, which may conflict
+// with the generated ones.
+type Method struct {
+	Type string
+	Name string
+	Pos  token.Position
+}
+
+var marshalingMethods = map[string]bool{
+	"MarshalJSON":       true,
+	"UnmarshalJSON":     true,
+	"MarshalEasyJSON":   true,
+	"UnmarshalEasyJSON": true,
+	"MarshalText":       true,
+	"UnmarshalText":     true,
+	"ValidateEasyJSON":  true,
+}
+
+// parseMethods collects the marshaling methods declared in the package in dir. All files of
+// the package built for the current platform are scanned, except for the easyjson output.
+func (p *Parser) parseMethods(src source, dir string) error {
+	infos, err := src.readDir(dir)
+	if err != nil {
+		return err
+	}
+
+	ctxt := buildContext(src)
+	fset := token.NewFileSet()
+	for _, info := range infos {
+		name := info.Name()
+		if info.IsDir() || !strings.HasSuffix(name, ".go") || !excludeTestFiles(info) {
+			continue
+		}
+		if ok, err := ctxt.MatchFile(dir, name); err != nil || !ok {
+			continue
+		}
+
+		data, err := readFile(src, src.join(dir, name))
+		if err != nil {
+			return err
+		}
+		f, err := parser.ParseFile(fset, src.join(dir, name), data, parser.ParseComments)
+		if err != nil {
+			return err
+		}
+		if f.Name.Name != p.PkgName || isEasyJSONOutput(f) {
+			continue
+		}
+
+		for _, decl := range f.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Recv == nil || len(fd.Recv.List) != 1 || !marshalingMethods[fd.Name.Name] {
+				continue
+			}
+			recv := fd.Recv.List[0].Type
+			if star, ok := recv.(*ast.StarExpr); ok {
+				recv = star.X
+			}
+			if ident, ok := receiverBase(recv).(*ast.Ident); ok {
+				p.Methods = append(p.Methods, Method{Type: ident.Name, Name: fd.Name.Name, Pos: fset.Position(fd.Pos())})
+			}
+		}
+	}
+	return nil
+}
+
+// isEasyJSONOutput tells if f is generated by easyjson, including the temporary stubs.
+func isEasyJSONOutput(f *ast.File) bool {
+	for _, group := range f.Comments {
+		if group.Pos() >= f.Package {
+			break
+		}
+		for _, c := range group.List {
+			if strings.HasPrefix(c.Text, "// Code generated by easyjson") ||
+				strings.HasPrefix(c.Text, "// TEMPORARY AUTOGENERATED FILE: easyjson") {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -1,10 +1,12 @@
 package parser
 
 import (
+	"fmt"
 	"go/ast"
 	"go/parser"
 	"go/token"
 	"os"
+	"path/filepath"
 	"strings"
 )
 
@@ -17,7 +19,44 @@ type Parser struct {
 	PkgPath     string
 	PkgName     string
 	StructNames []string
+	Enums       []Enum
 	AllStructs  bool
+
+	// If Protobuf is set, the oneof wrapper types of protoc-gen-go output are collected into
+	// OneofWrappers instead of StructNames.
+	Protobuf      bool
+	OneofWrappers []string
+
+	// Methods are the marshaling methods declared by hand in the package.
+	Methods []Method
+
+	// TypeParams are the type parameters of the generic types in StructNames.
+	TypeParams map[string][]TypeParam
+
+	// Docs are the doc comments of the struct types of the package, by type name.
+	Docs map[string]TypeDoc
+
+	// Instances are the instantiations of generic types in StructNames listed by easyjson:gen
+	// directives, e.g. Page[User], which get marshalers specialized for their type arguments.
+	Instances []string
+
+	// Env is the environment, as "key=value", of the go command run to find the module of the
+	// package, nil for the one of the process. Hermetic builds pass the environment of the
+	// generator, bootstrap.Generator.GoEnv().
+	Env []string
+}
+
+// TypeParam is a type parameter of a generic type. Only the any and comparable constraints
+// are supported.
+type TypeParam struct {
+	Name       string
+	Constraint string
+}
+
+var typeParamConstraints = map[string]bool{
+	"any":         true,
+	"interface{}": true,
+	"comparable":  true,
 }
 
 type visitor struct {
@@ -91,6 +130,12 @@ func (v *visitor) Visit(n ast.Node) (w ast.Visitor) {
 		}
 
 		v.name = n.Name.String()
+		if params := typeParams(n); params != nil {
+			if v.TypeParams == nil {
+				v.TypeParams = map[string][]TypeParam{}
+			}
+			v.TypeParams[v.name] = params
+		}
 
 		// Allow to specify non-structs explicitly independent of '-all' flag.
 		if explicit {
@@ -108,31 +153,107 @@ func (v *visitor) Visit(n ast.Node) (w ast.Visitor) {
 
 func (p *Parser) Parse(fname string, isDir bool) error {
 	var err error
-	if p.PkgPath, err = getPkgPath(fname, isDir); err != nil {
+	if p.PkgPath, err = getPkgPath(fname, isDir, p.Env); err != nil {
 		return err
 	}
 
-	fset := token.NewFileSet()
+	dir := fname
+	if !isDir {
+		dir = filepath.Dir(fname)
+	}
+	return p.parse(osSource{}, dir, fname, isDir)
+}
+
+// parse parses the file fname, or all non-test files in it if it is a directory, and the
+// hand-written methods of the package in dir.
+func (p *Parser) parse(src source, dir, fname string, isDir bool) error {
+	files := []string{fname}
 	if isDir {
-		packages, err := parser.ParseDir(fset, fname, excludeTestFiles, parser.ParseComments)
+		infos, err := src.readDir(fname)
 		if err != nil {
 			return err
 		}
+		files = files[:0]
+		for _, info := range infos {
+			if !info.IsDir() && strings.HasSuffix(info.Name(), ".go") && excludeTestFiles(info) {
+				files = append(files, src.join(fname, info.Name()))
+			}
+		}
+	}
 
-		for _, pckg := range packages {
-			ast.Walk(&visitor{Parser: p}, pckg)
+	oneofs, wrappers := map[string]bool{}, map[string][]string{}
+	fset := token.NewFileSet()
+	for _, name := range files {
+		data, err := readFile(src, name)
+		if err != nil {
+			return err
 		}
-	} else {
-		f, err := parser.ParseFile(fset, fname, nil, parser.ParseComments)
+		f, err := parser.ParseFile(fset, name, data, parser.ParseComments)
 		if err != nil {
 			return err
 		}
 
 		ast.Walk(&visitor{Parser: p}, f)
+		if err := p.parseEnums(fset, f); err != nil {
+			return err
+		}
+		if err := p.parseInstances(fset, f); err != nil {
+			return err
+		}
+		p.parseOneofWrappers(f, oneofs, wrappers)
+		p.parseDocs(f)
+	}
+	p.setOneofWrappers(oneofs, wrappers)
+
+	if err := p.parseMethods(src, dir); err != nil {
+		return err
+	}
+
+	// The enums get their own marshalers, even if marked to be generated like other types,
+	// and the oneof wrappers are marshaled as a part of the messages containing them.
+	names := p.StructNames[:0]
+	for _, name := range p.StructNames {
+		if !p.isEnum(name) && !p.isOneofWrapper(name) {
+			names = append(names, name)
+		}
+	}
+	p.StructNames = names
+
+	for _, name := range p.StructNames {
+		for _, param := range p.TypeParams[name] {
+			if !typeParamConstraints[param.Constraint] {
+				return fmt.Errorf("type parameter %v of %v: constraint %v is not supported, only any and comparable are", param.Name, name, param.Constraint)
+			}
+		}
+	}
+	for _, inst := range p.Instances {
+		name := InstanceType(inst)
+		if len(p.TypeParams[name]) == 0 || !p.isGenerated(name) {
+			return fmt.Errorf("%v directive: %v is not a generic type marshalers are generated for", instancesComment, name)
+		}
 	}
 	return nil
 }
 
+// isGenerated tells if the type named name is one of the StructNames.
+func (p *Parser) isGenerated(name string) bool {
+	for _, n := range p.StructNames {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Parser) isEnum(name string) bool {
+	for _, e := range p.Enums {
+		if e.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 func excludeTestFiles(fi os.FileInfo) bool {
 	return !strings.HasSuffix(fi.Name(), "_test.go")
 }
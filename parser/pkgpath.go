@@ -13,7 +13,9 @@ import (
 	"sync"
 )
 
-func getPkgPath(fname string, isDir bool) (string, error) {
+// getPkgPath returns the import path of the package of fname, running the go command with env,
+// or with the environment of the process if env is nil.
+func getPkgPath(fname string, isDir bool, env []string) (string, error) {
 	if !filepath.IsAbs(fname) {
 		pwd, err := os.Getwd()
 		if err != nil {
@@ -22,7 +24,7 @@ func getPkgPath(fname string, isDir bool) (string, error) {
 		fname = filepath.Join(pwd, fname)
 	}
 
-	goModPath, _ := goModPath(fname, isDir)
+	goModPath, _ := goModPath(fname, isDir, env)
 	if strings.Contains(goModPath, "go.mod") {
 		pkgPath, err := getPkgPathFromGoMod(fname, isDir, goModPath)
 		if err != nil {
@@ -32,7 +34,7 @@ func getPkgPath(fname string, isDir bool) (string, error) {
 		return pkgPath, nil
 	}
 
-	return getPkgPathFromGOPATH(fname, isDir)
+	return getPkgPathFromGOPATH(fname, isDir, env)
 }
 
 var goModPathCache = struct {
@@ -43,14 +45,18 @@ var goModPathCache = struct {
 }
 
 // empty if no go.mod, GO111MODULE=off or go without go modules support
-func goModPath(fname string, isDir bool) (string, error) {
+func goModPath(fname string, isDir bool, env []string) (string, error) {
 	root := fname
 	if !isDir {
 		root = filepath.Dir(fname)
 	}
+	key := root
+	if env != nil {
+		key += "\x00" + strings.Join(env, "\x00")
+	}
 
 	goModPathCache.RLock()
-	goModPath, ok := goModPathCache.paths[root]
+	goModPath, ok := goModPathCache.paths[key]
 	goModPathCache.RUnlock()
 	if ok {
 		return goModPath, nil
@@ -58,12 +64,13 @@ func goModPath(fname string, isDir bool) (string, error) {
 
 	defer func() {
 		goModPathCache.Lock()
-		goModPathCache.paths[root] = goModPath
+		goModPathCache.paths[key] = goModPath
 		goModPathCache.Unlock()
 	}()
 
 	cmd := exec.Command("go", "env", "GOMOD")
 	cmd.Dir = root
+	cmd.Env = env
 
 	stdout, err := cmd.Output()
 	if err != nil {
@@ -119,8 +126,8 @@ func getModulePath(goModPath string) string {
 	return pkgPath
 }
 
-func getPkgPathFromGOPATH(fname string, isDir bool) (string, error) {
-	gopath := os.Getenv("GOPATH")
+func getPkgPathFromGOPATH(fname string, isDir bool, env []string) (string, error) {
+	gopath := getenv(env, "GOPATH")
 	if gopath == "" {
 		gopath = build.Default.GOPATH
 	}
@@ -143,3 +150,17 @@ func getPkgPathFromGOPATH(fname string, isDir bool) (string, error) {
 func filePathToPackagePath(path string) string {
 	return filepath.ToSlash(path)
 }
+
+// getenv returns the value of the environment variable key in env, the last one if it is set more
+// than once like exec.Cmd does it, or in the environment of the process if env is nil.
+func getenv(env []string, key string) string {
+	if env == nil {
+		return os.Getenv(key)
+	}
+	for i := len(env) - 1; i >= 0; i-- {
+		if strings.HasPrefix(env[i], key+"=") {
+			return env[i][len(key)+1:]
+		}
+	}
+	return ""
+}
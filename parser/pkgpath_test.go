@@ -1,6 +1,11 @@
 package parser
 
-import "testing"
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
 
 func Test_getModulePath(t *testing.T) {
 	tests := map[string]struct {
@@ -37,3 +42,34 @@ func Test_getModulePath(t *testing.T) {
 		})
 	}
 }
+
+func TestParseEnv(t *testing.T) {
+	gopath, err := ioutil.TempDir("", "easyjson-parser")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(gopath)
+	dir := filepath.Join(gopath, "src", "example.org", "gopath")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for name, data := range map[string]string{
+		"go.mod": "module example.com/env\n",
+		"a.go":   "package a\n\n//easyjson:json\ntype A struct{}\n",
+	} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	p := Parser{}
+	if err := p.Parse(dir, true); err != nil || p.PkgPath != "example.com/env" {
+		t.Errorf("Parse() package path = %q, %v; want example.com/env", p.PkgPath, err)
+	}
+
+	// The module is not used with modules turned off in the environment of the go command.
+	p = Parser{Env: append(os.Environ(), "GO111MODULE=off", "GOPATH="+gopath)}
+	if err := p.Parse(dir, true); err != nil || p.PkgPath != "example.org/gopath" {
+		t.Errorf("Parse() with Env package path = %q, %v; want example.org/gopath", p.PkgPath, err)
+	}
+}
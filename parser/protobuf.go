@@ -0,0 +1,71 @@
+package parser
+
+import (
+	"go/ast"
+	"sort"
+)
+
+// parseOneofWrappers collects the oneof wrapper types of protoc-gen-go output in f. A oneof
+// field has an unexported interface type with a single method of the same name, e.g.
+//
+//	type isValue_Kind interface {
+//		isValue_Kind()
+//	}
+//
+// and the wrappers are the struct types whose pointers implement it.
+func (p *Parser) parseOneofWrappers(f *ast.File, oneofs map[string]bool, wrappers map[string][]string) {
+	if !p.Protobuf {
+		return
+	}
+	for _, decl := range f.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				ts, ok := spec.(*ast.TypeSpec)
+				if !ok {
+					continue
+				}
+				it, ok := ts.Type.(*ast.InterfaceType)
+				if !ok || len(it.Methods.List) != 1 || len(it.Methods.List[0].Names) != 1 {
+					continue
+				}
+				if it.Methods.List[0].Names[0].Name == ts.Name.Name {
+					oneofs[ts.Name.Name] = true
+				}
+			}
+
+		case *ast.FuncDecl:
+			if decl.Recv == nil || len(decl.Recv.List) != 1 || decl.Type.Params.NumFields() != 0 || decl.Type.Results.NumFields() != 0 {
+				continue
+			}
+			star, ok := decl.Recv.List[0].Type.(*ast.StarExpr)
+			if !ok {
+				continue
+			}
+			if recv, ok := star.X.(*ast.Ident); ok {
+				wrappers[decl.Name.Name] = append(wrappers[decl.Name.Name], recv.Name)
+			}
+		}
+	}
+}
+
+// setOneofWrappers sets OneofWrappers to the receivers of the methods of the oneof interfaces
+// found by parseOneofWrappers.
+func (p *Parser) setOneofWrappers(oneofs map[string]bool, wrappers map[string][]string) {
+	if !p.Protobuf {
+		return
+	}
+	for name := range oneofs {
+		p.OneofWrappers = append(p.OneofWrappers, wrappers[name]...)
+	}
+	sort.Strings(p.OneofWrappers)
+}
+
+func (p *Parser) isOneofWrapper(name string) bool {
+	for _, w := range p.OneofWrappers {
+		if w == name {
+			return true
+		}
+	}
+	return false
+}
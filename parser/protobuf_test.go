@@ -0,0 +1,44 @@
+package parser
+
+import (
+	"go/parser"
+	"go/token"
+	"reflect"
+	"testing"
+)
+
+const oneofSource = `package pb
+
+type isMsg_Kind interface {
+	isMsg_Kind()
+}
+
+type notOneof interface {
+	other()
+}
+
+type Msg_B struct{ B int }
+type Msg_A struct{ A string }
+type Other struct{}
+
+func (*Msg_B) isMsg_Kind() {}
+func (*Msg_A) isMsg_Kind() {}
+func (Other) isMsg_Kind()  {}
+func (*Other) other()      {}
+`
+
+func TestParseOneofWrappers(t *testing.T) {
+	f, err := parser.ParseFile(token.NewFileSet(), "pb.go", oneofSource, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := Parser{Protobuf: true}
+	oneofs, wrappers := map[string]bool{}, map[string][]string{}
+	p.parseOneofWrappers(f, oneofs, wrappers)
+	p.setOneofWrappers(oneofs, wrappers)
+
+	if want := []string{"Msg_A", "Msg_B"}; !reflect.DeepEqual(p.OneofWrappers, want) {
+		t.Errorf("OneofWrappers = %v; want %v", p.OneofWrappers, want)
+	}
+}
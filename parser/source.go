@@ -0,0 +1,41 @@
+package parser
+
+import (
+	"go/build"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// source provides the files of the parsed package.
+type source interface {
+	readDir(dir string) ([]os.FileInfo, error)
+	open(name string) (io.ReadCloser, error)
+	join(elem ...string) string
+}
+
+// osSource reads the files from the operating system.
+type osSource struct{}
+
+func (osSource) readDir(dir string) ([]os.FileInfo, error) { return ioutil.ReadDir(dir) }
+func (osSource) open(name string) (io.ReadCloser, error)   { return os.Open(name) }
+func (osSource) join(elem ...string) string                { return filepath.Join(elem...) }
+
+// readFile returns the contents of the named file of src.
+func readFile(src source, name string) ([]byte, error) {
+	r, err := src.open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
+
+// buildContext returns the default build context reading the files from src.
+func buildContext(src source) *build.Context {
+	ctxt := build.Default
+	ctxt.OpenFile = src.open
+	ctxt.JoinPath = src.join
+	return &ctxt
+}
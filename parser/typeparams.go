@@ -0,0 +1,35 @@
+//go:build go1.18
+// +build go1.18
+
+package parser
+
+import (
+	"go/ast"
+	"go/types"
+)
+
+// typeParams returns the type parameters declared by ts.
+func typeParams(ts *ast.TypeSpec) []TypeParam {
+	if ts.TypeParams == nil {
+		return nil
+	}
+	var ret []TypeParam
+	for _, field := range ts.TypeParams.List {
+		for _, name := range field.Names {
+			ret = append(ret, TypeParam{Name: name.Name, Constraint: types.ExprString(field.Type)})
+		}
+	}
+	return ret
+}
+
+// receiverBase returns the type of the method receiver recv without its type arguments, e.g.
+// Page for Page[T].
+func receiverBase(recv ast.Expr) ast.Expr {
+	switch x := recv.(type) {
+	case *ast.IndexExpr:
+		return x.X
+	case *ast.IndexListExpr:
+		return x.X
+	}
+	return recv
+}
@@ -0,0 +1,17 @@
+//go:build !go1.18
+// +build !go1.18
+
+package parser
+
+import "go/ast"
+
+// typeParams returns the type parameters declared by ts, which can not have any before Go 1.18.
+func typeParams(ts *ast.TypeSpec) []TypeParam {
+	return nil
+}
+
+// receiverBase returns the type of the method receiver recv, which has no type arguments before
+// Go 1.18.
+func receiverBase(recv ast.Expr) ast.Expr {
+	return recv
+}
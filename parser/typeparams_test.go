@@ -0,0 +1,61 @@
+//go:build go1.18
+// +build go1.18
+
+package parser
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestParseTypeParams(t *testing.T) {
+	fsys := fstest.MapFS{
+		"go.mod": {Data: []byte("module example.com/m\n")},
+		"api/types.go": {Data: []byte(`package api
+
+//easyjson:json
+type Page[T any] struct{ Items []T }
+
+//easyjson:json
+type Pair[K comparable, V interface{}] struct {
+	Key   K
+	Value V
+}
+
+func (p *Page[T]) MarshalJSON() ([]byte, error) { return nil, nil }
+
+func (p Pair[K, V]) UnmarshalJSON([]byte) error { return nil }
+`)},
+	}
+
+	var p Parser
+	if err := p.ParseFS(fsys, "api", true); err != nil {
+		t.Fatalf("ParseFS() error: %v", err)
+	}
+	want := map[string][]TypeParam{
+		"Page": {{Name: "T", Constraint: "any"}},
+		"Pair": {{Name: "K", Constraint: "comparable"}, {Name: "V", Constraint: "interface{}"}},
+	}
+	if !reflect.DeepEqual(p.TypeParams, want) {
+		t.Errorf("ParseFS() type params = %v; want %v", p.TypeParams, want)
+	}
+	var methods []string
+	for _, m := range p.Methods {
+		methods = append(methods, m.Type+"."+m.Name)
+	}
+	if want := []string{"Page.MarshalJSON", "Pair.UnmarshalJSON"}; !reflect.DeepEqual(methods, want) {
+		t.Errorf("ParseFS() methods = %v; want %v", methods, want)
+	}
+
+	fsys["api/types.go"] = &fstest.MapFile{Data: []byte(`package api
+
+//easyjson:json
+type Numbers[T ~int | ~float64] struct{ Items []T }
+`)}
+	p = Parser{}
+	if err := p.ParseFS(fsys, "api", true); err == nil || !strings.Contains(err.Error(), "not supported") {
+		t.Errorf("ParseFS() error = %v; want an unsupported constraint", err)
+	}
+}
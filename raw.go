@@ -43,3 +43,25 @@ func (v RawMessage) MarshalJSON() ([]byte, error) {
 func (v *RawMessage) IsDefined() bool {
 	return len(*v) > 0
 }
+
+// RawField returns the raw bytes of the value of the member with the name of the object in
+// data, e.g. to forward a sub-document untouched, without decoding the other members. The
+// returned slice refers to data and is nil if the object has no such member; the members
+// after the first one with the name are not checked.
+func RawField(data []byte, name string) ([]byte, error) {
+	l := jlexer.Lexer{Data: data}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		if key == name {
+			raw := l.Raw()
+			return raw, l.Error()
+		}
+		l.SkipRecursive()
+		l.WantComma()
+	}
+	l.Delim('}')
+	l.Consumed()
+	return nil, l.Error()
+}
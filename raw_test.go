@@ -0,0 +1,35 @@
+package easyjson
+
+import "testing"
+
+func TestRawField(t *testing.T) {
+	for _, test := range []struct {
+		data, name string
+		want       string
+		wantNil    bool
+		wantErr    bool
+	}{
+		{data: `{"id":1,"payload":{"a":[1,2,"}"]},"b":2}`, name: "payload", want: `{"a":[1,2,"}"]}`},
+		{data: ` { "payload" : "x" } `, name: "payload", want: `"x"`},
+		{data: `{"id":1,"payload":null}`, name: "payload", want: `null`},
+		{data: `{"payload":[]}`, name: "payload", want: `[]`},
+		{data: `{"nested":{"payload":1}}`, name: "payload", wantNil: true},
+		{data: `{}`, name: "payload", wantNil: true},
+		{data: `{"payload":1,"b":}`, name: "payload", want: `1`},
+		{data: `{"a":1,}`, name: "payload", wantNil: true, wantErr: true},
+		{data: `[1]`, name: "payload", wantNil: true, wantErr: true},
+		{data: `{"a":1} x`, name: "payload", wantNil: true, wantErr: true},
+	} {
+		got, err := RawField([]byte(test.data), test.name)
+		if (err != nil) != test.wantErr {
+			t.Errorf("RawField(%s, %q) error = %v; want error %v", test.data, test.name, err, test.wantErr)
+		}
+		if test.wantNil {
+			if got != nil {
+				t.Errorf("RawField(%s, %q) = %s; want nil", test.data, test.name, got)
+			}
+		} else if string(got) != test.want {
+			t.Errorf("RawField(%s, %q) = %s; want %s", test.data, test.name, got, test.want)
+		}
+	}
+}
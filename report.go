@@ -0,0 +1,53 @@
+package easyjson
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mailru/easyjson/jlexer"
+)
+
+// FieldError is the error of a value skipped by UnmarshalBestEffort, e.g. a string where a
+// number is expected.
+type FieldError struct {
+	Path   string // JSON Pointer (RFC 6901) of the value, e.g. "/items/2/price".
+	Offset int    // Offset of the value in the input.
+	Reason string
+}
+
+// Error implements the error interface.
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("easyjson: skipped value at %q (offset %d): %s", e.Path, e.Offset, e.Reason)
+}
+
+// pointerEscaper escapes the reference tokens of JSON Pointers.
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// UnmarshalBestEffort decodes the JSON in data into the object like Unmarshal, except that a value
+// of the wrong type, e.g. a string in a number field or an invalid time, does not stop it: the
+// value is skipped, leaving its field zero and adding no map entry, and reported in the returned
+// errors, in input order. The error is only set for malformed input, which can not be skipped,
+// and may come with the reports of the values skipped before.
+func UnmarshalBestEffort(data []byte, v Unmarshaler) ([]*FieldError, error) {
+	l := jlexer.Lexer{Data: data, UseMultipleErrors: true}
+	err := unmarshal(&l, v)
+
+	lerrs := l.GetNonFatalErrors()
+	if len(lerrs) == 0 {
+		return nil, err
+	}
+	ix, _ := jlexer.NewIndex(data)
+	ret := make([]*FieldError, 0, len(lerrs))
+	for _, e := range lerrs {
+		fe := &FieldError{Offset: e.Offset, Reason: e.Reason}
+		if ix != nil {
+			if path, ok := ix.PathAt(e.Offset); ok {
+				for _, name := range path {
+					fe.Path += "/" + pointerEscaper.Replace(name)
+				}
+			}
+		}
+		ret = append(ret, fe)
+	}
+	return ret, err
+}
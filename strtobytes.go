@@ -0,0 +1,24 @@
+// This file will only be included to the build if neither
+// easyjson_nounsafe nor appengine build tag is set. See README notes
+// for more details.
+
+//+build !easyjson_nounsafe
+//+build !appengine
+
+package easyjson
+
+import "unsafe"
+
+// strToBytes creates a byte slice pointing at the string data to avoid copying.
+//
+// Warning: the slice returned by the function must never be modified, as strings are immutable.
+func strToBytes(s string) []byte {
+	if s == "" {
+		return nil
+	}
+	// A string header followed by the capacity has the layout of a slice header.
+	return *(*[]byte)(unsafe.Pointer(&struct {
+		string
+		int
+	}{s, len(s)}))
+}
@@ -0,0 +1,11 @@
+// This file is included to the build if any of the buildtags below
+// are defined. Refer to README notes for more details.
+
+//+build easyjson_nounsafe appengine
+
+package easyjson
+
+// strToBytes creates a byte slice normally from a string, copying the data.
+func strToBytes(s string) []byte {
+	return []byte(s)
+}
@@ -0,0 +1,12 @@
+package tests
+
+import "github.com/mailru/easyjson"
+
+//easyjson:json
+type AnyStruct struct {
+	Payload  easyjson.Any            `json:"payload"`
+	Optional easyjson.Any            `json:"optional,omitempty"`
+	Ptr      *easyjson.Any           `json:"ptr"`
+	List     []easyjson.Any          `json:"list"`
+	Attrs    map[string]easyjson.Any `json:"attrs"`
+}
@@ -0,0 +1,235 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjson1253b88DecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *AnyStruct) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "payload":
+					out.Payload = easyjson.Any{}
+				case "optional":
+					out.Optional = easyjson.Any{}
+				case "ptr":
+					out.Ptr = nil
+				case "list":
+					out.List = nil
+				case "attrs":
+					out.Attrs = nil
+				}
+			}
+			if !in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "payload":
+					out.Payload = easyjson.NullAny()
+				case "optional":
+					out.Optional = easyjson.NullAny()
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "payload":
+			(out.Payload).UnmarshalEasyJSON(in)
+		case "optional":
+			(out.Optional).UnmarshalEasyJSON(in)
+		case "ptr":
+			if in.IsNull() {
+				in.Skip()
+				out.Ptr = nil
+			} else {
+				if out.Ptr == nil {
+					out.Ptr = new(easyjson.Any)
+				}
+				(*out.Ptr).UnmarshalEasyJSON(in)
+			}
+		case "list":
+			if in.IsNull() {
+				in.Skip()
+				out.List = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.List == nil {
+					if !in.IsDelim(']') {
+						out.List = make([]easyjson.Any, 0, 2)
+					} else {
+						out.List = []easyjson.Any{}
+					}
+				} else {
+					out.List = (out.List)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 easyjson.Any
+					(v1).UnmarshalEasyJSON(in)
+					out.List = append(out.List, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "attrs":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.Attrs == nil {
+					out.Attrs = make(map[string]easyjson.Any)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := string(in.String())
+					in.WantColon()
+					v3 := in.ValueStart()
+					var v2 easyjson.Any
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.Attrs, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v2 = (out.Attrs)[key]
+					}
+					(v2).UnmarshalEasyJSON(in)
+					if !in.ValueSkipped(v3) {
+						(out.Attrs)[key] = v2
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1253b88EncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in AnyStruct) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"payload\":"
+		out.RawString(prefix[1:])
+		(in.Payload).MarshalEasyJSON(out)
+	}
+	if (in.Optional).IsDefined() {
+		const prefix string = ",\"optional\":"
+		out.RawString(prefix)
+		(in.Optional).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"ptr\":"
+		out.RawString(prefix)
+		if in.Ptr == nil {
+			out.RawString("null")
+		} else {
+			(*in.Ptr).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"list\":"
+		out.RawString(prefix)
+		if in.List == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v4, v5 := range in.List {
+				if v4 > 0 {
+					out.RawByte(',')
+				}
+				(v5).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"attrs\":"
+		out.RawString(prefix)
+		if in.Attrs == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v6First := true
+			for v6Name, v6Value := range in.Attrs {
+				if v6First {
+					v6First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v6Name))
+				out.RawByte(':')
+				(v6Value).MarshalEasyJSON(out)
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AnyStruct) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1253b88EncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AnyStruct) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1253b88EncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AnyStruct) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1253b88DecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AnyStruct) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1253b88DecodeGithubComMailruEasyjsonTests(l, v)
+}
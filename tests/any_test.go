@@ -0,0 +1,40 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestAny(t *testing.T) {
+	data := `{"payload":{"id":1,"tags":["a"]},"ptr":"x","list":[1,null],"attrs":{"k":true}}`
+
+	var v AnyStruct
+	if err := easyjson.Unmarshal([]byte(data), &v); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	if v.Payload.Kind() != easyjson.AnyObject || v.Optional.IsDefined() || v.Ptr.Kind() != easyjson.AnyString {
+		t.Errorf("Unmarshal() = %+v", v)
+	}
+	if b, err := v.Attrs["k"].Bool(); err != nil || !b {
+		t.Errorf(`Attrs["k"].Bool() = %v, %v; want true`, b, err)
+	}
+
+	out, err := easyjson.Marshal(v)
+	if err != nil || string(out) != data {
+		t.Errorf("Marshal() = %s, %v; want %s", out, err, data)
+	}
+
+	// null is kept apart from a missing member.
+	v = AnyStruct{}
+	if err := easyjson.Unmarshal([]byte(`{"payload":{"a":1},"optional":null,"ptr":null}`), &v); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	if v.Optional.Kind() != easyjson.AnyNull || v.Ptr != nil {
+		t.Errorf("Unmarshal() of null members = %+v", v)
+	}
+	want := `{"payload":{"a":1},"optional":null,"ptr":null,"list":null,"attrs":null}`
+	if out, err := easyjson.Marshal(v); err != nil || string(out) != want {
+		t.Errorf("Marshal() = %s, %v; want %s", out, err, want)
+	}
+}
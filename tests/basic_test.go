@@ -54,11 +54,13 @@ var testCases = []struct {
 	{&myUInt8SliceValue, myUInt8SliceString},
 	{&myUInt8ArrayValue, myUInt8ArrayString},
 	{&mapWithEncodingMarshaler, mapWithEncodingMarshalerString},
+	{&textMapKeyValue, textMapKeyString},
 	{&myGenDeclaredValue, myGenDeclaredString},
 	{&myGenDeclaredWithCommentValue, myGenDeclaredWithCommentString},
 	{&myTypeDeclaredValue, myTypeDeclaredString},
 	{&myTypeNotSkippedValue, myTypeNotSkippedString},
 	{&intern, internString},
+	{&unexportedNestedValue, unexportedNestedString},
 }
 
 func TestMarshal(t *testing.T) {
@@ -91,6 +93,43 @@ func TestUnmarshal(t *testing.T) {
 	}
 }
 
+func TestUnmarshalFromString(t *testing.T) {
+	for i, test := range testCases {
+		v := reflect.New(reflect.TypeOf(test.Decoded).Elem()).Interface().(easyjson.Unmarshaler)
+
+		err := easyjson.UnmarshalFromString(test.Encoded, v)
+		if err != nil {
+			t.Errorf("[%d, %T] UnmarshalFromString() error: %v", i, test.Decoded, err)
+		}
+
+		if !reflect.DeepEqual(v, test.Decoded) {
+			t.Errorf("[%d, %T] UnmarshalFromString(): got \n%+v\n\t\t want \n%+v", i, test.Decoded, v, test.Decoded)
+		}
+	}
+}
+
+func TestMarshalRaw(t *testing.T) {
+	for i, test := range testCases {
+		m, ok := test.Decoded.(easyjson.Marshaler)
+		if !ok {
+			continue
+		}
+		data, err := easyjson.MarshalRaw(m)
+		if err != nil || string(data) != test.Encoded {
+			t.Errorf("[%d, %T] MarshalRaw() = %s, %v; want %s", i, test.Decoded, data, err, test.Encoded)
+		}
+	}
+
+	var nilPtr *Opts
+	doc := struct {
+		V json.RawMessage `json:"v"`
+	}{}
+	doc.V, _ = easyjson.MarshalRaw(nilPtr)
+	if data, err := json.Marshal(doc); err != nil || string(data) != `{"v":null}` {
+		t.Errorf("embedded MarshalRaw(nil) = %s, %v; want {\"v\":null}", data, err)
+	}
+}
+
 func TestRawMessageSTD(t *testing.T) {
 	type T struct {
 		F    easyjson.RawMessage
@@ -181,6 +220,13 @@ func TestUnderflowArray(t *testing.T) {
 	}
 }
 
+func TestTextMapKeyErrors(t *testing.T) {
+	var v TextMapKeyStruct
+	if err := easyjson.Unmarshal([]byte(`{"M":{"nokey":1}}`), &v); err == nil {
+		t.Errorf("easyjson.Unmarshal() of an invalid key succeeded; want error")
+	}
+}
+
 func TestEncodingFlags(t *testing.T) {
 	for i, test := range []struct {
 		Flags jwriter.Flags
@@ -191,6 +237,9 @@ func TestEncodingFlags(t *testing.T) {
 		{0, EncodingFlagsTestSlice{}, `{"F":null}`},
 		{jwriter.NilMapAsEmpty, EncodingFlagsTestMap{}, `{"F":{}}`},
 		{jwriter.NilSliceAsEmpty, EncodingFlagsTestSlice{}, `{"F":[]}`},
+		{0, encodingFlagsTestPtrsValue, `{"S":[null,"x",null],"A":["x",null]}`},
+		{jwriter.NilElemSkip, encodingFlagsTestPtrsValue, `{"S":["x"],"A":["x"]}`},
+		{jwriter.NilElemSkip, EncodingFlagsTestPtrs{S: []*string{nil}}, `{"S":[],"A":[]}`},
 	} {
 		w := &jwriter.Writer{Flags: test.Flags}
 		test.In.MarshalEasyJSON(w)
@@ -208,6 +257,23 @@ func TestEncodingFlags(t *testing.T) {
 
 }
 
+func TestEncodingFlagsNilElemError(t *testing.T) {
+	w := &jwriter.Writer{Flags: jwriter.NilElemError}
+	encodingFlagsTestPtrsValue.MarshalEasyJSON(w)
+	if _, err := w.BuildBytes(); err == nil {
+		t.Errorf("MarshalEasyJSON(%+v) with NilElemError succeeded; want error", encodingFlagsTestPtrsValue)
+	}
+
+	w = &jwriter.Writer{Flags: jwriter.NilElemError}
+	EncodingFlagsTestPtrs{
+		S: []*string{&encodingFlagsTestStr},
+		A: [2]*string{&encodingFlagsTestStr, &encodingFlagsTestStr},
+	}.MarshalEasyJSON(w)
+	if _, err := w.BuildBytes(); err != nil {
+		t.Errorf("MarshalEasyJSON() without nil elements error: %v", err)
+	}
+}
+
 func TestNestedEasyJsonMarshal(t *testing.T) {
 	n := map[string]*NestedEasyMarshaler{
 		"Value":  {},
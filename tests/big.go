@@ -0,0 +1,16 @@
+package tests
+
+import "math/big"
+
+//easyjson:json
+type BigNumbers struct {
+	Int       *big.Int   `json:"int"`
+	IntStr    *big.Int   `json:"int_str,string"`
+	Value     big.Int    `json:"value"`
+	Float     *big.Float `json:"float" easyjson:"number"`
+	FloatText *big.Float `json:"float_text"`
+	Rat       *big.Rat   `json:"rat" easyjson:"number"`
+	RatText   *big.Rat   `json:"rat_text"`
+	Optional  *big.Int   `json:"optional,omitempty"`
+	List      []*big.Int `json:"list"`
+}
@@ -0,0 +1,299 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	big "math/big"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjson266000a0DecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *BigNumbers) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "int":
+					out.Int = nil
+				case "int_str":
+					out.IntStr = nil
+				case "value":
+					out.Value = big.Int{}
+				case "float":
+					out.Float = nil
+				case "float_text":
+					out.FloatText = nil
+				case "rat":
+					out.Rat = nil
+				case "rat_text":
+					out.RatText = nil
+				case "optional":
+					out.Optional = nil
+				case "list":
+					out.List = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "int":
+			if in.IsNull() {
+				in.Skip()
+				out.Int = nil
+			} else {
+				if out.Int == nil {
+					out.Int = new(big.Int)
+				}
+				in.BigInt(&*out.Int)
+			}
+		case "int_str":
+			if in.IsNull() {
+				in.Skip()
+				out.IntStr = nil
+			} else {
+				if out.IntStr == nil {
+					out.IntStr = new(big.Int)
+				}
+				in.BigInt(&*out.IntStr)
+			}
+		case "value":
+			in.BigInt(&out.Value)
+		case "float":
+			if in.IsNull() {
+				in.Skip()
+				out.Float = nil
+			} else {
+				if out.Float == nil {
+					out.Float = new(big.Float)
+				}
+				in.BigFloat(&*out.Float)
+			}
+		case "float_text":
+			if in.IsNull() {
+				in.Skip()
+				out.FloatText = nil
+			} else {
+				if out.FloatText == nil {
+					out.FloatText = new(big.Float)
+				}
+				in.BigFloat(&*out.FloatText)
+			}
+		case "rat":
+			if in.IsNull() {
+				in.Skip()
+				out.Rat = nil
+			} else {
+				if out.Rat == nil {
+					out.Rat = new(big.Rat)
+				}
+				in.BigRat(&*out.Rat)
+			}
+		case "rat_text":
+			if in.IsNull() {
+				in.Skip()
+				out.RatText = nil
+			} else {
+				if out.RatText == nil {
+					out.RatText = new(big.Rat)
+				}
+				in.BigRat(&*out.RatText)
+			}
+		case "optional":
+			if in.IsNull() {
+				in.Skip()
+				out.Optional = nil
+			} else {
+				if out.Optional == nil {
+					out.Optional = new(big.Int)
+				}
+				in.BigInt(&*out.Optional)
+			}
+		case "list":
+			if in.IsNull() {
+				in.Skip()
+				out.List = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.List == nil {
+					if !in.IsDelim(']') {
+						out.List = make([]*big.Int, 0, 8)
+					} else {
+						out.List = []*big.Int{}
+					}
+				} else {
+					out.List = (out.List)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 *big.Int
+					if in.IsNull() {
+						in.Skip()
+						v1 = nil
+					} else {
+						if v1 == nil {
+							v1 = new(big.Int)
+						}
+						in.BigInt(&*v1)
+					}
+					out.List = append(out.List, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson266000a0EncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in BigNumbers) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"int\":"
+		out.RawString(prefix[1:])
+		if in.Int == nil {
+			out.RawString("null")
+		} else {
+			out.BigInt(&*in.Int)
+		}
+	}
+	{
+		const prefix string = ",\"int_str\":"
+		out.RawString(prefix)
+		if in.IntStr == nil {
+			out.RawString("null")
+		} else {
+			out.BigIntStr(&*in.IntStr)
+		}
+	}
+	{
+		const prefix string = ",\"value\":"
+		out.RawString(prefix)
+		out.BigInt(&in.Value)
+	}
+	{
+		const prefix string = ",\"float\":"
+		out.RawString(prefix)
+		if in.Float == nil {
+			out.RawString("null")
+		} else {
+			out.BigFloat(&*in.Float)
+		}
+	}
+	{
+		const prefix string = ",\"float_text\":"
+		out.RawString(prefix)
+		if in.FloatText == nil {
+			out.RawString("null")
+		} else {
+			out.BigFloatStr(&*in.FloatText)
+		}
+	}
+	{
+		const prefix string = ",\"rat\":"
+		out.RawString(prefix)
+		if in.Rat == nil {
+			out.RawString("null")
+		} else {
+			out.BigRat(&*in.Rat)
+		}
+	}
+	{
+		const prefix string = ",\"rat_text\":"
+		out.RawString(prefix)
+		if in.RatText == nil {
+			out.RawString("null")
+		} else {
+			out.BigRatStr(&*in.RatText)
+		}
+	}
+	if in.Optional != nil {
+		const prefix string = ",\"optional\":"
+		out.RawString(prefix)
+		out.BigInt(&*in.Optional)
+	}
+	{
+		const prefix string = ",\"list\":"
+		out.RawString(prefix)
+		if in.List == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			v2 := false
+			for _, v3 := range in.List {
+				if v3 == nil && out.SkipNilElem() {
+					continue
+				}
+				if v2 {
+					out.RawByte(',')
+				}
+				v2 = true
+				if v3 == nil {
+					out.RawString("null")
+				} else {
+					out.BigInt(&*v3)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BigNumbers) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson266000a0EncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BigNumbers) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson266000a0EncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BigNumbers) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson266000a0DecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BigNumbers) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson266000a0DecodeGithubComMailruEasyjsonTests(l, v)
+}
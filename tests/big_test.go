@@ -0,0 +1,51 @@
+package tests
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestBigNumbers(t *testing.T) {
+	n, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	v := BigNumbers{
+		Int:       n,
+		IntStr:    big.NewInt(-5),
+		Value:     *big.NewInt(42),
+		Float:     big.NewFloat(0.5),
+		FloatText: big.NewFloat(1e100),
+		Rat:       big.NewRat(-5, 8),
+		RatText:   big.NewRat(1, 3),
+		List:      []*big.Int{big.NewInt(1), nil},
+	}
+	want := `{"int":123456789012345678901234567890,"int_str":"-5","value":42,"float":0.5,"float_text":"1e+100",` +
+		`"rat":-0.625,"rat_text":"1/3","list":[1,null]}`
+
+	data, err := easyjson.Marshal(v)
+	if err != nil || string(data) != want {
+		t.Errorf("Marshal() = %s, %v; want %s", data, err, want)
+	}
+
+	var got BigNumbers
+	if err := easyjson.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	if again, err := easyjson.Marshal(got); err != nil || string(again) != want {
+		t.Errorf("Marshal(Unmarshal()) = %s, %v; want %s", again, err, want)
+	}
+
+	// The values are accepted both as numbers and as strings, whatever the tags.
+	data = []byte(`{"int":"12","int_str":-5,"float":"0.5","float_text":1e100,"rat":"-5/8","rat_text":0.25}`)
+	got = BigNumbers{}
+	if err := easyjson.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal(%s) error: %v", data, err)
+	}
+	if got.Int.Int64() != 12 || got.Rat.Cmp(big.NewRat(-5, 8)) != 0 || got.RatText.Cmp(big.NewRat(1, 4)) != 0 {
+		t.Errorf("Unmarshal(%s) = %+v", data, got)
+	}
+
+	if _, err := easyjson.Marshal(BigNumbers{Rat: big.NewRat(1, 3)}); err == nil {
+		t.Error("Marshal() of a rational number without a decimal representation succeeded")
+	}
+}
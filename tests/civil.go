@@ -0,0 +1,13 @@
+package tests
+
+import "github.com/mailru/easyjson/civil"
+
+//easyjson:json
+type CivilValues struct {
+	Birthday civil.Date              `json:"birthday"`
+	Opens    civil.TimeOfDay         `json:"opens"`
+	Billing  civil.YearMonth         `json:"billing"`
+	Holiday  *civil.Date             `json:"holiday,omitempty"`
+	Expires  civil.Date              `json:"expires,omitempty"`
+	Totals   map[civil.YearMonth]int `json:"totals"`
+}
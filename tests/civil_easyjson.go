@@ -0,0 +1,201 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	civil "github.com/mailru/easyjson/civil"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjson35a9e417DecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *CivilValues) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "birthday":
+					out.Birthday = civil.Date{}
+				case "opens":
+					out.Opens = civil.TimeOfDay{}
+				case "billing":
+					out.Billing = civil.YearMonth{}
+				case "holiday":
+					out.Holiday = nil
+				case "expires":
+					out.Expires = civil.Date{}
+				case "totals":
+					out.Totals = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "birthday":
+			(out.Birthday).UnmarshalEasyJSON(in)
+		case "opens":
+			(out.Opens).UnmarshalEasyJSON(in)
+		case "billing":
+			(out.Billing).UnmarshalEasyJSON(in)
+		case "holiday":
+			if in.IsNull() {
+				in.Skip()
+				out.Holiday = nil
+			} else {
+				if out.Holiday == nil {
+					out.Holiday = new(civil.Date)
+				}
+				(*out.Holiday).UnmarshalEasyJSON(in)
+			}
+		case "expires":
+			(out.Expires).UnmarshalEasyJSON(in)
+		case "totals":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.Totals == nil {
+					out.Totals = make(map[civil.YearMonth]int)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					var key civil.YearMonth
+					if data := in.UnsafeBytes(); in.Ok() {
+						in.AddError(key.UnmarshalText(data))
+					}
+					in.WantColon()
+					v2 := in.ValueStart()
+					var v1 int
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.Totals, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v1 = (out.Totals)[key]
+					}
+					v1 = int(in.Int())
+					if !in.ValueSkipped(v2) {
+						(out.Totals)[key] = v1
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson35a9e417EncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in CivilValues) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"birthday\":"
+		out.RawString(prefix[1:])
+		(in.Birthday).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"opens\":"
+		out.RawString(prefix)
+		(in.Opens).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"billing\":"
+		out.RawString(prefix)
+		(in.Billing).MarshalEasyJSON(out)
+	}
+	if in.Holiday != nil && (in.Holiday).IsDefined() {
+		const prefix string = ",\"holiday\":"
+		out.RawString(prefix)
+		(*in.Holiday).MarshalEasyJSON(out)
+	}
+	if (in.Expires).IsDefined() {
+		const prefix string = ",\"expires\":"
+		out.RawString(prefix)
+		(in.Expires).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"totals\":"
+		out.RawString(prefix)
+		if in.Totals == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v3First := true
+			for v3Name, v3Value := range in.Totals {
+				if v3First {
+					v3First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.TextKey((v3Name).MarshalText())
+				out.RawByte(':')
+				out.Int(int(v3Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CivilValues) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson35a9e417EncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CivilValues) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson35a9e417EncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CivilValues) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson35a9e417DecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CivilValues) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson35a9e417DecodeGithubComMailruEasyjsonTests(l, v)
+}
@@ -0,0 +1,33 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mailru/easyjson"
+	"github.com/mailru/easyjson/civil"
+)
+
+func TestCivilValues(t *testing.T) {
+	v := CivilValues{
+		Birthday: civil.Date{Year: 1990, Month: time.May, Day: 17},
+		Opens:    civil.TimeOfDay{Hour: 9, Minute: 30},
+		Billing:  civil.YearMonth{Year: 2024, Month: time.December},
+		Totals:   map[civil.YearMonth]int{{Year: 2024, Month: time.November}: 10},
+	}
+	want := `{"birthday":"1990-05-17","opens":"09:30:00","billing":"2024-12","totals":{"2024-11":10}}`
+
+	data, err := easyjson.Marshal(v)
+	if err != nil || string(data) != want {
+		t.Errorf("Marshal() = %s, %v; want %s", data, err, want)
+	}
+
+	var got CivilValues
+	if err := easyjson.Unmarshal(data, &got); err != nil || !reflect.DeepEqual(got, v) {
+		t.Errorf("Unmarshal(%s) = %+v, %v; want %+v", data, got, err, v)
+	}
+	if err := easyjson.Unmarshal([]byte(`{"birthday":"1990-02-30"}`), &got); err == nil {
+		t.Error("Unmarshal() of an invalid date did not fail")
+	}
+}
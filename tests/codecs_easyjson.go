@@ -0,0 +1,14 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	easyjson "github.com/mailru/easyjson"
+)
+
+// Codecs maps the import path qualified names of the generated types to their codecs.
+var Codecs = map[string]easyjson.Codec{
+	"github.com/mailru/easyjson/tests.RegistrySlice":   {Name: "github.com/mailru/easyjson/tests.RegistrySlice", New: func() easyjson.MarshalerUnmarshaler { return new(RegistrySlice) }},
+	"github.com/mailru/easyjson/tests.RegistryStruct":  {Name: "github.com/mailru/easyjson/tests.RegistryStruct", New: func() easyjson.MarshalerUnmarshaler { return new(RegistryStruct) }},
+	"github.com/mailru/easyjson/tests.registryPrivate": {Name: "github.com/mailru/easyjson/tests.registryPrivate", New: func() easyjson.MarshalerUnmarshaler { return new(registryPrivate) }},
+}
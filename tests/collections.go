@@ -0,0 +1,23 @@
+package tests
+
+//easyjson:json
+type CollectionUser struct {
+	Name string `json:"name"`
+}
+
+type CollectionEntry struct {
+	ID   int             `json:"id"`
+	User *CollectionUser `json:"user,omitempty"`
+}
+
+//easyjson:json
+type CollectionUsers []CollectionUser
+
+//easyjson:json
+type CollectionUserPtrs []*CollectionUser
+
+//easyjson:json
+type CollectionIndex map[string]CollectionEntry
+
+//easyjson:json
+type CollectionGroups map[string]CollectionUsers
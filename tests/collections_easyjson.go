@@ -0,0 +1,482 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjsonDaeb1d75DecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *CollectionUsers) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else if !in.IsDelim('[') {
+		in.Delim('[')
+		in.Delim(']')
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(CollectionUsers, 0, 4)
+			} else {
+				*out = CollectionUsers{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v1 CollectionUser
+			easyjsonDaeb1d75DecodeGithubComMailruEasyjsonTests1(in, &v1)
+			*out = append(*out, v1)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDaeb1d75EncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in CollectionUsers) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v2, v3 := range in {
+			if v2 > 0 {
+				out.RawByte(',')
+			}
+			easyjsonDaeb1d75EncodeGithubComMailruEasyjsonTests1(out, v3)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CollectionUsers) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDaeb1d75EncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CollectionUsers) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDaeb1d75EncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CollectionUsers) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDaeb1d75DecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CollectionUsers) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDaeb1d75DecodeGithubComMailruEasyjsonTests(l, v)
+}
+func easyjsonDaeb1d75DecodeGithubComMailruEasyjsonTests2(in *jlexer.Lexer, out *CollectionUserPtrs) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else if !in.IsDelim('[') {
+		in.Delim('[')
+		in.Delim(']')
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(CollectionUserPtrs, 0, 8)
+			} else {
+				*out = CollectionUserPtrs{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v4 *CollectionUser
+			if in.IsNull() {
+				in.Skip()
+				v4 = nil
+			} else {
+				if v4 == nil {
+					v4 = new(CollectionUser)
+				}
+				easyjsonDaeb1d75DecodeGithubComMailruEasyjsonTests1(in, v4)
+			}
+			*out = append(*out, v4)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDaeb1d75EncodeGithubComMailruEasyjsonTests2(out *jwriter.Writer, in CollectionUserPtrs) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		v5 := false
+		for _, v6 := range in {
+			if v6 == nil && out.SkipNilElem() {
+				continue
+			}
+			if v5 {
+				out.RawByte(',')
+			}
+			v5 = true
+			if v6 == nil {
+				out.RawString("null")
+			} else {
+				easyjsonDaeb1d75EncodeGithubComMailruEasyjsonTests1(out, *v6)
+			}
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CollectionUserPtrs) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDaeb1d75EncodeGithubComMailruEasyjsonTests2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CollectionUserPtrs) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDaeb1d75EncodeGithubComMailruEasyjsonTests2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CollectionUserPtrs) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDaeb1d75DecodeGithubComMailruEasyjsonTests2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CollectionUserPtrs) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDaeb1d75DecodeGithubComMailruEasyjsonTests2(l, v)
+}
+func easyjsonDaeb1d75DecodeGithubComMailruEasyjsonTests1(in *jlexer.Lexer, out *CollectionUser) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "name":
+					out.Name = ""
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDaeb1d75EncodeGithubComMailruEasyjsonTests1(out *jwriter.Writer, in CollectionUser) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CollectionUser) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDaeb1d75EncodeGithubComMailruEasyjsonTests1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CollectionUser) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDaeb1d75EncodeGithubComMailruEasyjsonTests1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CollectionUser) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDaeb1d75DecodeGithubComMailruEasyjsonTests1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CollectionUser) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDaeb1d75DecodeGithubComMailruEasyjsonTests1(l, v)
+}
+func easyjsonDaeb1d75DecodeGithubComMailruEasyjsonTests3(in *jlexer.Lexer, out *CollectionIndex) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+	} else if !in.IsDelim('{') {
+		in.Delim('{')
+		in.Delim('}')
+	} else {
+		in.Delim('{')
+		if !in.MergePatch || *out == nil {
+			*out = make(CollectionIndex)
+		}
+		for !in.IsDelim('}') {
+			in.MarkKey()
+			key := string(in.String())
+			in.WantColon()
+			v8 := in.ValueStart()
+			var v7 CollectionEntry
+			if in.MergePatch {
+				if in.IsNull() {
+					in.Skip()
+					if !in.KeepOnNull {
+						delete(*out, key)
+					}
+					in.WantComma()
+					continue
+				}
+				v7 = (*out)[key]
+			}
+			easyjsonDaeb1d75DecodeGithubComMailruEasyjsonTests4(in, &v7)
+			if !in.ValueSkipped(v8) {
+				(*out)[key] = v7
+			}
+			in.WantComma()
+		}
+		in.Delim('}')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDaeb1d75EncodeGithubComMailruEasyjsonTests3(out *jwriter.Writer, in CollectionIndex) {
+	if in == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+		out.RawString(`null`)
+	} else {
+		out.RawByte('{')
+		v9First := true
+		for v9Name, v9Value := range in {
+			if v9First {
+				v9First = false
+			} else {
+				out.RawByte(',')
+			}
+			out.String(string(v9Name))
+			out.RawByte(':')
+			easyjsonDaeb1d75EncodeGithubComMailruEasyjsonTests4(out, v9Value)
+		}
+		out.RawByte('}')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CollectionIndex) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDaeb1d75EncodeGithubComMailruEasyjsonTests3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CollectionIndex) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDaeb1d75EncodeGithubComMailruEasyjsonTests3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CollectionIndex) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDaeb1d75DecodeGithubComMailruEasyjsonTests3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CollectionIndex) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDaeb1d75DecodeGithubComMailruEasyjsonTests3(l, v)
+}
+func easyjsonDaeb1d75DecodeGithubComMailruEasyjsonTests4(in *jlexer.Lexer, out *CollectionEntry) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "id":
+					out.ID = 0
+				case "user":
+					out.User = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "user":
+			if in.IsNull() {
+				in.Skip()
+				out.User = nil
+			} else {
+				if out.User == nil {
+					out.User = new(CollectionUser)
+				}
+				easyjsonDaeb1d75DecodeGithubComMailruEasyjsonTests1(in, out.User)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDaeb1d75EncodeGithubComMailruEasyjsonTests4(out *jwriter.Writer, in CollectionEntry) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	if in.User != nil {
+		const prefix string = ",\"user\":"
+		out.RawString(prefix)
+		easyjsonDaeb1d75EncodeGithubComMailruEasyjsonTests1(out, *in.User)
+	}
+	out.RawByte('}')
+}
+func easyjsonDaeb1d75DecodeGithubComMailruEasyjsonTests5(in *jlexer.Lexer, out *CollectionGroups) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+	} else if !in.IsDelim('{') {
+		in.Delim('{')
+		in.Delim('}')
+	} else {
+		in.Delim('{')
+		if !in.MergePatch || *out == nil {
+			*out = make(CollectionGroups)
+		}
+		for !in.IsDelim('}') {
+			in.MarkKey()
+			key := string(in.String())
+			in.WantColon()
+			v11 := in.ValueStart()
+			var v10 CollectionUsers
+			if in.MergePatch {
+				if in.IsNull() {
+					in.Skip()
+					if !in.KeepOnNull {
+						delete(*out, key)
+					}
+					in.WantComma()
+					continue
+				}
+				v10 = (*out)[key]
+			}
+			easyjsonDaeb1d75DecodeGithubComMailruEasyjsonTests(in, &v10)
+			if !in.ValueSkipped(v11) {
+				(*out)[key] = v10
+			}
+			in.WantComma()
+		}
+		in.Delim('}')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDaeb1d75EncodeGithubComMailruEasyjsonTests5(out *jwriter.Writer, in CollectionGroups) {
+	if in == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+		out.RawString(`null`)
+	} else {
+		out.RawByte('{')
+		v12First := true
+		for v12Name, v12Value := range in {
+			if v12First {
+				v12First = false
+			} else {
+				out.RawByte(',')
+			}
+			out.String(string(v12Name))
+			out.RawByte(':')
+			easyjsonDaeb1d75EncodeGithubComMailruEasyjsonTests(out, v12Value)
+		}
+		out.RawByte('}')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CollectionGroups) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDaeb1d75EncodeGithubComMailruEasyjsonTests5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CollectionGroups) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDaeb1d75EncodeGithubComMailruEasyjsonTests5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CollectionGroups) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDaeb1d75DecodeGithubComMailruEasyjsonTests5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CollectionGroups) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDaeb1d75DecodeGithubComMailruEasyjsonTests5(l, v)
+}
@@ -0,0 +1,50 @@
+package tests
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestTopLevelCollections(t *testing.T) {
+	for _, test := range []struct {
+		v, decoded easyjson.MarshalerUnmarshaler
+		data       string
+	}{
+		{
+			&CollectionUsers{{Name: "a"}, {Name: "b"}}, new(CollectionUsers),
+			`[{"name":"a"},{"name":"b"}]`,
+		},
+		{
+			&CollectionUserPtrs{{Name: "a"}, nil}, new(CollectionUserPtrs),
+			`[{"name":"a"},null]`,
+		},
+		{
+			&CollectionIndex{"x": {ID: 1, User: &CollectionUser{Name: "a"}}}, new(CollectionIndex),
+			`{"x":{"id":1,"user":{"name":"a"}}}`,
+		},
+		{
+			&CollectionGroups{"admins": {{Name: "root"}}}, new(CollectionGroups),
+			`{"admins":[{"name":"root"}]}`,
+		},
+	} {
+		got, err := easyjson.Marshal(test.v)
+		if err != nil || string(got) != test.data {
+			t.Errorf("Marshal(%T) = %s, %v; want %s", test.v, got, err, test.data)
+		}
+		if std, err := json.Marshal(test.v); err != nil || string(std) != test.data {
+			t.Errorf("json.Marshal(%T) = %s, %v; want %s", test.v, std, err, test.data)
+		}
+
+		if err := easyjson.Unmarshal([]byte(test.data), test.decoded); err != nil || !reflect.DeepEqual(test.decoded, test.v) {
+			t.Errorf("Unmarshal(%s) = %+v, %v; want %+v", test.data, test.decoded, err, test.v)
+		}
+	}
+
+	users := CollectionUsers{{Name: "a"}}
+	if err := easyjson.Unmarshal([]byte(`null`), &users); err != nil || users != nil {
+		t.Errorf("Unmarshal(null) = %+v, %v; want nil", users, err)
+	}
+}
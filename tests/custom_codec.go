@@ -0,0 +1,42 @@
+package tests
+
+import (
+	"fmt"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+// CustomMoney is an amount of cents, marshaled as a decimal string by encodeMoney.
+type CustomMoney struct {
+	Cents int64
+}
+
+func encodeMoney(w *jwriter.Writer, m CustomMoney) {
+	w.String(fmt.Sprintf("%d.%02d", m.Cents/100, m.Cents%100))
+}
+
+func decodeMoney(l *jlexer.Lexer) *CustomMoney {
+	if l.IsNull() {
+		l.Skip()
+		return nil
+	}
+	var units, cents int64
+	s := l.String()
+	if _, err := fmt.Sscanf(s, "%d.%02d", &units, &cents); err != nil {
+		l.AddError(err)
+		return nil
+	}
+	return &CustomMoney{Cents: units*100 + cents}
+}
+
+func decodeLenient(l *jlexer.Lexer) *int {
+	n := l.IntStr()
+	return &n
+}
+
+//easyjson:json
+type CustomCodecs struct {
+	Price CustomMoney `json:"price" easyjson:"custom=encodeMoney,decodeMoney"`
+	Count int         `json:"count" easyjson:"custom=,decodeLenient"`
+}
@@ -0,0 +1,107 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjsonA89ca0caDecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *CustomCodecs) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "price":
+					out.Price = CustomMoney{}
+				case "count":
+					out.Count = 0
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "price":
+			if v1 := decodeMoney(in); v1 != nil {
+				out.Price = *v1
+			}
+		case "count":
+			if v2 := decodeLenient(in); v2 != nil {
+				out.Count = *v2
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA89ca0caEncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in CustomCodecs) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix[1:])
+		encodeMoney(out, in.Price)
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Int(int(in.Count))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CustomCodecs) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonA89ca0caEncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CustomCodecs) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonA89ca0caEncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CustomCodecs) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonA89ca0caDecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CustomCodecs) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonA89ca0caDecodeGithubComMailruEasyjsonTests(l, v)
+}
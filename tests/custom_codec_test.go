@@ -0,0 +1,28 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestCustomCodecs(t *testing.T) {
+	v := CustomCodecs{Price: CustomMoney{Cents: 1205}, Count: 3}
+	want := `{"price":"12.05","count":3}`
+
+	data, err := easyjson.Marshal(v)
+	if err != nil || string(data) != want {
+		t.Errorf("Marshal() = %s, %v; want %s", data, err, want)
+	}
+
+	var got CustomCodecs
+	if err := easyjson.Unmarshal([]byte(`{"price":"12.05","count":"3"}`), &got); err != nil || got != v {
+		t.Errorf("Unmarshal() = %+v, %v; want %+v", got, err, v)
+	}
+	if err := easyjson.Unmarshal([]byte(`{"price":null}`), &got); err != nil || got != v {
+		t.Errorf("Unmarshal() of null = %+v, %v; want %+v", got, err, v)
+	}
+	if err := easyjson.Unmarshal([]byte(`{"price":"twelve"}`), &got); err == nil {
+		t.Error("Unmarshal() of an invalid price did not fail")
+	}
+}
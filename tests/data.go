@@ -44,12 +44,21 @@ type PrimitiveTypes struct {
 	Float32String float32 `json:",string"`
 	Float64String float64 `json:",string"`
 
+	BoolString    bool  `json:",string"`
+	PtrIntString  *int  `json:",string"`
+	PtrBoolString *bool `json:",string"`
+
 	Ptr    *string
 	PtrNil *string
 }
 
 var str = "bla"
 
+var (
+	primitiveInt  = 42
+	primitiveBool = false
+)
+
 var primitiveTypesValue = PrimitiveTypes{
 	String: "test", Bool: true,
 
@@ -83,6 +92,10 @@ var primitiveTypesValue = PrimitiveTypes{
 	Float32String: 1.5,
 	Float64String: math.MaxFloat64,
 
+	BoolString:    true,
+	PtrIntString:  &primitiveInt,
+	PtrBoolString: &primitiveBool,
+
 	Ptr: &str,
 }
 
@@ -119,6 +132,10 @@ var primitiveTypesString = "{" +
 	`"Float32String":"` + fmt.Sprint(1.5) + `",` +
 	`"Float64String":"` + fmt.Sprint(math.MaxFloat64) + `",` +
 
+	`"BoolString":"true",` +
+	`"PtrIntString":"42",` +
+	`"PtrBoolString":"false",` +
+
 	`"Ptr":"bla",` +
 	`"PtrNil":null` +
 
@@ -299,6 +316,10 @@ var structsValue = Structs{
 }
 
 var structsString = "{" +
+	// Embedded fields take the place of the embedded structs.
+	`"Value":"test",` +
+	`"V":"subp",` +
+
 	`"Value2":5,` +
 
 	`"substruct":{"Value":"test1","Value2":"v"},` +
@@ -320,11 +341,7 @@ var structsString = "{" +
 	`"AnonymousSlice":[{"V":1},{"V":2}],` +
 	`"AnonymousPtrSlice":[{"V":3},{"V":4}],` +
 
-	`"Slice":["test5","test6"],` +
-
-	// Embedded fields go last.
-	`"V":"subp",` +
-	`"Value":"test"` +
+	`"Slice":["test5","test6"]` +
 	"}"
 
 type OmitEmpty struct {
@@ -386,6 +403,25 @@ var optsString = `{` +
 	`"Int":5` +
 	`}`
 
+type OptsOmitEmpty struct {
+	Undefined    opt.Int  `json:",omitempty"`
+	Zero         opt.Int  `json:",omitempty"`
+	PtrNil       *opt.Int `json:",omitempty"`
+	PtrUndefined *opt.Int `json:",omitempty"`
+	PtrZero      *opt.Int `json:",omitempty"`
+	Null         opt.Int
+	PtrNull      *opt.Int
+}
+
+var optsOmitEmptyValue = OptsOmitEmpty{
+	Zero:         opt.OInt(0),
+	PtrUndefined: &opt.Int{},
+	PtrZero:      &opt.Int{Defined: true},
+	PtrNull:      &opt.Int{},
+}
+
+var optsOmitEmptyString = `{"Zero":0,"PtrZero":0,"Null":null,"PtrNull":null}`
+
 type Raw struct {
 	Field  easyjson.RawMessage
 	Field2 string
@@ -692,6 +728,11 @@ type RequiredOptionalStruct struct {
 	Lastname  string `json:"last_name"`
 }
 
+type RequiredDirectiveStruct struct {
+	ID   int    `json:"id" easyjson:"required"`
+	Name string `json:"name,omitempty"`
+}
+
 type RequiredOptionalMap struct {
 	ReqMap         map[int]string `json:"req_map,required"`
 	OmitEmptyMap   map[int]string `json:"oe_map,omitempty"`
@@ -708,6 +749,19 @@ type EncodingFlagsTestSlice struct {
 	F []string
 }
 
+//easyjson:json
+type EncodingFlagsTestPtrs struct {
+	S []*string
+	A [2]*string
+}
+
+var encodingFlagsTestStr = "x"
+
+var encodingFlagsTestPtrsValue = EncodingFlagsTestPtrs{
+	S: []*string{nil, &encodingFlagsTestStr, nil},
+	A: [2]*string{&encodingFlagsTestStr, nil},
+}
+
 type StructWithInterface struct {
 	Field1 int         `json:"f1"`
 	Field2 interface{} `json:"f2"`
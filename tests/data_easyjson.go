@@ -0,0 +1,6505 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	fmt "fmt"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	opt "github.com/mailru/easyjson/opt"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *unexportedStruct) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "Value":
+					out.Value = ""
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Value":
+			out.Value = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in unexportedStruct) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Value\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Value))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v unexportedStruct) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v unexportedStruct) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *unexportedStruct) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *unexportedStruct) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests1(in *jlexer.Lexer, out *UserMarshaler) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "V":
+					out.V = nil
+				case "T":
+					out.T = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "V":
+			if data := in.Raw(); in.Ok() {
+				in.AddValueError((out.V).UnmarshalJSON(data))
+			}
+		case "T":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddValueError((out.T).UnmarshalText(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests1(out *jwriter.Writer, in UserMarshaler) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"V\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.V).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"T\":"
+		out.RawString(prefix)
+		out.RawText((in.T).MarshalText())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UserMarshaler) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UserMarshaler) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UserMarshaler) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UserMarshaler) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests1(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests2(in *jlexer.Lexer, out *SubStruct) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "Value":
+					out.Value = ""
+				case "Value2":
+					out.Value2 = ""
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Value":
+			out.Value = string(in.String())
+		case "Value2":
+			out.Value2 = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests2(out *jwriter.Writer, in SubStruct) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Value\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Value))
+	}
+	{
+		const prefix string = ",\"Value2\":"
+		out.RawString(prefix)
+		out.String(string(in.Value2))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SubStruct) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SubStruct) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SubStruct) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SubStruct) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests2(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests3(in *jlexer.Lexer, out *SubP) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "V":
+					out.V = ""
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "V":
+			out.V = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests3(out *jwriter.Writer, in SubP) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"V\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.V))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SubP) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SubP) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SubP) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SubP) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests3(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests4(in *jlexer.Lexer, out *Structs) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			switch key {
+			case "V":
+				if out.SubP == nil {
+					out.SubP = new(SubP)
+				}
+			}
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "Value":
+					out.Value = ""
+				case "V":
+					out.V = ""
+				case "Value2":
+					out.Value2 = 0
+				case "substruct":
+					out.Sub1 = SubStruct{}
+				case "Sub2":
+					out.Sub2 = nil
+				case "SubNil":
+					out.SubNil = nil
+				case "SubSlice":
+					out.SubSlice = nil
+				case "SubSliceNil":
+					out.SubSliceNil = nil
+				case "SubPtrSlice":
+					out.SubPtrSlice = nil
+				case "SubPtrSliceNil":
+					out.SubPtrSliceNil = nil
+				case "SubA1":
+					out.SubA1 = SubStructAlias{}
+				case "SubA2":
+					out.SubA2 = nil
+				case "Anonymous":
+					out.Anonymous = struct {
+						V string
+						I int
+					}{}
+				case "Anonymous1":
+					out.Anonymous1 = nil
+				case "AnonymousSlice":
+					out.AnonymousSlice = nil
+				case "AnonymousPtrSlice":
+					out.AnonymousPtrSlice = nil
+				case "Slice":
+					out.Slice = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Value":
+			out.Value = string(in.String())
+		case "V":
+			if out.SubP == nil {
+				out.SubP = new(SubP)
+			}
+			out.V = string(in.String())
+		case "Value2":
+			out.Value2 = int(in.Int())
+		case "substruct":
+			easyjson794297d0DecodeGithubComMailruEasyjsonTests2(in, &out.Sub1)
+		case "Sub2":
+			if in.IsNull() {
+				in.Skip()
+				out.Sub2 = nil
+			} else {
+				if out.Sub2 == nil {
+					out.Sub2 = new(SubStruct)
+				}
+				easyjson794297d0DecodeGithubComMailruEasyjsonTests2(in, out.Sub2)
+			}
+		case "SubNil":
+			if in.IsNull() {
+				in.Skip()
+				out.SubNil = nil
+			} else {
+				if out.SubNil == nil {
+					out.SubNil = new(SubStruct)
+				}
+				easyjson794297d0DecodeGithubComMailruEasyjsonTests2(in, out.SubNil)
+			}
+		case "SubSlice":
+			if in.IsNull() {
+				in.Skip()
+				out.SubSlice = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.SubSlice == nil {
+					if !in.IsDelim(']') {
+						out.SubSlice = make([]SubStruct, 0, 1)
+					} else {
+						out.SubSlice = []SubStruct{}
+					}
+				} else {
+					out.SubSlice = (out.SubSlice)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 SubStruct
+					easyjson794297d0DecodeGithubComMailruEasyjsonTests2(in, &v1)
+					out.SubSlice = append(out.SubSlice, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "SubSliceNil":
+			if in.IsNull() {
+				in.Skip()
+				out.SubSliceNil = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.SubSliceNil == nil {
+					if !in.IsDelim(']') {
+						out.SubSliceNil = make([]SubStruct, 0, 1)
+					} else {
+						out.SubSliceNil = []SubStruct{}
+					}
+				} else {
+					out.SubSliceNil = (out.SubSliceNil)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 SubStruct
+					easyjson794297d0DecodeGithubComMailruEasyjsonTests2(in, &v2)
+					out.SubSliceNil = append(out.SubSliceNil, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "SubPtrSlice":
+			if in.IsNull() {
+				in.Skip()
+				out.SubPtrSlice = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.SubPtrSlice == nil {
+					if !in.IsDelim(']') {
+						out.SubPtrSlice = make([]*SubStruct, 0, 8)
+					} else {
+						out.SubPtrSlice = []*SubStruct{}
+					}
+				} else {
+					out.SubPtrSlice = (out.SubPtrSlice)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 *SubStruct
+					if in.IsNull() {
+						in.Skip()
+						v3 = nil
+					} else {
+						if v3 == nil {
+							v3 = new(SubStruct)
+						}
+						easyjson794297d0DecodeGithubComMailruEasyjsonTests2(in, v3)
+					}
+					out.SubPtrSlice = append(out.SubPtrSlice, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "SubPtrSliceNil":
+			if in.IsNull() {
+				in.Skip()
+				out.SubPtrSliceNil = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.SubPtrSliceNil == nil {
+					if !in.IsDelim(']') {
+						out.SubPtrSliceNil = make([]*SubStruct, 0, 8)
+					} else {
+						out.SubPtrSliceNil = []*SubStruct{}
+					}
+				} else {
+					out.SubPtrSliceNil = (out.SubPtrSliceNil)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 *SubStruct
+					if in.IsNull() {
+						in.Skip()
+						v4 = nil
+					} else {
+						if v4 == nil {
+							v4 = new(SubStruct)
+						}
+						easyjson794297d0DecodeGithubComMailruEasyjsonTests2(in, v4)
+					}
+					out.SubPtrSliceNil = append(out.SubPtrSliceNil, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "SubA1":
+			easyjson794297d0DecodeGithubComMailruEasyjsonTests5(in, &out.SubA1)
+		case "SubA2":
+			if in.IsNull() {
+				in.Skip()
+				out.SubA2 = nil
+			} else {
+				if out.SubA2 == nil {
+					out.SubA2 = new(SubStructAlias)
+				}
+				easyjson794297d0DecodeGithubComMailruEasyjsonTests5(in, out.SubA2)
+			}
+		case "Anonymous":
+			easyjson794297d0Decode(in, &out.Anonymous)
+		case "Anonymous1":
+			if in.IsNull() {
+				in.Skip()
+				out.Anonymous1 = nil
+			} else {
+				if out.Anonymous1 == nil {
+					out.Anonymous1 = new(struct{ V string })
+				}
+				easyjson794297d0Decode1(in, out.Anonymous1)
+			}
+		case "AnonymousSlice":
+			if in.IsNull() {
+				in.Skip()
+				out.AnonymousSlice = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.AnonymousSlice == nil {
+					if !in.IsDelim(']') {
+						out.AnonymousSlice = make([]struct{ V int }, 0, 8)
+					} else {
+						out.AnonymousSlice = []struct{ V int }{}
+					}
+				} else {
+					out.AnonymousSlice = (out.AnonymousSlice)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v5 struct{ V int }
+					easyjson794297d0Decode2(in, &v5)
+					out.AnonymousSlice = append(out.AnonymousSlice, v5)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "AnonymousPtrSlice":
+			if in.IsNull() {
+				in.Skip()
+				out.AnonymousPtrSlice = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.AnonymousPtrSlice == nil {
+					if !in.IsDelim(']') {
+						out.AnonymousPtrSlice = make([]*struct{ V int }, 0, 8)
+					} else {
+						out.AnonymousPtrSlice = []*struct{ V int }{}
+					}
+				} else {
+					out.AnonymousPtrSlice = (out.AnonymousPtrSlice)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v6 *struct{ V int }
+					if in.IsNull() {
+						in.Skip()
+						v6 = nil
+					} else {
+						if v6 == nil {
+							v6 = new(struct{ V int })
+						}
+						easyjson794297d0Decode2(in, v6)
+					}
+					out.AnonymousPtrSlice = append(out.AnonymousPtrSlice, v6)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Slice":
+			if in.IsNull() {
+				in.Skip()
+				out.Slice = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Slice == nil {
+					if !in.IsDelim(']') {
+						out.Slice = make([]string, 0, 4)
+					} else {
+						out.Slice = []string{}
+					}
+				} else {
+					out.Slice = (out.Slice)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 string
+					v7 = string(in.String())
+					out.Slice = append(out.Slice, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests4(out *jwriter.Writer, in Structs) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Value\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Value))
+	}
+	if in.SubP != nil {
+		const prefix string = ",\"V\":"
+		out.RawString(prefix)
+		out.String(string(in.V))
+	}
+	{
+		const prefix string = ",\"Value2\":"
+		out.RawString(prefix)
+		out.Int(int(in.Value2))
+	}
+	{
+		const prefix string = ",\"substruct\":"
+		out.RawString(prefix)
+		easyjson794297d0EncodeGithubComMailruEasyjsonTests2(out, in.Sub1)
+	}
+	{
+		const prefix string = ",\"Sub2\":"
+		out.RawString(prefix)
+		if in.Sub2 == nil {
+			out.RawString("null")
+		} else {
+			easyjson794297d0EncodeGithubComMailruEasyjsonTests2(out, *in.Sub2)
+		}
+	}
+	{
+		const prefix string = ",\"SubNil\":"
+		out.RawString(prefix)
+		if in.SubNil == nil {
+			out.RawString("null")
+		} else {
+			easyjson794297d0EncodeGithubComMailruEasyjsonTests2(out, *in.SubNil)
+		}
+	}
+	{
+		const prefix string = ",\"SubSlice\":"
+		out.RawString(prefix)
+		if in.SubSlice == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.SubSlice {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				easyjson794297d0EncodeGithubComMailruEasyjsonTests2(out, v9)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"SubSliceNil\":"
+		out.RawString(prefix)
+		if in.SubSliceNil == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v10, v11 := range in.SubSliceNil {
+				if v10 > 0 {
+					out.RawByte(',')
+				}
+				easyjson794297d0EncodeGithubComMailruEasyjsonTests2(out, v11)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"SubPtrSlice\":"
+		out.RawString(prefix)
+		if in.SubPtrSlice == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			v12 := false
+			for _, v13 := range in.SubPtrSlice {
+				if v13 == nil && out.SkipNilElem() {
+					continue
+				}
+				if v12 {
+					out.RawByte(',')
+				}
+				v12 = true
+				if v13 == nil {
+					out.RawString("null")
+				} else {
+					easyjson794297d0EncodeGithubComMailruEasyjsonTests2(out, *v13)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"SubPtrSliceNil\":"
+		out.RawString(prefix)
+		if in.SubPtrSliceNil == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			v14 := false
+			for _, v15 := range in.SubPtrSliceNil {
+				if v15 == nil && out.SkipNilElem() {
+					continue
+				}
+				if v14 {
+					out.RawByte(',')
+				}
+				v14 = true
+				if v15 == nil {
+					out.RawString("null")
+				} else {
+					easyjson794297d0EncodeGithubComMailruEasyjsonTests2(out, *v15)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"SubA1\":"
+		out.RawString(prefix)
+		easyjson794297d0EncodeGithubComMailruEasyjsonTests5(out, in.SubA1)
+	}
+	{
+		const prefix string = ",\"SubA2\":"
+		out.RawString(prefix)
+		if in.SubA2 == nil {
+			out.RawString("null")
+		} else {
+			easyjson794297d0EncodeGithubComMailruEasyjsonTests5(out, *in.SubA2)
+		}
+	}
+	{
+		const prefix string = ",\"Anonymous\":"
+		out.RawString(prefix)
+		easyjson794297d0Encode(out, in.Anonymous)
+	}
+	{
+		const prefix string = ",\"Anonymous1\":"
+		out.RawString(prefix)
+		if in.Anonymous1 == nil {
+			out.RawString("null")
+		} else {
+			easyjson794297d0Encode1(out, *in.Anonymous1)
+		}
+	}
+	{
+		const prefix string = ",\"AnonymousSlice\":"
+		out.RawString(prefix)
+		if in.AnonymousSlice == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v16, v17 := range in.AnonymousSlice {
+				if v16 > 0 {
+					out.RawByte(',')
+				}
+				easyjson794297d0Encode2(out, v17)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"AnonymousPtrSlice\":"
+		out.RawString(prefix)
+		if in.AnonymousPtrSlice == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			v18 := false
+			for _, v19 := range in.AnonymousPtrSlice {
+				if v19 == nil && out.SkipNilElem() {
+					continue
+				}
+				if v18 {
+					out.RawByte(',')
+				}
+				v18 = true
+				if v19 == nil {
+					out.RawString("null")
+				} else {
+					easyjson794297d0Encode2(out, *v19)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Slice\":"
+		out.RawString(prefix)
+		if in.Slice == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v20, v21 := range in.Slice {
+				if v20 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v21))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Structs) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Structs) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Structs) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Structs) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests4(l, v)
+}
+func easyjson794297d0Decode2(in *jlexer.Lexer, out *struct{ V int }) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "V":
+					out.V = 0
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "V":
+			out.V = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0Encode2(out *jwriter.Writer, in struct{ V int }) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"V\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.V))
+	}
+	out.RawByte('}')
+}
+func easyjson794297d0Decode1(in *jlexer.Lexer, out *struct{ V string }) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "V":
+					out.V = ""
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "V":
+			out.V = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0Encode1(out *jwriter.Writer, in struct{ V string }) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"V\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.V))
+	}
+	out.RawByte('}')
+}
+func easyjson794297d0Decode(in *jlexer.Lexer, out *struct {
+	V string
+	I int
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "V":
+					out.V = ""
+				case "I":
+					out.I = 0
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "V":
+			out.V = string(in.String())
+		case "I":
+			out.I = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0Encode(out *jwriter.Writer, in struct {
+	V string
+	I int
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"V\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.V))
+	}
+	{
+		const prefix string = ",\"I\":"
+		out.RawString(prefix)
+		out.Int(int(in.I))
+	}
+	out.RawByte('}')
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests5(in *jlexer.Lexer, out *SubStructAlias) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "Value":
+					out.Value = ""
+				case "Value2":
+					out.Value2 = ""
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Value":
+			out.Value = string(in.String())
+		case "Value2":
+			out.Value2 = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests5(out *jwriter.Writer, in SubStructAlias) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Value\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Value))
+	}
+	{
+		const prefix string = ",\"Value2\":"
+		out.RawString(prefix)
+		out.String(string(in.Value2))
+	}
+	out.RawByte('}')
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests6(in *jlexer.Lexer, out *StructWithInterface) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "f1":
+					out.Field1 = 0
+				case "f2":
+					out.Field2 = nil
+				case "f3":
+					out.Field3 = ""
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "f1":
+			out.Field1 = int(in.Int())
+		case "f2":
+			if m, ok := out.Field2.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.Field2.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.Field2 = in.Interface()
+			}
+		case "f3":
+			out.Field3 = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests6(out *jwriter.Writer, in StructWithInterface) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"f1\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Field1))
+	}
+	{
+		const prefix string = ",\"f2\":"
+		out.RawString(prefix)
+		if m, ok := in.Field2.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Field2.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.Field2))
+		}
+	}
+	{
+		const prefix string = ",\"f3\":"
+		out.RawString(prefix)
+		out.String(string(in.Field3))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v StructWithInterface) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v StructWithInterface) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *StructWithInterface) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *StructWithInterface) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests6(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests7(in *jlexer.Lexer, out *StdMarshaler) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "T":
+					out.T = time.Time{}
+				case "IP":
+					out.IP = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "T":
+			if data := in.Raw(); in.Ok() {
+				in.AddValueError((out.T).UnmarshalJSON(data))
+			}
+		case "IP":
+			in.IP(&out.IP)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests7(out *jwriter.Writer, in StdMarshaler) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"T\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.T).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"IP\":"
+		out.RawString(prefix)
+		out.IP(in.IP)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v StdMarshaler) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v StdMarshaler) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *StdMarshaler) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *StdMarshaler) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests7(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests8(in *jlexer.Lexer, out *Slices) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "ByteSlice":
+					out.ByteSlice = nil
+				case "EmptyByteSlice":
+					out.EmptyByteSlice = nil
+				case "NilByteSlice":
+					out.NilByteSlice = nil
+				case "IntSlice":
+					out.IntSlice = nil
+				case "EmptyIntSlice":
+					out.EmptyIntSlice = nil
+				case "NilIntSlice":
+					out.NilIntSlice = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ByteSlice":
+			if in.IsNull() {
+				in.Skip()
+				out.ByteSlice = nil
+			} else {
+				out.ByteSlice = in.Bytes()
+			}
+		case "EmptyByteSlice":
+			if in.IsNull() {
+				in.Skip()
+				out.EmptyByteSlice = nil
+			} else {
+				out.EmptyByteSlice = in.Bytes()
+			}
+		case "NilByteSlice":
+			if in.IsNull() {
+				in.Skip()
+				out.NilByteSlice = nil
+			} else {
+				out.NilByteSlice = in.Bytes()
+			}
+		case "IntSlice":
+			if in.IsNull() {
+				in.Skip()
+				out.IntSlice = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.IntSlice == nil {
+					if !in.IsDelim(']') {
+						out.IntSlice = make([]int, 0, 8)
+					} else {
+						out.IntSlice = []int{}
+					}
+				} else {
+					out.IntSlice = (out.IntSlice)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v25 int
+					v25 = int(in.Int())
+					out.IntSlice = append(out.IntSlice, v25)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "EmptyIntSlice":
+			if in.IsNull() {
+				in.Skip()
+				out.EmptyIntSlice = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.EmptyIntSlice == nil {
+					if !in.IsDelim(']') {
+						out.EmptyIntSlice = make([]int, 0, 8)
+					} else {
+						out.EmptyIntSlice = []int{}
+					}
+				} else {
+					out.EmptyIntSlice = (out.EmptyIntSlice)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v26 int
+					v26 = int(in.Int())
+					out.EmptyIntSlice = append(out.EmptyIntSlice, v26)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "NilIntSlice":
+			if in.IsNull() {
+				in.Skip()
+				out.NilIntSlice = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.NilIntSlice == nil {
+					if !in.IsDelim(']') {
+						out.NilIntSlice = make([]int, 0, 8)
+					} else {
+						out.NilIntSlice = []int{}
+					}
+				} else {
+					out.NilIntSlice = (out.NilIntSlice)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v27 int
+					v27 = int(in.Int())
+					out.NilIntSlice = append(out.NilIntSlice, v27)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests8(out *jwriter.Writer, in Slices) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ByteSlice\":"
+		out.RawString(prefix[1:])
+		out.Base64Bytes(in.ByteSlice)
+	}
+	{
+		const prefix string = ",\"EmptyByteSlice\":"
+		out.RawString(prefix)
+		out.Base64Bytes(in.EmptyByteSlice)
+	}
+	{
+		const prefix string = ",\"NilByteSlice\":"
+		out.RawString(prefix)
+		out.Base64Bytes(in.NilByteSlice)
+	}
+	{
+		const prefix string = ",\"IntSlice\":"
+		out.RawString(prefix)
+		if in.IntSlice == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v34, v35 := range in.IntSlice {
+				if v34 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v35))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"EmptyIntSlice\":"
+		out.RawString(prefix)
+		if in.EmptyIntSlice == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v36, v37 := range in.EmptyIntSlice {
+				if v36 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v37))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"NilIntSlice\":"
+		out.RawString(prefix)
+		if in.NilIntSlice == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v38, v39 := range in.NilIntSlice {
+				if v38 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v39))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Slices) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Slices) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Slices) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Slices) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests8(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests9(in *jlexer.Lexer, out *RequiredOptionalStruct) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	var FirstNameSet bool
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "first_name":
+					out.FirstName = ""
+				case "last_name":
+					out.Lastname = ""
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "first_name":
+			out.FirstName = string(in.String())
+			FirstNameSet = true
+		case "last_name":
+			out.Lastname = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+	if !FirstNameSet {
+		in.AddError(fmt.Errorf("key 'first_name' is required"))
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests9(out *jwriter.Writer, in RequiredOptionalStruct) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"first_name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.FirstName))
+	}
+	{
+		const prefix string = ",\"last_name\":"
+		out.RawString(prefix)
+		out.String(string(in.Lastname))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RequiredOptionalStruct) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RequiredOptionalStruct) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RequiredOptionalStruct) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RequiredOptionalStruct) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests9(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests10(in *jlexer.Lexer, out *RequiredOptionalMap) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	var ReqMapSet bool
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "req_map":
+					out.ReqMap = nil
+				case "oe_map":
+					out.OmitEmptyMap = nil
+				case "noe_map":
+					out.NoOmitEmptyMap = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "req_map":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.ReqMap == nil {
+					out.ReqMap = make(map[int]string)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := int(in.IntStr())
+					in.WantColon()
+					v41 := in.ValueStart()
+					var v40 string
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.ReqMap, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v40 = (out.ReqMap)[key]
+					}
+					v40 = string(in.String())
+					if !in.ValueSkipped(v41) {
+						(out.ReqMap)[key] = v40
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+			ReqMapSet = true
+		case "oe_map":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.OmitEmptyMap == nil {
+					if !in.IsDelim('}') {
+						out.OmitEmptyMap = make(map[int]string)
+					} else {
+						out.OmitEmptyMap = nil
+					}
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := int(in.IntStr())
+					in.WantColon()
+					v43 := in.ValueStart()
+					var v42 string
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.OmitEmptyMap, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v42 = (out.OmitEmptyMap)[key]
+					}
+					v42 = string(in.String())
+					if !in.ValueSkipped(v43) {
+						(out.OmitEmptyMap)[key] = v42
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "noe_map":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.NoOmitEmptyMap == nil {
+					out.NoOmitEmptyMap = make(map[int]string)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := int(in.IntStr())
+					in.WantColon()
+					v45 := in.ValueStart()
+					var v44 string
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.NoOmitEmptyMap, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v44 = (out.NoOmitEmptyMap)[key]
+					}
+					v44 = string(in.String())
+					if !in.ValueSkipped(v45) {
+						(out.NoOmitEmptyMap)[key] = v44
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+	if !ReqMapSet {
+		in.AddError(fmt.Errorf("key 'req_map' is required"))
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests10(out *jwriter.Writer, in RequiredOptionalMap) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"req_map\":"
+		out.RawString(prefix[1:])
+		if in.ReqMap == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v46First := true
+			for v46Name, v46Value := range in.ReqMap {
+				if v46First {
+					v46First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.IntStr(int(v46Name))
+				out.RawByte(':')
+				out.String(string(v46Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	if len(in.OmitEmptyMap) != 0 {
+		const prefix string = ",\"oe_map\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v47First := true
+			for v47Name, v47Value := range in.OmitEmptyMap {
+				if v47First {
+					v47First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.IntStr(int(v47Name))
+				out.RawByte(':')
+				out.String(string(v47Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"noe_map\":"
+		out.RawString(prefix)
+		if in.NoOmitEmptyMap == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v48First := true
+			for v48Name, v48Value := range in.NoOmitEmptyMap {
+				if v48First {
+					v48First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.IntStr(int(v48Name))
+				out.RawByte(':')
+				out.String(string(v48Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RequiredOptionalMap) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RequiredOptionalMap) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RequiredOptionalMap) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RequiredOptionalMap) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests10(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests11(in *jlexer.Lexer, out *RequiredDirectiveStruct) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	var IDSet bool
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "id":
+					out.ID = 0
+				case "name":
+					out.Name = ""
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+			IDSet = true
+		case "name":
+			out.Name = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+	if !IDSet {
+		in.AddError(fmt.Errorf("key 'id' is required"))
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests11(out *jwriter.Writer, in RequiredDirectiveStruct) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RequiredDirectiveStruct) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests11(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RequiredDirectiveStruct) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests11(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RequiredDirectiveStruct) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests11(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RequiredDirectiveStruct) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests11(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests12(in *jlexer.Lexer, out *Raw) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "Field":
+					out.Field = nil
+				case "Field2":
+					out.Field2 = ""
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Field":
+			(out.Field).UnmarshalEasyJSON(in)
+		case "Field2":
+			out.Field2 = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests12(out *jwriter.Writer, in Raw) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Field\":"
+		out.RawString(prefix[1:])
+		(in.Field).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Field2\":"
+		out.RawString(prefix)
+		out.String(string(in.Field2))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Raw) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests12(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Raw) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests12(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Raw) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests12(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Raw) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests12(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests13(in *jlexer.Lexer, out *PrimitiveTypes) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "String":
+					out.String = ""
+				case "Bool":
+					out.Bool = false
+				case "Int":
+					out.Int = 0
+				case "Int8":
+					out.Int8 = 0
+				case "Int16":
+					out.Int16 = 0
+				case "Int32":
+					out.Int32 = 0
+				case "Int64":
+					out.Int64 = 0
+				case "Uint":
+					out.Uint = 0
+				case "Uint8":
+					out.Uint8 = 0
+				case "Uint16":
+					out.Uint16 = 0
+				case "Uint32":
+					out.Uint32 = 0
+				case "Uint64":
+					out.Uint64 = 0
+				case "IntString":
+					out.IntString = 0
+				case "Int8String":
+					out.Int8String = 0
+				case "Int16String":
+					out.Int16String = 0
+				case "Int32String":
+					out.Int32String = 0
+				case "Int64String":
+					out.Int64String = 0
+				case "UintString":
+					out.UintString = 0
+				case "Uint8String":
+					out.Uint8String = 0
+				case "Uint16String":
+					out.Uint16String = 0
+				case "Uint32String":
+					out.Uint32String = 0
+				case "Uint64String":
+					out.Uint64String = 0
+				case "Float32":
+					out.Float32 = 0
+				case "Float64":
+					out.Float64 = 0
+				case "Float32String":
+					out.Float32String = 0
+				case "Float64String":
+					out.Float64String = 0
+				case "BoolString":
+					out.BoolString = false
+				case "PtrIntString":
+					out.PtrIntString = nil
+				case "PtrBoolString":
+					out.PtrBoolString = nil
+				case "Ptr":
+					out.Ptr = nil
+				case "PtrNil":
+					out.PtrNil = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "String":
+			out.String = string(in.String())
+		case "Bool":
+			out.Bool = bool(in.Bool())
+		case "Int":
+			out.Int = int(in.Int())
+		case "Int8":
+			out.Int8 = int8(in.Int8())
+		case "Int16":
+			out.Int16 = int16(in.Int16())
+		case "Int32":
+			out.Int32 = int32(in.Int32())
+		case "Int64":
+			out.Int64 = int64(in.Int64())
+		case "Uint":
+			out.Uint = uint(in.Uint())
+		case "Uint8":
+			out.Uint8 = uint8(in.Uint8())
+		case "Uint16":
+			out.Uint16 = uint16(in.Uint16())
+		case "Uint32":
+			out.Uint32 = uint32(in.Uint32())
+		case "Uint64":
+			out.Uint64 = uint64(in.Uint64())
+		case "IntString":
+			out.IntString = int(in.IntStr())
+		case "Int8String":
+			out.Int8String = int8(in.Int8Str())
+		case "Int16String":
+			out.Int16String = int16(in.Int16Str())
+		case "Int32String":
+			out.Int32String = int32(in.Int32Str())
+		case "Int64String":
+			out.Int64String = int64(in.Int64Str())
+		case "UintString":
+			out.UintString = uint(in.UintStr())
+		case "Uint8String":
+			out.Uint8String = uint8(in.Uint8Str())
+		case "Uint16String":
+			out.Uint16String = uint16(in.Uint16Str())
+		case "Uint32String":
+			out.Uint32String = uint32(in.Uint32Str())
+		case "Uint64String":
+			out.Uint64String = uint64(in.Uint64Str())
+		case "Float32":
+			out.Float32 = float32(in.Float32())
+		case "Float64":
+			out.Float64 = float64(in.Float64())
+		case "Float32String":
+			out.Float32String = float32(in.Float32Str())
+		case "Float64String":
+			out.Float64String = float64(in.Float64Str())
+		case "BoolString":
+			out.BoolString = bool(in.BoolStr())
+		case "PtrIntString":
+			if in.IsNull() {
+				in.Skip()
+				out.PtrIntString = nil
+			} else {
+				if out.PtrIntString == nil {
+					out.PtrIntString = new(int)
+				}
+				*out.PtrIntString = int(in.IntStr())
+			}
+		case "PtrBoolString":
+			if in.IsNull() {
+				in.Skip()
+				out.PtrBoolString = nil
+			} else {
+				if out.PtrBoolString == nil {
+					out.PtrBoolString = new(bool)
+				}
+				*out.PtrBoolString = bool(in.BoolStr())
+			}
+		case "Ptr":
+			if in.IsNull() {
+				in.Skip()
+				out.Ptr = nil
+			} else {
+				if out.Ptr == nil {
+					out.Ptr = new(string)
+				}
+				*out.Ptr = string(in.String())
+			}
+		case "PtrNil":
+			if in.IsNull() {
+				in.Skip()
+				out.PtrNil = nil
+			} else {
+				if out.PtrNil == nil {
+					out.PtrNil = new(string)
+				}
+				*out.PtrNil = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests13(out *jwriter.Writer, in PrimitiveTypes) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"String\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.String))
+	}
+	{
+		const prefix string = ",\"Bool\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Bool))
+	}
+	{
+		const prefix string = ",\"Int\":"
+		out.RawString(prefix)
+		out.Int(int(in.Int))
+	}
+	{
+		const prefix string = ",\"Int8\":"
+		out.RawString(prefix)
+		out.Int8(int8(in.Int8))
+	}
+	{
+		const prefix string = ",\"Int16\":"
+		out.RawString(prefix)
+		out.Int16(int16(in.Int16))
+	}
+	{
+		const prefix string = ",\"Int32\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Int32))
+	}
+	{
+		const prefix string = ",\"Int64\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Int64))
+	}
+	{
+		const prefix string = ",\"Uint\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.Uint))
+	}
+	{
+		const prefix string = ",\"Uint8\":"
+		out.RawString(prefix)
+		out.Uint8(uint8(in.Uint8))
+	}
+	{
+		const prefix string = ",\"Uint16\":"
+		out.RawString(prefix)
+		out.Uint16(uint16(in.Uint16))
+	}
+	{
+		const prefix string = ",\"Uint32\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.Uint32))
+	}
+	{
+		const prefix string = ",\"Uint64\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Uint64))
+	}
+	{
+		const prefix string = ",\"IntString\":"
+		out.RawString(prefix)
+		out.IntStr(int(in.IntString))
+	}
+	{
+		const prefix string = ",\"Int8String\":"
+		out.RawString(prefix)
+		out.Int8Str(int8(in.Int8String))
+	}
+	{
+		const prefix string = ",\"Int16String\":"
+		out.RawString(prefix)
+		out.Int16Str(int16(in.Int16String))
+	}
+	{
+		const prefix string = ",\"Int32String\":"
+		out.RawString(prefix)
+		out.Int32Str(int32(in.Int32String))
+	}
+	{
+		const prefix string = ",\"Int64String\":"
+		out.RawString(prefix)
+		out.Int64Str(int64(in.Int64String))
+	}
+	{
+		const prefix string = ",\"UintString\":"
+		out.RawString(prefix)
+		out.UintStr(uint(in.UintString))
+	}
+	{
+		const prefix string = ",\"Uint8String\":"
+		out.RawString(prefix)
+		out.Uint8Str(uint8(in.Uint8String))
+	}
+	{
+		const prefix string = ",\"Uint16String\":"
+		out.RawString(prefix)
+		out.Uint16Str(uint16(in.Uint16String))
+	}
+	{
+		const prefix string = ",\"Uint32String\":"
+		out.RawString(prefix)
+		out.Uint32Str(uint32(in.Uint32String))
+	}
+	{
+		const prefix string = ",\"Uint64String\":"
+		out.RawString(prefix)
+		out.Uint64Str(uint64(in.Uint64String))
+	}
+	{
+		const prefix string = ",\"Float32\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.Float32))
+	}
+	{
+		const prefix string = ",\"Float64\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Float64))
+	}
+	{
+		const prefix string = ",\"Float32String\":"
+		out.RawString(prefix)
+		out.Float32Str(float32(in.Float32String))
+	}
+	{
+		const prefix string = ",\"Float64String\":"
+		out.RawString(prefix)
+		out.Float64Str(float64(in.Float64String))
+	}
+	{
+		const prefix string = ",\"BoolString\":"
+		out.RawString(prefix)
+		out.BoolStr(bool(in.BoolString))
+	}
+	{
+		const prefix string = ",\"PtrIntString\":"
+		out.RawString(prefix)
+		if in.PtrIntString == nil {
+			out.RawString("null")
+		} else {
+			out.IntStr(int(*in.PtrIntString))
+		}
+	}
+	{
+		const prefix string = ",\"PtrBoolString\":"
+		out.RawString(prefix)
+		if in.PtrBoolString == nil {
+			out.RawString("null")
+		} else {
+			out.BoolStr(bool(*in.PtrBoolString))
+		}
+	}
+	{
+		const prefix string = ",\"Ptr\":"
+		out.RawString(prefix)
+		if in.Ptr == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Ptr))
+		}
+	}
+	{
+		const prefix string = ",\"PtrNil\":"
+		out.RawString(prefix)
+		if in.PtrNil == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.PtrNil))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PrimitiveTypes) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PrimitiveTypes) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PrimitiveTypes) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PrimitiveTypes) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests13(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests14(in *jlexer.Lexer, out *OptsOmitEmpty) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "Undefined":
+					out.Undefined = opt.Int{}
+				case "Zero":
+					out.Zero = opt.Int{}
+				case "PtrNil":
+					out.PtrNil = nil
+				case "PtrUndefined":
+					out.PtrUndefined = nil
+				case "PtrZero":
+					out.PtrZero = nil
+				case "Null":
+					out.Null = opt.Int{}
+				case "PtrNull":
+					out.PtrNull = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Undefined":
+			(out.Undefined).UnmarshalEasyJSON(in)
+		case "Zero":
+			(out.Zero).UnmarshalEasyJSON(in)
+		case "PtrNil":
+			if in.IsNull() {
+				in.Skip()
+				out.PtrNil = nil
+			} else {
+				if out.PtrNil == nil {
+					out.PtrNil = new(opt.Int)
+				}
+				(*out.PtrNil).UnmarshalEasyJSON(in)
+			}
+		case "PtrUndefined":
+			if in.IsNull() {
+				in.Skip()
+				out.PtrUndefined = nil
+			} else {
+				if out.PtrUndefined == nil {
+					out.PtrUndefined = new(opt.Int)
+				}
+				(*out.PtrUndefined).UnmarshalEasyJSON(in)
+			}
+		case "PtrZero":
+			if in.IsNull() {
+				in.Skip()
+				out.PtrZero = nil
+			} else {
+				if out.PtrZero == nil {
+					out.PtrZero = new(opt.Int)
+				}
+				(*out.PtrZero).UnmarshalEasyJSON(in)
+			}
+		case "Null":
+			(out.Null).UnmarshalEasyJSON(in)
+		case "PtrNull":
+			if in.IsNull() {
+				in.Skip()
+				out.PtrNull = nil
+			} else {
+				if out.PtrNull == nil {
+					out.PtrNull = new(opt.Int)
+				}
+				(*out.PtrNull).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests14(out *jwriter.Writer, in OptsOmitEmpty) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if (in.Undefined).IsDefined() {
+		const prefix string = ",\"Undefined\":"
+		first = false
+		out.RawString(prefix[1:])
+		(in.Undefined).MarshalEasyJSON(out)
+	}
+	if (in.Zero).IsDefined() {
+		const prefix string = ",\"Zero\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(in.Zero).MarshalEasyJSON(out)
+	}
+	if in.PtrNil != nil && (in.PtrNil).IsDefined() {
+		const prefix string = ",\"PtrNil\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.PtrNil).MarshalEasyJSON(out)
+	}
+	if in.PtrUndefined != nil && (in.PtrUndefined).IsDefined() {
+		const prefix string = ",\"PtrUndefined\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.PtrUndefined).MarshalEasyJSON(out)
+	}
+	if in.PtrZero != nil && (in.PtrZero).IsDefined() {
+		const prefix string = ",\"PtrZero\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.PtrZero).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Null\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(in.Null).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"PtrNull\":"
+		out.RawString(prefix)
+		if in.PtrNull == nil {
+			out.RawString("null")
+		} else {
+			(*in.PtrNull).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OptsOmitEmpty) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests14(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OptsOmitEmpty) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests14(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OptsOmitEmpty) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests14(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OptsOmitEmpty) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests14(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests15(in *jlexer.Lexer, out *Opts) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "StrNull":
+					out.StrNull = opt.String{}
+				case "StrEmpty":
+					out.StrEmpty = opt.String{}
+				case "Str":
+					out.Str = opt.String{}
+				case "StrOmitempty":
+					out.StrOmitempty = opt.String{}
+				case "IntNull":
+					out.IntNull = opt.Int{}
+				case "IntZero":
+					out.IntZero = opt.Int{}
+				case "Int":
+					out.Int = opt.Int{}
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "StrNull":
+			(out.StrNull).UnmarshalEasyJSON(in)
+		case "StrEmpty":
+			(out.StrEmpty).UnmarshalEasyJSON(in)
+		case "Str":
+			(out.Str).UnmarshalEasyJSON(in)
+		case "StrOmitempty":
+			(out.StrOmitempty).UnmarshalEasyJSON(in)
+		case "IntNull":
+			(out.IntNull).UnmarshalEasyJSON(in)
+		case "IntZero":
+			(out.IntZero).UnmarshalEasyJSON(in)
+		case "Int":
+			(out.Int).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests15(out *jwriter.Writer, in Opts) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"StrNull\":"
+		out.RawString(prefix[1:])
+		(in.StrNull).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"StrEmpty\":"
+		out.RawString(prefix)
+		(in.StrEmpty).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Str\":"
+		out.RawString(prefix)
+		(in.Str).MarshalEasyJSON(out)
+	}
+	if (in.StrOmitempty).IsDefined() {
+		const prefix string = ",\"StrOmitempty\":"
+		out.RawString(prefix)
+		(in.StrOmitempty).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"IntNull\":"
+		out.RawString(prefix)
+		(in.IntNull).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"IntZero\":"
+		out.RawString(prefix)
+		(in.IntZero).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Int\":"
+		out.RawString(prefix)
+		(in.Int).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Opts) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests15(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Opts) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests15(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Opts) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests15(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Opts) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests15(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests16(in *jlexer.Lexer, out *OmitEmpty) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "StrE":
+					out.StrE = ""
+				case "StrNE":
+					out.StrNE = ""
+				case "PtrE":
+					out.PtrE = nil
+				case "PtrNE":
+					out.PtrNE = nil
+				case "intField":
+					out.IntNE = 0
+				case "IntE":
+					out.IntE = 0
+				case "SubE":
+					out.SubE = SubStruct{}
+				case "SubNE":
+					out.SubNE = SubStruct{}
+				case "SubPE":
+					out.SubPE = nil
+				case "SubPNE":
+					out.SubPNE = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "StrE":
+			out.StrE = string(in.String())
+		case "StrNE":
+			out.StrNE = string(in.String())
+		case "PtrE":
+			if in.IsNull() {
+				in.Skip()
+				out.PtrE = nil
+			} else {
+				if out.PtrE == nil {
+					out.PtrE = new(string)
+				}
+				*out.PtrE = string(in.String())
+			}
+		case "PtrNE":
+			if in.IsNull() {
+				in.Skip()
+				out.PtrNE = nil
+			} else {
+				if out.PtrNE == nil {
+					out.PtrNE = new(string)
+				}
+				*out.PtrNE = string(in.String())
+			}
+		case "intField":
+			out.IntNE = int(in.Int())
+		case "IntE":
+			out.IntE = int(in.Int())
+		case "SubE":
+			easyjson794297d0DecodeGithubComMailruEasyjsonTests2(in, &out.SubE)
+		case "SubNE":
+			easyjson794297d0DecodeGithubComMailruEasyjsonTests2(in, &out.SubNE)
+		case "SubPE":
+			if in.IsNull() {
+				in.Skip()
+				out.SubPE = nil
+			} else {
+				if out.SubPE == nil {
+					out.SubPE = new(SubStruct)
+				}
+				easyjson794297d0DecodeGithubComMailruEasyjsonTests2(in, out.SubPE)
+			}
+		case "SubPNE":
+			if in.IsNull() {
+				in.Skip()
+				out.SubPNE = nil
+			} else {
+				if out.SubPNE == nil {
+					out.SubPNE = new(SubStruct)
+				}
+				easyjson794297d0DecodeGithubComMailruEasyjsonTests2(in, out.SubPNE)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests16(out *jwriter.Writer, in OmitEmpty) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.StrE != "" {
+		const prefix string = ",\"StrE\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.StrE))
+	}
+	if in.StrNE != "" {
+		const prefix string = ",\"StrNE\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.StrNE))
+	}
+	if in.PtrE != nil {
+		const prefix string = ",\"PtrE\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(*in.PtrE))
+	}
+	if in.PtrNE != nil {
+		const prefix string = ",\"PtrNE\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(*in.PtrNE))
+	}
+	if in.IntNE != 0 {
+		const prefix string = ",\"intField\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.IntNE))
+	}
+	if in.IntE != 0 {
+		const prefix string = ",\"IntE\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.IntE))
+	}
+	if true {
+		const prefix string = ",\"SubE\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		easyjson794297d0EncodeGithubComMailruEasyjsonTests2(out, in.SubE)
+	}
+	if true {
+		const prefix string = ",\"SubNE\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		easyjson794297d0EncodeGithubComMailruEasyjsonTests2(out, in.SubNE)
+	}
+	if in.SubPE != nil {
+		const prefix string = ",\"SubPE\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		easyjson794297d0EncodeGithubComMailruEasyjsonTests2(out, *in.SubPE)
+	}
+	if in.SubPNE != nil {
+		const prefix string = ",\"SubPNE\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		easyjson794297d0EncodeGithubComMailruEasyjsonTests2(out, *in.SubPNE)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OmitEmpty) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests16(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OmitEmpty) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests16(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OmitEmpty) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests16(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OmitEmpty) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests16(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests17(in *jlexer.Lexer, out *NamedPrimitiveTypes) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "String":
+					out.String = ""
+				case "Bool":
+					out.Bool = false
+				case "Int":
+					out.Int = 0
+				case "Int8":
+					out.Int8 = 0
+				case "Int16":
+					out.Int16 = 0
+				case "Int32":
+					out.Int32 = 0
+				case "Int64":
+					out.Int64 = 0
+				case "Uint":
+					out.Uint = 0
+				case "Uint8":
+					out.Uint8 = 0
+				case "Uint16":
+					out.Uint16 = 0
+				case "Uint32":
+					out.Uint32 = 0
+				case "Uint64":
+					out.Uint64 = 0
+				case "Float32":
+					out.Float32 = 0
+				case "Float64":
+					out.Float64 = 0
+				case "Ptr":
+					out.Ptr = nil
+				case "PtrNil":
+					out.PtrNil = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "String":
+			out.String = NamedString(in.String())
+		case "Bool":
+			out.Bool = NamedBool(in.Bool())
+		case "Int":
+			out.Int = NamedInt(in.Int())
+		case "Int8":
+			out.Int8 = NamedInt8(in.Int8())
+		case "Int16":
+			out.Int16 = NamedInt16(in.Int16())
+		case "Int32":
+			out.Int32 = NamedInt32(in.Int32())
+		case "Int64":
+			out.Int64 = NamedInt64(in.Int64())
+		case "Uint":
+			out.Uint = NamedUint(in.Uint())
+		case "Uint8":
+			out.Uint8 = NamedUint8(in.Uint8())
+		case "Uint16":
+			out.Uint16 = NamedUint16(in.Uint16())
+		case "Uint32":
+			out.Uint32 = NamedUint32(in.Uint32())
+		case "Uint64":
+			out.Uint64 = NamedUint64(in.Uint64())
+		case "Float32":
+			out.Float32 = NamedFloat32(in.Float32())
+		case "Float64":
+			out.Float64 = NamedFloat64(in.Float64())
+		case "Ptr":
+			if in.IsNull() {
+				in.Skip()
+				out.Ptr = nil
+			} else {
+				if out.Ptr == nil {
+					out.Ptr = new(string)
+				}
+				*out.Ptr = string(in.String())
+			}
+		case "PtrNil":
+			if in.IsNull() {
+				in.Skip()
+				out.PtrNil = nil
+			} else {
+				if out.PtrNil == nil {
+					out.PtrNil = new(string)
+				}
+				*out.PtrNil = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests17(out *jwriter.Writer, in NamedPrimitiveTypes) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"String\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.String))
+	}
+	{
+		const prefix string = ",\"Bool\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Bool))
+	}
+	{
+		const prefix string = ",\"Int\":"
+		out.RawString(prefix)
+		out.Int(int(in.Int))
+	}
+	{
+		const prefix string = ",\"Int8\":"
+		out.RawString(prefix)
+		out.Int8(int8(in.Int8))
+	}
+	{
+		const prefix string = ",\"Int16\":"
+		out.RawString(prefix)
+		out.Int16(int16(in.Int16))
+	}
+	{
+		const prefix string = ",\"Int32\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Int32))
+	}
+	{
+		const prefix string = ",\"Int64\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Int64))
+	}
+	{
+		const prefix string = ",\"Uint\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.Uint))
+	}
+	{
+		const prefix string = ",\"Uint8\":"
+		out.RawString(prefix)
+		out.Uint8(uint8(in.Uint8))
+	}
+	{
+		const prefix string = ",\"Uint16\":"
+		out.RawString(prefix)
+		out.Uint16(uint16(in.Uint16))
+	}
+	{
+		const prefix string = ",\"Uint32\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.Uint32))
+	}
+	{
+		const prefix string = ",\"Uint64\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Uint64))
+	}
+	{
+		const prefix string = ",\"Float32\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.Float32))
+	}
+	{
+		const prefix string = ",\"Float64\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Float64))
+	}
+	{
+		const prefix string = ",\"Ptr\":"
+		out.RawString(prefix)
+		if in.Ptr == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Ptr))
+		}
+	}
+	{
+		const prefix string = ",\"PtrNil\":"
+		out.RawString(prefix)
+		if in.PtrNil == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.PtrNil))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NamedPrimitiveTypes) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests17(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NamedPrimitiveTypes) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests17(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NamedPrimitiveTypes) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests17(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NamedPrimitiveTypes) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests17(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests18(in *jlexer.Lexer, out *MyUInt8Slice) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else if !in.IsDelim('[') {
+		in.Delim('[')
+		in.Delim(']')
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(MyUInt8Slice, 0, 64)
+			} else {
+				*out = MyUInt8Slice{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v49 MyUInt8
+			v49 = MyUInt8(in.Uint8())
+			*out = append(*out, v49)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests18(out *jwriter.Writer, in MyUInt8Slice) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v50, v51 := range in {
+			if v50 > 0 {
+				out.RawByte(',')
+			}
+			out.Uint8(uint8(v51))
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MyUInt8Slice) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests18(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MyUInt8Slice) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests18(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MyUInt8Slice) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests18(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MyUInt8Slice) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests18(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests19(in *jlexer.Lexer, out *MyUInt8Array) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+	} else if !in.IsDelim('[') {
+		in.Delim('[')
+		in.Delim(']')
+	} else {
+		in.Delim('[')
+		v52 := 0
+		for !in.IsDelim(']') {
+			if v52 < 2 {
+				(*out)[v52] = MyUInt8(in.Uint8())
+				v52++
+			} else {
+				in.SkipRecursive()
+			}
+			in.WantComma()
+		}
+		for ; v52 < 2; v52++ {
+			(*out)[v52] = 0
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests19(out *jwriter.Writer, in MyUInt8Array) {
+	out.RawByte('[')
+	for v53 := range in {
+		if v53 > 0 {
+			out.RawByte(',')
+		}
+		out.Uint8(uint8((in)[v53]))
+	}
+	out.RawByte(']')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MyUInt8Array) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests19(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MyUInt8Array) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests19(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MyUInt8Array) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests19(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MyUInt8Array) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests19(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests20(in *jlexer.Lexer, out *Maps) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "Map":
+					out.Map = nil
+				case "InterfaceMap":
+					out.InterfaceMap = nil
+				case "NilMap":
+					out.NilMap = nil
+				case "CustomMap":
+					out.CustomMap = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Map":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.Map == nil {
+					out.Map = make(map[string]string)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := string(in.String())
+					in.WantColon()
+					v55 := in.ValueStart()
+					var v54 string
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.Map, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v54 = (out.Map)[key]
+					}
+					v54 = string(in.String())
+					if !in.ValueSkipped(v55) {
+						(out.Map)[key] = v54
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "InterfaceMap":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.InterfaceMap == nil {
+					out.InterfaceMap = make(map[string]interface{})
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := string(in.String())
+					in.WantColon()
+					v57 := in.ValueStart()
+					var v56 interface{}
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.InterfaceMap, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v56 = (out.InterfaceMap)[key]
+					}
+					if m, ok := v56.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v56.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v56 = in.Interface()
+					}
+					if !in.ValueSkipped(v57) {
+						(out.InterfaceMap)[key] = v56
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "NilMap":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.NilMap == nil {
+					out.NilMap = make(map[string]string)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := string(in.String())
+					in.WantColon()
+					v59 := in.ValueStart()
+					var v58 string
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.NilMap, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v58 = (out.NilMap)[key]
+					}
+					v58 = string(in.String())
+					if !in.ValueSkipped(v59) {
+						(out.NilMap)[key] = v58
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "CustomMap":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.CustomMap == nil {
+					out.CustomMap = make(map[Str]Str)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := Str(in.String())
+					in.WantColon()
+					v61 := in.ValueStart()
+					var v60 Str
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.CustomMap, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v60 = (out.CustomMap)[key]
+					}
+					v60 = Str(in.String())
+					if !in.ValueSkipped(v61) {
+						(out.CustomMap)[key] = v60
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests20(out *jwriter.Writer, in Maps) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Map\":"
+		out.RawString(prefix[1:])
+		if in.Map == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v62First := true
+			for v62Name, v62Value := range in.Map {
+				if v62First {
+					v62First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v62Name))
+				out.RawByte(':')
+				out.String(string(v62Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"InterfaceMap\":"
+		out.RawString(prefix)
+		if in.InterfaceMap == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v63First := true
+			for v63Name, v63Value := range in.InterfaceMap {
+				if v63First {
+					v63First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v63Name))
+				out.RawByte(':')
+				if m, ok := v63Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v63Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v63Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"NilMap\":"
+		out.RawString(prefix)
+		if in.NilMap == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v64First := true
+			for v64Name, v64Value := range in.NilMap {
+				if v64First {
+					v64First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v64Name))
+				out.RawByte(':')
+				out.String(string(v64Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"CustomMap\":"
+		out.RawString(prefix)
+		if in.CustomMap == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v65First := true
+			for v65Name, v65Value := range in.CustomMap {
+				if v65First {
+					v65First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v65Name))
+				out.RawByte(':')
+				out.String(string(v65Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Maps) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests20(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Maps) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests20(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Maps) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests20(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Maps) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests20(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests21(in *jlexer.Lexer, out *MapUintptrString) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+	} else if !in.IsDelim('{') {
+		in.Delim('{')
+		in.Delim('}')
+	} else {
+		in.Delim('{')
+		if !in.MergePatch || *out == nil {
+			*out = make(MapUintptrString)
+		}
+		for !in.IsDelim('}') {
+			in.MarkKey()
+			key := uintptr(in.UintptrStr())
+			in.WantColon()
+			v67 := in.ValueStart()
+			var v66 string
+			if in.MergePatch {
+				if in.IsNull() {
+					in.Skip()
+					if !in.KeepOnNull {
+						delete(*out, key)
+					}
+					in.WantComma()
+					continue
+				}
+				v66 = (*out)[key]
+			}
+			v66 = string(in.String())
+			if !in.ValueSkipped(v67) {
+				(*out)[key] = v66
+			}
+			in.WantComma()
+		}
+		in.Delim('}')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests21(out *jwriter.Writer, in MapUintptrString) {
+	if in == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+		out.RawString(`null`)
+	} else {
+		out.RawByte('{')
+		v68First := true
+		for v68Name, v68Value := range in {
+			if v68First {
+				v68First = false
+			} else {
+				out.RawByte(',')
+			}
+			out.UintptrStr(uintptr(v68Name))
+			out.RawByte(':')
+			out.String(string(v68Value))
+		}
+		out.RawByte('}')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MapUintptrString) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests21(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MapUintptrString) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests21(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MapUintptrString) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests21(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MapUintptrString) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests21(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests22(in *jlexer.Lexer, out *MapUintString) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+	} else if !in.IsDelim('{') {
+		in.Delim('{')
+		in.Delim('}')
+	} else {
+		in.Delim('{')
+		if !in.MergePatch || *out == nil {
+			*out = make(MapUintString)
+		}
+		for !in.IsDelim('}') {
+			in.MarkKey()
+			key := uint(in.UintStr())
+			in.WantColon()
+			v70 := in.ValueStart()
+			var v69 string
+			if in.MergePatch {
+				if in.IsNull() {
+					in.Skip()
+					if !in.KeepOnNull {
+						delete(*out, key)
+					}
+					in.WantComma()
+					continue
+				}
+				v69 = (*out)[key]
+			}
+			v69 = string(in.String())
+			if !in.ValueSkipped(v70) {
+				(*out)[key] = v69
+			}
+			in.WantComma()
+		}
+		in.Delim('}')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests22(out *jwriter.Writer, in MapUintString) {
+	if in == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+		out.RawString(`null`)
+	} else {
+		out.RawByte('{')
+		v71First := true
+		for v71Name, v71Value := range in {
+			if v71First {
+				v71First = false
+			} else {
+				out.RawByte(',')
+			}
+			out.UintStr(uint(v71Name))
+			out.RawByte(':')
+			out.String(string(v71Value))
+		}
+		out.RawByte('}')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MapUintString) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests22(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MapUintString) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests22(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MapUintString) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests22(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MapUintString) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests22(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests23(in *jlexer.Lexer, out *MapUint64String) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+	} else if !in.IsDelim('{') {
+		in.Delim('{')
+		in.Delim('}')
+	} else {
+		in.Delim('{')
+		if !in.MergePatch || *out == nil {
+			*out = make(MapUint64String)
+		}
+		for !in.IsDelim('}') {
+			in.MarkKey()
+			key := uint64(in.Uint64Str())
+			in.WantColon()
+			v73 := in.ValueStart()
+			var v72 string
+			if in.MergePatch {
+				if in.IsNull() {
+					in.Skip()
+					if !in.KeepOnNull {
+						delete(*out, key)
+					}
+					in.WantComma()
+					continue
+				}
+				v72 = (*out)[key]
+			}
+			v72 = string(in.String())
+			if !in.ValueSkipped(v73) {
+				(*out)[key] = v72
+			}
+			in.WantComma()
+		}
+		in.Delim('}')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests23(out *jwriter.Writer, in MapUint64String) {
+	if in == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+		out.RawString(`null`)
+	} else {
+		out.RawByte('{')
+		v74First := true
+		for v74Name, v74Value := range in {
+			if v74First {
+				v74First = false
+			} else {
+				out.RawByte(',')
+			}
+			out.Uint64Str(uint64(v74Name))
+			out.RawByte(':')
+			out.String(string(v74Value))
+		}
+		out.RawByte('}')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MapUint64String) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests23(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MapUint64String) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests23(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MapUint64String) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests23(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MapUint64String) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests23(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests24(in *jlexer.Lexer, out *MapUint32String) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+	} else if !in.IsDelim('{') {
+		in.Delim('{')
+		in.Delim('}')
+	} else {
+		in.Delim('{')
+		if !in.MergePatch || *out == nil {
+			*out = make(MapUint32String)
+		}
+		for !in.IsDelim('}') {
+			in.MarkKey()
+			key := uint32(in.Uint32Str())
+			in.WantColon()
+			v76 := in.ValueStart()
+			var v75 string
+			if in.MergePatch {
+				if in.IsNull() {
+					in.Skip()
+					if !in.KeepOnNull {
+						delete(*out, key)
+					}
+					in.WantComma()
+					continue
+				}
+				v75 = (*out)[key]
+			}
+			v75 = string(in.String())
+			if !in.ValueSkipped(v76) {
+				(*out)[key] = v75
+			}
+			in.WantComma()
+		}
+		in.Delim('}')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests24(out *jwriter.Writer, in MapUint32String) {
+	if in == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+		out.RawString(`null`)
+	} else {
+		out.RawByte('{')
+		v77First := true
+		for v77Name, v77Value := range in {
+			if v77First {
+				v77First = false
+			} else {
+				out.RawByte(',')
+			}
+			out.Uint32Str(uint32(v77Name))
+			out.RawByte(':')
+			out.String(string(v77Value))
+		}
+		out.RawByte('}')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MapUint32String) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests24(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MapUint32String) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests24(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MapUint32String) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests24(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MapUint32String) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests24(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests25(in *jlexer.Lexer, out *MapStringString) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+	} else if !in.IsDelim('{') {
+		in.Delim('{')
+		in.Delim('}')
+	} else {
+		in.Delim('{')
+		if !in.MergePatch || *out == nil {
+			*out = make(MapStringString)
+		}
+		for !in.IsDelim('}') {
+			in.MarkKey()
+			key := string(in.String())
+			in.WantColon()
+			v79 := in.ValueStart()
+			var v78 string
+			if in.MergePatch {
+				if in.IsNull() {
+					in.Skip()
+					if !in.KeepOnNull {
+						delete(*out, key)
+					}
+					in.WantComma()
+					continue
+				}
+				v78 = (*out)[key]
+			}
+			v78 = string(in.String())
+			if !in.ValueSkipped(v79) {
+				(*out)[key] = v78
+			}
+			in.WantComma()
+		}
+		in.Delim('}')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests25(out *jwriter.Writer, in MapStringString) {
+	if in == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+		out.RawString(`null`)
+	} else {
+		out.RawByte('{')
+		v80First := true
+		for v80Name, v80Value := range in {
+			if v80First {
+				v80First = false
+			} else {
+				out.RawByte(',')
+			}
+			out.String(string(v80Name))
+			out.RawByte(':')
+			out.String(string(v80Value))
+		}
+		out.RawByte('}')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MapStringString) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests25(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MapStringString) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests25(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MapStringString) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests25(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MapStringString) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests25(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests26(in *jlexer.Lexer, out *MapMyIntString) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+	} else if !in.IsDelim('{') {
+		in.Delim('{')
+		in.Delim('}')
+	} else {
+		in.Delim('{')
+		if !in.MergePatch || *out == nil {
+			*out = make(MapMyIntString)
+		}
+		for !in.IsDelim('}') {
+			in.MarkKey()
+			key := MyInt(in.IntStr())
+			in.WantColon()
+			v82 := in.ValueStart()
+			var v81 string
+			if in.MergePatch {
+				if in.IsNull() {
+					in.Skip()
+					if !in.KeepOnNull {
+						delete(*out, key)
+					}
+					in.WantComma()
+					continue
+				}
+				v81 = (*out)[key]
+			}
+			v81 = string(in.String())
+			if !in.ValueSkipped(v82) {
+				(*out)[key] = v81
+			}
+			in.WantComma()
+		}
+		in.Delim('}')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests26(out *jwriter.Writer, in MapMyIntString) {
+	if in == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+		out.RawString(`null`)
+	} else {
+		out.RawByte('{')
+		v83First := true
+		for v83Name, v83Value := range in {
+			if v83First {
+				v83First = false
+			} else {
+				out.RawByte(',')
+			}
+			out.IntStr(int(v83Name))
+			out.RawByte(':')
+			out.String(string(v83Value))
+		}
+		out.RawByte('}')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MapMyIntString) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests26(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MapMyIntString) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests26(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MapMyIntString) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests26(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MapMyIntString) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests26(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests27(in *jlexer.Lexer, out *MapIntString) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+	} else if !in.IsDelim('{') {
+		in.Delim('{')
+		in.Delim('}')
+	} else {
+		in.Delim('{')
+		if !in.MergePatch || *out == nil {
+			*out = make(MapIntString)
+		}
+		for !in.IsDelim('}') {
+			in.MarkKey()
+			key := int(in.IntStr())
+			in.WantColon()
+			v85 := in.ValueStart()
+			var v84 string
+			if in.MergePatch {
+				if in.IsNull() {
+					in.Skip()
+					if !in.KeepOnNull {
+						delete(*out, key)
+					}
+					in.WantComma()
+					continue
+				}
+				v84 = (*out)[key]
+			}
+			v84 = string(in.String())
+			if !in.ValueSkipped(v85) {
+				(*out)[key] = v84
+			}
+			in.WantComma()
+		}
+		in.Delim('}')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests27(out *jwriter.Writer, in MapIntString) {
+	if in == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+		out.RawString(`null`)
+	} else {
+		out.RawByte('{')
+		v86First := true
+		for v86Name, v86Value := range in {
+			if v86First {
+				v86First = false
+			} else {
+				out.RawByte(',')
+			}
+			out.IntStr(int(v86Name))
+			out.RawByte(':')
+			out.String(string(v86Value))
+		}
+		out.RawByte('}')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MapIntString) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests27(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MapIntString) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests27(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MapIntString) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests27(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MapIntString) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests27(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests28(in *jlexer.Lexer, out *MapInt64String) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+	} else if !in.IsDelim('{') {
+		in.Delim('{')
+		in.Delim('}')
+	} else {
+		in.Delim('{')
+		if !in.MergePatch || *out == nil {
+			*out = make(MapInt64String)
+		}
+		for !in.IsDelim('}') {
+			in.MarkKey()
+			key := int64(in.Int64Str())
+			in.WantColon()
+			v88 := in.ValueStart()
+			var v87 string
+			if in.MergePatch {
+				if in.IsNull() {
+					in.Skip()
+					if !in.KeepOnNull {
+						delete(*out, key)
+					}
+					in.WantComma()
+					continue
+				}
+				v87 = (*out)[key]
+			}
+			v87 = string(in.String())
+			if !in.ValueSkipped(v88) {
+				(*out)[key] = v87
+			}
+			in.WantComma()
+		}
+		in.Delim('}')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests28(out *jwriter.Writer, in MapInt64String) {
+	if in == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+		out.RawString(`null`)
+	} else {
+		out.RawByte('{')
+		v89First := true
+		for v89Name, v89Value := range in {
+			if v89First {
+				v89First = false
+			} else {
+				out.RawByte(',')
+			}
+			out.Int64Str(int64(v89Name))
+			out.RawByte(':')
+			out.String(string(v89Value))
+		}
+		out.RawByte('}')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MapInt64String) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests28(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MapInt64String) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests28(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MapInt64String) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests28(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MapInt64String) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests28(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests29(in *jlexer.Lexer, out *MapInt32String) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+	} else if !in.IsDelim('{') {
+		in.Delim('{')
+		in.Delim('}')
+	} else {
+		in.Delim('{')
+		if !in.MergePatch || *out == nil {
+			*out = make(MapInt32String)
+		}
+		for !in.IsDelim('}') {
+			in.MarkKey()
+			key := int32(in.Int32Str())
+			in.WantColon()
+			v91 := in.ValueStart()
+			var v90 string
+			if in.MergePatch {
+				if in.IsNull() {
+					in.Skip()
+					if !in.KeepOnNull {
+						delete(*out, key)
+					}
+					in.WantComma()
+					continue
+				}
+				v90 = (*out)[key]
+			}
+			v90 = string(in.String())
+			if !in.ValueSkipped(v91) {
+				(*out)[key] = v90
+			}
+			in.WantComma()
+		}
+		in.Delim('}')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests29(out *jwriter.Writer, in MapInt32String) {
+	if in == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+		out.RawString(`null`)
+	} else {
+		out.RawByte('{')
+		v92First := true
+		for v92Name, v92Value := range in {
+			if v92First {
+				v92First = false
+			} else {
+				out.RawByte(',')
+			}
+			out.Int32Str(int32(v92Name))
+			out.RawByte(':')
+			out.String(string(v92Value))
+		}
+		out.RawByte('}')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MapInt32String) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests29(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MapInt32String) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests29(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MapInt32String) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests29(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MapInt32String) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests29(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests30(in *jlexer.Lexer, out *Ints) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else if !in.IsDelim('[') {
+		in.Delim('[')
+		in.Delim(']')
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(Ints, 0, 8)
+			} else {
+				*out = Ints{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v93 int
+			v93 = int(in.Int())
+			*out = append(*out, v93)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests30(out *jwriter.Writer, in Ints) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v94, v95 := range in {
+			if v94 > 0 {
+				out.RawByte(',')
+			}
+			out.Int(int(v95))
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Ints) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests30(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Ints) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests30(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Ints) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests30(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Ints) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests30(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests31(in *jlexer.Lexer, out *IntKeyedMapStruct) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "foo":
+					out.Foo = nil
+				case "bar":
+					out.Bar = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "foo":
+			easyjson794297d0DecodeGithubComMailruEasyjsonTests26(in, &out.Foo)
+		case "bar":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.Bar == nil {
+					out.Bar = make(map[int16]MapUint32String)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := int16(in.Int16Str())
+					in.WantColon()
+					v97 := in.ValueStart()
+					var v96 MapUint32String
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.Bar, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v96 = (out.Bar)[key]
+					}
+					easyjson794297d0DecodeGithubComMailruEasyjsonTests24(in, &v96)
+					if !in.ValueSkipped(v97) {
+						(out.Bar)[key] = v96
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests31(out *jwriter.Writer, in IntKeyedMapStruct) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"foo\":"
+		out.RawString(prefix[1:])
+		easyjson794297d0EncodeGithubComMailruEasyjsonTests26(out, in.Foo)
+	}
+	{
+		const prefix string = ",\"bar\":"
+		out.RawString(prefix)
+		if in.Bar == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v98First := true
+			for v98Name, v98Value := range in.Bar {
+				if v98First {
+					v98First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.Int16Str(int16(v98Name))
+				out.RawByte(':')
+				easyjson794297d0EncodeGithubComMailruEasyjsonTests24(out, v98Value)
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v IntKeyedMapStruct) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests31(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v IntKeyedMapStruct) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests31(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *IntKeyedMapStruct) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests31(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *IntKeyedMapStruct) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests31(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests32(in *jlexer.Lexer, out *IntArrayStruct) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "pointer":
+					out.Pointer = nil
+				case "value":
+					out.Value = IntArray{}
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "pointer":
+			if in.IsNull() {
+				in.Skip()
+				out.Pointer = nil
+			} else {
+				if out.Pointer == nil {
+					out.Pointer = new(IntArray)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else if !in.IsDelim('[') {
+					in.Delim('[')
+					in.Delim(']')
+				} else {
+					in.Delim('[')
+					v99 := 0
+					for !in.IsDelim(']') {
+						if v99 < 2 {
+							(*out.Pointer)[v99] = int(in.Int())
+							v99++
+						} else {
+							in.SkipRecursive()
+						}
+						in.WantComma()
+					}
+					for ; v99 < 2; v99++ {
+						(*out.Pointer)[v99] = 0
+					}
+					in.Delim(']')
+				}
+			}
+		case "value":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				v100 := 0
+				for !in.IsDelim(']') {
+					if v100 < 2 {
+						(out.Value)[v100] = int(in.Int())
+						v100++
+					} else {
+						in.SkipRecursive()
+					}
+					in.WantComma()
+				}
+				for ; v100 < 2; v100++ {
+					(out.Value)[v100] = 0
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests32(out *jwriter.Writer, in IntArrayStruct) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"pointer\":"
+		out.RawString(prefix[1:])
+		if in.Pointer == nil {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v101 := range *in.Pointer {
+				if v101 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int((*in.Pointer)[v101]))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"value\":"
+		out.RawString(prefix)
+		out.RawByte('[')
+		for v102 := range in.Value {
+			if v102 > 0 {
+				out.RawByte(',')
+			}
+			out.Int(int((in.Value)[v102]))
+		}
+		out.RawByte(']')
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v IntArrayStruct) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests32(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v IntArrayStruct) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests32(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *IntArrayStruct) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests32(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *IntArrayStruct) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests32(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests33(in *jlexer.Lexer, out *ExcludedField) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "process":
+					out.Process = false
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "process":
+			out.Process = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests33(out *jwriter.Writer, in ExcludedField) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"process\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Process))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExcludedField) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests33(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExcludedField) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests33(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExcludedField) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests33(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExcludedField) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests33(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests34(in *jlexer.Lexer, out *EncodingFlagsTestSlice) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "F":
+					out.F = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "F":
+			if in.IsNull() {
+				in.Skip()
+				out.F = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.F == nil {
+					if !in.IsDelim(']') {
+						out.F = make([]string, 0, 4)
+					} else {
+						out.F = []string{}
+					}
+				} else {
+					out.F = (out.F)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v103 string
+					v103 = string(in.String())
+					out.F = append(out.F, v103)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests34(out *jwriter.Writer, in EncodingFlagsTestSlice) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"F\":"
+		out.RawString(prefix[1:])
+		if in.F == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v104, v105 := range in.F {
+				if v104 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v105))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v EncodingFlagsTestSlice) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests34(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v EncodingFlagsTestSlice) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests34(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *EncodingFlagsTestSlice) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests34(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *EncodingFlagsTestSlice) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests34(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests35(in *jlexer.Lexer, out *EncodingFlagsTestPtrs) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "S":
+					out.S = nil
+				case "A":
+					out.A = [2]*string{}
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "S":
+			if in.IsNull() {
+				in.Skip()
+				out.S = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.S == nil {
+					if !in.IsDelim(']') {
+						out.S = make([]*string, 0, 8)
+					} else {
+						out.S = []*string{}
+					}
+				} else {
+					out.S = (out.S)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v106 *string
+					if in.IsNull() {
+						in.Skip()
+						v106 = nil
+					} else {
+						if v106 == nil {
+							v106 = new(string)
+						}
+						*v106 = string(in.String())
+					}
+					out.S = append(out.S, v106)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "A":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				v107 := 0
+				for !in.IsDelim(']') {
+					if v107 < 2 {
+						if in.IsNull() {
+							in.Skip()
+							(out.A)[v107] = nil
+						} else {
+							if (out.A)[v107] == nil {
+								(out.A)[v107] = new(string)
+							}
+							*(out.A)[v107] = string(in.String())
+						}
+						v107++
+					} else {
+						in.SkipRecursive()
+					}
+					in.WantComma()
+				}
+				for ; v107 < 2; v107++ {
+					(out.A)[v107] = nil
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests35(out *jwriter.Writer, in EncodingFlagsTestPtrs) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"S\":"
+		out.RawString(prefix[1:])
+		if in.S == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			v108 := false
+			for _, v109 := range in.S {
+				if v109 == nil && out.SkipNilElem() {
+					continue
+				}
+				if v108 {
+					out.RawByte(',')
+				}
+				v108 = true
+				if v109 == nil {
+					out.RawString("null")
+				} else {
+					out.String(string(*v109))
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"A\":"
+		out.RawString(prefix)
+		out.RawByte('[')
+		v111 := false
+		for v110 := range in.A {
+			v112 := (in.A)[v110]
+			if v112 == nil && out.SkipNilElem() {
+				continue
+			}
+			if v111 {
+				out.RawByte(',')
+			}
+			v111 = true
+			if v112 == nil {
+				out.RawString("null")
+			} else {
+				out.String(string(*v112))
+			}
+		}
+		out.RawByte(']')
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v EncodingFlagsTestPtrs) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests35(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v EncodingFlagsTestPtrs) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests35(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *EncodingFlagsTestPtrs) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests35(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *EncodingFlagsTestPtrs) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests35(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests36(in *jlexer.Lexer, out *EncodingFlagsTestMap) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "F":
+					out.F = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "F":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.F == nil {
+					out.F = make(map[string]string)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := string(in.String())
+					in.WantColon()
+					v114 := in.ValueStart()
+					var v113 string
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.F, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v113 = (out.F)[key]
+					}
+					v113 = string(in.String())
+					if !in.ValueSkipped(v114) {
+						(out.F)[key] = v113
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests36(out *jwriter.Writer, in EncodingFlagsTestMap) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"F\":"
+		out.RawString(prefix[1:])
+		if in.F == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v115First := true
+			for v115Name, v115Value := range in.F {
+				if v115First {
+					v115First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v115Name))
+				out.RawByte(':')
+				out.String(string(v115Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v EncodingFlagsTestMap) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests36(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v EncodingFlagsTestMap) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests36(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *EncodingFlagsTestMap) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests36(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *EncodingFlagsTestMap) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests36(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests37(in *jlexer.Lexer, out *EmbeddedStruct) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "f1":
+					out.Field1 = 0
+				case "f2":
+					out.Field2 = ""
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "f1":
+			out.Field1 = int(in.Int())
+		case "f2":
+			out.Field2 = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests37(out *jwriter.Writer, in EmbeddedStruct) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"f1\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Field1))
+	}
+	{
+		const prefix string = ",\"f2\":"
+		out.RawString(prefix)
+		out.String(string(in.Field2))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v EmbeddedStruct) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests37(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v EmbeddedStruct) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests37(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *EmbeddedStruct) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests37(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *EmbeddedStruct) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests37(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests38(in *jlexer.Lexer, out *DeepNestOptional) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "MapSlice":
+					out.MapSlice = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "MapSlice":
+			if in.IsNull() {
+				in.Skip()
+				out.MapSlice = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.MapSlice == nil {
+					if !in.IsDelim(']') {
+						out.MapSlice = make([]map[Str]Str, 0, 8)
+					} else {
+						out.MapSlice = []map[Str]Str{}
+					}
+				} else {
+					out.MapSlice = (out.MapSlice)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v116 map[Str]Str
+					if in.IsNull() {
+						in.Skip()
+					} else if !in.IsDelim('{') {
+						in.Delim('{')
+						in.Delim('}')
+					} else {
+						in.Delim('{')
+						if !in.MergePatch || v116 == nil {
+							if !in.IsDelim('}') {
+								v116 = make(map[Str]Str)
+							} else {
+								v116 = nil
+							}
+						}
+						for !in.IsDelim('}') {
+							in.MarkKey()
+							key := Str(in.String())
+							in.WantColon()
+							v118 := in.ValueStart()
+							var v117 Str
+							if in.MergePatch {
+								if in.IsNull() {
+									in.Skip()
+									if !in.KeepOnNull {
+										delete(v116, key)
+									}
+									in.WantComma()
+									continue
+								}
+								v117 = (v116)[key]
+							}
+							v117 = Str(in.String())
+							if !in.ValueSkipped(v118) {
+								(v116)[key] = v117
+							}
+							in.WantComma()
+						}
+						in.Delim('}')
+					}
+					out.MapSlice = append(out.MapSlice, v116)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests38(out *jwriter.Writer, in DeepNestOptional) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if len(in.MapSlice) != 0 {
+		const prefix string = ",\"MapSlice\":"
+		first = false
+		out.RawString(prefix[1:])
+		{
+			out.RawByte('[')
+			for v119, v120 := range in.MapSlice {
+				if v119 > 0 {
+					out.RawByte(',')
+				}
+				if v120 == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+					out.RawString(`null`)
+				} else {
+					out.RawByte('{')
+					v121First := true
+					for v121Name, v121Value := range v120 {
+						if v121First {
+							v121First = false
+						} else {
+							out.RawByte(',')
+						}
+						out.String(string(v121Name))
+						out.RawByte(':')
+						out.String(string(v121Value))
+					}
+					out.RawByte('}')
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DeepNestOptional) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests38(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DeepNestOptional) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests38(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DeepNestOptional) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests38(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DeepNestOptional) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests38(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests39(in *jlexer.Lexer, out *DeepNest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "SliceMap":
+					out.SliceMap = nil
+				case "SliceMap1":
+					out.SliceMap1 = nil
+				case "SliceMap2":
+					out.SliceMap2 = nil
+				case "NamedSliceMap":
+					out.NamedSliceMap = nil
+				case "NamedMapMap":
+					out.NamedMapMap = nil
+				case "MapSlice":
+					out.MapSlice = nil
+				case "NamedSliceSlice":
+					out.NamedSliceSlice = nil
+				case "NamedMapSlice":
+					out.NamedMapSlice = nil
+				case "NamedStringSlice":
+					out.NamedStringSlice = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "SliceMap":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.SliceMap == nil {
+					out.SliceMap = make(map[Str][]Str)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := Str(in.String())
+					in.WantColon()
+					v123 := in.ValueStart()
+					var v122 []Str
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.SliceMap, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v122 = (out.SliceMap)[key]
+					}
+					if in.IsNull() {
+						in.Skip()
+						v122 = nil
+					} else if !in.IsDelim('[') {
+						in.Delim('[')
+						in.Delim(']')
+					} else {
+						in.Delim('[')
+						if v122 == nil {
+							if !in.IsDelim(']') {
+								v122 = make([]Str, 0, 4)
+							} else {
+								v122 = []Str{}
+							}
+						} else {
+							v122 = (v122)[:0]
+						}
+						for !in.IsDelim(']') {
+							var v124 Str
+							v124 = Str(in.String())
+							v122 = append(v122, v124)
+							in.WantComma()
+						}
+						in.Delim(']')
+					}
+					if !in.ValueSkipped(v123) {
+						(out.SliceMap)[key] = v122
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "SliceMap1":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.SliceMap1 == nil {
+					out.SliceMap1 = make(map[Str][]Str)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := Str(in.String())
+					in.WantColon()
+					v126 := in.ValueStart()
+					var v125 []Str
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.SliceMap1, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v125 = (out.SliceMap1)[key]
+					}
+					if in.IsNull() {
+						in.Skip()
+						v125 = nil
+					} else if !in.IsDelim('[') {
+						in.Delim('[')
+						in.Delim(']')
+					} else {
+						in.Delim('[')
+						if v125 == nil {
+							if !in.IsDelim(']') {
+								v125 = make([]Str, 0, 4)
+							} else {
+								v125 = []Str{}
+							}
+						} else {
+							v125 = (v125)[:0]
+						}
+						for !in.IsDelim(']') {
+							var v127 Str
+							v127 = Str(in.String())
+							v125 = append(v125, v127)
+							in.WantComma()
+						}
+						in.Delim(']')
+					}
+					if !in.ValueSkipped(v126) {
+						(out.SliceMap1)[key] = v125
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "SliceMap2":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.SliceMap2 == nil {
+					out.SliceMap2 = make(map[Str][]Str)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := Str(in.String())
+					in.WantColon()
+					v129 := in.ValueStart()
+					var v128 []Str
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.SliceMap2, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v128 = (out.SliceMap2)[key]
+					}
+					if in.IsNull() {
+						in.Skip()
+						v128 = nil
+					} else if !in.IsDelim('[') {
+						in.Delim('[')
+						in.Delim(']')
+					} else {
+						in.Delim('[')
+						if v128 == nil {
+							if !in.IsDelim(']') {
+								v128 = make([]Str, 0, 4)
+							} else {
+								v128 = []Str{}
+							}
+						} else {
+							v128 = (v128)[:0]
+						}
+						for !in.IsDelim(']') {
+							var v130 Str
+							v130 = Str(in.String())
+							v128 = append(v128, v130)
+							in.WantComma()
+						}
+						in.Delim(']')
+					}
+					if !in.ValueSkipped(v129) {
+						(out.SliceMap2)[key] = v128
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "NamedSliceMap":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.NamedSliceMap == nil {
+					out.NamedSliceMap = make(map[Str]NamedSlice)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := Str(in.String())
+					in.WantColon()
+					v132 := in.ValueStart()
+					var v131 NamedSlice
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.NamedSliceMap, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v131 = (out.NamedSliceMap)[key]
+					}
+					if in.IsNull() {
+						in.Skip()
+						v131 = nil
+					} else if !in.IsDelim('[') {
+						in.Delim('[')
+						in.Delim(']')
+					} else {
+						in.Delim('[')
+						if v131 == nil {
+							if !in.IsDelim(']') {
+								v131 = make(NamedSlice, 0, 4)
+							} else {
+								v131 = NamedSlice{}
+							}
+						} else {
+							v131 = (v131)[:0]
+						}
+						for !in.IsDelim(']') {
+							var v133 Str
+							v133 = Str(in.String())
+							v131 = append(v131, v133)
+							in.WantComma()
+						}
+						in.Delim(']')
+					}
+					if !in.ValueSkipped(v132) {
+						(out.NamedSliceMap)[key] = v131
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "NamedMapMap":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.NamedMapMap == nil {
+					out.NamedMapMap = make(map[Str]NamedMap)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := Str(in.String())
+					in.WantColon()
+					v135 := in.ValueStart()
+					var v134 NamedMap
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.NamedMapMap, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v134 = (out.NamedMapMap)[key]
+					}
+					if in.IsNull() {
+						in.Skip()
+					} else if !in.IsDelim('{') {
+						in.Delim('{')
+						in.Delim('}')
+					} else {
+						in.Delim('{')
+						if !in.MergePatch || v134 == nil {
+							v134 = make(NamedMap)
+						}
+						for !in.IsDelim('}') {
+							in.MarkKey()
+							key := Str(in.String())
+							in.WantColon()
+							v137 := in.ValueStart()
+							var v136 Str
+							if in.MergePatch {
+								if in.IsNull() {
+									in.Skip()
+									if !in.KeepOnNull {
+										delete(v134, key)
+									}
+									in.WantComma()
+									continue
+								}
+								v136 = (v134)[key]
+							}
+							v136 = Str(in.String())
+							if !in.ValueSkipped(v137) {
+								(v134)[key] = v136
+							}
+							in.WantComma()
+						}
+						in.Delim('}')
+					}
+					if !in.ValueSkipped(v135) {
+						(out.NamedMapMap)[key] = v134
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "MapSlice":
+			if in.IsNull() {
+				in.Skip()
+				out.MapSlice = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.MapSlice == nil {
+					if !in.IsDelim(']') {
+						out.MapSlice = make([]map[Str]Str, 0, 8)
+					} else {
+						out.MapSlice = []map[Str]Str{}
+					}
+				} else {
+					out.MapSlice = (out.MapSlice)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v138 map[Str]Str
+					if in.IsNull() {
+						in.Skip()
+					} else if !in.IsDelim('{') {
+						in.Delim('{')
+						in.Delim('}')
+					} else {
+						in.Delim('{')
+						if !in.MergePatch || v138 == nil {
+							v138 = make(map[Str]Str)
+						}
+						for !in.IsDelim('}') {
+							in.MarkKey()
+							key := Str(in.String())
+							in.WantColon()
+							v140 := in.ValueStart()
+							var v139 Str
+							if in.MergePatch {
+								if in.IsNull() {
+									in.Skip()
+									if !in.KeepOnNull {
+										delete(v138, key)
+									}
+									in.WantComma()
+									continue
+								}
+								v139 = (v138)[key]
+							}
+							v139 = Str(in.String())
+							if !in.ValueSkipped(v140) {
+								(v138)[key] = v139
+							}
+							in.WantComma()
+						}
+						in.Delim('}')
+					}
+					out.MapSlice = append(out.MapSlice, v138)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "NamedSliceSlice":
+			if in.IsNull() {
+				in.Skip()
+				out.NamedSliceSlice = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.NamedSliceSlice == nil {
+					if !in.IsDelim(']') {
+						out.NamedSliceSlice = make([]NamedSlice, 0, 2)
+					} else {
+						out.NamedSliceSlice = []NamedSlice{}
+					}
+				} else {
+					out.NamedSliceSlice = (out.NamedSliceSlice)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v141 NamedSlice
+					if in.IsNull() {
+						in.Skip()
+						v141 = nil
+					} else if !in.IsDelim('[') {
+						in.Delim('[')
+						in.Delim(']')
+					} else {
+						in.Delim('[')
+						if v141 == nil {
+							if !in.IsDelim(']') {
+								v141 = make(NamedSlice, 0, 4)
+							} else {
+								v141 = NamedSlice{}
+							}
+						} else {
+							v141 = (v141)[:0]
+						}
+						for !in.IsDelim(']') {
+							var v142 Str
+							v142 = Str(in.String())
+							v141 = append(v141, v142)
+							in.WantComma()
+						}
+						in.Delim(']')
+					}
+					out.NamedSliceSlice = append(out.NamedSliceSlice, v141)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "NamedMapSlice":
+			if in.IsNull() {
+				in.Skip()
+				out.NamedMapSlice = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.NamedMapSlice == nil {
+					if !in.IsDelim(']') {
+						out.NamedMapSlice = make([]NamedMap, 0, 8)
+					} else {
+						out.NamedMapSlice = []NamedMap{}
+					}
+				} else {
+					out.NamedMapSlice = (out.NamedMapSlice)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v143 NamedMap
+					if in.IsNull() {
+						in.Skip()
+					} else if !in.IsDelim('{') {
+						in.Delim('{')
+						in.Delim('}')
+					} else {
+						in.Delim('{')
+						if !in.MergePatch || v143 == nil {
+							v143 = make(NamedMap)
+						}
+						for !in.IsDelim('}') {
+							in.MarkKey()
+							key := Str(in.String())
+							in.WantColon()
+							v145 := in.ValueStart()
+							var v144 Str
+							if in.MergePatch {
+								if in.IsNull() {
+									in.Skip()
+									if !in.KeepOnNull {
+										delete(v143, key)
+									}
+									in.WantComma()
+									continue
+								}
+								v144 = (v143)[key]
+							}
+							v144 = Str(in.String())
+							if !in.ValueSkipped(v145) {
+								(v143)[key] = v144
+							}
+							in.WantComma()
+						}
+						in.Delim('}')
+					}
+					out.NamedMapSlice = append(out.NamedMapSlice, v143)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "NamedStringSlice":
+			if in.IsNull() {
+				in.Skip()
+				out.NamedStringSlice = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.NamedStringSlice == nil {
+					if !in.IsDelim(']') {
+						out.NamedStringSlice = make([]NamedString, 0, 4)
+					} else {
+						out.NamedStringSlice = []NamedString{}
+					}
+				} else {
+					out.NamedStringSlice = (out.NamedStringSlice)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v146 NamedString
+					v146 = NamedString(in.String())
+					out.NamedStringSlice = append(out.NamedStringSlice, v146)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests39(out *jwriter.Writer, in DeepNest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"SliceMap\":"
+		out.RawString(prefix[1:])
+		if in.SliceMap == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v147First := true
+			for v147Name, v147Value := range in.SliceMap {
+				if v147First {
+					v147First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v147Name))
+				out.RawByte(':')
+				if v147Value == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+					out.RawString("null")
+				} else {
+					out.RawByte('[')
+					for v148, v149 := range v147Value {
+						if v148 > 0 {
+							out.RawByte(',')
+						}
+						out.String(string(v149))
+					}
+					out.RawByte(']')
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"SliceMap1\":"
+		out.RawString(prefix)
+		if in.SliceMap1 == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v150First := true
+			for v150Name, v150Value := range in.SliceMap1 {
+				if v150First {
+					v150First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v150Name))
+				out.RawByte(':')
+				if v150Value == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+					out.RawString("null")
+				} else {
+					out.RawByte('[')
+					for v151, v152 := range v150Value {
+						if v151 > 0 {
+							out.RawByte(',')
+						}
+						out.String(string(v152))
+					}
+					out.RawByte(']')
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"SliceMap2\":"
+		out.RawString(prefix)
+		if in.SliceMap2 == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v153First := true
+			for v153Name, v153Value := range in.SliceMap2 {
+				if v153First {
+					v153First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v153Name))
+				out.RawByte(':')
+				if v153Value == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+					out.RawString("null")
+				} else {
+					out.RawByte('[')
+					for v154, v155 := range v153Value {
+						if v154 > 0 {
+							out.RawByte(',')
+						}
+						out.String(string(v155))
+					}
+					out.RawByte(']')
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"NamedSliceMap\":"
+		out.RawString(prefix)
+		if in.NamedSliceMap == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v156First := true
+			for v156Name, v156Value := range in.NamedSliceMap {
+				if v156First {
+					v156First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v156Name))
+				out.RawByte(':')
+				if v156Value == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+					out.RawString("null")
+				} else {
+					out.RawByte('[')
+					for v157, v158 := range v156Value {
+						if v157 > 0 {
+							out.RawByte(',')
+						}
+						out.String(string(v158))
+					}
+					out.RawByte(']')
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"NamedMapMap\":"
+		out.RawString(prefix)
+		if in.NamedMapMap == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v159First := true
+			for v159Name, v159Value := range in.NamedMapMap {
+				if v159First {
+					v159First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v159Name))
+				out.RawByte(':')
+				if v159Value == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+					out.RawString(`null`)
+				} else {
+					out.RawByte('{')
+					v160First := true
+					for v160Name, v160Value := range v159Value {
+						if v160First {
+							v160First = false
+						} else {
+							out.RawByte(',')
+						}
+						out.String(string(v160Name))
+						out.RawByte(':')
+						out.String(string(v160Value))
+					}
+					out.RawByte('}')
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"MapSlice\":"
+		out.RawString(prefix)
+		if in.MapSlice == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v161, v162 := range in.MapSlice {
+				if v161 > 0 {
+					out.RawByte(',')
+				}
+				if v162 == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+					out.RawString(`null`)
+				} else {
+					out.RawByte('{')
+					v163First := true
+					for v163Name, v163Value := range v162 {
+						if v163First {
+							v163First = false
+						} else {
+							out.RawByte(',')
+						}
+						out.String(string(v163Name))
+						out.RawByte(':')
+						out.String(string(v163Value))
+					}
+					out.RawByte('}')
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"NamedSliceSlice\":"
+		out.RawString(prefix)
+		if in.NamedSliceSlice == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v164, v165 := range in.NamedSliceSlice {
+				if v164 > 0 {
+					out.RawByte(',')
+				}
+				if v165 == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+					out.RawString("null")
+				} else {
+					out.RawByte('[')
+					for v166, v167 := range v165 {
+						if v166 > 0 {
+							out.RawByte(',')
+						}
+						out.String(string(v167))
+					}
+					out.RawByte(']')
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"NamedMapSlice\":"
+		out.RawString(prefix)
+		if in.NamedMapSlice == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v168, v169 := range in.NamedMapSlice {
+				if v168 > 0 {
+					out.RawByte(',')
+				}
+				if v169 == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+					out.RawString(`null`)
+				} else {
+					out.RawByte('{')
+					v170First := true
+					for v170Name, v170Value := range v169 {
+						if v170First {
+							v170First = false
+						} else {
+							out.RawByte(',')
+						}
+						out.String(string(v170Name))
+						out.RawByte(':')
+						out.String(string(v170Value))
+					}
+					out.RawByte('}')
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"NamedStringSlice\":"
+		out.RawString(prefix)
+		if in.NamedStringSlice == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v171, v172 := range in.NamedStringSlice {
+				if v171 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v172))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DeepNest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests39(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DeepNest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests39(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DeepNest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests39(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DeepNest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests39(l, v)
+}
+func easyjson794297d0DecodeGithubComMailruEasyjsonTests40(in *jlexer.Lexer, out *Arrays) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "ByteArray":
+					out.ByteArray = [3]uint8{}
+				case "EmptyByteArray":
+					out.EmptyByteArray = [0]uint8{}
+				case "IntArray":
+					out.IntArray = [5]int{}
+				case "EmptyIntArray":
+					out.EmptyIntArray = [0]int{}
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ByteArray":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				for v173 := copy((out.ByteArray)[:], in.Bytes()); v173 < 3; v173++ {
+					(out.ByteArray)[v173] = 0
+				}
+			}
+		case "EmptyByteArray":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				for v174 := copy((out.EmptyByteArray)[:], in.Bytes()); v174 < 0; v174++ {
+					(out.EmptyByteArray)[v174] = 0
+				}
+			}
+		case "IntArray":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				v175 := 0
+				for !in.IsDelim(']') {
+					if v175 < 5 {
+						(out.IntArray)[v175] = int(in.Int())
+						v175++
+					} else {
+						in.SkipRecursive()
+					}
+					in.WantComma()
+				}
+				for ; v175 < 5; v175++ {
+					(out.IntArray)[v175] = 0
+				}
+				in.Delim(']')
+			}
+		case "EmptyIntArray":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				v176 := 0
+				for !in.IsDelim(']') {
+					if v176 < 0 {
+						(out.EmptyIntArray)[v176] = int(in.Int())
+						v176++
+					} else {
+						in.SkipRecursive()
+					}
+					in.WantComma()
+				}
+				for ; v176 < 0; v176++ {
+					(out.EmptyIntArray)[v176] = 0
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson794297d0EncodeGithubComMailruEasyjsonTests40(out *jwriter.Writer, in Arrays) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ByteArray\":"
+		out.RawString(prefix[1:])
+		out.Base64Bytes(in.ByteArray[:])
+	}
+	{
+		const prefix string = ",\"EmptyByteArray\":"
+		out.RawString(prefix)
+		out.Base64Bytes(in.EmptyByteArray[:])
+	}
+	{
+		const prefix string = ",\"IntArray\":"
+		out.RawString(prefix)
+		out.RawByte('[')
+		for v179 := range in.IntArray {
+			if v179 > 0 {
+				out.RawByte(',')
+			}
+			out.Int(int((in.IntArray)[v179]))
+		}
+		out.RawByte(']')
+	}
+	{
+		const prefix string = ",\"EmptyIntArray\":"
+		out.RawString(prefix)
+		out.RawByte('[')
+		for v180 := range in.EmptyIntArray {
+			if v180 > 0 {
+				out.RawByte(',')
+			}
+			out.Int(int((in.EmptyIntArray)[v180]))
+		}
+		out.RawByte(']')
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Arrays) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests40(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Arrays) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson794297d0EncodeGithubComMailruEasyjsonTests40(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Arrays) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests40(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Arrays) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson794297d0DecodeGithubComMailruEasyjsonTests40(l, v)
+}
@@ -0,0 +1,17 @@
+package tests
+
+//easyjson:json
+type EmbeddedPtr struct {
+	*EmbeddedPtrInner
+	ID int `json:"id"`
+}
+
+type EmbeddedPtrInner struct {
+	Name string `json:"name"`
+	*EmbeddedPtrBase
+}
+
+type EmbeddedPtrBase struct {
+	Version int      `json:"version"`
+	Tags    []string `json:"tags"`
+}
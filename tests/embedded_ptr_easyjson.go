@@ -0,0 +1,207 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjsonBe618449DecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *EmbeddedPtr) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			switch key {
+			case "name":
+				if out.EmbeddedPtrInner == nil {
+					out.EmbeddedPtrInner = new(EmbeddedPtrInner)
+				}
+			case "version":
+				if out.EmbeddedPtrInner == nil {
+					out.EmbeddedPtrInner = new(EmbeddedPtrInner)
+				}
+				if out.EmbeddedPtrInner.EmbeddedPtrBase == nil {
+					out.EmbeddedPtrInner.EmbeddedPtrBase = new(EmbeddedPtrBase)
+				}
+			case "tags":
+				if out.EmbeddedPtrInner == nil {
+					out.EmbeddedPtrInner = new(EmbeddedPtrInner)
+				}
+				if out.EmbeddedPtrInner.EmbeddedPtrBase == nil {
+					out.EmbeddedPtrInner.EmbeddedPtrBase = new(EmbeddedPtrBase)
+				}
+			}
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "name":
+					out.Name = ""
+				case "version":
+					out.Version = 0
+				case "tags":
+					out.Tags = nil
+				case "id":
+					out.ID = 0
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			if out.EmbeddedPtrInner == nil {
+				out.EmbeddedPtrInner = new(EmbeddedPtrInner)
+			}
+			out.Name = string(in.String())
+		case "version":
+			if out.EmbeddedPtrInner == nil {
+				out.EmbeddedPtrInner = new(EmbeddedPtrInner)
+			}
+			if out.EmbeddedPtrInner.EmbeddedPtrBase == nil {
+				out.EmbeddedPtrInner.EmbeddedPtrBase = new(EmbeddedPtrBase)
+			}
+			out.Version = int(in.Int())
+		case "tags":
+			if out.EmbeddedPtrInner == nil {
+				out.EmbeddedPtrInner = new(EmbeddedPtrInner)
+			}
+			if out.EmbeddedPtrInner.EmbeddedPtrBase == nil {
+				out.EmbeddedPtrInner.EmbeddedPtrBase = new(EmbeddedPtrBase)
+			}
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]string, 0, 4)
+					} else {
+						out.Tags = []string{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 string
+					v1 = string(in.String())
+					out.Tags = append(out.Tags, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "id":
+			out.ID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBe618449EncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in EmbeddedPtr) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.EmbeddedPtrInner != nil {
+		const prefix string = ",\"name\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	if in.EmbeddedPtrInner != nil && in.EmbeddedPtrInner.EmbeddedPtrBase != nil {
+		const prefix string = ",\"version\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.Version))
+	}
+	if in.EmbeddedPtrInner != nil && in.EmbeddedPtrInner.EmbeddedPtrBase != nil {
+		const prefix string = ",\"tags\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		if in.Tags == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Tags {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v3))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.ID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v EmbeddedPtr) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBe618449EncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v EmbeddedPtr) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBe618449EncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *EmbeddedPtr) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBe618449DecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *EmbeddedPtr) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBe618449DecodeGithubComMailruEasyjsonTests(l, v)
+}
@@ -0,0 +1,47 @@
+package tests
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+// embeddedPtrPlain has the fields of EmbeddedPtr without its marshaling methods.
+type embeddedPtrPlain EmbeddedPtr
+
+func TestEmbeddedPtrUnmarshal(t *testing.T) {
+	for _, data := range []string{
+		`{"id":1}`,
+		`{"id":1,"name":"a"}`,
+		`{"id":1,"version":2,"tags":["x"]}`,
+		`{"tags":null}`,
+	} {
+		var want embeddedPtrPlain
+		if err := json.Unmarshal([]byte(data), &want); err != nil {
+			t.Fatal(err)
+		}
+		var got EmbeddedPtr
+		if err := easyjson.Unmarshal([]byte(data), &got); err != nil || !reflect.DeepEqual(embeddedPtrPlain(got), want) {
+			t.Errorf("Unmarshal(%s) = %+v, %v; want %+v", data, got, err, want)
+		}
+	}
+}
+
+func TestEmbeddedPtrMarshal(t *testing.T) {
+	for _, v := range []EmbeddedPtr{
+		{ID: 1},
+		{ID: 1, EmbeddedPtrInner: &EmbeddedPtrInner{Name: "a"}},
+		{ID: 1, EmbeddedPtrInner: &EmbeddedPtrInner{EmbeddedPtrBase: &EmbeddedPtrBase{Version: 2}}},
+	} {
+		want, err := json.Marshal(embeddedPtrPlain(v))
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := easyjson.Marshal(v)
+		if err != nil || string(data) != string(want) {
+			t.Errorf("Marshal(%+v) = %s, %v; want %s", v, data, err, want)
+		}
+	}
+}
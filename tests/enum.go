@@ -0,0 +1,30 @@
+package tests
+
+//easyjson:enum Status 0=unknown 1=active -1=deleted
+type Status int
+
+//easyjson:json
+type EnumStruct struct {
+	Status   Status
+	Ptr      *Status
+	ByStatus map[Status]int
+	List     []Status
+}
+
+var enumStatusDeleted = Status(-1)
+
+var enumStructValue = EnumStruct{
+	Status:   1,
+	Ptr:      &enumStatusDeleted,
+	ByStatus: map[Status]int{0: 2},
+	List:     []Status{0, 1},
+}
+
+var enumStructString = `{"Status":"active","Ptr":"deleted","ByStatus":{"unknown":2},"List":["unknown","active"]}`
+
+//easyjson:json
+type EnumFallbackStruct struct {
+	Status Status   `easyjson:"unknown=unknown"`
+	Ptr    *Status  `easyjson:"unknown=unknown"`
+	List   []Status `easyjson:"unknown=deleted"`
+}
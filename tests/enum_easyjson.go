@@ -0,0 +1,482 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	fmt "fmt"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjson30025ec7DecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *Status) {
+	if in.IsNull() {
+		in.Skip()
+		return
+	}
+	pos := in.GetPos()
+	switch data := in.UnsafeBytes(); string(data) {
+	case "unknown":
+		*out = 0
+	case "active":
+		*out = 1
+	case "deleted":
+		*out = -1
+	default:
+		if in.Ok() {
+			in.AddError(&jlexer.LexerError{
+				Offset: pos,
+				Reason: "unknown Status value",
+				Data:   string(data),
+			})
+		}
+	}
+}
+func easyjson30025ec7EncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in Status) {
+	switch in {
+	case 0:
+		out.StringNoEscape("unknown")
+	case 1:
+		out.StringNoEscape("active")
+	case -1:
+		out.StringNoEscape("deleted")
+	default:
+		if out.Error == nil {
+			out.Error = fmt.Errorf("invalid Status value %d", in)
+		}
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Status) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson30025ec7EncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Status) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson30025ec7EncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Status) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson30025ec7DecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Status) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson30025ec7DecodeGithubComMailruEasyjsonTests(l, v)
+}
+
+// MarshalText supports encoding.TextMarshaler interface
+func (v Status) MarshalText() ([]byte, error) {
+	switch v {
+	case 0:
+		return []byte("unknown"), nil
+	case 1:
+		return []byte("active"), nil
+	case -1:
+		return []byte("deleted"), nil
+	}
+	return nil, fmt.Errorf("invalid Status value %d", v)
+}
+
+// UnmarshalText supports encoding.TextUnmarshaler interface
+func (v *Status) UnmarshalText(data []byte) error {
+	switch string(data) {
+	case "unknown":
+		*v = 0
+		return nil
+	case "active":
+		*v = 1
+		return nil
+	case "deleted":
+		*v = -1
+		return nil
+	}
+	return fmt.Errorf("unknown Status value %q", data)
+}
+func easyjson30025ec7DecodeGithubComMailruEasyjsonTests1(in *jlexer.Lexer, out *EnumStruct) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "Status":
+					out.Status = 0
+				case "Ptr":
+					out.Ptr = nil
+				case "ByStatus":
+					out.ByStatus = nil
+				case "List":
+					out.List = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Status":
+			(out.Status).UnmarshalEasyJSON(in)
+		case "Ptr":
+			if in.IsNull() {
+				in.Skip()
+				out.Ptr = nil
+			} else {
+				if out.Ptr == nil {
+					out.Ptr = new(Status)
+				}
+				(*out.Ptr).UnmarshalEasyJSON(in)
+			}
+		case "ByStatus":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.ByStatus == nil {
+					out.ByStatus = make(map[Status]int)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					var key Status
+					if data := in.UnsafeBytes(); in.Ok() {
+						in.AddError(key.UnmarshalText(data))
+					}
+					in.WantColon()
+					v2 := in.ValueStart()
+					var v1 int
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.ByStatus, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v1 = (out.ByStatus)[key]
+					}
+					v1 = int(in.Int())
+					if !in.ValueSkipped(v2) {
+						(out.ByStatus)[key] = v1
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "List":
+			if in.IsNull() {
+				in.Skip()
+				out.List = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.List == nil {
+					if !in.IsDelim(']') {
+						out.List = make([]Status, 0, 8)
+					} else {
+						out.List = []Status{}
+					}
+				} else {
+					out.List = (out.List)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 Status
+					(v3).UnmarshalEasyJSON(in)
+					out.List = append(out.List, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson30025ec7EncodeGithubComMailruEasyjsonTests1(out *jwriter.Writer, in EnumStruct) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Status\":"
+		out.RawString(prefix[1:])
+		(in.Status).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Ptr\":"
+		out.RawString(prefix)
+		if in.Ptr == nil {
+			out.RawString("null")
+		} else {
+			(*in.Ptr).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"ByStatus\":"
+		out.RawString(prefix)
+		if in.ByStatus == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v4First := true
+			for v4Name, v4Value := range in.ByStatus {
+				if v4First {
+					v4First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.TextKey((v4Name).MarshalText())
+				out.RawByte(':')
+				out.Int(int(v4Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"List\":"
+		out.RawString(prefix)
+		if in.List == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.List {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v EnumStruct) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson30025ec7EncodeGithubComMailruEasyjsonTests1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v EnumStruct) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson30025ec7EncodeGithubComMailruEasyjsonTests1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *EnumStruct) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson30025ec7DecodeGithubComMailruEasyjsonTests1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *EnumStruct) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson30025ec7DecodeGithubComMailruEasyjsonTests1(l, v)
+}
+func easyjson30025ec7DecodeGithubComMailruEasyjsonTests2(in *jlexer.Lexer, out *EnumFallbackStruct) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "Status":
+					out.Status = 0
+				case "Ptr":
+					out.Ptr = nil
+				case "List":
+					out.List = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Status":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				switch string(in.UnsafeBytes()) {
+				case "active":
+					out.Status = Status(1)
+				case "deleted":
+					out.Status = Status(-1)
+				default:
+					out.Status = Status(0)
+				}
+			}
+		case "Ptr":
+			if in.IsNull() {
+				in.Skip()
+				out.Ptr = nil
+			} else {
+				if out.Ptr == nil {
+					out.Ptr = new(Status)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					switch string(in.UnsafeBytes()) {
+					case "active":
+						*out.Ptr = Status(1)
+					case "deleted":
+						*out.Ptr = Status(-1)
+					default:
+						*out.Ptr = Status(0)
+					}
+				}
+			}
+		case "List":
+			if in.IsNull() {
+				in.Skip()
+				out.List = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.List == nil {
+					if !in.IsDelim(']') {
+						out.List = make([]Status, 0, 8)
+					} else {
+						out.List = []Status{}
+					}
+				} else {
+					out.List = (out.List)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 Status
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						switch string(in.UnsafeBytes()) {
+						case "unknown":
+							v7 = Status(0)
+						case "active":
+							v7 = Status(1)
+						default:
+							v7 = Status(-1)
+						}
+					}
+					out.List = append(out.List, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson30025ec7EncodeGithubComMailruEasyjsonTests2(out *jwriter.Writer, in EnumFallbackStruct) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Status\":"
+		out.RawString(prefix[1:])
+		(in.Status).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Ptr\":"
+		out.RawString(prefix)
+		if in.Ptr == nil {
+			out.RawString("null")
+		} else {
+			(*in.Ptr).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"List\":"
+		out.RawString(prefix)
+		if in.List == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.List {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				(v9).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v EnumFallbackStruct) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson30025ec7EncodeGithubComMailruEasyjsonTests2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v EnumFallbackStruct) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson30025ec7EncodeGithubComMailruEasyjsonTests2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *EnumFallbackStruct) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson30025ec7DecodeGithubComMailruEasyjsonTests2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *EnumFallbackStruct) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson30025ec7DecodeGithubComMailruEasyjsonTests2(l, v)
+}
@@ -0,0 +1,70 @@
+package tests
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestEnum(t *testing.T) {
+	data, err := json.Marshal(enumStructValue)
+	if err != nil || string(data) != enumStructString {
+		t.Errorf("json.Marshal() = %s, %v; want %s", data, err, enumStructString)
+	}
+
+	var v EnumStruct
+	if err := json.Unmarshal([]byte(enumStructString), &v); err != nil {
+		t.Errorf("json.Unmarshal() error: %v", err)
+	} else if !reflect.DeepEqual(v, enumStructValue) {
+		t.Errorf("json.Unmarshal() = %+v; want %+v", v, enumStructValue)
+	}
+}
+
+func TestEnumErrors(t *testing.T) {
+	if _, err := json.Marshal(EnumStruct{Status: 5}); err == nil || !strings.Contains(err.Error(), "invalid Status value 5") {
+		t.Errorf("json.Marshal() of an invalid value error: %v", err)
+	}
+	if _, err := Status(5).MarshalText(); err == nil {
+		t.Error("MarshalText() of an invalid value succeeded")
+	}
+
+	for _, in := range []string{
+		`{"Status":"inactive"}`,
+		`{"Status":1}`,
+		`{"ByStatus":{"inactive":1}}`,
+	} {
+		var v EnumStruct
+		if err := json.Unmarshal([]byte(in), &v); err == nil {
+			t.Errorf("json.Unmarshal(%s) succeeded: %+v", in, v)
+		}
+	}
+
+	var s Status = 1
+	if err := json.Unmarshal([]byte(`null`), &s); err != nil || s != 1 {
+		t.Errorf("json.Unmarshal(null) = %v, %v; want the value unchanged", s, err)
+	}
+}
+
+func TestEnumFallback(t *testing.T) {
+	active := Status(1)
+	for _, test := range []struct {
+		In   string
+		Want EnumFallbackStruct
+	}{
+		{`{"Status":"active"}`, EnumFallbackStruct{Status: 1}},
+		{`{"Status":"archived","Ptr":"active"}`, EnumFallbackStruct{Status: 0, Ptr: &active}},
+		{`{"Ptr":"archived"}`, EnumFallbackStruct{Ptr: new(Status)}},
+		{`{"List":["active","archived","deleted"]}`, EnumFallbackStruct{List: []Status{1, -1, -1}}},
+	} {
+		var v EnumFallbackStruct
+		if err := json.Unmarshal([]byte(test.In), &v); err != nil || !reflect.DeepEqual(v, test.Want) {
+			t.Errorf("json.Unmarshal(%s) = %+v, %v; want %+v", test.In, v, err, test.Want)
+		}
+	}
+
+	var v EnumFallbackStruct
+	if err := json.Unmarshal([]byte(`{"Status":1}`), &v); err == nil {
+		t.Errorf("json.Unmarshal() of a number succeeded: %+v", v)
+	}
+}
@@ -0,0 +1,9 @@
+package tests
+
+//easyjson:json
+type ErrorResult struct {
+	Value  int     `json:"value"`
+	Err    error   `json:"err"`
+	Cause  error   `json:"cause,omitempty" easyjson:"errobject"`
+	Errors []error `json:"errors,omitempty"`
+}
@@ -0,0 +1,196 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjson5bc66abeDecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *ErrorResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "value":
+					out.Value = 0
+				case "err":
+					out.Err = nil
+				case "cause":
+					out.Cause = nil
+				case "errors":
+					out.Errors = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "value":
+			out.Value = int(in.Int())
+		case "err":
+			if in.IsNull() {
+				in.Skip()
+				out.Err = nil
+			} else {
+				v1 := in.String()
+				out.Err = &easyjson.Error{Message: v1}
+			}
+		case "cause":
+			if in.IsNull() {
+				in.Skip()
+				out.Cause = nil
+			} else {
+				var v2 string
+				in.Delim('{')
+				for !in.IsDelim('}') {
+					key := in.UnsafeFieldName(false)
+					in.WantColon()
+					if key == "message" {
+						v2 = in.String()
+					} else {
+						in.SkipRecursive()
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+				out.Cause = &easyjson.Error{Message: v2}
+			}
+		case "errors":
+			if in.IsNull() {
+				in.Skip()
+				out.Errors = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Errors == nil {
+					if !in.IsDelim(']') {
+						out.Errors = make([]error, 0, 4)
+					} else {
+						out.Errors = []error{}
+					}
+				} else {
+					out.Errors = (out.Errors)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 error
+					if in.IsNull() {
+						in.Skip()
+						v3 = nil
+					} else {
+						v4 := in.String()
+						v3 = &easyjson.Error{Message: v4}
+					}
+					out.Errors = append(out.Errors, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson5bc66abeEncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in ErrorResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"value\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Value))
+	}
+	{
+		const prefix string = ",\"err\":"
+		out.RawString(prefix)
+		if in.Err == nil {
+			out.RawString("null")
+		} else {
+			out.String((in.Err).Error())
+		}
+	}
+	if in.Cause != nil {
+		const prefix string = ",\"cause\":"
+		out.RawString(prefix)
+		{
+			out.RawString(`{"message":`)
+			out.String((in.Cause).Error())
+			out.RawByte('}')
+		}
+	}
+	if len(in.Errors) != 0 {
+		const prefix string = ",\"errors\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v5, v6 := range in.Errors {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				if v6 == nil {
+					out.RawString("null")
+				} else {
+					out.String((v6).Error())
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ErrorResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson5bc66abeEncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ErrorResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson5bc66abeEncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ErrorResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson5bc66abeDecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ErrorResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson5bc66abeDecodeGithubComMailruEasyjsonTests(l, v)
+}
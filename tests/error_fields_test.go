@@ -0,0 +1,43 @@
+package tests
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestErrorFields(t *testing.T) {
+	v := ErrorResult{
+		Value:  1,
+		Err:    errors.New("not found"),
+		Cause:  errors.New(`bad "id"`),
+		Errors: []error{errors.New("a failed"), nil},
+	}
+	want := `{"value":1,"err":"not found","cause":{"message":"bad \"id\""},"errors":["a failed",null]}`
+	if data, err := easyjson.Marshal(v); err != nil || string(data) != want {
+		t.Errorf("Marshal() = %s, %v; want %s", data, err, want)
+	}
+	if data, err := easyjson.Marshal(ErrorResult{}); err != nil || string(data) != `{"value":0,"err":null}` {
+		t.Errorf("Marshal(ErrorResult{}) = %s, %v; want %s", data, err, `{"value":0,"err":null}`)
+	}
+
+	var got ErrorResult
+	if err := easyjson.Unmarshal([]byte(want), &got); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	wantValue := ErrorResult{
+		Value:  1,
+		Err:    &easyjson.Error{Message: "not found"},
+		Cause:  &easyjson.Error{Message: `bad "id"`},
+		Errors: []error{&easyjson.Error{Message: "a failed"}, nil},
+	}
+	if !reflect.DeepEqual(got, wantValue) {
+		t.Errorf("Unmarshal() = %+v; want %+v", got, wantValue)
+	}
+
+	if err := easyjson.Unmarshal([]byte(`{"cause":"bad"}`), &got); err == nil {
+		t.Errorf("Unmarshal() of a string into an errobject field did not fail")
+	}
+}
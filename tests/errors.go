@@ -1,5 +1,7 @@
 package tests
 
+import "time"
+
 //easyjson:json
 type ErrorIntSlice []int
 
@@ -24,3 +26,11 @@ type ErrorNestedStruct struct {
 
 //easyjson:json
 type ErrorIntMap map[uint32]string
+
+//easyjson:json
+type ErrorEvent struct {
+	ID     int            `json:"id"`
+	At     time.Time      `json:"at"`
+	Items  []ErrorStruct  `json:"items"`
+	Labels map[string]int `json:"labels"`
+}
@@ -1,8 +1,10 @@
 package tests
 
 import (
+	"reflect"
 	"testing"
 
+	"github.com/mailru/easyjson"
 	"github.com/mailru/easyjson/jlexer"
 )
 
@@ -283,3 +285,40 @@ func TestMultipleErrorsIntMap(t *testing.T) {
 		}
 	}
 }
+
+func TestUnmarshalBestEffort(t *testing.T) {
+	data := `{"id": "1", "at": "yesterday", "items": [{"int": 1}, {"int": 2, "string": 3}], "labels": {"a/b": true, "c": 4}}`
+
+	var v ErrorEvent
+	report, err := easyjson.UnmarshalBestEffort([]byte(data), &v)
+	if err != nil {
+		t.Fatalf("UnmarshalBestEffort() error: %v", err)
+	}
+	var paths []string
+	for _, e := range report {
+		paths = append(paths, e.Path)
+	}
+	if want := []string{"/id", "/at", "/items/1/string", "/labels/a~1b"}; !reflect.DeepEqual(paths, want) {
+		t.Errorf("UnmarshalBestEffort() reported %q; want %q", paths, want)
+	}
+	want := ErrorEvent{Items: []ErrorStruct{{Int: 1}, {Int: 2}}, Labels: map[string]int{"c": 4}}
+	if !reflect.DeepEqual(v, want) {
+		t.Errorf("UnmarshalBestEffort() = %+v; want %+v", v, want)
+	}
+
+	v = ErrorEvent{}
+	report, err = easyjson.UnmarshalBestEffort([]byte(`{"items": "x", "labels": 5, "id": 1}`), &v)
+	if err != nil || len(report) != 2 {
+		t.Fatalf("UnmarshalBestEffort() of mismatched values = %v, %v; want 2 reports", report, err)
+	}
+	if want := (ErrorEvent{ID: 1}); !reflect.DeepEqual(v, want) {
+		t.Errorf("UnmarshalBestEffort() of mismatched values = %+v; want %+v", v, want)
+	}
+
+	if report, err := easyjson.UnmarshalBestEffort([]byte(`{"id": "1", "at": }`), &v); len(report) != 1 || err == nil {
+		t.Errorf("UnmarshalBestEffort() of malformed input = %v, %v; want a report and an error", report, err)
+	}
+	if err := easyjson.Unmarshal([]byte(data), &v); err == nil {
+		t.Error("Unmarshal() succeeded; want error")
+	}
+}
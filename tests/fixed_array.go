@@ -0,0 +1,17 @@
+package tests
+
+//easyjson:json
+type FixedArrays struct {
+	Ints    [3]int            `json:"ints"`
+	Matrix  [2][2]int         `json:"matrix"`
+	Names   [2]*string        `json:"names"`
+	Items   [2]FixedArrayItem `json:"items"`
+	Maps    [1]map[string]int `json:"maps"`
+	Bytes   [4]byte           `json:"bytes"`
+	Pointer *[2]int           `json:"pointer"`
+	Empty   [0]int            `json:"empty"`
+}
+
+type FixedArrayItem struct {
+	ID int `json:"id"`
+}
@@ -0,0 +1,515 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjson2df20232DecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *FixedArrays) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "ints":
+					out.Ints = [3]int{}
+				case "matrix":
+					out.Matrix = [2][2]int{}
+				case "names":
+					out.Names = [2]*string{}
+				case "items":
+					out.Items = [2]FixedArrayItem{}
+				case "maps":
+					out.Maps = [1]map[string]int{}
+				case "bytes":
+					out.Bytes = [4]uint8{}
+				case "pointer":
+					out.Pointer = nil
+				case "empty":
+					out.Empty = [0]int{}
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ints":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				v1 := 0
+				for !in.IsDelim(']') {
+					if v1 < 3 {
+						(out.Ints)[v1] = int(in.Int())
+						v1++
+					} else {
+						in.SkipRecursive()
+					}
+					in.WantComma()
+				}
+				for ; v1 < 3; v1++ {
+					(out.Ints)[v1] = 0
+				}
+				in.Delim(']')
+			}
+		case "matrix":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				v2 := 0
+				for !in.IsDelim(']') {
+					if v2 < 2 {
+						if in.IsNull() {
+							in.Skip()
+						} else if !in.IsDelim('[') {
+							in.Delim('[')
+							in.Delim(']')
+						} else {
+							in.Delim('[')
+							v3 := 0
+							for !in.IsDelim(']') {
+								if v3 < 2 {
+									((out.Matrix)[v2])[v3] = int(in.Int())
+									v3++
+								} else {
+									in.SkipRecursive()
+								}
+								in.WantComma()
+							}
+							for ; v3 < 2; v3++ {
+								((out.Matrix)[v2])[v3] = 0
+							}
+							in.Delim(']')
+						}
+						v2++
+					} else {
+						in.SkipRecursive()
+					}
+					in.WantComma()
+				}
+				for ; v2 < 2; v2++ {
+					(out.Matrix)[v2] = [2]int{}
+				}
+				in.Delim(']')
+			}
+		case "names":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				v4 := 0
+				for !in.IsDelim(']') {
+					if v4 < 2 {
+						if in.IsNull() {
+							in.Skip()
+							(out.Names)[v4] = nil
+						} else {
+							if (out.Names)[v4] == nil {
+								(out.Names)[v4] = new(string)
+							}
+							*(out.Names)[v4] = string(in.String())
+						}
+						v4++
+					} else {
+						in.SkipRecursive()
+					}
+					in.WantComma()
+				}
+				for ; v4 < 2; v4++ {
+					(out.Names)[v4] = nil
+				}
+				in.Delim(']')
+			}
+		case "items":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				v5 := 0
+				for !in.IsDelim(']') {
+					if v5 < 2 {
+						easyjson2df20232DecodeGithubComMailruEasyjsonTests1(in, &(out.Items)[v5])
+						v5++
+					} else {
+						in.SkipRecursive()
+					}
+					in.WantComma()
+				}
+				for ; v5 < 2; v5++ {
+					(out.Items)[v5] = FixedArrayItem{}
+				}
+				in.Delim(']')
+			}
+		case "maps":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				v6 := 0
+				for !in.IsDelim(']') {
+					if v6 < 1 {
+						if in.IsNull() {
+							in.Skip()
+						} else if !in.IsDelim('{') {
+							in.Delim('{')
+							in.Delim('}')
+						} else {
+							in.Delim('{')
+							if !in.MergePatch || (out.Maps)[v6] == nil {
+								(out.Maps)[v6] = make(map[string]int)
+							}
+							for !in.IsDelim('}') {
+								in.MarkKey()
+								key := string(in.String())
+								in.WantColon()
+								v8 := in.ValueStart()
+								var v7 int
+								if in.MergePatch {
+									if in.IsNull() {
+										in.Skip()
+										if !in.KeepOnNull {
+											delete((out.Maps)[v6], key)
+										}
+										in.WantComma()
+										continue
+									}
+									v7 = ((out.Maps)[v6])[key]
+								}
+								v7 = int(in.Int())
+								if !in.ValueSkipped(v8) {
+									((out.Maps)[v6])[key] = v7
+								}
+								in.WantComma()
+							}
+							in.Delim('}')
+						}
+						v6++
+					} else {
+						in.SkipRecursive()
+					}
+					in.WantComma()
+				}
+				for ; v6 < 1; v6++ {
+					(out.Maps)[v6] = nil
+				}
+				in.Delim(']')
+			}
+		case "bytes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				for v9 := copy((out.Bytes)[:], in.Bytes()); v9 < 4; v9++ {
+					(out.Bytes)[v9] = 0
+				}
+			}
+		case "pointer":
+			if in.IsNull() {
+				in.Skip()
+				out.Pointer = nil
+			} else {
+				if out.Pointer == nil {
+					out.Pointer = new([2]int)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else if !in.IsDelim('[') {
+					in.Delim('[')
+					in.Delim(']')
+				} else {
+					in.Delim('[')
+					v10 := 0
+					for !in.IsDelim(']') {
+						if v10 < 2 {
+							(*out.Pointer)[v10] = int(in.Int())
+							v10++
+						} else {
+							in.SkipRecursive()
+						}
+						in.WantComma()
+					}
+					for ; v10 < 2; v10++ {
+						(*out.Pointer)[v10] = 0
+					}
+					in.Delim(']')
+				}
+			}
+		case "empty":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				v11 := 0
+				for !in.IsDelim(']') {
+					if v11 < 0 {
+						(out.Empty)[v11] = int(in.Int())
+						v11++
+					} else {
+						in.SkipRecursive()
+					}
+					in.WantComma()
+				}
+				for ; v11 < 0; v11++ {
+					(out.Empty)[v11] = 0
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2df20232EncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in FixedArrays) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ints\":"
+		out.RawString(prefix[1:])
+		out.RawByte('[')
+		for v12 := range in.Ints {
+			if v12 > 0 {
+				out.RawByte(',')
+			}
+			out.Int(int((in.Ints)[v12]))
+		}
+		out.RawByte(']')
+	}
+	{
+		const prefix string = ",\"matrix\":"
+		out.RawString(prefix)
+		out.RawByte('[')
+		for v13 := range in.Matrix {
+			if v13 > 0 {
+				out.RawByte(',')
+			}
+			out.RawByte('[')
+			for v14 := range (in.Matrix)[v13] {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(((in.Matrix)[v13])[v14]))
+			}
+			out.RawByte(']')
+		}
+		out.RawByte(']')
+	}
+	{
+		const prefix string = ",\"names\":"
+		out.RawString(prefix)
+		out.RawByte('[')
+		v16 := false
+		for v15 := range in.Names {
+			v17 := (in.Names)[v15]
+			if v17 == nil && out.SkipNilElem() {
+				continue
+			}
+			if v16 {
+				out.RawByte(',')
+			}
+			v16 = true
+			if v17 == nil {
+				out.RawString("null")
+			} else {
+				out.String(string(*v17))
+			}
+		}
+		out.RawByte(']')
+	}
+	{
+		const prefix string = ",\"items\":"
+		out.RawString(prefix)
+		out.RawByte('[')
+		for v18 := range in.Items {
+			if v18 > 0 {
+				out.RawByte(',')
+			}
+			easyjson2df20232EncodeGithubComMailruEasyjsonTests1(out, (in.Items)[v18])
+		}
+		out.RawByte(']')
+	}
+	{
+		const prefix string = ",\"maps\":"
+		out.RawString(prefix)
+		out.RawByte('[')
+		for v19 := range in.Maps {
+			if v19 > 0 {
+				out.RawByte(',')
+			}
+			if (in.Maps)[v19] == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+				out.RawString(`null`)
+			} else {
+				out.RawByte('{')
+				v20First := true
+				for v20Name, v20Value := range (in.Maps)[v19] {
+					if v20First {
+						v20First = false
+					} else {
+						out.RawByte(',')
+					}
+					out.String(string(v20Name))
+					out.RawByte(':')
+					out.Int(int(v20Value))
+				}
+				out.RawByte('}')
+			}
+		}
+		out.RawByte(']')
+	}
+	{
+		const prefix string = ",\"bytes\":"
+		out.RawString(prefix)
+		out.Base64Bytes(in.Bytes[:])
+	}
+	{
+		const prefix string = ",\"pointer\":"
+		out.RawString(prefix)
+		if in.Pointer == nil {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v22 := range *in.Pointer {
+				if v22 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int((*in.Pointer)[v22]))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"empty\":"
+		out.RawString(prefix)
+		out.RawByte('[')
+		for v23 := range in.Empty {
+			if v23 > 0 {
+				out.RawByte(',')
+			}
+			out.Int(int((in.Empty)[v23]))
+		}
+		out.RawByte(']')
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FixedArrays) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2df20232EncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FixedArrays) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2df20232EncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FixedArrays) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2df20232DecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FixedArrays) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2df20232DecodeGithubComMailruEasyjsonTests(l, v)
+}
+func easyjson2df20232DecodeGithubComMailruEasyjsonTests1(in *jlexer.Lexer, out *FixedArrayItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "id":
+					out.ID = 0
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2df20232EncodeGithubComMailruEasyjsonTests1(out *jwriter.Writer, in FixedArrayItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	out.RawByte('}')
+}
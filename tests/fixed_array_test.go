@@ -0,0 +1,53 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestFixedArrays(t *testing.T) {
+	a, b := "a", "b"
+	v := FixedArrays{
+		Ints:    [3]int{1, 2, 3},
+		Matrix:  [2][2]int{{1, 2}, {3, 4}},
+		Names:   [2]*string{&a, nil},
+		Items:   [2]FixedArrayItem{{ID: 1}, {ID: 2}},
+		Maps:    [1]map[string]int{{"x": 1}},
+		Bytes:   [4]byte{'a', 'b', 'c', 'd'},
+		Pointer: &[2]int{5, 6},
+	}
+	data := `{"ints":[1,2,3],"matrix":[[1,2],[3,4]],"names":["a",null],"items":[{"id":1},{"id":2}],` +
+		`"maps":[{"x":1}],"bytes":"YWJjZA==","pointer":[5,6],"empty":[]}`
+
+	got, err := easyjson.Marshal(v)
+	if err != nil || string(got) != data {
+		t.Errorf("Marshal() = %s, %v; want %s", got, err, data)
+	}
+
+	var decoded FixedArrays
+	if err := easyjson.Unmarshal([]byte(data), &decoded); err != nil || !reflect.DeepEqual(decoded, v) {
+		t.Errorf("Unmarshal(%s) = %+v, %v; want %+v", data, decoded, err, v)
+	}
+
+	// like encoding/json, extra elements are dropped and missing ones are zeroed
+	short := `{"ints":[7,8,9,10],"matrix":[[5],[6,7,8]],"names":["b"],"items":[{"id":3}],` +
+		`"maps":[],"bytes":"eHk=","pointer":[9],"empty":[1,2]}`
+	want := FixedArrays{
+		Ints:    [3]int{7, 8, 9},
+		Matrix:  [2][2]int{{5, 0}, {6, 7}},
+		Names:   [2]*string{&b, nil},
+		Items:   [2]FixedArrayItem{{ID: 3}, {}},
+		Bytes:   [4]byte{'x', 'y', 0, 0},
+		Pointer: &[2]int{9, 0},
+	}
+	if err := easyjson.Unmarshal([]byte(short), &decoded); err != nil || !reflect.DeepEqual(decoded, want) {
+		t.Errorf("Unmarshal(%s) = %+v, %v; want %+v", short, decoded, err, want)
+	}
+
+	// null leaves arrays unchanged
+	if err := easyjson.Unmarshal([]byte(`{"ints":null,"bytes":null}`), &decoded); err != nil || !reflect.DeepEqual(decoded, want) {
+		t.Errorf("Unmarshal() of nulls = %+v, %v; want %+v", decoded, err, want)
+	}
+}
@@ -0,0 +1,9 @@
+package tests
+
+//easyjson:json
+type FoldedKeys struct {
+	UserID   int    `json:"user_id"`
+	Name     string `json:"name"`
+	NickName string
+	Nick     string `json:"nickname"`
+}
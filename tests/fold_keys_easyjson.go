@@ -0,0 +1,136 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	strings "strings"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjsonD6b6d6aDecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *FoldedKeys) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		switch key {
+		case "user_id", "name", "NickName", "nickname":
+		default:
+			switch {
+			case strings.EqualFold(key, "user_id"):
+				key = "user_id"
+			case strings.EqualFold(key, "name"):
+				key = "name"
+			case strings.EqualFold(key, "NickName"):
+				key = "NickName"
+			case strings.EqualFold(key, "nickname"):
+				key = "nickname"
+			}
+		}
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "user_id":
+					out.UserID = 0
+				case "name":
+					out.Name = ""
+				case "NickName":
+					out.NickName = ""
+				case "nickname":
+					out.Nick = ""
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "name":
+			out.Name = string(in.String())
+		case "NickName":
+			out.NickName = string(in.String())
+		case "nickname":
+			out.Nick = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD6b6d6aEncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in FoldedKeys) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"NickName\":"
+		out.RawString(prefix)
+		out.String(string(in.NickName))
+	}
+	{
+		const prefix string = ",\"nickname\":"
+		out.RawString(prefix)
+		out.String(string(in.Nick))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FoldedKeys) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD6b6d6aEncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FoldedKeys) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD6b6d6aEncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FoldedKeys) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD6b6d6aDecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FoldedKeys) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD6b6d6aDecodeGithubComMailruEasyjsonTests(l, v)
+}
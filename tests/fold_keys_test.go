@@ -0,0 +1,25 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestFoldKeys(t *testing.T) {
+	for _, test := range []struct {
+		data string
+		want FoldedKeys
+	}{
+		{`{"user_id":1,"name":"a"}`, FoldedKeys{UserID: 1, Name: "a"}},
+		{`{"USER_ID":1,"Name":"a"}`, FoldedKeys{UserID: 1, Name: "a"}},
+		{`{"nickname":"a"}`, FoldedKeys{Nick: "a"}},
+		{`{"NICKNAME":"a"}`, FoldedKeys{NickName: "a"}},
+		{`{"user-id":1}`, FoldedKeys{}},
+	} {
+		var got FoldedKeys
+		if err := easyjson.Unmarshal([]byte(test.data), &got); err != nil || got != test.want {
+			t.Errorf("Unmarshal(%s) = %+v, %v; want %+v", test.data, got, err, test.want)
+		}
+	}
+}
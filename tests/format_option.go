@@ -0,0 +1,18 @@
+package tests
+
+import "time"
+
+//easyjson:json
+type FormatOptions struct {
+	Created  time.Time   `json:"created,format:RFC1123"`
+	Day      *time.Time  `json:"day,omitempty,format:DateOnly"`
+	Seen     []time.Time `json:"seen,format:unix"`
+	Written  time.Time   `json:"written,format:'2006-01-02,15:04'"`
+	Token    []byte      `json:"token,format:base64url"`
+	Hash     [4]byte     `json:"hash,format:base16"`
+	Raw      []byte      `json:"raw,format:array"`
+	Standard []byte      `json:"standard,format:base64"`
+	Micro    time.Time   `json:"micro,format:unixmicro"`
+	Nano     time.Time   `json:"nano,format:unixnano"`
+	Default  time.Time   `json:"default,format:unixnanos"` // not a format nor a layout, ignored
+}
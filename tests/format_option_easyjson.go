@@ -0,0 +1,305 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjson78b28655DecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *FormatOptions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "created":
+					out.Created = time.Time{}
+				case "day":
+					out.Day = nil
+				case "seen":
+					out.Seen = nil
+				case "written":
+					out.Written = time.Time{}
+				case "token":
+					out.Token = nil
+				case "hash":
+					out.Hash = [4]uint8{}
+				case "raw":
+					out.Raw = nil
+				case "standard":
+					out.Standard = nil
+				case "micro":
+					out.Micro = time.Time{}
+				case "nano":
+					out.Nano = time.Time{}
+				case "default":
+					out.Default = time.Time{}
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "created":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Created = in.Time("Mon, 02 Jan 2006 15:04:05 MST")
+			}
+		case "day":
+			if in.IsNull() {
+				in.Skip()
+				out.Day = nil
+			} else {
+				if out.Day == nil {
+					out.Day = new(time.Time)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					*out.Day = in.Time("2006-01-02")
+				}
+			}
+		case "seen":
+			if in.IsNull() {
+				in.Skip()
+				out.Seen = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Seen == nil {
+					if !in.IsDelim(']') {
+						out.Seen = make([]time.Time, 0, 2)
+					} else {
+						out.Seen = []time.Time{}
+					}
+				} else {
+					out.Seen = (out.Seen)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 time.Time
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v1 = in.UnixTime()
+					}
+					out.Seen = append(out.Seen, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "written":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Written = in.Time("2006-01-02,15:04")
+			}
+		case "token":
+			if in.IsNull() {
+				in.Skip()
+				out.Token = nil
+			} else {
+				out.Token = in.BytesBase64URL()
+			}
+		case "hash":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				for v3 := copy((out.Hash)[:], in.BytesBase16()); v3 < 4; v3++ {
+					(out.Hash)[v3] = 0
+				}
+			}
+		case "raw":
+			if in.IsNull() {
+				in.Skip()
+				out.Raw = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Raw == nil {
+					if !in.IsDelim(']') {
+						out.Raw = make([]uint8, 0, 64)
+					} else {
+						out.Raw = []uint8{}
+					}
+				} else {
+					out.Raw = (out.Raw)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 uint8
+					v4 = uint8(in.Uint8())
+					out.Raw = append(out.Raw, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "standard":
+			if in.IsNull() {
+				in.Skip()
+				out.Standard = nil
+			} else {
+				out.Standard = in.Bytes()
+			}
+		case "micro":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Micro = in.UnixMicroTime()
+			}
+		case "nano":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Nano = in.UnixNanoTime()
+			}
+		case "default":
+			if data := in.Raw(); in.Ok() {
+				in.AddValueError((out.Default).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson78b28655EncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in FormatOptions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"created\":"
+		out.RawString(prefix[1:])
+		out.Time(in.Created, "Mon, 02 Jan 2006 15:04:05 MST")
+	}
+	if in.Day != nil {
+		const prefix string = ",\"day\":"
+		out.RawString(prefix)
+		out.Time(*in.Day, "2006-01-02")
+	}
+	{
+		const prefix string = ",\"seen\":"
+		out.RawString(prefix)
+		if in.Seen == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v6, v7 := range in.Seen {
+				if v6 > 0 {
+					out.RawByte(',')
+				}
+				out.UnixTime(v7)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"written\":"
+		out.RawString(prefix)
+		out.Time(in.Written, "2006-01-02,15:04")
+	}
+	{
+		const prefix string = ",\"token\":"
+		out.RawString(prefix)
+		out.Base64URLBytes(in.Token)
+	}
+	{
+		const prefix string = ",\"hash\":"
+		out.RawString(prefix)
+		out.Base16Bytes((in.Hash)[:])
+	}
+	{
+		const prefix string = ",\"raw\":"
+		out.RawString(prefix)
+		if in.Raw == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v11, v12 := range in.Raw {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint8(uint8(v12))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"standard\":"
+		out.RawString(prefix)
+		out.Base64Bytes(in.Standard)
+	}
+	{
+		const prefix string = ",\"micro\":"
+		out.RawString(prefix)
+		out.UnixMicroTime(in.Micro)
+	}
+	{
+		const prefix string = ",\"nano\":"
+		out.RawString(prefix)
+		out.UnixNanoTime(in.Nano)
+	}
+	{
+		const prefix string = ",\"default\":"
+		out.RawString(prefix)
+		out.Raw((in.Default).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FormatOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson78b28655EncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FormatOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson78b28655EncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FormatOptions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson78b28655DecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FormatOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson78b28655DecodeGithubComMailruEasyjsonTests(l, v)
+}
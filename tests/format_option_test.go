@@ -0,0 +1,50 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestFormatOptions(t *testing.T) {
+	day := time.Date(2024, 3, 9, 0, 0, 0, 0, time.UTC)
+	v := FormatOptions{
+		Created:  time.Date(2024, 3, 9, 10, 30, 0, 0, time.UTC),
+		Day:      &day,
+		Seen:     []time.Time{time.Unix(1700000000, 0).UTC()},
+		Written:  day,
+		Token:    []byte{0xfb, 0xff},
+		Hash:     [4]byte{0xde, 0xad, 0, 0x01},
+		Raw:      []byte{1, 2},
+		Standard: []byte{0xfb, 0xff},
+		Micro:    time.Unix(1700000000, 123456000).UTC(),
+		Nano:     time.Unix(1700000000, 123456789).UTC(),
+		Default:  day,
+	}
+	data := `{"created":"Sat, 09 Mar 2024 10:30:00 UTC","day":"2024-03-09","seen":[1700000000],` +
+		`"written":"2024-03-09,00:00","token":"-_8=","hash":"dead0001","raw":[1,2],"standard":"+/8=",` +
+		`"micro":1700000000123456,"nano":1700000000123456789,"default":"2024-03-09T00:00:00Z"}`
+
+	got, err := easyjson.Marshal(v)
+	if err != nil || string(got) != data {
+		t.Errorf("Marshal() = %s, %v; want %s", got, err, data)
+	}
+
+	var decoded FormatOptions
+	if err := easyjson.Unmarshal([]byte(data), &decoded); err != nil || !reflect.DeepEqual(decoded, v) {
+		t.Errorf("Unmarshal(%s) = %+v, %v; want %+v", data, decoded, err, v)
+	}
+
+	// encoding/json/v2 writes the fractions of the Unix times.
+	var v2 FormatOptions
+	if err := easyjson.Unmarshal([]byte(`{"seen":[1700000000.5],"micro":1700000000123456.789}`), &v2); err != nil ||
+		len(v2.Seen) != 1 || !v2.Seen[0].Equal(time.Unix(1700000000, 5e8)) || !v2.Micro.Equal(time.Unix(1700000000, 123456789)) {
+		t.Errorf("Unmarshal() of fractional Unix times = %v, %v, %v", v2.Seen, v2.Micro, err)
+	}
+
+	if err := easyjson.Unmarshal([]byte(`{"token":"+/8="}`), &decoded); err == nil {
+		t.Errorf("Unmarshal() of a token with the standard base64 alphabet succeeded; want an error")
+	}
+}
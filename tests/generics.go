@@ -0,0 +1,30 @@
+//go:build go1.18
+// +build go1.18
+
+package tests
+
+//easyjson:gen GenericPage[GenericItem] GenericPair[string, []int]
+
+//easyjson:json
+type GenericPage[T any] struct {
+	Items []T             `json:"items"`
+	Total int             `json:"total"`
+	Next  *GenericPage[T] `json:"next,omitempty"`
+}
+
+//easyjson:json
+type GenericPair[K comparable, V any] struct {
+	Key   K                `json:"key"`
+	Value V                `json:"value"`
+	Meta  GenericMeta[V]   `json:"meta"`
+	Ints  GenericPage[int] `json:"ints"`
+}
+
+type GenericMeta[V any] struct {
+	Default V            `json:"default"`
+	Values  map[string]V `json:"values,omitempty"`
+}
+
+type GenericItem struct {
+	Name string `json:"name"`
+}
@@ -0,0 +1,902 @@
+//go:build go1.18
+// +build go1.18
+
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjson29d7d13aDecodeGithubComMailruEasyjsonTestsGenericPairStringInt(in *jlexer.Lexer, out *GenericPair[string, []int]) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "key":
+					out.Key = ""
+				case "value":
+					out.Value = nil
+				case "meta":
+					out.Meta = GenericMeta[[]int]{}
+				case "ints":
+					out.Ints = GenericPage[int]{}
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "key":
+			out.Key = string(in.String())
+		case "value":
+			if in.IsNull() {
+				in.Skip()
+				out.Value = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Value == nil {
+					if !in.IsDelim(']') {
+						out.Value = make([]int, 0, 8)
+					} else {
+						out.Value = []int{}
+					}
+				} else {
+					out.Value = (out.Value)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 int
+					v1 = int(in.Int())
+					out.Value = append(out.Value, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "meta":
+			easyjson29d7d13aDecodeGithubComMailruEasyjsonTestsGenericMetaInt(in, &out.Meta)
+		case "ints":
+			(out.Ints).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson29d7d13aEncodeGithubComMailruEasyjsonTestsGenericPairStringInt(out *jwriter.Writer, in GenericPair[string, []int]) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"key\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Key))
+	}
+	{
+		const prefix string = ",\"value\":"
+		out.RawString(prefix)
+		if in.Value == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Value {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v3))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"meta\":"
+		out.RawString(prefix)
+		easyjson29d7d13aEncodeGithubComMailruEasyjsonTestsGenericMetaInt(out, in.Meta)
+	}
+	{
+		const prefix string = ",\"ints\":"
+		out.RawString(prefix)
+		(in.Ints).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+func easyjson29d7d13aDecodeGithubComMailruEasyjsonTestsGenericMetaInt(in *jlexer.Lexer, out *GenericMeta[[]int]) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "default":
+					out.Default = nil
+				case "values":
+					out.Values = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "default":
+			if in.IsNull() {
+				in.Skip()
+				out.Default = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Default == nil {
+					if !in.IsDelim(']') {
+						out.Default = make([]int, 0, 8)
+					} else {
+						out.Default = []int{}
+					}
+				} else {
+					out.Default = (out.Default)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 int
+					v4 = int(in.Int())
+					out.Default = append(out.Default, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "values":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.Values == nil {
+					if !in.IsDelim('}') {
+						out.Values = make(map[string][]int)
+					} else {
+						out.Values = nil
+					}
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := string(in.String())
+					in.WantColon()
+					v6 := in.ValueStart()
+					var v5 []int
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.Values, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v5 = (out.Values)[key]
+					}
+					if in.IsNull() {
+						in.Skip()
+						v5 = nil
+					} else if !in.IsDelim('[') {
+						in.Delim('[')
+						in.Delim(']')
+					} else {
+						in.Delim('[')
+						if v5 == nil {
+							if !in.IsDelim(']') {
+								v5 = make([]int, 0, 8)
+							} else {
+								v5 = []int{}
+							}
+						} else {
+							v5 = (v5)[:0]
+						}
+						for !in.IsDelim(']') {
+							var v7 int
+							v7 = int(in.Int())
+							v5 = append(v5, v7)
+							in.WantComma()
+						}
+						in.Delim(']')
+					}
+					if !in.ValueSkipped(v6) {
+						(out.Values)[key] = v5
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson29d7d13aEncodeGithubComMailruEasyjsonTestsGenericMetaInt(out *jwriter.Writer, in GenericMeta[[]int]) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"default\":"
+		out.RawString(prefix[1:])
+		if in.Default == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.Default {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v9))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Values) != 0 {
+		const prefix string = ",\"values\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v10First := true
+			for v10Name, v10Value := range in.Values {
+				if v10First {
+					v10First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v10Name))
+				out.RawByte(':')
+				if v10Value == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+					out.RawString("null")
+				} else {
+					out.RawByte('[')
+					for v11, v12 := range v10Value {
+						if v11 > 0 {
+							out.RawByte(',')
+						}
+						out.Int(int(v12))
+					}
+					out.RawByte(']')
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjson29d7d13aDecodeGithubComMailruEasyjsonTestsGenericPageGithubComMailruEasyjsonTestsGenericItem(in *jlexer.Lexer, out *GenericPage[GenericItem]) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "items":
+					out.Items = nil
+				case "total":
+					out.Total = 0
+				case "next":
+					out.Next = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "items":
+			if in.IsNull() {
+				in.Skip()
+				out.Items = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Items == nil {
+					if !in.IsDelim(']') {
+						out.Items = make([]GenericItem, 0, 4)
+					} else {
+						out.Items = []GenericItem{}
+					}
+				} else {
+					out.Items = (out.Items)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v13 GenericItem
+					easyjson29d7d13aDecodeGithubComMailruEasyjsonTests(in, &v13)
+					out.Items = append(out.Items, v13)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "total":
+			out.Total = int(in.Int())
+		case "next":
+			if in.IsNull() {
+				in.Skip()
+				out.Next = nil
+			} else {
+				if out.Next == nil {
+					out.Next = new(GenericPage[GenericItem])
+				}
+				easyjson29d7d13aDecodeGithubComMailruEasyjsonTestsGenericPageGithubComMailruEasyjsonTestsGenericItem(in, out.Next)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson29d7d13aEncodeGithubComMailruEasyjsonTestsGenericPageGithubComMailruEasyjsonTestsGenericItem(out *jwriter.Writer, in GenericPage[GenericItem]) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"items\":"
+		out.RawString(prefix[1:])
+		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v14, v15 := range in.Items {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				easyjson29d7d13aEncodeGithubComMailruEasyjsonTests(out, v15)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.Int(int(in.Total))
+	}
+	if in.Next != nil {
+		const prefix string = ",\"next\":"
+		out.RawString(prefix)
+		easyjson29d7d13aEncodeGithubComMailruEasyjsonTestsGenericPageGithubComMailruEasyjsonTestsGenericItem(out, *in.Next)
+	}
+	out.RawByte('}')
+}
+func easyjson29d7d13aDecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *GenericItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "name":
+					out.Name = ""
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson29d7d13aEncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in GenericItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	out.RawByte('}')
+}
+func easyjson29d7d13aDecodeGithubComMailruEasyjsonTestsGenericPairEasyjsonT1EasyjsonT2[easyjsonT1 comparable, easyjsonT2 any](in *jlexer.Lexer, out *GenericPair[easyjsonT1, easyjsonT2]) {
+	switch out := interface{}(out).(type) {
+	case *GenericPair[string, []int]:
+		easyjson29d7d13aDecodeGithubComMailruEasyjsonTestsGenericPairStringInt(in, out)
+		return
+	}
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "key":
+					out.Key = *new(easyjsonT1)
+				case "value":
+					out.Value = *new(easyjsonT2)
+				case "meta":
+					out.Meta = GenericMeta[easyjsonT2]{}
+				case "ints":
+					out.Ints = GenericPage[int]{}
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "key":
+			if m, ok := interface{}(&out.Key).(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := interface{}(&out.Key).(json.Unmarshaler); ok {
+				if data := in.Raw(); in.Ok() {
+					in.AddError(m.UnmarshalJSON(data))
+				}
+			} else if data := in.Raw(); in.Ok() {
+				in.AddError(json.Unmarshal(data, &out.Key))
+			}
+		case "value":
+			if m, ok := interface{}(&out.Value).(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := interface{}(&out.Value).(json.Unmarshaler); ok {
+				if data := in.Raw(); in.Ok() {
+					in.AddError(m.UnmarshalJSON(data))
+				}
+			} else if data := in.Raw(); in.Ok() {
+				in.AddError(json.Unmarshal(data, &out.Value))
+			}
+		case "meta":
+			easyjson29d7d13aDecodeGithubComMailruEasyjsonTestsGenericMetaEasyjsonT2(in, &out.Meta)
+		case "ints":
+			(out.Ints).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson29d7d13aEncodeGithubComMailruEasyjsonTestsGenericPairEasyjsonT1EasyjsonT2[easyjsonT1 comparable, easyjsonT2 any](out *jwriter.Writer, in GenericPair[easyjsonT1, easyjsonT2]) {
+	switch in := interface{}(&in).(type) {
+	case *GenericPair[string, []int]:
+		easyjson29d7d13aEncodeGithubComMailruEasyjsonTestsGenericPairStringInt(out, *in)
+		return
+	}
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"key\":"
+		out.RawString(prefix[1:])
+		if m, ok := interface{}(in.Key).(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := interface{}(in.Key).(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(interface{}(in.Key)))
+		}
+	}
+	{
+		const prefix string = ",\"value\":"
+		out.RawString(prefix)
+		if m, ok := interface{}(in.Value).(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := interface{}(in.Value).(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(interface{}(in.Value)))
+		}
+	}
+	{
+		const prefix string = ",\"meta\":"
+		out.RawString(prefix)
+		easyjson29d7d13aEncodeGithubComMailruEasyjsonTestsGenericMetaEasyjsonT2(out, in.Meta)
+	}
+	{
+		const prefix string = ",\"ints\":"
+		out.RawString(prefix)
+		(in.Ints).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GenericPair[easyjsonT1, easyjsonT2]) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson29d7d13aEncodeGithubComMailruEasyjsonTestsGenericPairEasyjsonT1EasyjsonT2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v GenericPair[easyjsonT1, easyjsonT2]) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson29d7d13aEncodeGithubComMailruEasyjsonTestsGenericPairEasyjsonT1EasyjsonT2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GenericPair[easyjsonT1, easyjsonT2]) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson29d7d13aDecodeGithubComMailruEasyjsonTestsGenericPairEasyjsonT1EasyjsonT2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *GenericPair[easyjsonT1, easyjsonT2]) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson29d7d13aDecodeGithubComMailruEasyjsonTestsGenericPairEasyjsonT1EasyjsonT2(l, v)
+}
+func easyjson29d7d13aDecodeGithubComMailruEasyjsonTestsGenericMetaEasyjsonT2[easyjsonT2 any](in *jlexer.Lexer, out *GenericMeta[easyjsonT2]) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "default":
+					out.Default = *new(easyjsonT2)
+				case "values":
+					out.Values = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "default":
+			if m, ok := interface{}(&out.Default).(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := interface{}(&out.Default).(json.Unmarshaler); ok {
+				if data := in.Raw(); in.Ok() {
+					in.AddError(m.UnmarshalJSON(data))
+				}
+			} else if data := in.Raw(); in.Ok() {
+				in.AddError(json.Unmarshal(data, &out.Default))
+			}
+		case "values":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.Values == nil {
+					if !in.IsDelim('}') {
+						out.Values = make(map[string]easyjsonT2)
+					} else {
+						out.Values = nil
+					}
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := string(in.String())
+					in.WantColon()
+					v17 := in.ValueStart()
+					var v16 easyjsonT2
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.Values, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v16 = (out.Values)[key]
+					}
+					if m, ok := interface{}(&v16).(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := interface{}(&v16).(json.Unmarshaler); ok {
+						if data := in.Raw(); in.Ok() {
+							in.AddError(m.UnmarshalJSON(data))
+						}
+					} else if data := in.Raw(); in.Ok() {
+						in.AddError(json.Unmarshal(data, &v16))
+					}
+					if !in.ValueSkipped(v17) {
+						(out.Values)[key] = v16
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson29d7d13aEncodeGithubComMailruEasyjsonTestsGenericMetaEasyjsonT2[easyjsonT2 any](out *jwriter.Writer, in GenericMeta[easyjsonT2]) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"default\":"
+		out.RawString(prefix[1:])
+		if m, ok := interface{}(in.Default).(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := interface{}(in.Default).(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(interface{}(in.Default)))
+		}
+	}
+	if len(in.Values) != 0 {
+		const prefix string = ",\"values\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v18First := true
+			for v18Name, v18Value := range in.Values {
+				if v18First {
+					v18First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v18Name))
+				out.RawByte(':')
+				if m, ok := interface{}(v18Value).(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := interface{}(v18Value).(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(interface{}(v18Value)))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjson29d7d13aDecodeGithubComMailruEasyjsonTestsGenericPageEasyjsonT0[easyjsonT0 any](in *jlexer.Lexer, out *GenericPage[easyjsonT0]) {
+	switch out := interface{}(out).(type) {
+	case *GenericPage[GenericItem]:
+		easyjson29d7d13aDecodeGithubComMailruEasyjsonTestsGenericPageGithubComMailruEasyjsonTestsGenericItem(in, out)
+		return
+	}
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "items":
+					out.Items = nil
+				case "total":
+					out.Total = 0
+				case "next":
+					out.Next = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "items":
+			if in.IsNull() {
+				in.Skip()
+				out.Items = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Items == nil {
+					if !in.IsDelim(']') {
+						out.Items = make([]easyjsonT0, 0, 1)
+					} else {
+						out.Items = []easyjsonT0{}
+					}
+				} else {
+					out.Items = (out.Items)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v19 easyjsonT0
+					if m, ok := interface{}(&v19).(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := interface{}(&v19).(json.Unmarshaler); ok {
+						if data := in.Raw(); in.Ok() {
+							in.AddError(m.UnmarshalJSON(data))
+						}
+					} else if data := in.Raw(); in.Ok() {
+						in.AddError(json.Unmarshal(data, &v19))
+					}
+					out.Items = append(out.Items, v19)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "total":
+			out.Total = int(in.Int())
+		case "next":
+			if in.IsNull() {
+				in.Skip()
+				out.Next = nil
+			} else {
+				if out.Next == nil {
+					out.Next = new(GenericPage[easyjsonT0])
+				}
+				easyjson29d7d13aDecodeGithubComMailruEasyjsonTestsGenericPageEasyjsonT0(in, out.Next)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson29d7d13aEncodeGithubComMailruEasyjsonTestsGenericPageEasyjsonT0[easyjsonT0 any](out *jwriter.Writer, in GenericPage[easyjsonT0]) {
+	switch in := interface{}(&in).(type) {
+	case *GenericPage[GenericItem]:
+		easyjson29d7d13aEncodeGithubComMailruEasyjsonTestsGenericPageGithubComMailruEasyjsonTestsGenericItem(out, *in)
+		return
+	}
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"items\":"
+		out.RawString(prefix[1:])
+		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v20, v21 := range in.Items {
+				if v20 > 0 {
+					out.RawByte(',')
+				}
+				if m, ok := interface{}(v21).(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := interface{}(v21).(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(interface{}(v21)))
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.Int(int(in.Total))
+	}
+	if in.Next != nil {
+		const prefix string = ",\"next\":"
+		out.RawString(prefix)
+		easyjson29d7d13aEncodeGithubComMailruEasyjsonTestsGenericPageEasyjsonT0(out, *in.Next)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GenericPage[easyjsonT0]) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson29d7d13aEncodeGithubComMailruEasyjsonTestsGenericPageEasyjsonT0(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v GenericPage[easyjsonT0]) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson29d7d13aEncodeGithubComMailruEasyjsonTestsGenericPageEasyjsonT0(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GenericPage[easyjsonT0]) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson29d7d13aDecodeGithubComMailruEasyjsonTestsGenericPageEasyjsonT0(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *GenericPage[easyjsonT0]) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson29d7d13aDecodeGithubComMailruEasyjsonTestsGenericPageEasyjsonT0(l, v)
+}
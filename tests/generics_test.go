@@ -0,0 +1,95 @@
+//go:build go1.18
+// +build go1.18
+
+package tests
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestGenericMarshal(t *testing.T) {
+	v := GenericPage[GenericItem]{
+		Items: []GenericItem{{Name: "a"}, {Name: "b"}},
+		Total: 3,
+		Next:  &GenericPage[GenericItem]{Items: []GenericItem{{Name: "c"}}, Total: 3},
+	}
+	want := `{"items":[{"name":"a"},{"name":"b"}],"total":3,"next":{"items":[{"name":"c"}],"total":3}}`
+
+	data, err := easyjson.Marshal(v)
+	if err != nil || string(data) != want {
+		t.Errorf("Marshal() = %s, %v; want %s", data, err, want)
+	}
+	if std, err := json.Marshal(v); err != nil || string(std) != want {
+		t.Errorf("json.Marshal() = %s, %v; want %s", std, err, want)
+	}
+}
+
+func TestGenericUnmarshal(t *testing.T) {
+	in := `{"key":"k","value":[1,2],"meta":{"default":[3],"values":{"x":[4]}},"ints":{"items":[5],"total":1}}`
+
+	var v GenericPair[string, []int]
+	if err := easyjson.Unmarshal([]byte(in), &v); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	want := GenericPair[string, []int]{
+		Key:   "k",
+		Value: []int{1, 2},
+		Meta:  GenericMeta[[]int]{Default: []int{3}, Values: map[string][]int{"x": {4}}},
+		Ints:  GenericPage[int]{Items: []int{5}, Total: 1},
+	}
+	if !reflect.DeepEqual(v, want) {
+		t.Errorf("Unmarshal() = %+v; want %+v", v, want)
+	}
+
+	data, err := easyjson.Marshal(v)
+	if err != nil || string(data) != in {
+		t.Errorf("Marshal() = %s, %v; want %s", data, err, in)
+	}
+}
+
+func TestGenericInstanceUnmarshal(t *testing.T) {
+	in := `{"items":[{"name":"a"}],"total":2,"next":{"items":[{"name":"b"}],"total":2}}`
+
+	var v GenericPage[GenericItem]
+	if err := easyjson.Unmarshal([]byte(in), &v); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	want := GenericPage[GenericItem]{
+		Items: []GenericItem{{Name: "a"}},
+		Total: 2,
+		Next:  &GenericPage[GenericItem]{Items: []GenericItem{{Name: "b"}}, Total: 2},
+	}
+	if !reflect.DeepEqual(v, want) {
+		t.Errorf("Unmarshal() = %+v; want %+v", v, want)
+	}
+
+	for _, tc := range []struct {
+		v    easyjson.Marshaler
+		want string
+	}{
+		{v: want, want: in},
+		{
+			v:    GenericPair[string, []int]{Key: "k", Value: []int{1}, Meta: GenericMeta[[]int]{Values: map[string][]int{"x": nil}}},
+			want: `{"key":"k","value":[1],"meta":{"default":null,"values":{"x":null}},"ints":{"items":null,"total":0}}`,
+		},
+	} {
+		data, err := easyjson.Marshal(tc.v)
+		if err != nil || string(data) != tc.want {
+			t.Errorf("Marshal(%T) = %s, %v; want %s", tc.v, data, err, tc.want)
+		}
+		if std, err := json.Marshal(tc.v); err != nil || string(std) != tc.want {
+			t.Errorf("json.Marshal(%T) = %s, %v; want %s", tc.v, std, err, tc.want)
+		}
+	}
+}
+
+func TestGenericUnmarshalError(t *testing.T) {
+	var v GenericPage[int]
+	if err := easyjson.Unmarshal([]byte(`{"items":["a"]}`), &v); err == nil {
+		t.Error("Unmarshal() of a string into an int type argument did not fail")
+	}
+}
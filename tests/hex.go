@@ -0,0 +1,13 @@
+package tests
+
+//easyjson:json
+type HexStruct struct {
+	Nonce   uint64     `json:"nonce" easyjson:"hex"`
+	Gas     *uint      `json:"gas,omitempty" easyjson:"hex"`
+	Hash    [4]byte    `json:"hash" easyjson:"hex"`
+	Data    []byte     `json:"data" easyjson:"hex"`
+	Address HexAddress `json:"address" easyjson:"hex"`
+	Plain   uint64     `json:"plain"`
+}
+
+type HexAddress [2]byte
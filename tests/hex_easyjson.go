@@ -0,0 +1,160 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjsonB0330479DecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *HexStruct) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "nonce":
+					out.Nonce = 0
+				case "gas":
+					out.Gas = nil
+				case "hash":
+					out.Hash = [4]uint8{}
+				case "data":
+					out.Data = nil
+				case "address":
+					out.Address = HexAddress{}
+				case "plain":
+					out.Plain = 0
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "nonce":
+			out.Nonce = uint64(in.Uint64Hex())
+		case "gas":
+			if in.IsNull() {
+				in.Skip()
+				out.Gas = nil
+			} else {
+				if out.Gas == nil {
+					out.Gas = new(uint)
+				}
+				*out.Gas = uint(in.Uint64Hex())
+			}
+		case "hash":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.BytesHexInto((out.Hash)[:])
+			}
+		case "data":
+			if in.IsNull() {
+				in.Skip()
+				out.Data = nil
+			} else {
+				out.Data = []uint8(in.BytesHex())
+			}
+		case "address":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.BytesHexInto((out.Address)[:])
+			}
+		case "plain":
+			out.Plain = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonB0330479EncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in HexStruct) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"nonce\":"
+		out.RawString(prefix[1:])
+		out.Uint64Hex(uint64(in.Nonce))
+	}
+	if in.Gas != nil {
+		const prefix string = ",\"gas\":"
+		out.RawString(prefix)
+		out.Uint64Hex(uint64(*in.Gas))
+	}
+	{
+		const prefix string = ",\"hash\":"
+		out.RawString(prefix)
+		out.RawStringHex((in.Hash)[:])
+	}
+	{
+		const prefix string = ",\"data\":"
+		out.RawString(prefix)
+		out.RawStringHex(in.Data)
+	}
+	{
+		const prefix string = ",\"address\":"
+		out.RawString(prefix)
+		out.RawStringHex((in.Address)[:])
+	}
+	{
+		const prefix string = ",\"plain\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Plain))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HexStruct) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonB0330479EncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HexStruct) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonB0330479EncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HexStruct) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonB0330479DecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HexStruct) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonB0330479DecodeGithubComMailruEasyjsonTests(l, v)
+}
@@ -0,0 +1,50 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestHexMarshal(t *testing.T) {
+	gas := uint(0x5208)
+	v := HexStruct{
+		Nonce:   0x1f,
+		Gas:     &gas,
+		Hash:    [4]byte{0, 1, 0xab, 0xff},
+		Address: HexAddress{0xde, 0xad},
+		Plain:   31,
+	}
+	want := `{"nonce":"0x1f","gas":"0x5208","hash":"0x0001abff","data":null,"address":"0xdead","plain":31}`
+
+	data, err := easyjson.Marshal(v)
+	if err != nil || string(data) != want {
+		t.Errorf("Marshal() = %s, %v; want %s", data, err, want)
+	}
+
+	var got HexStruct
+	if err := easyjson.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	if !reflect.DeepEqual(got, v) {
+		t.Errorf("Unmarshal() = %+v; want %+v", got, v)
+	}
+}
+
+func TestHexUnmarshalErrors(t *testing.T) {
+	for _, in := range []string{
+		`{"nonce":"1f"}`,
+		`{"nonce":31}`,
+		`{"data":"0xabc"}`,
+		`{"hash":"0xzz"}`,
+		`{"hash":"0x00"}`,
+		`{"hash":"0x0001020304"}`,
+		`{"address":"0xdeadbeef"}`,
+	} {
+		var v HexStruct
+		if err := easyjson.Unmarshal([]byte(in), &v); err == nil {
+			t.Errorf("Unmarshal(%s) did not fail", in)
+		}
+	}
+}
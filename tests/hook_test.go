@@ -0,0 +1,44 @@
+package tests
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/mailru/easyjson/jlexer"
+)
+
+func TestHookMapKeys(t *testing.T) {
+	var got []string
+	l := jlexer.Lexer{
+		Data: []byte(`{"id":1,"labels":{"a":1},"by_id":{"2":"b"}}`),
+		Hook: jlexer.HookFunc(func(kind jlexer.EventKind, offset int, data []byte) {
+			if kind == jlexer.EventKey || kind == jlexer.EventValue {
+				got = append(got, fmt.Sprintf("%v:%s", kind, data))
+			}
+		}),
+	}
+	var v ValidateRequest
+	v.UnmarshalEasyJSON(&l)
+	if err := l.Error(); err != nil {
+		t.Fatalf("UnmarshalEasyJSON() error: %v", err)
+	}
+
+	want := []string{
+		`Key:"id"`, `Value:1`,
+		`Key:"labels"`, `Key:"a"`, `Value:1`,
+		`Key:"by_id"`, `Key:"2"`, `Value:"b"`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got events %q; want %q", got, want)
+	}
+
+	got = nil
+	l = jlexer.Lexer{Data: l.Data, Hook: l.Hook}
+	if err := (*ValidateRequest)(nil).ValidateEasyJSON(&l); err != nil {
+		t.Fatalf("ValidateEasyJSON() error: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got validation events %q; want %q", got, want)
+	}
+}
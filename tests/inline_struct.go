@@ -0,0 +1,32 @@
+package tests
+
+import "time"
+
+//easyjson:json
+type InlineStructs struct {
+	Meta struct {
+		ID      int    `json:"id"`
+		Comment string `json:"comment,omitempty"`
+		hidden  int
+		Nested  struct {
+			Tags []string `json:"tags"`
+		} `json:"nested"`
+	} `json:"meta"`
+	Window *struct {
+		From time.Time `json:"from"`
+		To   time.Time `json:"to"`
+	} `json:"window,omitempty"`
+	Labels map[string]struct {
+		Value string `json:"value"`
+	} `json:"labels"`
+	Points []struct{ X, Y int } `json:"points"`
+	Empty  struct{}             `json:"empty"`
+	Embeds struct {
+		InlineStructBase
+		Extra int `json:"extra"`
+	} `json:"embeds"`
+}
+
+type InlineStructBase struct {
+	Base string `json:"base"`
+}
@@ -0,0 +1,708 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjson8a467d53DecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *InlineStructs) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "meta":
+					out.Meta = struct {
+						ID      int    `json:"id"`
+						Comment string `json:"comment,omitempty"`
+						hidden  int
+						Nested  struct {
+							Tags []string `json:"tags"`
+						} `json:"nested"`
+					}{}
+				case "window":
+					out.Window = nil
+				case "labels":
+					out.Labels = nil
+				case "points":
+					out.Points = nil
+				case "empty":
+					out.Empty = struct{}{}
+				case "embeds":
+					out.Embeds = struct {
+						InlineStructBase
+						Extra int `json:"extra"`
+					}{}
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "meta":
+			easyjson8a467d53Decode(in, &out.Meta)
+		case "window":
+			if in.IsNull() {
+				in.Skip()
+				out.Window = nil
+			} else {
+				if out.Window == nil {
+					out.Window = new(struct {
+						From time.Time `json:"from"`
+						To   time.Time `json:"to"`
+					})
+				}
+				easyjson8a467d53Decode1(in, out.Window)
+			}
+		case "labels":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.Labels == nil {
+					out.Labels = make(map[string]struct {
+						Value string `json:"value"`
+					})
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := string(in.String())
+					in.WantColon()
+					v2 := in.ValueStart()
+					var v1 struct {
+						Value string `json:"value"`
+					}
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.Labels, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v1 = (out.Labels)[key]
+					}
+					easyjson8a467d53Decode2(in, &v1)
+					if !in.ValueSkipped(v2) {
+						(out.Labels)[key] = v1
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "points":
+			if in.IsNull() {
+				in.Skip()
+				out.Points = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Points == nil {
+					if !in.IsDelim(']') {
+						out.Points = make([]struct {
+							X int
+							Y int
+						}, 0, 4)
+					} else {
+						out.Points = []struct {
+							X int
+							Y int
+						}{}
+					}
+				} else {
+					out.Points = (out.Points)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 struct {
+						X int
+						Y int
+					}
+					easyjson8a467d53Decode3(in, &v3)
+					out.Points = append(out.Points, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "empty":
+			easyjson8a467d53Decode4(in, &out.Empty)
+		case "embeds":
+			easyjson8a467d53Decode5(in, &out.Embeds)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson8a467d53EncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in InlineStructs) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"meta\":"
+		out.RawString(prefix[1:])
+		easyjson8a467d53Encode(out, in.Meta)
+	}
+	if in.Window != nil {
+		const prefix string = ",\"window\":"
+		out.RawString(prefix)
+		easyjson8a467d53Encode1(out, *in.Window)
+	}
+	{
+		const prefix string = ",\"labels\":"
+		out.RawString(prefix)
+		if in.Labels == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v4First := true
+			for v4Name, v4Value := range in.Labels {
+				if v4First {
+					v4First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v4Name))
+				out.RawByte(':')
+				easyjson8a467d53Encode2(out, v4Value)
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"points\":"
+		out.RawString(prefix)
+		if in.Points == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Points {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				easyjson8a467d53Encode3(out, v6)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"empty\":"
+		out.RawString(prefix)
+		easyjson8a467d53Encode4(out, in.Empty)
+	}
+	{
+		const prefix string = ",\"embeds\":"
+		out.RawString(prefix)
+		easyjson8a467d53Encode5(out, in.Embeds)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v InlineStructs) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson8a467d53EncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v InlineStructs) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson8a467d53EncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *InlineStructs) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson8a467d53DecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *InlineStructs) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson8a467d53DecodeGithubComMailruEasyjsonTests(l, v)
+}
+func easyjson8a467d53Decode5(in *jlexer.Lexer, out *struct {
+	InlineStructBase
+	Extra int `json:"extra"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "base":
+					out.Base = ""
+				case "extra":
+					out.Extra = 0
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "base":
+			out.Base = string(in.String())
+		case "extra":
+			out.Extra = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson8a467d53Encode5(out *jwriter.Writer, in struct {
+	InlineStructBase
+	Extra int `json:"extra"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"base\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Base))
+	}
+	{
+		const prefix string = ",\"extra\":"
+		out.RawString(prefix)
+		out.Int(int(in.Extra))
+	}
+	out.RawByte('}')
+}
+func easyjson8a467d53Decode4(in *jlexer.Lexer, out *struct{}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson8a467d53Encode4(out *jwriter.Writer, in struct{}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	out.RawByte('}')
+}
+func easyjson8a467d53Decode3(in *jlexer.Lexer, out *struct {
+	X int
+	Y int
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "X":
+					out.X = 0
+				case "Y":
+					out.Y = 0
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "X":
+			out.X = int(in.Int())
+		case "Y":
+			out.Y = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson8a467d53Encode3(out *jwriter.Writer, in struct {
+	X int
+	Y int
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"X\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.X))
+	}
+	{
+		const prefix string = ",\"Y\":"
+		out.RawString(prefix)
+		out.Int(int(in.Y))
+	}
+	out.RawByte('}')
+}
+func easyjson8a467d53Decode2(in *jlexer.Lexer, out *struct {
+	Value string `json:"value"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "value":
+					out.Value = ""
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "value":
+			out.Value = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson8a467d53Encode2(out *jwriter.Writer, in struct {
+	Value string `json:"value"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"value\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Value))
+	}
+	out.RawByte('}')
+}
+func easyjson8a467d53Decode1(in *jlexer.Lexer, out *struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "from":
+					out.From = time.Time{}
+				case "to":
+					out.To = time.Time{}
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "from":
+			if data := in.Raw(); in.Ok() {
+				in.AddValueError((out.From).UnmarshalJSON(data))
+			}
+		case "to":
+			if data := in.Raw(); in.Ok() {
+				in.AddValueError((out.To).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson8a467d53Encode1(out *jwriter.Writer, in struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"from\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.From).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"to\":"
+		out.RawString(prefix)
+		out.Raw((in.To).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+func easyjson8a467d53Decode(in *jlexer.Lexer, out *struct {
+	ID      int    `json:"id"`
+	Comment string `json:"comment,omitempty"`
+	hidden  int
+	Nested  struct {
+		Tags []string `json:"tags"`
+	} `json:"nested"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "id":
+					out.ID = 0
+				case "comment":
+					out.Comment = ""
+				case "nested":
+					out.Nested = struct {
+						Tags []string `json:"tags"`
+					}{}
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "comment":
+			out.Comment = string(in.String())
+		case "nested":
+			easyjson8a467d53Decode6(in, &out.Nested)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson8a467d53Encode(out *jwriter.Writer, in struct {
+	ID      int    `json:"id"`
+	Comment string `json:"comment,omitempty"`
+	hidden  int
+	Nested  struct {
+		Tags []string `json:"tags"`
+	} `json:"nested"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	if in.Comment != "" {
+		const prefix string = ",\"comment\":"
+		out.RawString(prefix)
+		out.String(string(in.Comment))
+	}
+	{
+		const prefix string = ",\"nested\":"
+		out.RawString(prefix)
+		easyjson8a467d53Encode6(out, in.Nested)
+	}
+	out.RawByte('}')
+}
+func easyjson8a467d53Decode6(in *jlexer.Lexer, out *struct {
+	Tags []string `json:"tags"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "tags":
+					out.Tags = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]string, 0, 4)
+					} else {
+						out.Tags = []string{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 string
+					v7 = string(in.String())
+					out.Tags = append(out.Tags, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson8a467d53Encode6(out *jwriter.Writer, in struct {
+	Tags []string `json:"tags"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"tags\":"
+		out.RawString(prefix[1:])
+		if in.Tags == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.Tags {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v9))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
@@ -0,0 +1,40 @@
+package tests
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestInlineStructs(t *testing.T) {
+	var v InlineStructs
+	v.Meta.ID = 1
+	v.Meta.Nested.Tags = []string{"a"}
+	v.Window = &struct {
+		From time.Time `json:"from"`
+		To   time.Time `json:"to"`
+	}{From: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)}
+	v.Labels = map[string]struct {
+		Value string `json:"value"`
+	}{"k": {Value: "v"}}
+	v.Points = []struct{ X, Y int }{{1, 2}}
+	v.Embeds.Base = "s"
+	v.Embeds.Extra = 3
+
+	want, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := easyjson.Marshal(v)
+	if err != nil || string(data) != string(want) {
+		t.Errorf("Marshal() = %s, %v; want %s", data, err, want)
+	}
+
+	var got InlineStructs
+	if err := easyjson.Unmarshal(want, &got); err != nil || !reflect.DeepEqual(got, v) {
+		t.Errorf("Unmarshal(%s) = %+v, %v; want %+v", want, got, err, v)
+	}
+}
@@ -0,0 +1,29 @@
+package tests
+
+import "github.com/mailru/easyjson"
+
+//easyjson:json
+type Int128Struct struct {
+	Signed   easyjson.Int128
+	Unsigned easyjson.Uint128
+	Ptr      *easyjson.Int128
+	Omitted  easyjson.Uint128 `json:",omitempty"`
+	Slice    []easyjson.Int128
+	Map      map[easyjson.Uint128]easyjson.Int128
+}
+
+var int128StructValue = Int128Struct{
+	Signed:   easyjson.Int128{Hi: -1 << 63},
+	Unsigned: easyjson.Uint128{Hi: ^uint64(0), Lo: ^uint64(0)},
+	Ptr:      &easyjson.Int128{Hi: 1},
+	Slice:    []easyjson.Int128{easyjson.Int128From64(-1), {}},
+	Map:      map[easyjson.Uint128]easyjson.Int128{easyjson.Uint128From64(7): easyjson.Int128From64(-7)},
+}
+
+var int128StructString = `{` +
+	`"Signed":"-170141183460469231731687303715884105728",` +
+	`"Unsigned":"340282366920938463463374607431768211455",` +
+	`"Ptr":"18446744073709551616",` +
+	`"Slice":["-1","0"],` +
+	`"Map":{"7":"-7"}` +
+	`}`
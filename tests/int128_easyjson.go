@@ -0,0 +1,239 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjson57c358a2DecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *Int128Struct) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "Signed":
+					out.Signed = easyjson.Int128{}
+				case "Unsigned":
+					out.Unsigned = easyjson.Uint128{}
+				case "Ptr":
+					out.Ptr = nil
+				case "Omitted":
+					out.Omitted = easyjson.Uint128{}
+				case "Slice":
+					out.Slice = nil
+				case "Map":
+					out.Map = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Signed":
+			(out.Signed).UnmarshalEasyJSON(in)
+		case "Unsigned":
+			(out.Unsigned).UnmarshalEasyJSON(in)
+		case "Ptr":
+			if in.IsNull() {
+				in.Skip()
+				out.Ptr = nil
+			} else {
+				if out.Ptr == nil {
+					out.Ptr = new(easyjson.Int128)
+				}
+				(*out.Ptr).UnmarshalEasyJSON(in)
+			}
+		case "Omitted":
+			(out.Omitted).UnmarshalEasyJSON(in)
+		case "Slice":
+			if in.IsNull() {
+				in.Skip()
+				out.Slice = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Slice == nil {
+					if !in.IsDelim(']') {
+						out.Slice = make([]easyjson.Int128, 0, 4)
+					} else {
+						out.Slice = []easyjson.Int128{}
+					}
+				} else {
+					out.Slice = (out.Slice)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 easyjson.Int128
+					(v1).UnmarshalEasyJSON(in)
+					out.Slice = append(out.Slice, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Map":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.Map == nil {
+					out.Map = make(map[easyjson.Uint128]easyjson.Int128)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					var key easyjson.Uint128
+					if data := in.UnsafeBytes(); in.Ok() {
+						in.AddError(key.UnmarshalText(data))
+					}
+					in.WantColon()
+					v3 := in.ValueStart()
+					var v2 easyjson.Int128
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.Map, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v2 = (out.Map)[key]
+					}
+					(v2).UnmarshalEasyJSON(in)
+					if !in.ValueSkipped(v3) {
+						(out.Map)[key] = v2
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson57c358a2EncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in Int128Struct) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Signed\":"
+		out.RawString(prefix[1:])
+		(in.Signed).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Unsigned\":"
+		out.RawString(prefix)
+		(in.Unsigned).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Ptr\":"
+		out.RawString(prefix)
+		if in.Ptr == nil {
+			out.RawString("null")
+		} else {
+			(*in.Ptr).MarshalEasyJSON(out)
+		}
+	}
+	if (in.Omitted).IsDefined() {
+		const prefix string = ",\"Omitted\":"
+		out.RawString(prefix)
+		(in.Omitted).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Slice\":"
+		out.RawString(prefix)
+		if in.Slice == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v4, v5 := range in.Slice {
+				if v4 > 0 {
+					out.RawByte(',')
+				}
+				(v5).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Map\":"
+		out.RawString(prefix)
+		if in.Map == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v6First := true
+			for v6Name, v6Value := range in.Map {
+				if v6First {
+					v6First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.TextKey((v6Name).MarshalText())
+				out.RawByte(':')
+				(v6Value).MarshalEasyJSON(out)
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Int128Struct) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson57c358a2EncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Int128Struct) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson57c358a2EncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Int128Struct) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson57c358a2DecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Int128Struct) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson57c358a2DecodeGithubComMailruEasyjsonTests(l, v)
+}
@@ -0,0 +1,47 @@
+package tests
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestInt128Marshal(t *testing.T) {
+	data, err := easyjson.Marshal(int128StructValue)
+	if err != nil {
+		t.Errorf("easyjson.Marshal() error: %v", err)
+	}
+	if string(data) != int128StructString {
+		t.Errorf("easyjson.Marshal() = %s; want %s", data, int128StructString)
+	}
+
+	// The types are also usable with encoding/json, inside and outside of generated code.
+	data, err = json.Marshal(int128StructValue)
+	if err != nil || string(data) != int128StructString {
+		t.Errorf("json.Marshal() = %s, %v; want %s", data, err, int128StructString)
+	}
+}
+
+func TestInt128Unmarshal(t *testing.T) {
+	var v Int128Struct
+	if err := easyjson.Unmarshal([]byte(int128StructString), &v); err != nil {
+		t.Errorf("easyjson.Unmarshal() error: %v", err)
+	}
+	if !reflect.DeepEqual(v, int128StructValue) {
+		t.Errorf("easyjson.Unmarshal() = %+v; want %+v", v, int128StructValue)
+	}
+
+	var n Int128Struct
+	if err := easyjson.Unmarshal([]byte(`{"Signed":-5,"Unsigned":18446744073709551617}`), &n); err != nil {
+		t.Errorf("easyjson.Unmarshal() of numbers error: %v", err)
+	}
+	if n.Signed != easyjson.Int128From64(-5) || n.Unsigned != (easyjson.Uint128{Hi: 1, Lo: 1}) {
+		t.Errorf("easyjson.Unmarshal() of numbers = %+v", n)
+	}
+
+	if err := easyjson.Unmarshal([]byte(`{"Map":{"-1":"0"}}`), &n); err == nil {
+		t.Errorf("easyjson.Unmarshal() of a negative Uint128 key succeeded; want error")
+	}
+}
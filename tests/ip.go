@@ -0,0 +1,19 @@
+//go:build go1.18
+// +build go1.18
+
+package tests
+
+import (
+	"net"
+	"net/netip"
+)
+
+//easyjson:json
+type IPAddresses struct {
+	IP       net.IP         `json:"ip"`
+	Addr     netip.Addr     `json:"addr"`
+	Prefix   netip.Prefix   `json:"prefix"`
+	Gateway  *netip.Addr    `json:"gateway"`
+	Optional net.IP         `json:"optional,omitempty"`
+	Routes   []netip.Prefix `json:"routes"`
+}
@@ -0,0 +1,190 @@
+//go:build go1.18
+// +build go1.18
+
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	netip "net/netip"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjson4759a457DecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *IPAddresses) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "ip":
+					out.IP = nil
+				case "addr":
+					out.Addr = netip.Addr{}
+				case "prefix":
+					out.Prefix = netip.Prefix{}
+				case "gateway":
+					out.Gateway = nil
+				case "optional":
+					out.Optional = nil
+				case "routes":
+					out.Routes = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ip":
+			in.IP(&out.IP)
+		case "addr":
+			in.IPAddr(&out.Addr)
+		case "prefix":
+			in.IPPrefix(&out.Prefix)
+		case "gateway":
+			if in.IsNull() {
+				in.Skip()
+				out.Gateway = nil
+			} else {
+				if out.Gateway == nil {
+					out.Gateway = new(netip.Addr)
+				}
+				in.IPAddr(&*out.Gateway)
+			}
+		case "optional":
+			in.IP(&out.Optional)
+		case "routes":
+			if in.IsNull() {
+				in.Skip()
+				out.Routes = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Routes == nil {
+					if !in.IsDelim(']') {
+						out.Routes = make([]netip.Prefix, 0, 2)
+					} else {
+						out.Routes = []netip.Prefix{}
+					}
+				} else {
+					out.Routes = (out.Routes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 netip.Prefix
+					in.IPPrefix(&v1)
+					out.Routes = append(out.Routes, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4759a457EncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in IPAddresses) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ip\":"
+		out.RawString(prefix[1:])
+		out.IP(in.IP)
+	}
+	{
+		const prefix string = ",\"addr\":"
+		out.RawString(prefix)
+		out.IPAddr(in.Addr)
+	}
+	{
+		const prefix string = ",\"prefix\":"
+		out.RawString(prefix)
+		out.IPPrefix(in.Prefix)
+	}
+	{
+		const prefix string = ",\"gateway\":"
+		out.RawString(prefix)
+		if in.Gateway == nil {
+			out.RawString("null")
+		} else {
+			out.IPAddr(*in.Gateway)
+		}
+	}
+	if len(in.Optional) != 0 {
+		const prefix string = ",\"optional\":"
+		out.RawString(prefix)
+		out.IP(in.Optional)
+	}
+	{
+		const prefix string = ",\"routes\":"
+		out.RawString(prefix)
+		if in.Routes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Routes {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.IPPrefix(v3)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v IPAddresses) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4759a457EncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v IPAddresses) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4759a457EncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *IPAddresses) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4759a457DecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *IPAddresses) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4759a457DecodeGithubComMailruEasyjsonTests(l, v)
+}
@@ -0,0 +1,49 @@
+//go:build go1.18
+// +build go1.18
+
+package tests
+
+import (
+	"encoding/json"
+	"net"
+	"net/netip"
+	"reflect"
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestIPAddresses(t *testing.T) {
+	gateway := netip.MustParseAddr("fe80::1%eth0")
+	v := IPAddresses{
+		IP:      net.IPv4(192, 0, 2, 1),
+		Addr:    netip.MustParseAddr("2001:db8::1"),
+		Prefix:  netip.MustParsePrefix("10.0.0.0/8"),
+		Gateway: &gateway,
+		Routes:  []netip.Prefix{netip.MustParsePrefix("0.0.0.0/0"), {}},
+	}
+	want := `{"ip":"192.0.2.1","addr":"2001:db8::1","prefix":"10.0.0.0/8","gateway":"fe80::1%eth0",` +
+		`"routes":["0.0.0.0/0",""]}`
+
+	data, err := easyjson.Marshal(v)
+	if err != nil || string(data) != want {
+		t.Errorf("Marshal() = %s, %v; want %s", data, err, want)
+	}
+	if std, err := json.Marshal(v); err != nil || string(std) != string(data) {
+		t.Errorf("json.Marshal() = %s, %v; want %s", std, err, data)
+	}
+
+	var got IPAddresses
+	if err := easyjson.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	if !reflect.DeepEqual(got, v) {
+		t.Errorf("Unmarshal() = %+v; want %+v", got, v)
+	}
+
+	for _, data := range []string{`{"ip":"x"}`, `{"addr":"192.0.2.1/24"}`, `{"prefix":"192.0.2.1"}`, `{"ip":1}`} {
+		if err := easyjson.Unmarshal([]byte(data), &got); err == nil {
+			t.Errorf("Unmarshal(%s) succeeded; want error", data)
+		}
+	}
+}
@@ -0,0 +1,18 @@
+//go:build go1.23
+// +build go1.23
+
+package tests
+
+import "iter"
+
+//easyjson:json
+type IterStruct struct {
+	Values iter.Seq[int]                `json:"values"`
+	Pairs  iter.Seq2[string, *IterItem] `json:"pairs"`
+	Empty  iter.Seq[string]             `json:"empty,omitempty"`
+	Nested iter.Seq[iter.Seq[IterItem]] `json:"nested"`
+}
+
+type IterItem struct {
+	Name string `json:"name"`
+}
@@ -0,0 +1,437 @@
+//go:build go1.23
+// +build go1.23
+
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	iter "iter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjsonB0353456DecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *IterStruct) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "values":
+					out.Values = nil
+				case "pairs":
+					out.Pairs = nil
+				case "empty":
+					out.Empty = nil
+				case "nested":
+					out.Nested = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "values":
+			{
+				var v1 []int
+				if in.IsNull() {
+					in.Skip()
+					v1 = nil
+				} else if !in.IsDelim('[') {
+					in.Delim('[')
+					in.Delim(']')
+				} else {
+					in.Delim('[')
+					if v1 == nil {
+						if !in.IsDelim(']') {
+							v1 = make([]int, 0, 8)
+						} else {
+							v1 = []int{}
+						}
+					} else {
+						v1 = (v1)[:0]
+					}
+					for !in.IsDelim(']') {
+						var v2 int
+						v2 = int(in.Int())
+						v1 = append(v1, v2)
+						in.WantComma()
+					}
+					in.Delim(']')
+				}
+				if v1 == nil {
+					out.Values = nil
+				} else {
+					out.Values = func(yield func(int) bool) {
+						for _, v1Value := range v1 {
+							if !yield(v1Value) {
+								return
+							}
+						}
+					}
+				}
+			}
+		case "pairs":
+			if in.IsNull() {
+				in.Skip()
+				out.Pairs = nil
+			} else {
+				var v3Names []string
+				var v3Values []*IterItem
+				in.Delim('{')
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := string(in.String())
+					in.WantColon()
+					var v3 *IterItem
+					if in.IsNull() {
+						in.Skip()
+						v3 = nil
+					} else {
+						if v3 == nil {
+							v3 = new(IterItem)
+						}
+						easyjsonB0353456DecodeGithubComMailruEasyjsonTests1(in, v3)
+					}
+					v3Names = append(v3Names, key)
+					v3Values = append(v3Values, v3)
+					in.WantComma()
+				}
+				in.Delim('}')
+				out.Pairs = func(yield func(string, *IterItem) bool) {
+					for i := range v3Names {
+						if !yield(v3Names[i], v3Values[i]) {
+							return
+						}
+					}
+				}
+			}
+		case "empty":
+			{
+				var v4 []string
+				if in.IsNull() {
+					in.Skip()
+					v4 = nil
+				} else if !in.IsDelim('[') {
+					in.Delim('[')
+					in.Delim(']')
+				} else {
+					in.Delim('[')
+					if v4 == nil {
+						if !in.IsDelim(']') {
+							v4 = make([]string, 0, 4)
+						} else {
+							v4 = []string{}
+						}
+					} else {
+						v4 = (v4)[:0]
+					}
+					for !in.IsDelim(']') {
+						var v5 string
+						v5 = string(in.String())
+						v4 = append(v4, v5)
+						in.WantComma()
+					}
+					in.Delim(']')
+				}
+				if v4 == nil {
+					out.Empty = nil
+				} else {
+					out.Empty = func(yield func(string) bool) {
+						for _, v4Value := range v4 {
+							if !yield(v4Value) {
+								return
+							}
+						}
+					}
+				}
+			}
+		case "nested":
+			{
+				var v6 []iter.Seq[IterItem]
+				if in.IsNull() {
+					in.Skip()
+					v6 = nil
+				} else if !in.IsDelim('[') {
+					in.Delim('[')
+					in.Delim(']')
+				} else {
+					in.Delim('[')
+					if v6 == nil {
+						if !in.IsDelim(']') {
+							v6 = make([]iter.Seq[IterItem], 0, 8)
+						} else {
+							v6 = []iter.Seq[IterItem]{}
+						}
+					} else {
+						v6 = (v6)[:0]
+					}
+					for !in.IsDelim(']') {
+						var v7 iter.Seq[IterItem]
+						{
+							var v8 []IterItem
+							if in.IsNull() {
+								in.Skip()
+								v8 = nil
+							} else if !in.IsDelim('[') {
+								in.Delim('[')
+								in.Delim(']')
+							} else {
+								in.Delim('[')
+								if v8 == nil {
+									if !in.IsDelim(']') {
+										v8 = make([]IterItem, 0, 4)
+									} else {
+										v8 = []IterItem{}
+									}
+								} else {
+									v8 = (v8)[:0]
+								}
+								for !in.IsDelim(']') {
+									var v9 IterItem
+									easyjsonB0353456DecodeGithubComMailruEasyjsonTests1(in, &v9)
+									v8 = append(v8, v9)
+									in.WantComma()
+								}
+								in.Delim(']')
+							}
+							if v8 == nil {
+								v7 = nil
+							} else {
+								v7 = func(yield func(IterItem) bool) {
+									for _, v8Value := range v8 {
+										if !yield(v8Value) {
+											return
+										}
+									}
+								}
+							}
+						}
+						v6 = append(v6, v7)
+						in.WantComma()
+					}
+					in.Delim(']')
+				}
+				if v6 == nil {
+					out.Nested = nil
+				} else {
+					out.Nested = func(yield func(iter.Seq[IterItem]) bool) {
+						for _, v6Value := range v6 {
+							if !yield(v6Value) {
+								return
+							}
+						}
+					}
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonB0353456EncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in IterStruct) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"values\":"
+		out.RawString(prefix[1:])
+		if in.Values == nil {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			v10First := true
+			(in.Values)(func(v10Value int) bool {
+				if v10First {
+					v10First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.Int(int(v10Value))
+				return true
+			})
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"pairs\":"
+		out.RawString(prefix)
+		if in.Pairs == nil {
+			out.RawString("null")
+		} else {
+			out.RawByte('{')
+			v11First := true
+			(in.Pairs)(func(v11Name string, v11Value *IterItem) bool {
+				if v11First {
+					v11First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v11Name))
+				out.RawByte(':')
+				if v11Value == nil {
+					out.RawString("null")
+				} else {
+					easyjsonB0353456EncodeGithubComMailruEasyjsonTests1(out, *v11Value)
+				}
+				return true
+			})
+			out.RawByte('}')
+		}
+	}
+	if in.Empty != nil {
+		const prefix string = ",\"empty\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			v12First := true
+			(in.Empty)(func(v12Value string) bool {
+				if v12First {
+					v12First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v12Value))
+				return true
+			})
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"nested\":"
+		out.RawString(prefix)
+		if in.Nested == nil {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			v13First := true
+			(in.Nested)(func(v13Value iter.Seq[IterItem]) bool {
+				if v13First {
+					v13First = false
+				} else {
+					out.RawByte(',')
+				}
+				if v13Value == nil {
+					out.RawString("null")
+				} else {
+					out.RawByte('[')
+					v14First := true
+					(v13Value)(func(v14Value IterItem) bool {
+						if v14First {
+							v14First = false
+						} else {
+							out.RawByte(',')
+						}
+						easyjsonB0353456EncodeGithubComMailruEasyjsonTests1(out, v14Value)
+						return true
+					})
+					out.RawByte(']')
+				}
+				return true
+			})
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v IterStruct) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonB0353456EncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v IterStruct) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonB0353456EncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *IterStruct) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonB0353456DecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *IterStruct) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonB0353456DecodeGithubComMailruEasyjsonTests(l, v)
+}
+func easyjsonB0353456DecodeGithubComMailruEasyjsonTests1(in *jlexer.Lexer, out *IterItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "name":
+					out.Name = ""
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonB0353456EncodeGithubComMailruEasyjsonTests1(out *jwriter.Writer, in IterItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	out.RawByte('}')
+}
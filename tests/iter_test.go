@@ -0,0 +1,67 @@
+//go:build go1.23
+// +build go1.23
+
+package tests
+
+import (
+	"iter"
+	"slices"
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestIteratorMarshal(t *testing.T) {
+	v := IterStruct{
+		Values: slices.Values([]int{1, 2, 3}),
+		Pairs: func(yield func(string, *IterItem) bool) {
+			_ = yield("a", &IterItem{Name: "x"}) && yield("b", nil)
+		},
+		Nested: slices.Values([]iter.Seq[IterItem]{slices.Values([]IterItem{{Name: "y"}}), nil}),
+	}
+	want := `{"values":[1,2,3],"pairs":{"a":{"name":"x"},"b":null},"nested":[[{"name":"y"}],null]}`
+
+	data, err := easyjson.Marshal(v)
+	if err != nil || string(data) != want {
+		t.Errorf("Marshal() = %s, %v; want %s", data, err, want)
+	}
+}
+
+func TestIteratorUnmarshal(t *testing.T) {
+	in := `{"values":[1,2,3],"pairs":{"b":{"name":"x"},"a":null},"empty":[],"nested":null}`
+
+	var v IterStruct
+	if err := easyjson.Unmarshal([]byte(in), &v); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	if got := slices.Collect(v.Values); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("Values = %v; want [1 2 3]", got)
+	}
+
+	var names []string
+	for name, item := range v.Pairs {
+		names = append(names, name)
+		if name == "b" && (item == nil || item.Name != "x") || name == "a" && item != nil {
+			t.Errorf("Pairs[%v] = %+v", name, item)
+		}
+	}
+	if !slices.Equal(names, []string{"b", "a"}) {
+		t.Errorf("Pairs names = %v; want the input order [b a]", names)
+	}
+	if v.Empty == nil || len(slices.Collect(v.Empty)) != 0 {
+		t.Errorf("Empty = %v; want an empty iterator", v.Empty)
+	}
+	if v.Nested != nil {
+		t.Errorf("Nested is not nil for null")
+	}
+
+	// Stopping the iteration early must be respected.
+	var count int
+	for range v.Values {
+		count++
+		break
+	}
+	if count != 1 {
+		t.Errorf("iteration went on after break")
+	}
+}
@@ -0,0 +1,12 @@
+package tests
+
+import "encoding/json"
+
+//easyjson:json
+type JSONNumbers struct {
+	Amount   json.Number   `json:"amount"`
+	Quoted   json.Number   `json:"quoted,string"`
+	Optional *json.Number  `json:"optional,omitempty"`
+	Empty    json.Number   `json:"empty,omitempty"`
+	List     []json.Number `json:"list"`
+}
@@ -0,0 +1,173 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjsonFdbaeb1cDecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *JSONNumbers) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "amount":
+					out.Amount = ""
+				case "quoted":
+					out.Quoted = ""
+				case "optional":
+					out.Optional = nil
+				case "empty":
+					out.Empty = ""
+				case "list":
+					out.List = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "amount":
+			out.Amount = in.JsonNumber()
+		case "quoted":
+			out.Quoted = in.JsonNumber()
+		case "optional":
+			if in.IsNull() {
+				in.Skip()
+				out.Optional = nil
+			} else {
+				if out.Optional == nil {
+					out.Optional = new(json.Number)
+				}
+				*out.Optional = in.JsonNumber()
+			}
+		case "empty":
+			out.Empty = in.JsonNumber()
+		case "list":
+			if in.IsNull() {
+				in.Skip()
+				out.List = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.List == nil {
+					if !in.IsDelim(']') {
+						out.List = make([]json.Number, 0, 4)
+					} else {
+						out.List = []json.Number{}
+					}
+				} else {
+					out.List = (out.List)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 json.Number
+					v1 = in.JsonNumber()
+					out.List = append(out.List, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonFdbaeb1cEncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in JSONNumbers) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix[1:])
+		out.JsonNumber(in.Amount)
+	}
+	{
+		const prefix string = ",\"quoted\":"
+		out.RawString(prefix)
+		out.JsonNumberStr(in.Quoted)
+	}
+	if in.Optional != nil {
+		const prefix string = ",\"optional\":"
+		out.RawString(prefix)
+		out.JsonNumber(*in.Optional)
+	}
+	if in.Empty != "" {
+		const prefix string = ",\"empty\":"
+		out.RawString(prefix)
+		out.JsonNumber(in.Empty)
+	}
+	{
+		const prefix string = ",\"list\":"
+		out.RawString(prefix)
+		if in.List == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.List {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.JsonNumber(v3)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v JSONNumbers) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonFdbaeb1cEncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v JSONNumbers) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonFdbaeb1cEncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *JSONNumbers) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonFdbaeb1cDecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *JSONNumbers) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonFdbaeb1cDecodeGithubComMailruEasyjsonTests(l, v)
+}
@@ -0,0 +1,42 @@
+package tests
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestJSONNumbers(t *testing.T) {
+	big := json.Number("12345678901234567890.000000000000000001")
+	v := JSONNumbers{
+		Amount:   "123456789012345678901234567890",
+		Quoted:   "-1.5e300",
+		Optional: &big,
+		List:     []json.Number{"0", "", "2E-7"},
+	}
+	want := `{"amount":123456789012345678901234567890,"quoted":"-1.5e300",` +
+		`"optional":12345678901234567890.000000000000000001,"list":[0,0,2E-7]}`
+
+	data, err := easyjson.Marshal(v)
+	if err != nil || string(data) != want {
+		t.Errorf("Marshal() = %s, %v; want %s", data, err, want)
+	}
+	if std, err := json.Marshal(v); err != nil || string(std) != want {
+		t.Errorf("json.Marshal() = %s, %v; want %s", std, err, want)
+	}
+
+	var got JSONNumbers
+	if err := easyjson.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	v.List[1] = "0"
+	if !reflect.DeepEqual(got, v) {
+		t.Errorf("Unmarshal() = %+v; want %+v", got, v)
+	}
+
+	if _, err := easyjson.Marshal(JSONNumbers{Amount: "1,5"}); err == nil {
+		t.Error("Marshal() of an invalid number succeeded")
+	}
+}
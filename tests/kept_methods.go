@@ -0,0 +1,16 @@
+package tests
+
+//easyjson:json
+type KeptMarshaler struct {
+	Value int
+}
+
+// MarshalJSON is written by hand, so the generated MarshalEasyJSON delegates to it.
+func (v KeptMarshaler) MarshalJSON() ([]byte, error) {
+	return []byte(`"custom"`), nil
+}
+
+//easyjson:json
+type KeptMarshalerContainer struct {
+	Kept KeptMarshaler
+}
@@ -0,0 +1,159 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjson228d00f7DecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *KeptMarshalerContainer) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "Kept":
+					out.Kept = KeptMarshaler{}
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Kept":
+			(out.Kept).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson228d00f7EncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in KeptMarshalerContainer) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Kept\":"
+		out.RawString(prefix[1:])
+		(in.Kept).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v KeptMarshalerContainer) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson228d00f7EncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v KeptMarshalerContainer) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson228d00f7EncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *KeptMarshalerContainer) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson228d00f7DecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *KeptMarshalerContainer) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson228d00f7DecodeGithubComMailruEasyjsonTests(l, v)
+}
+func easyjson228d00f7DecodeGithubComMailruEasyjsonTests1(in *jlexer.Lexer, out *KeptMarshaler) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "Value":
+					out.Value = 0
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Value":
+			out.Value = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson228d00f7EncodeGithubComMailruEasyjsonTests1(out *jwriter.Writer, in KeptMarshaler) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Value\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Value))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v KeptMarshaler) MarshalEasyJSON(w *jwriter.Writer) {
+	w.Raw(v.MarshalJSON())
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *KeptMarshaler) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson228d00f7DecodeGithubComMailruEasyjsonTests1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *KeptMarshaler) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson228d00f7DecodeGithubComMailruEasyjsonTests1(l, v)
+}
@@ -0,0 +1,23 @@
+package tests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestKeptMethods(t *testing.T) {
+	for _, v := range []easyjson.Marshaler{KeptMarshaler{Value: 1}, KeptMarshalerContainer{}} {
+		data, err := easyjson.Marshal(v)
+		want, _ := json.Marshal(v)
+		if err != nil || string(data) != string(want) {
+			t.Errorf("easyjson.Marshal(%+v) = %s, %v; want %s", v, data, err, want)
+		}
+	}
+
+	var v KeptMarshalerContainer
+	if err := easyjson.Unmarshal([]byte(`{"Kept":{"Value":2}}`), &v); err != nil || v.Kept.Value != 2 {
+		t.Errorf("easyjson.Unmarshal() = %+v, %v; want the generated unmarshaler used", v, err)
+	}
+}
@@ -0,0 +1,109 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjson20386856DecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *KeyWithEncodingMarshalers) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+	} else if !in.IsDelim('{') {
+		in.Delim('{')
+		in.Delim('}')
+	} else {
+		in.Delim('{')
+		if !in.MergePatch || *out == nil {
+			*out = make(KeyWithEncodingMarshalers)
+		}
+		for !in.IsDelim('}') {
+			in.MarkKey()
+			var key KeyWithEncodingMarshaler
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError(key.UnmarshalText(data))
+			}
+			in.WantColon()
+			v2 := in.ValueStart()
+			var v1 string
+			if in.MergePatch {
+				if in.IsNull() {
+					in.Skip()
+					if !in.KeepOnNull {
+						delete(*out, key)
+					}
+					in.WantComma()
+					continue
+				}
+				v1 = (*out)[key]
+			}
+			v1 = string(in.String())
+			if !in.ValueSkipped(v2) {
+				(*out)[key] = v1
+			}
+			in.WantComma()
+		}
+		in.Delim('}')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson20386856EncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in KeyWithEncodingMarshalers) {
+	if in == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+		out.RawString(`null`)
+	} else {
+		out.RawByte('{')
+		v3First := true
+		for v3Name, v3Value := range in {
+			if v3First {
+				v3First = false
+			} else {
+				out.RawByte(',')
+			}
+			out.TextKey((v3Name).MarshalText())
+			out.RawByte(':')
+			out.String(string(v3Value))
+		}
+		out.RawByte('}')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v KeyWithEncodingMarshalers) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson20386856EncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v KeyWithEncodingMarshalers) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson20386856EncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *KeyWithEncodingMarshalers) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson20386856DecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *KeyWithEncodingMarshalers) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson20386856DecodeGithubComMailruEasyjsonTests(l, v)
+}
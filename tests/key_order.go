@@ -0,0 +1,18 @@
+package tests
+
+import "strings"
+
+// keyOrderFirst lists the members of KeyOrdered.Headers written first.
+var keyOrderFirst = []string{"Host", "Content-Type"}
+
+// keyOrderFold orders names case-insensitively.
+func keyOrderFold(a, b string) bool {
+	return strings.ToLower(a) < strings.ToLower(b)
+}
+
+//easyjson:json
+type KeyOrdered struct {
+	Headers map[string]string         `json:"headers" easyjson:"keyorder=keyOrderFirst"`
+	Columns map[string]map[string]int `json:"columns" easyjson:"keyorder=keyOrderFold"`
+	Codes   *map[int]string           `json:"codes,omitempty" easyjson:"keyorder=keyOrderFirst"`
+}
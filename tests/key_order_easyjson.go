@@ -0,0 +1,359 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	sort "sort"
+	strconv "strconv"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjson5d7cac3eDecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *KeyOrdered) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "headers":
+					out.Headers = nil
+				case "columns":
+					out.Columns = nil
+				case "codes":
+					out.Codes = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "headers":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.Headers == nil {
+					out.Headers = make(map[string]string)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := string(in.String())
+					in.WantColon()
+					v2 := in.ValueStart()
+					var v1 string
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.Headers, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v1 = (out.Headers)[key]
+					}
+					v1 = string(in.String())
+					if !in.ValueSkipped(v2) {
+						(out.Headers)[key] = v1
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "columns":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.Columns == nil {
+					out.Columns = make(map[string]map[string]int)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := string(in.String())
+					in.WantColon()
+					v4 := in.ValueStart()
+					var v3 map[string]int
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.Columns, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v3 = (out.Columns)[key]
+					}
+					if in.IsNull() {
+						in.Skip()
+					} else if !in.IsDelim('{') {
+						in.Delim('{')
+						in.Delim('}')
+					} else {
+						in.Delim('{')
+						if !in.MergePatch || v3 == nil {
+							v3 = make(map[string]int)
+						}
+						for !in.IsDelim('}') {
+							in.MarkKey()
+							key := string(in.String())
+							in.WantColon()
+							v6 := in.ValueStart()
+							var v5 int
+							if in.MergePatch {
+								if in.IsNull() {
+									in.Skip()
+									if !in.KeepOnNull {
+										delete(v3, key)
+									}
+									in.WantComma()
+									continue
+								}
+								v5 = (v3)[key]
+							}
+							v5 = int(in.Int())
+							if !in.ValueSkipped(v6) {
+								(v3)[key] = v5
+							}
+							in.WantComma()
+						}
+						in.Delim('}')
+					}
+					if !in.ValueSkipped(v4) {
+						(out.Columns)[key] = v3
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "codes":
+			if in.IsNull() {
+				in.Skip()
+				out.Codes = nil
+			} else {
+				if out.Codes == nil {
+					out.Codes = new(map[int]string)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else if !in.IsDelim('{') {
+					in.Delim('{')
+					in.Delim('}')
+				} else {
+					in.Delim('{')
+					if !in.MergePatch || *out.Codes == nil {
+						if !in.IsDelim('}') {
+							*out.Codes = make(map[int]string)
+						} else {
+							*out.Codes = nil
+						}
+					}
+					for !in.IsDelim('}') {
+						in.MarkKey()
+						key := int(in.IntStr())
+						in.WantColon()
+						v8 := in.ValueStart()
+						var v7 string
+						if in.MergePatch {
+							if in.IsNull() {
+								in.Skip()
+								if !in.KeepOnNull {
+									delete(*out.Codes, key)
+								}
+								in.WantComma()
+								continue
+							}
+							v7 = (*out.Codes)[key]
+						}
+						v7 = string(in.String())
+						if !in.ValueSkipped(v8) {
+							(*out.Codes)[key] = v7
+						}
+						in.WantComma()
+					}
+					in.Delim('}')
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson5d7cac3eEncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in KeyOrdered) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"headers\":"
+		out.RawString(prefix[1:])
+		if in.Headers == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			v9Pairs := make([]struct {
+				Name  string
+				Value string
+			}, 0, len(in.Headers))
+			for v9Name, v9Value := range in.Headers {
+				v9Pairs = append(v9Pairs, struct {
+					Name  string
+					Value string
+				}{string(v9Name), v9Value})
+			}
+			sort.SliceStable(v9Pairs, func(i, j int) bool {
+				return easyjson5d7cac3eKeyOrderKeyOrderFirst(v9Pairs[i].Name, v9Pairs[j].Name) || !easyjson5d7cac3eKeyOrderKeyOrderFirst(v9Pairs[j].Name, v9Pairs[i].Name) && v9Pairs[i].Name < v9Pairs[j].Name
+			})
+			out.RawByte('{')
+			for v9I, v9Pair := range v9Pairs {
+				if v9I > 0 {
+					out.RawByte(',')
+				}
+				out.String(v9Pair.Name)
+				out.RawByte(':')
+				out.String(string(v9Pair.Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"columns\":"
+		out.RawString(prefix)
+		if in.Columns == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			v10Pairs := make([]struct {
+				Name  string
+				Value map[string]int
+			}, 0, len(in.Columns))
+			for v10Name, v10Value := range in.Columns {
+				v10Pairs = append(v10Pairs, struct {
+					Name  string
+					Value map[string]int
+				}{string(v10Name), v10Value})
+			}
+			sort.SliceStable(v10Pairs, func(i, j int) bool {
+				return easyjson5d7cac3eKeyOrderKeyOrderFold(v10Pairs[i].Name, v10Pairs[j].Name) || !easyjson5d7cac3eKeyOrderKeyOrderFold(v10Pairs[j].Name, v10Pairs[i].Name) && v10Pairs[i].Name < v10Pairs[j].Name
+			})
+			out.RawByte('{')
+			for v10I, v10Pair := range v10Pairs {
+				if v10I > 0 {
+					out.RawByte(',')
+				}
+				out.String(v10Pair.Name)
+				out.RawByte(':')
+				if v10Pair.Value == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+					out.RawString(`null`)
+				} else {
+					out.RawByte('{')
+					v11First := true
+					for v11Name, v11Value := range v10Pair.Value {
+						if v11First {
+							v11First = false
+						} else {
+							out.RawByte(',')
+						}
+						out.String(string(v11Name))
+						out.RawByte(':')
+						out.Int(int(v11Value))
+					}
+					out.RawByte('}')
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	if in.Codes != nil {
+		const prefix string = ",\"codes\":"
+		out.RawString(prefix)
+		if *in.Codes == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			v12Pairs := make([]struct {
+				Name  string
+				Value string
+			}, 0, len(*in.Codes))
+			for v12Name, v12Value := range *in.Codes {
+				v12Pairs = append(v12Pairs, struct {
+					Name  string
+					Value string
+				}{strconv.FormatInt(int64(v12Name), 10), v12Value})
+			}
+			sort.SliceStable(v12Pairs, func(i, j int) bool {
+				return easyjson5d7cac3eKeyOrderKeyOrderFirst(v12Pairs[i].Name, v12Pairs[j].Name) || !easyjson5d7cac3eKeyOrderKeyOrderFirst(v12Pairs[j].Name, v12Pairs[i].Name) && v12Pairs[i].Name < v12Pairs[j].Name
+			})
+			out.RawByte('{')
+			for v12I, v12Pair := range v12Pairs {
+				if v12I > 0 {
+					out.RawByte(',')
+				}
+				out.String(v12Pair.Name)
+				out.RawByte(':')
+				out.String(string(v12Pair.Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v KeyOrdered) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson5d7cac3eEncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v KeyOrdered) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson5d7cac3eEncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *KeyOrdered) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson5d7cac3eDecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *KeyOrdered) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson5d7cac3eDecodeGithubComMailruEasyjsonTests(l, v)
+}
+
+var easyjson5d7cac3eKeyOrderKeyOrderFirst = easyjson.KeyOrder(keyOrderFirst)
+var easyjson5d7cac3eKeyOrderKeyOrderFold = easyjson.KeyOrder(keyOrderFold)
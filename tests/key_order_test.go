@@ -0,0 +1,25 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestKeyOrder(t *testing.T) {
+	codes := map[int]string{404: "not found", 200: "ok"}
+	v := KeyOrdered{
+		Headers: map[string]string{"Accept": "*/*", "Content-Type": "text/plain", "Host": "example.com", "Age": "1"},
+		Columns: map[string]map[string]int{"b": {"x": 1}, "A": {}, "c": nil, "a": {}, "B": nil},
+		Codes:   &codes,
+	}
+	want := `{"headers":{"Host":"example.com","Content-Type":"text/plain","Accept":"*/*","Age":"1"},` +
+		`"columns":{"A":{},"a":{},"B":null,"b":{"x":1},"c":null},"codes":{"200":"ok","404":"not found"}}`
+
+	for i := 0; i < 20; i++ {
+		data, err := easyjson.Marshal(v)
+		if err != nil || string(data) != want {
+			t.Fatalf("Marshal() = %s, %v; want %s", data, err, want)
+		}
+	}
+}
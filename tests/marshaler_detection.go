@@ -0,0 +1,70 @@
+package tests
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"github.com/mailru/easyjson"
+)
+
+// Cents is an amount marshaled by its own methods, with nothing to promote when embedded.
+type Cents struct {
+	n int64
+}
+
+func (c Cents) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatInt(c.n, 10)), nil
+}
+
+func (c *Cents) UnmarshalJSON(data []byte) error {
+	n, err := strconv.ParseInt(string(data), 10, 64)
+	c.n = n
+	return err
+}
+
+// Code is a string marshaled as text by its own methods.
+type Code struct {
+	s string
+}
+
+func (c Code) MarshalText() ([]byte, error) {
+	return []byte(c.s), nil
+}
+
+func (c *Code) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("empty code")
+	}
+	c.s = string(data)
+	return nil
+}
+
+type JSONCodec interface {
+	json.Marshaler
+	json.Unmarshaler
+}
+
+type TextCodec interface {
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+}
+
+//easyjson:json
+type MarshalerDetection struct {
+	Cents `json:"Cents"`
+	*Code `json:"Code"`
+	JSON  JSONCodec                     `json:"json"`
+	Text  TextCodec                     `json:"text"`
+	Easy  easyjson.MarshalerUnmarshaler `json:"easy"`
+}
+
+// FlattenedCents embeds Cents without naming it, so like with encoding/json it contributes no
+// members.
+//
+//easyjson:json
+type FlattenedCents struct {
+	Cents
+	Name string `json:"name"`
+}
@@ -0,0 +1,261 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	errors "errors"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjsonBbb836b5DecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *MarshalerDetection) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "Cents":
+					out.Cents = Cents{}
+				case "Code":
+					out.Code = nil
+				case "json":
+					out.JSON = nil
+				case "text":
+					out.Text = nil
+				case "easy":
+					out.Easy = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Cents":
+			if data := in.Raw(); in.Ok() {
+				in.AddValueError((out.Cents).UnmarshalJSON(data))
+			}
+		case "Code":
+			if in.IsNull() {
+				in.Skip()
+				out.Code = nil
+			} else {
+				if out.Code == nil {
+					out.Code = new(Code)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddValueError((*out.Code).UnmarshalText(data))
+				}
+			}
+		case "json":
+			if in.IsNull() {
+				in.Skip()
+				out.JSON = nil
+			} else if out.JSON == nil {
+				in.SkipRecursive()
+				in.AddValueError(errors.New("easyjson: unmarshal into nil tests.JSONCodec"))
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddValueError(out.JSON.UnmarshalJSON(data))
+				}
+			}
+		case "text":
+			if in.IsNull() {
+				in.Skip()
+				out.Text = nil
+			} else if out.Text == nil {
+				in.SkipRecursive()
+				in.AddValueError(errors.New("easyjson: unmarshal into nil tests.TextCodec"))
+			} else {
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddValueError(out.Text.UnmarshalText(data))
+				}
+			}
+		case "easy":
+			if in.IsNull() {
+				in.Skip()
+				out.Easy = nil
+			} else if out.Easy == nil {
+				in.SkipRecursive()
+				in.AddValueError(errors.New("easyjson: unmarshal into nil easyjson.MarshalerUnmarshaler"))
+			} else {
+				out.Easy.UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBbb836b5EncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in MarshalerDetection) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Cents\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.Cents).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"Code\":"
+		out.RawString(prefix)
+		if in.Code == nil {
+			out.RawString("null")
+		} else {
+			out.RawText((*in.Code).MarshalText())
+		}
+	}
+	{
+		const prefix string = ",\"json\":"
+		out.RawString(prefix)
+		if in.JSON == nil {
+			out.RawString("null")
+		} else if m, ok := in.JSON.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else {
+			out.Raw(in.JSON.MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		if in.Text == nil {
+			out.RawString("null")
+		} else {
+			out.RawText(in.Text.MarshalText())
+		}
+	}
+	{
+		const prefix string = ",\"easy\":"
+		out.RawString(prefix)
+		if in.Easy == nil {
+			out.RawString("null")
+		} else {
+			in.Easy.MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MarshalerDetection) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBbb836b5EncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MarshalerDetection) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBbb836b5EncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MarshalerDetection) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBbb836b5DecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MarshalerDetection) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBbb836b5DecodeGithubComMailruEasyjsonTests(l, v)
+}
+func easyjsonBbb836b5DecodeGithubComMailruEasyjsonTests1(in *jlexer.Lexer, out *FlattenedCents) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "name":
+					out.Name = ""
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBbb836b5EncodeGithubComMailruEasyjsonTests1(out *jwriter.Writer, in FlattenedCents) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FlattenedCents) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBbb836b5EncodeGithubComMailruEasyjsonTests1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FlattenedCents) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBbb836b5EncodeGithubComMailruEasyjsonTests1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FlattenedCents) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBbb836b5DecodeGithubComMailruEasyjsonTests1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FlattenedCents) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBbb836b5DecodeGithubComMailruEasyjsonTests1(l, v)
+}
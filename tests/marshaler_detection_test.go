@@ -0,0 +1,71 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestMarshalerDetection(t *testing.T) {
+	raw := easyjson.RawMessage(`[1,2]`)
+	v := MarshalerDetection{
+		Cents: Cents{n: 150},
+		Code:  &Code{s: "EUR"},
+		JSON:  &Cents{n: 7},
+		Text:  &Code{s: "USD"},
+		Easy:  &raw,
+	}
+	want := `{"Cents":150,"Code":"EUR","json":7,"text":"USD","easy":[1,2]}`
+
+	data, err := easyjson.Marshal(v)
+	if err != nil || string(data) != want {
+		t.Errorf("Marshal() = %s, %v; want %s", data, err, want)
+	}
+
+	// the interfaces decode into the values they hold.
+	got := MarshalerDetection{JSON: &Cents{}, Text: &Code{}, Easy: &easyjson.RawMessage{}}
+	if err := easyjson.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	if !reflect.DeepEqual(got, v) {
+		t.Errorf("Unmarshal() = %+v; want %+v", got, v)
+	}
+
+	want = `{"Cents":0,"Code":null,"json":null,"text":null,"easy":null}`
+	if data, err := easyjson.Marshal(MarshalerDetection{}); err != nil || string(data) != want {
+		t.Errorf("Marshal() = %s, %v; want %s", data, err, want)
+	}
+}
+
+func TestMarshalerDetectionErrors(t *testing.T) {
+	for _, data := range []string{
+		`{"Code":""}`,
+		`{"text":""}`,
+		`{"json":1}`,
+	} {
+		got := MarshalerDetection{Text: &Code{}}
+		if err := easyjson.Unmarshal([]byte(data), &got); err == nil {
+			t.Errorf("Unmarshal(%s) = %+v; want an error", data, got)
+		}
+	}
+
+	errs, err := easyjson.UnmarshalBestEffort([]byte(`{"Cents":"x","text":"","json":1,"Code":"GBP"}`), &MarshalerDetection{Text: &Code{}})
+	if err != nil {
+		t.Fatalf("UnmarshalBestEffort() error: %v", err)
+	}
+	var paths []string
+	for _, e := range errs {
+		paths = append(paths, e.Path)
+	}
+	if want := []string{"/Cents", "/text", "/json"}; !reflect.DeepEqual(paths, want) {
+		t.Errorf("UnmarshalBestEffort() errors at %v; want %v", paths, want)
+	}
+}
+
+func TestMarshalerDetectionFlattened(t *testing.T) {
+	want := `{"name":"a"}`
+	if data, err := easyjson.Marshal(FlattenedCents{Cents: Cents{n: 5}, Name: "a"}); err != nil || string(data) != want {
+		t.Errorf("Marshal() = %s, %v; want %s", data, err, want)
+	}
+}
@@ -0,0 +1,94 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjsonD4e3f26dDecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *MembersEscaped) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "漢語":
+					out.A = ""
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "漢語":
+			out.A = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD4e3f26dEncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in MembersEscaped) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"漢語\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.A))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MembersEscaped) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD4e3f26dEncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MembersEscaped) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD4e3f26dEncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MembersEscaped) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD4e3f26dDecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MembersEscaped) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD4e3f26dDecodeGithubComMailruEasyjsonTests(l, v)
+}
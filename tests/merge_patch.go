@@ -0,0 +1,17 @@
+package tests
+
+//easyjson:json
+type MergePatchStruct struct {
+	Title  string                      `json:"title"`
+	Author *MergePatchAuthor           `json:"author"`
+	Tags   []string                    `json:"tags"`
+	Meta   map[string]string           `json:"meta"`
+	Nested map[string]MergePatchAuthor `json:"nested"`
+	Count  int                         `json:"count"`
+	Note   *string                     `json:"note" easyjson:"keepnull"`
+}
+
+type MergePatchAuthor struct {
+	GivenName  string `json:"givenName"`
+	FamilyName string `json:"familyName"`
+}
@@ -0,0 +1,362 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjsonB1264725DecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *MergePatchStruct) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "title":
+					out.Title = ""
+				case "author":
+					out.Author = nil
+				case "tags":
+					out.Tags = nil
+				case "meta":
+					out.Meta = nil
+				case "nested":
+					out.Nested = nil
+				case "count":
+					out.Count = 0
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "title":
+			out.Title = string(in.String())
+		case "author":
+			if in.IsNull() {
+				in.Skip()
+				out.Author = nil
+			} else {
+				if out.Author == nil {
+					out.Author = new(MergePatchAuthor)
+				}
+				easyjsonB1264725DecodeGithubComMailruEasyjsonTests1(in, out.Author)
+			}
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]string, 0, 4)
+					} else {
+						out.Tags = []string{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 string
+					v1 = string(in.String())
+					out.Tags = append(out.Tags, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "meta":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.Meta == nil {
+					out.Meta = make(map[string]string)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := string(in.String())
+					in.WantColon()
+					v3 := in.ValueStart()
+					var v2 string
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.Meta, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v2 = (out.Meta)[key]
+					}
+					v2 = string(in.String())
+					if !in.ValueSkipped(v3) {
+						(out.Meta)[key] = v2
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "nested":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.Nested == nil {
+					out.Nested = make(map[string]MergePatchAuthor)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := string(in.String())
+					in.WantColon()
+					v5 := in.ValueStart()
+					var v4 MergePatchAuthor
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.Nested, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v4 = (out.Nested)[key]
+					}
+					easyjsonB1264725DecodeGithubComMailruEasyjsonTests1(in, &v4)
+					if !in.ValueSkipped(v5) {
+						(out.Nested)[key] = v4
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "count":
+			out.Count = int(in.Int())
+		case "note":
+			if in.IsNull() {
+				in.Skip()
+				out.Note = nil
+			} else {
+				if out.Note == nil {
+					out.Note = new(string)
+				}
+				*out.Note = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonB1264725EncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in MergePatchStruct) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"author\":"
+		out.RawString(prefix)
+		if in.Author == nil {
+			out.RawString("null")
+		} else {
+			easyjsonB1264725EncodeGithubComMailruEasyjsonTests1(out, *in.Author)
+		}
+	}
+	{
+		const prefix string = ",\"tags\":"
+		out.RawString(prefix)
+		if in.Tags == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v6, v7 := range in.Tags {
+				if v6 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v7))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"meta\":"
+		out.RawString(prefix)
+		if in.Meta == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v8First := true
+			for v8Name, v8Value := range in.Meta {
+				if v8First {
+					v8First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v8Name))
+				out.RawByte(':')
+				out.String(string(v8Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"nested\":"
+		out.RawString(prefix)
+		if in.Nested == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v9First := true
+			for v9Name, v9Value := range in.Nested {
+				if v9First {
+					v9First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v9Name))
+				out.RawByte(':')
+				easyjsonB1264725EncodeGithubComMailruEasyjsonTests1(out, v9Value)
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Int(int(in.Count))
+	}
+	{
+		const prefix string = ",\"note\":"
+		out.RawString(prefix)
+		if in.Note == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Note))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MergePatchStruct) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonB1264725EncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MergePatchStruct) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonB1264725EncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MergePatchStruct) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonB1264725DecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MergePatchStruct) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonB1264725DecodeGithubComMailruEasyjsonTests(l, v)
+}
+func easyjsonB1264725DecodeGithubComMailruEasyjsonTests1(in *jlexer.Lexer, out *MergePatchAuthor) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "givenName":
+					out.GivenName = ""
+				case "familyName":
+					out.FamilyName = ""
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "givenName":
+			out.GivenName = string(in.String())
+		case "familyName":
+			out.FamilyName = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonB1264725EncodeGithubComMailruEasyjsonTests1(out *jwriter.Writer, in MergePatchAuthor) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"givenName\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.GivenName))
+	}
+	{
+		const prefix string = ",\"familyName\":"
+		out.RawString(prefix)
+		out.String(string(in.FamilyName))
+	}
+	out.RawByte('}')
+}
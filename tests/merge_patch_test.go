@@ -0,0 +1,64 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mailru/easyjson"
+	"github.com/mailru/easyjson/jlexer"
+)
+
+func TestApplyMergePatch(t *testing.T) {
+	v := MergePatchStruct{
+		Title:  "Goodbye!",
+		Author: &MergePatchAuthor{GivenName: "John", FamilyName: "Doe"},
+		Tags:   []string{"example", "sample"},
+		Meta:   map[string]string{"a": "1", "b": "2"},
+		Nested: map[string]MergePatchAuthor{"x": {GivenName: "X", FamilyName: "Y"}},
+		Count:  5,
+	}
+	patch := `{"title":"Hello!","author":{"familyName":null},"tags":["example"],"meta":{"a":null,"c":"3"},"nested":{"x":{"givenName":"Z"}},"count":null}`
+
+	want := MergePatchStruct{
+		Title:  "Hello!",
+		Author: &MergePatchAuthor{GivenName: "John"},
+		Tags:   []string{"example"},
+		Meta:   map[string]string{"b": "2", "c": "3"},
+		Nested: map[string]MergePatchAuthor{"x": {GivenName: "Z", FamilyName: "Y"}},
+	}
+
+	if err := easyjson.ApplyMergePatch(&v, []byte(patch)); err != nil {
+		t.Errorf("ApplyMergePatch() error: %v", err)
+	}
+	if !reflect.DeepEqual(v, want) {
+		t.Errorf("ApplyMergePatch() = %+v; want %+v", v, want)
+	}
+
+	if err := easyjson.ApplyMergePatch(&v, []byte(`{"author":null,"meta":null}`)); err != nil {
+		t.Errorf("ApplyMergePatch() error: %v", err)
+	}
+	if v.Author != nil || v.Meta != nil {
+		t.Errorf("ApplyMergePatch() = %+v; want author and meta removed", v)
+	}
+}
+
+func TestMergePatchKeepOnNull(t *testing.T) {
+	note := "keep"
+	v := MergePatchStruct{Title: "a", Count: 5, Meta: map[string]string{"a": "1"}, Note: &note}
+	if err := easyjson.ApplyMergePatch(&v, []byte(`{"note":null}`)); err != nil {
+		t.Errorf("ApplyMergePatch() error: %v", err)
+	}
+	if v.Note != &note {
+		t.Errorf("ApplyMergePatch() reset the keepnull field: %+v", v)
+	}
+
+	want := v
+	l := jlexer.Lexer{Data: []byte(`{"title":null,"count":null,"meta":{"a":null},"note":null}`), MergePatch: true, KeepOnNull: true}
+	v.UnmarshalEasyJSON(&l)
+	if err := l.Error(); err != nil {
+		t.Errorf("UnmarshalEasyJSON() error: %v", err)
+	}
+	if !reflect.DeepEqual(v, want) {
+		t.Errorf("UnmarshalEasyJSON() with KeepOnNull = %+v; want %+v", v, want)
+	}
+}
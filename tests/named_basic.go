@@ -0,0 +1,25 @@
+package tests
+
+//easyjson:json
+type NamedUserID int64
+
+//easyjson:json
+type NamedName string
+
+//easyjson:json
+type NamedFlag bool
+
+//easyjson:json
+type NamedRatio float64
+
+//easyjson:json
+type NamedLabels map[string]string
+
+//easyjson:json
+type NamedBasics struct {
+	ID     NamedUserID            `json:"id"`
+	Name   NamedName              `json:"name"`
+	Owners []NamedUserID          `json:"owners"`
+	Labels NamedLabels            `json:"labels"`
+	ByID   map[NamedUserID]string `json:"by_id"`
+}
@@ -0,0 +1,451 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjsonDd980deeDecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *NamedUserID) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+	} else {
+		*out = NamedUserID(in.Int64())
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDd980deeEncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in NamedUserID) {
+	out.Int64(int64(in))
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NamedUserID) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDd980deeEncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NamedUserID) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDd980deeEncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NamedUserID) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDd980deeDecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NamedUserID) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDd980deeDecodeGithubComMailruEasyjsonTests(l, v)
+}
+func easyjsonDd980deeDecodeGithubComMailruEasyjsonTests1(in *jlexer.Lexer, out *NamedRatio) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+	} else {
+		*out = NamedRatio(in.Float64())
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDd980deeEncodeGithubComMailruEasyjsonTests1(out *jwriter.Writer, in NamedRatio) {
+	out.Float64(float64(in))
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NamedRatio) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDd980deeEncodeGithubComMailruEasyjsonTests1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NamedRatio) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDd980deeEncodeGithubComMailruEasyjsonTests1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NamedRatio) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDd980deeDecodeGithubComMailruEasyjsonTests1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NamedRatio) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDd980deeDecodeGithubComMailruEasyjsonTests1(l, v)
+}
+func easyjsonDd980deeDecodeGithubComMailruEasyjsonTests2(in *jlexer.Lexer, out *NamedName) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+	} else {
+		*out = NamedName(in.String())
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDd980deeEncodeGithubComMailruEasyjsonTests2(out *jwriter.Writer, in NamedName) {
+	out.String(string(in))
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NamedName) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDd980deeEncodeGithubComMailruEasyjsonTests2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NamedName) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDd980deeEncodeGithubComMailruEasyjsonTests2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NamedName) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDd980deeDecodeGithubComMailruEasyjsonTests2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NamedName) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDd980deeDecodeGithubComMailruEasyjsonTests2(l, v)
+}
+func easyjsonDd980deeDecodeGithubComMailruEasyjsonTests3(in *jlexer.Lexer, out *NamedLabels) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+	} else if !in.IsDelim('{') {
+		in.Delim('{')
+		in.Delim('}')
+	} else {
+		in.Delim('{')
+		if !in.MergePatch || *out == nil {
+			*out = make(NamedLabels)
+		}
+		for !in.IsDelim('}') {
+			in.MarkKey()
+			key := string(in.String())
+			in.WantColon()
+			v2 := in.ValueStart()
+			var v1 string
+			if in.MergePatch {
+				if in.IsNull() {
+					in.Skip()
+					if !in.KeepOnNull {
+						delete(*out, key)
+					}
+					in.WantComma()
+					continue
+				}
+				v1 = (*out)[key]
+			}
+			v1 = string(in.String())
+			if !in.ValueSkipped(v2) {
+				(*out)[key] = v1
+			}
+			in.WantComma()
+		}
+		in.Delim('}')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDd980deeEncodeGithubComMailruEasyjsonTests3(out *jwriter.Writer, in NamedLabels) {
+	if in == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+		out.RawString(`null`)
+	} else {
+		out.RawByte('{')
+		v3First := true
+		for v3Name, v3Value := range in {
+			if v3First {
+				v3First = false
+			} else {
+				out.RawByte(',')
+			}
+			out.String(string(v3Name))
+			out.RawByte(':')
+			out.String(string(v3Value))
+		}
+		out.RawByte('}')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NamedLabels) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDd980deeEncodeGithubComMailruEasyjsonTests3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NamedLabels) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDd980deeEncodeGithubComMailruEasyjsonTests3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NamedLabels) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDd980deeDecodeGithubComMailruEasyjsonTests3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NamedLabels) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDd980deeDecodeGithubComMailruEasyjsonTests3(l, v)
+}
+func easyjsonDd980deeDecodeGithubComMailruEasyjsonTests4(in *jlexer.Lexer, out *NamedFlag) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+	} else {
+		*out = NamedFlag(in.Bool())
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDd980deeEncodeGithubComMailruEasyjsonTests4(out *jwriter.Writer, in NamedFlag) {
+	out.Bool(bool(in))
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NamedFlag) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDd980deeEncodeGithubComMailruEasyjsonTests4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NamedFlag) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDd980deeEncodeGithubComMailruEasyjsonTests4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NamedFlag) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDd980deeDecodeGithubComMailruEasyjsonTests4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NamedFlag) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDd980deeDecodeGithubComMailruEasyjsonTests4(l, v)
+}
+func easyjsonDd980deeDecodeGithubComMailruEasyjsonTests5(in *jlexer.Lexer, out *NamedBasics) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "id":
+					out.ID = 0
+				case "name":
+					out.Name = ""
+				case "owners":
+					out.Owners = nil
+				case "labels":
+					out.Labels = nil
+				case "by_id":
+					out.ByID = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			easyjsonDd980deeDecodeGithubComMailruEasyjsonTests(in, &out.ID)
+		case "name":
+			easyjsonDd980deeDecodeGithubComMailruEasyjsonTests2(in, &out.Name)
+		case "owners":
+			if in.IsNull() {
+				in.Skip()
+				out.Owners = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Owners == nil {
+					if !in.IsDelim(']') {
+						out.Owners = make([]NamedUserID, 0, 8)
+					} else {
+						out.Owners = []NamedUserID{}
+					}
+				} else {
+					out.Owners = (out.Owners)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 NamedUserID
+					easyjsonDd980deeDecodeGithubComMailruEasyjsonTests(in, &v4)
+					out.Owners = append(out.Owners, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "labels":
+			easyjsonDd980deeDecodeGithubComMailruEasyjsonTests3(in, &out.Labels)
+		case "by_id":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.ByID == nil {
+					out.ByID = make(map[NamedUserID]string)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := NamedUserID(in.Int64Str())
+					in.WantColon()
+					v6 := in.ValueStart()
+					var v5 string
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.ByID, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v5 = (out.ByID)[key]
+					}
+					v5 = string(in.String())
+					if !in.ValueSkipped(v6) {
+						(out.ByID)[key] = v5
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDd980deeEncodeGithubComMailruEasyjsonTests5(out *jwriter.Writer, in NamedBasics) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		easyjsonDd980deeEncodeGithubComMailruEasyjsonTests(out, in.ID)
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		easyjsonDd980deeEncodeGithubComMailruEasyjsonTests2(out, in.Name)
+	}
+	{
+		const prefix string = ",\"owners\":"
+		out.RawString(prefix)
+		if in.Owners == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v7, v8 := range in.Owners {
+				if v7 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonDd980deeEncodeGithubComMailruEasyjsonTests(out, v8)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"labels\":"
+		out.RawString(prefix)
+		easyjsonDd980deeEncodeGithubComMailruEasyjsonTests3(out, in.Labels)
+	}
+	{
+		const prefix string = ",\"by_id\":"
+		out.RawString(prefix)
+		if in.ByID == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v9First := true
+			for v9Name, v9Value := range in.ByID {
+				if v9First {
+					v9First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.Int64Str(int64(v9Name))
+				out.RawByte(':')
+				out.String(string(v9Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NamedBasics) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDd980deeEncodeGithubComMailruEasyjsonTests5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NamedBasics) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDd980deeEncodeGithubComMailruEasyjsonTests5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NamedBasics) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDd980deeDecodeGithubComMailruEasyjsonTests5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NamedBasics) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDd980deeDecodeGithubComMailruEasyjsonTests5(l, v)
+}
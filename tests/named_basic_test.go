@@ -0,0 +1,67 @@
+package tests
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestNamedBasicTypes(t *testing.T) {
+	for _, test := range []struct {
+		v    easyjson.MarshalerUnmarshaler
+		data string
+	}{
+		{new(NamedUserID), `42`},
+		{new(NamedName), `"alice"`},
+		{new(NamedFlag), `true`},
+		{new(NamedRatio), `0.5`},
+		{new(NamedLabels), `{"env":"prod"}`},
+	} {
+		if err := easyjson.Unmarshal([]byte(test.data), test.v); err != nil {
+			t.Errorf("Unmarshal(%s) error: %v", test.data, err)
+			continue
+		}
+		if got, err := easyjson.Marshal(test.v); err != nil || string(got) != test.data {
+			t.Errorf("Marshal(%T) = %s, %v; want %s", test.v, got, err, test.data)
+		}
+
+		// null leaves the value unchanged
+		if err := easyjson.Unmarshal([]byte(`null`), test.v); err != nil {
+			t.Errorf("Unmarshal(null) into %T error: %v", test.v, err)
+		}
+		if got, _ := easyjson.Marshal(test.v); string(got) != test.data {
+			t.Errorf("Unmarshal(null) changed %T to %s; want %s", test.v, got, test.data)
+		}
+	}
+
+	var id NamedUserID
+	if err := easyjson.Unmarshal([]byte(`"42"`), &id); err == nil {
+		t.Errorf("Unmarshal(\"42\") into NamedUserID = %v; want an error", id)
+	}
+}
+
+func TestNamedBasicFields(t *testing.T) {
+	v := NamedBasics{
+		ID:     7,
+		Name:   "bob",
+		Owners: []NamedUserID{1, 2},
+		Labels: NamedLabels{"a": "b"},
+		ByID:   map[NamedUserID]string{3: "c"},
+	}
+	data := `{"id":7,"name":"bob","owners":[1,2],"labels":{"a":"b"},"by_id":{"3":"c"}}`
+
+	got, err := easyjson.Marshal(v)
+	if err != nil || string(got) != data {
+		t.Errorf("Marshal() = %s, %v; want %s", got, err, data)
+	}
+	if std, _ := json.Marshal(v); string(std) != data {
+		t.Errorf("json.Marshal() = %s; want %s", std, data)
+	}
+
+	var decoded NamedBasics
+	if err := easyjson.Unmarshal([]byte(data), &decoded); err != nil || !reflect.DeepEqual(decoded, v) {
+		t.Errorf("Unmarshal(%s) = %+v, %v; want %+v", data, decoded, err, v)
+	}
+}
@@ -0,0 +1,11 @@
+package tests
+
+//easyjson:json
+type NilGuarded struct {
+	Name  string            `json:"name"`
+	Child *NilGuarded       `json:"child"`
+	Items []NilGuardedItems `json:"items"`
+}
+
+//easyjson:json
+type NilGuardedItems []string
@@ -0,0 +1,251 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	errors "errors"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjson76c1a9d2DecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *NilGuardedItems) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else if !in.IsDelim('[') {
+		in.Delim('[')
+		in.Delim(']')
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(NilGuardedItems, 0, 4)
+			} else {
+				*out = NilGuardedItems{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v1 string
+			v1 = string(in.String())
+			*out = append(*out, v1)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson76c1a9d2EncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in NilGuardedItems) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v2, v3 := range in {
+			if v2 > 0 {
+				out.RawByte(',')
+			}
+			out.String(string(v3))
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NilGuardedItems) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson76c1a9d2EncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v *NilGuardedItems) MarshalEasyJSON(w *jwriter.Writer) {
+	if v == nil {
+		w.RawString("null")
+		return
+	}
+	easyjson76c1a9d2EncodeGithubComMailruEasyjsonTests(w, *v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NilGuardedItems) UnmarshalJSON(data []byte) error {
+	if v == nil {
+		return errors.New("easyjson: UnmarshalJSON(nil *NilGuardedItems)")
+	}
+	r := jlexer.Lexer{Data: data}
+	easyjson76c1a9d2DecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NilGuardedItems) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	if v == nil {
+		l.AddError(errors.New("easyjson: UnmarshalEasyJSON(nil *NilGuardedItems)"))
+		return
+	}
+	easyjson76c1a9d2DecodeGithubComMailruEasyjsonTests(l, v)
+}
+func easyjson76c1a9d2DecodeGithubComMailruEasyjsonTests1(in *jlexer.Lexer, out *NilGuarded) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "name":
+					out.Name = ""
+				case "child":
+					out.Child = nil
+				case "items":
+					out.Items = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "child":
+			if in.IsNull() {
+				in.Skip()
+				out.Child = nil
+			} else {
+				if out.Child == nil {
+					out.Child = new(NilGuarded)
+				}
+				easyjson76c1a9d2DecodeGithubComMailruEasyjsonTests1(in, out.Child)
+			}
+		case "items":
+			if in.IsNull() {
+				in.Skip()
+				out.Items = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Items == nil {
+					if !in.IsDelim(']') {
+						out.Items = make([]NilGuardedItems, 0, 2)
+					} else {
+						out.Items = []NilGuardedItems{}
+					}
+				} else {
+					out.Items = (out.Items)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 NilGuardedItems
+					easyjson76c1a9d2DecodeGithubComMailruEasyjsonTests(in, &v4)
+					out.Items = append(out.Items, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson76c1a9d2EncodeGithubComMailruEasyjsonTests1(out *jwriter.Writer, in NilGuarded) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"child\":"
+		out.RawString(prefix)
+		if in.Child == nil {
+			out.RawString("null")
+		} else {
+			easyjson76c1a9d2EncodeGithubComMailruEasyjsonTests1(out, *in.Child)
+		}
+	}
+	{
+		const prefix string = ",\"items\":"
+		out.RawString(prefix)
+		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Items {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				easyjson76c1a9d2EncodeGithubComMailruEasyjsonTests(out, v6)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NilGuarded) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson76c1a9d2EncodeGithubComMailruEasyjsonTests1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v *NilGuarded) MarshalEasyJSON(w *jwriter.Writer) {
+	if v == nil {
+		w.RawString("null")
+		return
+	}
+	easyjson76c1a9d2EncodeGithubComMailruEasyjsonTests1(w, *v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NilGuarded) UnmarshalJSON(data []byte) error {
+	if v == nil {
+		return errors.New("easyjson: UnmarshalJSON(nil *NilGuarded)")
+	}
+	r := jlexer.Lexer{Data: data}
+	easyjson76c1a9d2DecodeGithubComMailruEasyjsonTests1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NilGuarded) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	if v == nil {
+		l.AddError(errors.New("easyjson: UnmarshalEasyJSON(nil *NilGuarded)"))
+		return
+	}
+	easyjson76c1a9d2DecodeGithubComMailruEasyjsonTests1(l, v)
+}
@@ -0,0 +1,47 @@
+package tests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mailru/easyjson"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+func TestNilGuards(t *testing.T) {
+	// The guards are called directly, easyjson.Marshal writes null for nil pointers itself.
+	var v *NilGuarded
+	w := jwriter.Writer{}
+	v.MarshalEasyJSON(&w)
+	data, err := w.BuildBytes()
+	if err != nil || string(data) != "null" {
+		t.Errorf("MarshalEasyJSON(nil) = %s, %v; want null", data, err)
+	}
+	var items *NilGuardedItems
+	w = jwriter.Writer{}
+	items.MarshalEasyJSON(&w)
+	if data, err = w.BuildBytes(); err != nil || string(data) != "null" {
+		t.Errorf("MarshalEasyJSON(nil items) = %s, %v; want null", data, err)
+	}
+
+	if err := easyjson.Unmarshal([]byte(`{}`), v); err == nil {
+		t.Error("easyjson.Unmarshal() into nil did not fail")
+	}
+	if err := v.UnmarshalJSON([]byte(`{}`)); err == nil {
+		t.Error("UnmarshalJSON() into nil did not fail")
+	}
+
+	in := NilGuarded{Name: "a", Child: &NilGuarded{Name: "b"}, Items: []NilGuardedItems{{"x"}, nil}}
+	want := `{"name":"a","child":{"name":"b","child":null,"items":null},"items":[["x"],null]}`
+	if data, err = easyjson.Marshal(&in); err != nil || string(data) != want {
+		t.Errorf("easyjson.Marshal() = %s, %v; want %s", data, err, want)
+	}
+	if data, err = json.Marshal(in); err != nil || string(data) != want {
+		t.Errorf("json.Marshal() = %s, %v; want %s", data, err, want)
+	}
+
+	var out NilGuarded
+	if err := easyjson.Unmarshal([]byte(want), &out); err != nil || out.Child == nil || out.Child.Name != "b" {
+		t.Errorf("easyjson.Unmarshal() = %+v, %v", out, err)
+	}
+}
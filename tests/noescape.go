@@ -0,0 +1,14 @@
+package tests
+
+//easyjson:json
+type NoEscapeStruct struct {
+	ID     string            `json:"id" easyjson:"noescape"`
+	Tags   []string          `json:"tags" easyjson:"noescape"`
+	Labels map[string]string `json:"labels" easyjson:"noescape"`
+	Ref    *string           `json:"ref" easyjson:"noescape"`
+	Text   string            `json:"text"`
+	Color  NoEscapeColor     `json:"color"`
+}
+
+//easyjson:enum NoEscapeColor 0=red 1=black&white
+type NoEscapeColor int
@@ -0,0 +1,318 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	fmt "fmt"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjsonF9b67ee0DecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *NoEscapeColor) {
+	if in.IsNull() {
+		in.Skip()
+		return
+	}
+	pos := in.GetPos()
+	switch data := in.UnsafeBytes(); string(data) {
+	case "red":
+		*out = 0
+	case "black&white":
+		*out = 1
+	default:
+		if in.Ok() {
+			in.AddError(&jlexer.LexerError{
+				Offset: pos,
+				Reason: "unknown NoEscapeColor value",
+				Data:   string(data),
+			})
+		}
+	}
+}
+func easyjsonF9b67ee0EncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in NoEscapeColor) {
+	switch in {
+	case 0:
+		out.StringNoEscape("red")
+	case 1:
+		out.String("black&white")
+	default:
+		if out.Error == nil {
+			out.Error = fmt.Errorf("invalid NoEscapeColor value %d", in)
+		}
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NoEscapeColor) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF9b67ee0EncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NoEscapeColor) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF9b67ee0EncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NoEscapeColor) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF9b67ee0DecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NoEscapeColor) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF9b67ee0DecodeGithubComMailruEasyjsonTests(l, v)
+}
+
+// MarshalText supports encoding.TextMarshaler interface
+func (v NoEscapeColor) MarshalText() ([]byte, error) {
+	switch v {
+	case 0:
+		return []byte("red"), nil
+	case 1:
+		return []byte("black&white"), nil
+	}
+	return nil, fmt.Errorf("invalid NoEscapeColor value %d", v)
+}
+
+// UnmarshalText supports encoding.TextUnmarshaler interface
+func (v *NoEscapeColor) UnmarshalText(data []byte) error {
+	switch string(data) {
+	case "red":
+		*v = 0
+		return nil
+	case "black&white":
+		*v = 1
+		return nil
+	}
+	return fmt.Errorf("unknown NoEscapeColor value %q", data)
+}
+func easyjsonF9b67ee0DecodeGithubComMailruEasyjsonTests1(in *jlexer.Lexer, out *NoEscapeStruct) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "id":
+					out.ID = ""
+				case "tags":
+					out.Tags = nil
+				case "labels":
+					out.Labels = nil
+				case "ref":
+					out.Ref = nil
+				case "text":
+					out.Text = ""
+				case "color":
+					out.Color = 0
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]string, 0, 4)
+					} else {
+						out.Tags = []string{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 string
+					v1 = string(in.String())
+					out.Tags = append(out.Tags, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "labels":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.Labels == nil {
+					out.Labels = make(map[string]string)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := string(in.String())
+					in.WantColon()
+					v3 := in.ValueStart()
+					var v2 string
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.Labels, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v2 = (out.Labels)[key]
+					}
+					v2 = string(in.String())
+					if !in.ValueSkipped(v3) {
+						(out.Labels)[key] = v2
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "ref":
+			if in.IsNull() {
+				in.Skip()
+				out.Ref = nil
+			} else {
+				if out.Ref == nil {
+					out.Ref = new(string)
+				}
+				*out.Ref = string(in.String())
+			}
+		case "text":
+			out.Text = string(in.String())
+		case "color":
+			(out.Color).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF9b67ee0EncodeGithubComMailruEasyjsonTests1(out *jwriter.Writer, in NoEscapeStruct) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.StringNoEscape(string(in.ID))
+	}
+	{
+		const prefix string = ",\"tags\":"
+		out.RawString(prefix)
+		if in.Tags == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v4, v5 := range in.Tags {
+				if v4 > 0 {
+					out.RawByte(',')
+				}
+				out.StringNoEscape(string(v5))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"labels\":"
+		out.RawString(prefix)
+		if in.Labels == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v6First := true
+			for v6Name, v6Value := range in.Labels {
+				if v6First {
+					v6First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v6Name))
+				out.RawByte(':')
+				out.StringNoEscape(string(v6Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"ref\":"
+		out.RawString(prefix)
+		if in.Ref == nil {
+			out.RawString("null")
+		} else {
+			out.StringNoEscape(string(*in.Ref))
+		}
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"color\":"
+		out.RawString(prefix)
+		(in.Color).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NoEscapeStruct) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF9b67ee0EncodeGithubComMailruEasyjsonTests1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NoEscapeStruct) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF9b67ee0EncodeGithubComMailruEasyjsonTests1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NoEscapeStruct) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF9b67ee0DecodeGithubComMailruEasyjsonTests1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NoEscapeStruct) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF9b67ee0DecodeGithubComMailruEasyjsonTests1(l, v)
+}
@@ -0,0 +1,34 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestNoEscape(t *testing.T) {
+	ref := "v1"
+	v := NoEscapeStruct{
+		ID:     "123e4567-e89b-12d3-a456-426614174000",
+		Tags:   []string{"a", "b"},
+		Labels: map[string]string{"env": "prod"},
+		Ref:    &ref,
+		Text:   `<"quoted">`,
+		Color:  1,
+	}
+	want := `{"id":"123e4567-e89b-12d3-a456-426614174000","tags":["a","b"],"labels":{"env":"prod"},"ref":"v1",` +
+		`"text":"\u003c\"quoted\"\u003e","color":"black\u0026white"}`
+
+	data, err := easyjson.Marshal(v)
+	if err != nil || string(data) != want {
+		t.Errorf("Marshal() = %s, %v; want %s", data, err, want)
+	}
+
+	var got NoEscapeStruct
+	if err := easyjson.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	if got.ID != v.ID || got.Text != v.Text || *got.Ref != ref || got.Color != v.Color {
+		t.Errorf("Unmarshal() = %+v; want %+v", got, v)
+	}
+}
@@ -0,0 +1,35 @@
+package tests
+
+import "time"
+
+//easyjson:json
+type OmitZero struct {
+	Int      int                `json:"int,omitzero"`
+	Float    float64            `json:"float,omitzero"`
+	Slice    []int              `json:"slice,omitzero"`
+	Time     time.Time          `json:"time,omitzero"`
+	Point    OmitZeroPoint      `json:"point,omitzero"`
+	Any      OmitZeroAny        `json:"any,omitzero"`
+	Zeroer   OmitZeroer         `json:"zeroer,omitzero"`
+	PtrTime  *time.Time         `json:"ptr_time,omitzero"`
+	Both     []int              `json:"both,omitempty,omitzero"`
+	Interval [2]OmitZeroerOnPtr `json:"interval,omitzero"`
+}
+
+type OmitZeroPoint struct {
+	X, Y int
+}
+
+type OmitZeroAny struct {
+	V interface{}
+}
+
+// OmitZeroer is zero when it is negative.
+type OmitZeroer int
+
+func (v OmitZeroer) IsZero() bool { return v < 0 }
+
+// OmitZeroerOnPtr is zero when it is empty.
+type OmitZeroerOnPtr string
+
+func (v *OmitZeroerOnPtr) IsZero() bool { return *v == "" }
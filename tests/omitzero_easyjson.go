@@ -0,0 +1,452 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	reflect "reflect"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjson9fb354a9DecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *OmitZero) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "int":
+					out.Int = 0
+				case "float":
+					out.Float = 0
+				case "slice":
+					out.Slice = nil
+				case "time":
+					out.Time = time.Time{}
+				case "point":
+					out.Point = OmitZeroPoint{}
+				case "any":
+					out.Any = OmitZeroAny{}
+				case "zeroer":
+					out.Zeroer = 0
+				case "ptr_time":
+					out.PtrTime = nil
+				case "both":
+					out.Both = nil
+				case "interval":
+					out.Interval = [2]OmitZeroerOnPtr{}
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "int":
+			out.Int = int(in.Int())
+		case "float":
+			out.Float = float64(in.Float64())
+		case "slice":
+			if in.IsNull() {
+				in.Skip()
+				out.Slice = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Slice == nil {
+					if !in.IsDelim(']') {
+						out.Slice = make([]int, 0, 8)
+					} else {
+						out.Slice = []int{}
+					}
+				} else {
+					out.Slice = (out.Slice)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 int
+					v1 = int(in.Int())
+					out.Slice = append(out.Slice, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "time":
+			if data := in.Raw(); in.Ok() {
+				in.AddValueError((out.Time).UnmarshalJSON(data))
+			}
+		case "point":
+			easyjson9fb354a9DecodeGithubComMailruEasyjsonTests1(in, &out.Point)
+		case "any":
+			easyjson9fb354a9DecodeGithubComMailruEasyjsonTests2(in, &out.Any)
+		case "zeroer":
+			out.Zeroer = OmitZeroer(in.Int())
+		case "ptr_time":
+			if in.IsNull() {
+				in.Skip()
+				out.PtrTime = nil
+			} else {
+				if out.PtrTime == nil {
+					out.PtrTime = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddValueError((*out.PtrTime).UnmarshalJSON(data))
+				}
+			}
+		case "both":
+			if in.IsNull() {
+				in.Skip()
+				out.Both = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Both == nil {
+					if !in.IsDelim(']') {
+						out.Both = make([]int, 0, 8)
+					} else {
+						out.Both = []int{}
+					}
+				} else {
+					out.Both = (out.Both)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 int
+					v2 = int(in.Int())
+					out.Both = append(out.Both, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "interval":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				v3 := 0
+				for !in.IsDelim(']') {
+					if v3 < 2 {
+						(out.Interval)[v3] = OmitZeroerOnPtr(in.String())
+						v3++
+					} else {
+						in.SkipRecursive()
+					}
+					in.WantComma()
+				}
+				for ; v3 < 2; v3++ {
+					(out.Interval)[v3] = ""
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9fb354a9EncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in OmitZero) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Int != 0 {
+		const prefix string = ",\"int\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Int(int(in.Int))
+	}
+	if in.Float != 0 {
+		const prefix string = ",\"float\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Float64(float64(in.Float))
+	}
+	if in.Slice != nil {
+		const prefix string = ",\"slice\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		if in.Slice == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v4, v5 := range in.Slice {
+				if v4 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v5))
+			}
+			out.RawByte(']')
+		}
+	}
+	if !(in.Time).IsZero() {
+		const prefix string = ",\"time\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((in.Time).MarshalJSON())
+	}
+	if in.Point != (OmitZeroPoint{}) {
+		const prefix string = ",\"point\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		easyjson9fb354a9EncodeGithubComMailruEasyjsonTests1(out, in.Point)
+	}
+	if !reflect.ValueOf(&in.Any).Elem().IsZero() {
+		const prefix string = ",\"any\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		easyjson9fb354a9EncodeGithubComMailruEasyjsonTests2(out, in.Any)
+	}
+	if !(in.Zeroer).IsZero() {
+		const prefix string = ",\"zeroer\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.Zeroer))
+	}
+	if in.PtrTime != nil && !(in.PtrTime).IsZero() {
+		const prefix string = ",\"ptr_time\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		if in.PtrTime == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.PtrTime).MarshalJSON())
+		}
+	}
+	if len(in.Both) != 0 && in.Both != nil {
+		const prefix string = ",\"both\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v6, v7 := range in.Both {
+				if v6 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v7))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Interval != ([2]OmitZeroerOnPtr{}) {
+		const prefix string = ",\"interval\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.RawByte('[')
+		for v8 := range in.Interval {
+			if v8 > 0 {
+				out.RawByte(',')
+			}
+			out.String(string((in.Interval)[v8]))
+		}
+		out.RawByte(']')
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OmitZero) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9fb354a9EncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OmitZero) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9fb354a9EncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OmitZero) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9fb354a9DecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OmitZero) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9fb354a9DecodeGithubComMailruEasyjsonTests(l, v)
+}
+func easyjson9fb354a9DecodeGithubComMailruEasyjsonTests2(in *jlexer.Lexer, out *OmitZeroAny) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "V":
+					out.V = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "V":
+			if m, ok := out.V.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.V.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.V = in.Interface()
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9fb354a9EncodeGithubComMailruEasyjsonTests2(out *jwriter.Writer, in OmitZeroAny) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"V\":"
+		out.RawString(prefix[1:])
+		if m, ok := in.V.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.V.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.V))
+		}
+	}
+	out.RawByte('}')
+}
+func easyjson9fb354a9DecodeGithubComMailruEasyjsonTests1(in *jlexer.Lexer, out *OmitZeroPoint) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "X":
+					out.X = 0
+				case "Y":
+					out.Y = 0
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "X":
+			out.X = int(in.Int())
+		case "Y":
+			out.Y = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9fb354a9EncodeGithubComMailruEasyjsonTests1(out *jwriter.Writer, in OmitZeroPoint) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"X\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.X))
+	}
+	{
+		const prefix string = ",\"Y\":"
+		out.RawString(prefix)
+		out.Int(int(in.Y))
+	}
+	out.RawByte('}')
+}
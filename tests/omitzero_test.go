@@ -0,0 +1,46 @@
+package tests
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestOmitZero(t *testing.T) {
+	var zero time.Time
+	for _, test := range []struct {
+		In   OmitZero
+		Want string
+	}{
+		{OmitZero{Zeroer: -1}, `{}`},
+		{OmitZero{}, `{"zeroer":0}`},
+		{
+			OmitZero{
+				Int:     1,
+				Float:   math.Copysign(0, -1),
+				Slice:   []int{},
+				Any:     OmitZeroAny{V: []int(nil)},
+				Zeroer:  -1,
+				PtrTime: &zero,
+				Both:    []int{},
+			},
+			`{"int":1,"slice":[],"any":{"V":null}}`,
+		},
+		{
+			OmitZero{
+				Time:     time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+				Point:    OmitZeroPoint{Y: 1},
+				Zeroer:   -1,
+				Interval: [2]OmitZeroerOnPtr{"a", ""},
+			},
+			`{"time":"2024-01-02T00:00:00Z","point":{"X":0,"Y":1},"interval":["a",""]}`,
+		},
+	} {
+		data, err := easyjson.Marshal(test.In)
+		if err != nil || string(data) != test.Want {
+			t.Errorf("Marshal(%+v) = %s, %v; want %s", test.In, data, err, test.Want)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"encoding/json"
 
+	"github.com/mailru/easyjson"
 	"github.com/mailru/easyjson/opt"
 )
 
@@ -68,3 +69,21 @@ func TestOptsVanilla(t *testing.T) {
 		t.Errorf("Vanilla opts unmarshal returned invalid value %+v, want %+v", ov, optsVanillaValue)
 	}
 }
+
+func TestOptsOmitEmpty(t *testing.T) {
+	data, err := easyjson.Marshal(optsOmitEmptyValue)
+	if err != nil || string(data) != optsOmitEmptyString {
+		t.Errorf("Marshal(%+v) = %s, %v; want %s", optsOmitEmptyValue, data, err, optsOmitEmptyString)
+	}
+
+	// Explicit nulls leave the values undefined, so they are omitted again.
+	var v OptsOmitEmpty
+	in := `{"Undefined":null,"PtrUndefined":null,"PtrNull":null}`
+	if err := easyjson.Unmarshal([]byte(in), &v); err != nil {
+		t.Fatalf("Unmarshal(%s) error: %v", in, err)
+	}
+	want := `{"Null":null,"PtrNull":null}`
+	if data, err := easyjson.Marshal(v); err != nil || string(data) != want {
+		t.Errorf("Marshal(%+v) = %s, %v; want %s", v, data, err, want)
+	}
+}
@@ -0,0 +1,31 @@
+package tests
+
+//easyjson:json
+type Promotion struct {
+	PromotionA
+	*PromotionB
+	PromotionC `json:"c"`
+	ID         int `json:"id"`
+}
+
+type PromotionA struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Label string
+	PromotionDeep
+}
+
+type PromotionB struct {
+	Title   string `json:"name"`
+	Caption string `json:"Label"`
+	Extra   int
+}
+
+type PromotionC struct {
+	X int
+}
+
+type PromotionDeep struct {
+	Extra int
+	Deep  int `json:"deep"`
+}
@@ -0,0 +1,194 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjsonDad19f25DecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *Promotion) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			switch key {
+			case "Label":
+				if out.PromotionB == nil {
+					out.PromotionB = new(PromotionB)
+				}
+			case "Extra":
+				if out.PromotionB == nil {
+					out.PromotionB = new(PromotionB)
+				}
+			}
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "deep":
+					out.Deep = 0
+				case "Label":
+					out.Caption = ""
+				case "Extra":
+					out.Extra = 0
+				case "c":
+					out.PromotionC = PromotionC{}
+				case "id":
+					out.ID = 0
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "deep":
+			out.Deep = int(in.Int())
+		case "Label":
+			if out.PromotionB == nil {
+				out.PromotionB = new(PromotionB)
+			}
+			out.Caption = string(in.String())
+		case "Extra":
+			if out.PromotionB == nil {
+				out.PromotionB = new(PromotionB)
+			}
+			out.Extra = int(in.Int())
+		case "c":
+			easyjsonDad19f25DecodeGithubComMailruEasyjsonTests1(in, &out.PromotionC)
+		case "id":
+			out.ID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDad19f25EncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in Promotion) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"deep\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Deep))
+	}
+	if in.PromotionB != nil {
+		const prefix string = ",\"Label\":"
+		out.RawString(prefix)
+		out.String(string(in.Caption))
+	}
+	if in.PromotionB != nil {
+		const prefix string = ",\"Extra\":"
+		out.RawString(prefix)
+		out.Int(int(in.Extra))
+	}
+	{
+		const prefix string = ",\"c\":"
+		out.RawString(prefix)
+		easyjsonDad19f25EncodeGithubComMailruEasyjsonTests1(out, in.PromotionC)
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Promotion) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDad19f25EncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Promotion) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDad19f25EncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Promotion) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDad19f25DecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Promotion) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDad19f25DecodeGithubComMailruEasyjsonTests(l, v)
+}
+func easyjsonDad19f25DecodeGithubComMailruEasyjsonTests1(in *jlexer.Lexer, out *PromotionC) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "X":
+					out.X = 0
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "X":
+			out.X = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDad19f25EncodeGithubComMailruEasyjsonTests1(out *jwriter.Writer, in PromotionC) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"X\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.X))
+	}
+	out.RawByte('}')
+}
@@ -0,0 +1,45 @@
+package tests
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+// promotionPlain has the fields of Promotion without its marshaling methods.
+type promotionPlain Promotion
+
+func TestPromotion(t *testing.T) {
+	v := Promotion{
+		PromotionA: PromotionA{
+			ID:            1,
+			Name:          "a",
+			Label:         "label",
+			PromotionDeep: PromotionDeep{Extra: 2, Deep: 3},
+		},
+		PromotionB: &PromotionB{Title: "b", Caption: "caption", Extra: 4},
+		PromotionC: PromotionC{X: 5},
+		ID:         6,
+	}
+
+	want, err := json.Marshal(promotionPlain(v))
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := easyjson.Marshal(v)
+	if err != nil || string(data) != string(want) {
+		t.Errorf("Marshal() = %s, %v; want %s", data, err, want)
+	}
+
+	input := `{"id":7,"name":"x","Label":"y","Extra":8,"deep":9,"c":{"X":10}}`
+	var wantValue promotionPlain
+	if err := json.Unmarshal([]byte(input), &wantValue); err != nil {
+		t.Fatal(err)
+	}
+	var got Promotion
+	if err := easyjson.Unmarshal([]byte(input), &got); err != nil || !reflect.DeepEqual(promotionPlain(got), wantValue) {
+		t.Errorf("Unmarshal(%s) = %+v, %v; want %+v", input, got, err, wantValue)
+	}
+}
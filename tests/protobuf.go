@@ -0,0 +1,50 @@
+package tests
+
+// The types below follow the output of protoc-gen-go for
+//
+//	message PbEvent {
+//	  string event_id = 1;
+//	  int32 retry_count = 2;
+//	  oneof payload {
+//	    string text = 3;
+//	    PbPoint location = 4;
+//	  }
+//	}
+//
+//	message PbPoint {
+//	  double lat = 1;
+//	  double lng = 2;
+//	}
+
+type PbEvent struct {
+	EventId    string `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	RetryCount int32  `protobuf:"varint,2,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//	*PbEvent_Text
+	//	*PbEvent_Location
+	Payload              isPbEvent_Payload `protobuf_oneof:"payload"`
+	XXX_NoUnkeyedLiteral struct{}
+	XXX_unrecognized     []byte
+	XXX_sizecache        int32
+}
+
+type isPbEvent_Payload interface {
+	isPbEvent_Payload()
+}
+
+type PbEvent_Text struct {
+	Text string `protobuf:"bytes,3,opt,name=text,proto3,oneof"`
+}
+
+type PbEvent_Location struct {
+	Location *PbPoint `protobuf:"bytes,4,opt,name=location,proto3,oneof"`
+}
+
+func (*PbEvent_Text) isPbEvent_Payload() {}
+
+func (*PbEvent_Location) isPbEvent_Payload() {}
+
+type PbPoint struct {
+	Lat float64 `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lng float64 `protobuf:"fixed64,2,opt,name=lng,proto3" json:"lng,omitempty"`
+}
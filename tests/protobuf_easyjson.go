@@ -0,0 +1,238 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjsonF778a2a5DecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *PbPoint) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "lat":
+					out.Lat = 0
+				case "lng":
+					out.Lng = 0
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "lat":
+			out.Lat = float64(in.Float64())
+		case "lng":
+			out.Lng = float64(in.Float64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF778a2a5EncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in PbPoint) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Lat != 0 {
+		const prefix string = ",\"lat\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Float64(float64(in.Lat))
+	}
+	if in.Lng != 0 {
+		const prefix string = ",\"lng\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Float64(float64(in.Lng))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PbPoint) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF778a2a5EncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PbPoint) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF778a2a5EncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PbPoint) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF778a2a5DecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PbPoint) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF778a2a5DecodeGithubComMailruEasyjsonTests(l, v)
+}
+func easyjsonF778a2a5DecodeGithubComMailruEasyjsonTests1(in *jlexer.Lexer, out *PbEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "eventId", "event_id":
+					out.EventId = ""
+				case "retryCount", "retry_count":
+					out.RetryCount = 0
+				case "location", "text":
+					out.Payload = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "eventId", "event_id":
+			out.EventId = string(in.String())
+		case "retryCount", "retry_count":
+			out.RetryCount = int32(in.Int32())
+		case "location":
+			v1 := new(PbEvent_Location)
+			if in.IsNull() {
+				in.Skip()
+				v1.Location = nil
+			} else {
+				if v1.Location == nil {
+					v1.Location = new(PbPoint)
+				}
+				easyjsonF778a2a5DecodeGithubComMailruEasyjsonTests(in, v1.Location)
+			}
+			out.Payload = v1
+		case "text":
+			v2 := new(PbEvent_Text)
+			v2.Text = string(in.String())
+			out.Payload = v2
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF778a2a5EncodeGithubComMailruEasyjsonTests1(out *jwriter.Writer, in PbEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.EventId != "" {
+		const prefix string = ",\"eventId\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.EventId))
+	}
+	if in.RetryCount != 0 {
+		const prefix string = ",\"retryCount\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int32(int32(in.RetryCount))
+	}
+	switch v3 := in.Payload.(type) {
+	case *PbEvent_Location:
+		const prefix string = ",\"location\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		if v3.Location == nil {
+			out.RawString("null")
+		} else {
+			easyjsonF778a2a5EncodeGithubComMailruEasyjsonTests(out, *v3.Location)
+		}
+	case *PbEvent_Text:
+		const prefix string = ",\"text\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(v3.Text))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PbEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF778a2a5EncodeGithubComMailruEasyjsonTests1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PbEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF778a2a5EncodeGithubComMailruEasyjsonTests1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PbEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF778a2a5DecodeGithubComMailruEasyjsonTests1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PbEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF778a2a5DecodeGithubComMailruEasyjsonTests1(l, v)
+}
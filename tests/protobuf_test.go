@@ -0,0 +1,41 @@
+package tests
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestProtobufMarshal(t *testing.T) {
+	for _, test := range []struct {
+		In   PbEvent
+		Want string
+	}{
+		{PbEvent{}, `{}`},
+		{PbEvent{EventId: "e1", XXX_unrecognized: []byte{1}}, `{"eventId":"e1"}`},
+		{PbEvent{Payload: &PbEvent_Text{}}, `{"text":""}`},
+		{PbEvent{EventId: "e1", Payload: &PbEvent_Location{&PbPoint{Lat: 1.5}}}, `{"eventId":"e1","location":{"lat":1.5}}`},
+	} {
+		data, err := json.Marshal(test.In)
+		if err != nil || string(data) != test.Want {
+			t.Errorf("json.Marshal(%+v) = %s, %v; want %s", test.In, data, err, test.Want)
+		}
+	}
+}
+
+func TestProtobufUnmarshal(t *testing.T) {
+	for _, test := range []struct {
+		In   string
+		Want PbEvent
+	}{
+		{`{"eventId":"e1","retryCount":2}`, PbEvent{EventId: "e1", RetryCount: 2}},
+		{`{"event_id":"e1","retry_count":2}`, PbEvent{EventId: "e1", RetryCount: 2}},
+		{`{"text":"hi","XXX_sizecache":1}`, PbEvent{Payload: &PbEvent_Text{"hi"}}},
+		{`{"location":{"lat":1.5,"lng":-2}}`, PbEvent{Payload: &PbEvent_Location{&PbPoint{Lat: 1.5, Lng: -2}}}},
+	} {
+		var v PbEvent
+		if err := json.Unmarshal([]byte(test.In), &v); err != nil || !reflect.DeepEqual(v, test.Want) {
+			t.Errorf("json.Unmarshal(%s) = %+v, %v; want %+v", test.In, v, err, test.Want)
+		}
+	}
+}
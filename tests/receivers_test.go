@@ -0,0 +1,26 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestMarshalerValueReceivers(t *testing.T) {
+	for _, v := range []interface{}{Structs{}, NamedType{}, Maps{}} {
+		typ := reflect.TypeOf(v)
+		for _, name := range []string{"MarshalJSON", "MarshalEasyJSON"} {
+			if _, ok := typ.MethodByName(name); !ok {
+				t.Errorf("%v.%v is not declared on a value receiver", typ, name)
+			}
+		}
+		for _, name := range []string{"UnmarshalJSON", "UnmarshalEasyJSON"} {
+			if _, ok := reflect.PtrTo(typ).MethodByName(name); !ok {
+				t.Errorf("(*%v).%v is not declared", typ, name)
+			}
+		}
+	}
+}
+
+var _ easyjson.Marshaler = Structs{}
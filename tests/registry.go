@@ -0,0 +1,14 @@
+package tests
+
+//easyjson:json
+type RegistryStruct struct {
+	Name string `json:"name"`
+}
+
+//easyjson:json
+type RegistrySlice []RegistryStruct
+
+//easyjson:json
+type registryPrivate struct {
+	ID int `json:"id"`
+}
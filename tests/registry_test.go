@@ -0,0 +1,38 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestRegistryCodecs(t *testing.T) {
+	for name, want := range map[string]easyjson.MarshalerUnmarshaler{
+		"github.com/mailru/easyjson/tests.RegistryStruct":  &RegistryStruct{Name: "a"},
+		"github.com/mailru/easyjson/tests.RegistrySlice":   &RegistrySlice{{Name: "a"}, {Name: "b"}},
+		"github.com/mailru/easyjson/tests.registryPrivate": &registryPrivate{ID: 1},
+	} {
+		c, ok := Codecs[name]
+		if !ok || c.Name != name {
+			t.Errorf("codec of %v not found: %+v", name, c)
+			continue
+		}
+
+		data, err := easyjson.Marshal(want)
+		if err != nil {
+			t.Errorf("Marshal(%#v) error: %v", want, err)
+			continue
+		}
+		got, err := c.Unmarshal(data)
+		if err != nil {
+			t.Errorf("%v codec Unmarshal(%s) error: %v", name, data, err)
+		} else if !reflect.DeepEqual(got, want) {
+			t.Errorf("%v codec Unmarshal(%s) = %#v; want %#v", name, data, got, want)
+		}
+	}
+
+	if len(Codecs) != 3 {
+		t.Errorf("registry has %d codecs; want 3", len(Codecs))
+	}
+}
@@ -28,6 +28,17 @@ func TestRequiredField(t *testing.T) {
 	}
 }
 
+func TestRequiredDirective(t *testing.T) {
+	var v RequiredDirectiveStruct
+	if err := v.UnmarshalJSON([]byte(`{"id":0}`)); err != nil {
+		t.Errorf("UnmarshalJSON didn't expect error: %v", err)
+	}
+	err := v.UnmarshalJSON([]byte(`{"name":"Foo"}`))
+	if want := "key 'id' is required"; fmt.Sprintf("%v", err) != want {
+		t.Errorf("UnmarshalJSON expected error: %v. got: %v", want, err)
+	}
+}
+
 func TestRequiredOptionalMap(t *testing.T) {
 	baseJson := `{"req_map":{}, "oe_map":{}, "noe_map":{}, "oe_slice":[]}`
 	wantDecoding := RequiredOptionalMap{MapIntString{}, nil, MapIntString{}}
@@ -0,0 +1,12 @@
+package tests
+
+//easyjson:json
+type SlabList struct {
+	Items  []*SlabItem   `json:"items"`
+	Nested [][]*SlabItem `json:"nested"`
+}
+
+type SlabItem struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
@@ -0,0 +1,321 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjson3259aae4DecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *SlabList) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "items":
+					out.Items = nil
+				case "nested":
+					out.Nested = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "items":
+			if in.IsNull() {
+				in.Skip()
+				out.Items = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Items == nil {
+					if !in.IsDelim(']') {
+						out.Items = make([]*SlabItem, 0, 8)
+					} else {
+						out.Items = []*SlabItem{}
+					}
+				} else {
+					out.Items = (out.Items)[:0]
+				}
+				var v2 []SlabItem
+				for !in.IsDelim(']') {
+					var v1 *SlabItem
+					if !in.IsNull() {
+						if len(v2) == 0 {
+							n := len(out.Items) + 1
+							if n > 4 {
+								n = 4
+							}
+							v2 = make([]SlabItem, n)
+						}
+						v1, v2 = &v2[0], v2[1:]
+					}
+					if in.IsNull() {
+						in.Skip()
+						v1 = nil
+					} else {
+						if v1 == nil {
+							v1 = new(SlabItem)
+						}
+						easyjson3259aae4DecodeGithubComMailruEasyjsonTests1(in, v1)
+					}
+					out.Items = append(out.Items, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "nested":
+			if in.IsNull() {
+				in.Skip()
+				out.Nested = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Nested == nil {
+					if !in.IsDelim(']') {
+						out.Nested = make([][]*SlabItem, 0, 2)
+					} else {
+						out.Nested = [][]*SlabItem{}
+					}
+				} else {
+					out.Nested = (out.Nested)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 []*SlabItem
+					if in.IsNull() {
+						in.Skip()
+						v3 = nil
+					} else if !in.IsDelim('[') {
+						in.Delim('[')
+						in.Delim(']')
+					} else {
+						in.Delim('[')
+						if v3 == nil {
+							if !in.IsDelim(']') {
+								v3 = make([]*SlabItem, 0, 8)
+							} else {
+								v3 = []*SlabItem{}
+							}
+						} else {
+							v3 = (v3)[:0]
+						}
+						var v5 []SlabItem
+						for !in.IsDelim(']') {
+							var v4 *SlabItem
+							if !in.IsNull() {
+								if len(v5) == 0 {
+									n := len(v3) + 1
+									if n > 4 {
+										n = 4
+									}
+									v5 = make([]SlabItem, n)
+								}
+								v4, v5 = &v5[0], v5[1:]
+							}
+							if in.IsNull() {
+								in.Skip()
+								v4 = nil
+							} else {
+								if v4 == nil {
+									v4 = new(SlabItem)
+								}
+								easyjson3259aae4DecodeGithubComMailruEasyjsonTests1(in, v4)
+							}
+							v3 = append(v3, v4)
+							in.WantComma()
+						}
+						in.Delim(']')
+					}
+					out.Nested = append(out.Nested, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3259aae4EncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in SlabList) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"items\":"
+		out.RawString(prefix[1:])
+		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			v6 := false
+			for _, v7 := range in.Items {
+				if v7 == nil && out.SkipNilElem() {
+					continue
+				}
+				if v6 {
+					out.RawByte(',')
+				}
+				v6 = true
+				if v7 == nil {
+					out.RawString("null")
+				} else {
+					easyjson3259aae4EncodeGithubComMailruEasyjsonTests1(out, *v7)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"nested\":"
+		out.RawString(prefix)
+		if in.Nested == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.Nested {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				if v9 == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+					out.RawString("null")
+				} else {
+					out.RawByte('[')
+					v10 := false
+					for _, v11 := range v9 {
+						if v11 == nil && out.SkipNilElem() {
+							continue
+						}
+						if v10 {
+							out.RawByte(',')
+						}
+						v10 = true
+						if v11 == nil {
+							out.RawString("null")
+						} else {
+							easyjson3259aae4EncodeGithubComMailruEasyjsonTests1(out, *v11)
+						}
+					}
+					out.RawByte(']')
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SlabList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3259aae4EncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SlabList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3259aae4EncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SlabList) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3259aae4DecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SlabList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3259aae4DecodeGithubComMailruEasyjsonTests(l, v)
+}
+func easyjson3259aae4DecodeGithubComMailruEasyjsonTests1(in *jlexer.Lexer, out *SlabItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "id":
+					out.ID = 0
+				case "name":
+					out.Name = ""
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "name":
+			out.Name = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3259aae4EncodeGithubComMailruEasyjsonTests1(out *jwriter.Writer, in SlabItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	out.RawByte('}')
+}
@@ -0,0 +1,40 @@
+package tests
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestSlabAlloc(t *testing.T) {
+	var want SlabList
+	var items []string
+	for i := 0; i < 10; i++ {
+		if i == 3 {
+			want.Items = append(want.Items, nil)
+			items = append(items, "null")
+			continue
+		}
+		want.Items = append(want.Items, &SlabItem{ID: i, Name: fmt.Sprint("item", i)})
+		items = append(items, fmt.Sprintf(`{"id":%d,"name":"item%d"}`, i, i))
+	}
+	want.Nested = [][]*SlabItem{{{ID: 1}}, {}, nil}
+	in := `{"items":[` + strings.Join(items, ",") + `],"nested":[[{"id":1}],[],null]}`
+
+	var got SlabList
+	if err := easyjson.Unmarshal([]byte(in), &got); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Unmarshal() = %+v; want %+v", got, want)
+	}
+
+	// Changing an element must not affect the others sharing its slab.
+	got.Items[0].ID = 100
+	if got.Items[1].ID != 1 || got.Items[2].ID != 2 {
+		t.Errorf("elements after changing the first one: %+v, %+v", got.Items[1], got.Items[2])
+	}
+}
@@ -0,0 +1,15 @@
+package tests
+
+import "database/sql"
+
+//easyjson:json
+type SQLNulls struct {
+	Name    sql.NullString            `json:"name"`
+	Count   sql.NullInt64             `json:"count,string"`
+	Score   sql.NullFloat64           `json:"score,omitempty"`
+	Active  sql.NullBool              `json:"active"`
+	Created sql.NullTime              `json:"created" easyjson:"layout=2006-01-02"`
+	Codes   []sql.NullInt32           `json:"codes"`
+	Ptr     *sql.NullString           `json:"ptr"`
+	ByName  map[string]sql.NullString `json:"by_name"`
+}
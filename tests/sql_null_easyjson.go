@@ -0,0 +1,353 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	sql "database/sql"
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjsonE98ff05cDecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *SQLNulls) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "name":
+					out.Name = sql.NullString{}
+				case "count":
+					out.Count = sql.NullInt64{}
+				case "score":
+					out.Score = sql.NullFloat64{}
+				case "active":
+					out.Active = sql.NullBool{}
+				case "created":
+					out.Created = sql.NullTime{}
+				case "codes":
+					out.Codes = nil
+				case "ptr":
+					out.Ptr = nil
+				case "by_name":
+					out.ByName = nil
+				}
+			}
+			if !in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "name":
+					out.Name = sql.NullString{}
+				case "count":
+					out.Count = sql.NullInt64{}
+				case "score":
+					out.Score = sql.NullFloat64{}
+				case "active":
+					out.Active = sql.NullBool{}
+				case "created":
+					out.Created = sql.NullTime{}
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			if in.IsNull() {
+				in.Skip()
+				out.Name = sql.NullString{}
+			} else {
+				(out.Name).String = string(in.String())
+				(out.Name).Valid = true
+			}
+		case "count":
+			if in.IsNull() {
+				in.Skip()
+				out.Count = sql.NullInt64{}
+			} else {
+				(out.Count).Int64 = int64(in.Int64Str())
+				(out.Count).Valid = true
+			}
+		case "score":
+			if in.IsNull() {
+				in.Skip()
+				out.Score = sql.NullFloat64{}
+			} else {
+				(out.Score).Float64 = float64(in.Float64())
+				(out.Score).Valid = true
+			}
+		case "active":
+			if in.IsNull() {
+				in.Skip()
+				out.Active = sql.NullBool{}
+			} else {
+				(out.Active).Bool = bool(in.Bool())
+				(out.Active).Valid = true
+			}
+		case "created":
+			if in.IsNull() {
+				in.Skip()
+				out.Created = sql.NullTime{}
+			} else {
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(out.Created).Time = in.Time("2006-01-02")
+				}
+				(out.Created).Valid = true
+			}
+		case "codes":
+			if in.IsNull() {
+				in.Skip()
+				out.Codes = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Codes == nil {
+					if !in.IsDelim(']') {
+						out.Codes = make([]sql.NullInt32, 0, 8)
+					} else {
+						out.Codes = []sql.NullInt32{}
+					}
+				} else {
+					out.Codes = (out.Codes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 sql.NullInt32
+					if in.IsNull() {
+						in.Skip()
+						v1 = sql.NullInt32{}
+					} else {
+						(v1).Int32 = int32(in.Int32())
+						(v1).Valid = true
+					}
+					out.Codes = append(out.Codes, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "ptr":
+			if in.IsNull() {
+				in.Skip()
+				out.Ptr = nil
+			} else {
+				if out.Ptr == nil {
+					out.Ptr = new(sql.NullString)
+				}
+				if in.IsNull() {
+					in.Skip()
+					*out.Ptr = sql.NullString{}
+				} else {
+					(*out.Ptr).String = string(in.String())
+					(*out.Ptr).Valid = true
+				}
+			}
+		case "by_name":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.ByName == nil {
+					out.ByName = make(map[string]sql.NullString)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := string(in.String())
+					in.WantColon()
+					v3 := in.ValueStart()
+					var v2 sql.NullString
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.ByName, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v2 = (out.ByName)[key]
+					}
+					if in.IsNull() {
+						in.Skip()
+						v2 = sql.NullString{}
+					} else {
+						(v2).String = string(in.String())
+						(v2).Valid = true
+					}
+					if !in.ValueSkipped(v3) {
+						(out.ByName)[key] = v2
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE98ff05cEncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in SQLNulls) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		if !(in.Name).Valid {
+			out.RawString("null")
+		} else {
+			out.String(string((in.Name).String))
+		}
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		if !(in.Count).Valid {
+			out.RawString("null")
+		} else {
+			out.Int64Str(int64((in.Count).Int64))
+		}
+	}
+	if (in.Score).Valid {
+		const prefix string = ",\"score\":"
+		out.RawString(prefix)
+		if !(in.Score).Valid {
+			out.RawString("null")
+		} else {
+			out.Float64(float64((in.Score).Float64))
+		}
+	}
+	{
+		const prefix string = ",\"active\":"
+		out.RawString(prefix)
+		if !(in.Active).Valid {
+			out.RawString("null")
+		} else {
+			out.Bool(bool((in.Active).Bool))
+		}
+	}
+	{
+		const prefix string = ",\"created\":"
+		out.RawString(prefix)
+		if !(in.Created).Valid {
+			out.RawString("null")
+		} else {
+			out.Time((in.Created).Time, "2006-01-02")
+		}
+	}
+	{
+		const prefix string = ",\"codes\":"
+		out.RawString(prefix)
+		if in.Codes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v4, v5 := range in.Codes {
+				if v4 > 0 {
+					out.RawByte(',')
+				}
+				if !(v5).Valid {
+					out.RawString("null")
+				} else {
+					out.Int32(int32((v5).Int32))
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"ptr\":"
+		out.RawString(prefix)
+		if in.Ptr == nil {
+			out.RawString("null")
+		} else {
+			if !(*in.Ptr).Valid {
+				out.RawString("null")
+			} else {
+				out.String(string((*in.Ptr).String))
+			}
+		}
+	}
+	{
+		const prefix string = ",\"by_name\":"
+		out.RawString(prefix)
+		if in.ByName == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v6First := true
+			for v6Name, v6Value := range in.ByName {
+				if v6First {
+					v6First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v6Name))
+				out.RawByte(':')
+				if !(v6Value).Valid {
+					out.RawString("null")
+				} else {
+					out.String(string((v6Value).String))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SQLNulls) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE98ff05cEncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SQLNulls) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE98ff05cEncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SQLNulls) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE98ff05cDecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SQLNulls) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE98ff05cDecodeGithubComMailruEasyjsonTests(l, v)
+}
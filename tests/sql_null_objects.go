@@ -0,0 +1,8 @@
+package tests
+
+import "database/sql"
+
+//easyjson:json
+type SQLNullObjects struct {
+	Name sql.NullString `json:"name"`
+}
@@ -0,0 +1,63 @@
+package tests
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestSQLNulls(t *testing.T) {
+	v := SQLNulls{
+		Name:    sql.NullString{String: "a", Valid: true},
+		Count:   sql.NullInt64{Int64: 12, Valid: true},
+		Score:   sql.NullFloat64{Float64: 0.5, Valid: true},
+		Active:  sql.NullBool{},
+		Created: sql.NullTime{Time: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+		Codes:   []sql.NullInt32{{Int32: 1, Valid: true}, {}},
+		Ptr:     &sql.NullString{String: "p", Valid: true},
+		ByName:  map[string]sql.NullString{"b": {String: "c", Valid: true}},
+	}
+	want := `{"name":"a","count":"12","score":0.5,"active":null,"created":"2024-03-01","codes":[1,null],"ptr":"p","by_name":{"b":"c"}}`
+
+	data, err := easyjson.Marshal(v)
+	if err != nil || string(data) != want {
+		t.Errorf("Marshal() = %s, %v; want %s", data, err, want)
+	}
+
+	var got SQLNulls
+	if err := easyjson.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	if !reflect.DeepEqual(got, v) {
+		t.Errorf("Unmarshal() = %+v; want %+v", got, v)
+	}
+
+	// null members reset the values, omitempty leaves the invalid ones out.
+	if err := easyjson.Unmarshal([]byte(`{"name":null,"count":null,"score":null}`), &got); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	if got.Name.Valid || got.Count.Valid || got.Score.Valid || !got.Created.Valid {
+		t.Errorf("Unmarshal() of null members = %+v", got)
+	}
+	want = `{"name":null,"count":null,"active":null,"created":null,"codes":null,"ptr":null,"by_name":null}`
+	if data, err := easyjson.Marshal(SQLNulls{}); err != nil || string(data) != want {
+		t.Errorf("Marshal() = %s, %v; want %s", data, err, want)
+	}
+}
+
+func TestSQLNullObjects(t *testing.T) {
+	v := SQLNullObjects{Name: sql.NullString{String: "a", Valid: true}}
+	want := `{"name":{"String":"a","Valid":true}}`
+
+	data, err := easyjson.Marshal(v)
+	if err != nil || string(data) != want {
+		t.Errorf("Marshal() = %s, %v; want %s", data, err, want)
+	}
+	var got SQLNullObjects
+	if err := easyjson.Unmarshal(data, &got); err != nil || got != v {
+		t.Errorf("Unmarshal() = %+v, %v; want %+v", got, err, v)
+	}
+}
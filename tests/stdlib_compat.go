@@ -0,0 +1,84 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// StdlibCompat is generated with the stdlib-compat profile. Only the top-level type has
+// marshalers, so that stdlibCompatStd, which has the same underlying type, is handled by
+// encoding/json entirely.
+//
+//easyjson:json
+type StdlibCompat struct {
+	Str     string    `json:"str"`
+	StrPtr  *string   `json:"str_ptr"`
+	Float32 float32   `json:"float32"`
+	Float64 float64   `json:"float64"`
+	FloatS  float64   `json:"float_s,string"`
+	Int     int       `json:"int"`
+	IntS    int64     `json:"int_s,string"`
+	Uint8   uint8     `json:"uint8"`
+	Bool    bool      `json:"bool,omitempty"`
+	Bytes   []byte    `json:"bytes"`
+	Array   [3]byte   `json:"array"`
+	Floats  []float64 `json:"floats"`
+
+	StrMap  map[string]int           `json:"str_map"`
+	IntMap  map[int]string           `json:"int_map"`
+	UintMap map[uint16]bool          `json:"uint_map"`
+	TextMap map[compatTextKey]string `json:"text_map"`
+
+	Inner    compatInner            `json:"inner"`
+	InnerPtr *compatInner           `json:"inner_ptr,omitempty"`
+	Inners   []compatInner          `json:"inners"`
+	InnerMap map[string]compatInner `json:"inner_map"`
+
+	Any  interface{}     `json:"any"`
+	Raw  json.RawMessage `json:"raw,omitempty"`
+	Text compatText      `json:"text"`
+
+	HTML   string `json:"<html>&"`
+	Kelvin int    `json:"kelvin"`
+	Upper  int    `json:"UPPER"`
+	Skip   int    `json:"-"`
+}
+
+type stdlibCompatStd StdlibCompat
+
+type compatInner struct {
+	A string            `json:"a"`
+	B []int             `json:"b"`
+	M map[string]string `json:"m,omitempty"`
+}
+
+// compatTextKey is a map key type encoded with encoding.TextMarshaler.
+type compatTextKey struct {
+	X, Y string
+}
+
+func (k compatTextKey) MarshalText() ([]byte, error) {
+	return []byte(k.X + ":" + k.Y), nil
+}
+
+func (k *compatTextKey) UnmarshalText(data []byte) error {
+	i := strings.IndexByte(string(data), ':')
+	if i == -1 {
+		return errors.New("missing ':' in key")
+	}
+	k.X, k.Y = string(data[:i]), string(data[i+1:])
+	return nil
+}
+
+// compatText is encoded with encoding.TextMarshaler.
+type compatText string
+
+func (t compatText) MarshalText() ([]byte, error) {
+	return []byte(strings.ToUpper(string(t))), nil
+}
+
+func (t *compatText) UnmarshalText(data []byte) error {
+	*t = compatText(strings.ToLower(string(data)))
+	return nil
+}
@@ -0,0 +1,1034 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	sort "sort"
+	strconv "strconv"
+	strings "strings"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjson2af28397DecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *StdlibCompat) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		switch key {
+		case "str", "str_ptr", "float32", "float64", "float_s", "int", "int_s", "uint8", "bool", "bytes", "array", "floats", "str_map", "int_map", "uint_map", "text_map", "inner", "inner_ptr", "inners", "inner_map", "any", "raw", "text", "<html>&", "kelvin", "UPPER":
+		default:
+			switch {
+			case strings.EqualFold(key, "str"):
+				key = "str"
+			case strings.EqualFold(key, "str_ptr"):
+				key = "str_ptr"
+			case strings.EqualFold(key, "float32"):
+				key = "float32"
+			case strings.EqualFold(key, "float64"):
+				key = "float64"
+			case strings.EqualFold(key, "float_s"):
+				key = "float_s"
+			case strings.EqualFold(key, "int"):
+				key = "int"
+			case strings.EqualFold(key, "int_s"):
+				key = "int_s"
+			case strings.EqualFold(key, "uint8"):
+				key = "uint8"
+			case strings.EqualFold(key, "bool"):
+				key = "bool"
+			case strings.EqualFold(key, "bytes"):
+				key = "bytes"
+			case strings.EqualFold(key, "array"):
+				key = "array"
+			case strings.EqualFold(key, "floats"):
+				key = "floats"
+			case strings.EqualFold(key, "str_map"):
+				key = "str_map"
+			case strings.EqualFold(key, "int_map"):
+				key = "int_map"
+			case strings.EqualFold(key, "uint_map"):
+				key = "uint_map"
+			case strings.EqualFold(key, "text_map"):
+				key = "text_map"
+			case strings.EqualFold(key, "inner"):
+				key = "inner"
+			case strings.EqualFold(key, "inner_ptr"):
+				key = "inner_ptr"
+			case strings.EqualFold(key, "inners"):
+				key = "inners"
+			case strings.EqualFold(key, "inner_map"):
+				key = "inner_map"
+			case strings.EqualFold(key, "any"):
+				key = "any"
+			case strings.EqualFold(key, "raw"):
+				key = "raw"
+			case strings.EqualFold(key, "text"):
+				key = "text"
+			case strings.EqualFold(key, "<html>&"):
+				key = "<html>&"
+			case strings.EqualFold(key, "kelvin"):
+				key = "kelvin"
+			case strings.EqualFold(key, "UPPER"):
+				key = "UPPER"
+			}
+		}
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "str":
+					out.Str = ""
+				case "str_ptr":
+					out.StrPtr = nil
+				case "float32":
+					out.Float32 = 0
+				case "float64":
+					out.Float64 = 0
+				case "float_s":
+					out.FloatS = 0
+				case "int":
+					out.Int = 0
+				case "int_s":
+					out.IntS = 0
+				case "uint8":
+					out.Uint8 = 0
+				case "bool":
+					out.Bool = false
+				case "bytes":
+					out.Bytes = nil
+				case "array":
+					out.Array = [3]uint8{}
+				case "floats":
+					out.Floats = nil
+				case "str_map":
+					out.StrMap = nil
+				case "int_map":
+					out.IntMap = nil
+				case "uint_map":
+					out.UintMap = nil
+				case "text_map":
+					out.TextMap = nil
+				case "inner":
+					out.Inner = compatInner{}
+				case "inner_ptr":
+					out.InnerPtr = nil
+				case "inners":
+					out.Inners = nil
+				case "inner_map":
+					out.InnerMap = nil
+				case "any":
+					out.Any = nil
+				case "raw":
+					out.Raw = nil
+				case "text":
+					out.Text = ""
+				case "<html>&":
+					out.HTML = ""
+				case "kelvin":
+					out.Kelvin = 0
+				case "UPPER":
+					out.Upper = 0
+				}
+			}
+			if !in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "str_ptr":
+					out.StrPtr = nil
+				case "bytes":
+					out.Bytes = nil
+				case "floats":
+					out.Floats = nil
+				case "str_map":
+					out.StrMap = nil
+				case "int_map":
+					out.IntMap = nil
+				case "uint_map":
+					out.UintMap = nil
+				case "text_map":
+					out.TextMap = nil
+				case "inner_ptr":
+					out.InnerPtr = nil
+				case "inners":
+					out.Inners = nil
+				case "inner_map":
+					out.InnerMap = nil
+				case "any":
+					out.Any = nil
+				case "raw":
+					in.AddError(out.Raw.UnmarshalJSON([]byte("null")))
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "str":
+			out.Str = string(in.StringCompat())
+		case "str_ptr":
+			if in.IsNull() {
+				in.Skip()
+				out.StrPtr = nil
+			} else {
+				if out.StrPtr == nil {
+					out.StrPtr = new(string)
+				}
+				*out.StrPtr = string(in.StringCompat())
+			}
+		case "float32":
+			out.Float32 = float32(in.Float32())
+		case "float64":
+			out.Float64 = float64(in.Float64())
+		case "float_s":
+			out.FloatS = float64(in.Float64Str())
+		case "int":
+			out.Int = int(in.Int())
+		case "int_s":
+			out.IntS = int64(in.Int64Str())
+		case "uint8":
+			out.Uint8 = uint8(in.Uint8())
+		case "bool":
+			out.Bool = bool(in.Bool())
+		case "bytes":
+			if in.IsNull() {
+				in.Skip()
+				out.Bytes = nil
+			} else {
+				out.Bytes = in.Bytes()
+			}
+		case "array":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				v2 := 0
+				for !in.IsDelim(']') {
+					if v2 < 3 {
+						(out.Array)[v2] = uint8(in.Uint8())
+						v2++
+					} else {
+						in.SkipRecursive()
+					}
+					in.WantComma()
+				}
+				for ; v2 < 3; v2++ {
+					(out.Array)[v2] = 0
+				}
+				in.Delim(']')
+			}
+		case "floats":
+			if in.IsNull() {
+				in.Skip()
+				out.Floats = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Floats == nil {
+					if !in.IsDelim(']') {
+						out.Floats = make([]float64, 0, 8)
+					} else {
+						out.Floats = []float64{}
+					}
+				} else {
+					out.Floats = (out.Floats)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 float64
+					if len(out.Floats) < cap(out.Floats) {
+						v3 = (out.Floats)[:len(out.Floats)+1][len(out.Floats)]
+					}
+					v3 = float64(in.Float64())
+					out.Floats = append(out.Floats, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "str_map":
+			if in.IsNull() {
+				in.Skip()
+				out.StrMap = nil
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if out.StrMap == nil {
+					out.StrMap = make(map[string]int)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := string(in.String())
+					in.WantColon()
+					v5 := in.ValueStart()
+					var v4 int
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.StrMap, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v4 = (out.StrMap)[key]
+					}
+					v4 = int(in.Int())
+					if !in.ValueSkipped(v5) {
+						(out.StrMap)[key] = v4
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "int_map":
+			if in.IsNull() {
+				in.Skip()
+				out.IntMap = nil
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if out.IntMap == nil {
+					out.IntMap = make(map[int]string)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := int(in.IntStr())
+					in.WantColon()
+					v7 := in.ValueStart()
+					var v6 string
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.IntMap, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v6 = (out.IntMap)[key]
+					}
+					v6 = string(in.StringCompat())
+					if !in.ValueSkipped(v7) {
+						(out.IntMap)[key] = v6
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "uint_map":
+			if in.IsNull() {
+				in.Skip()
+				out.UintMap = nil
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if out.UintMap == nil {
+					out.UintMap = make(map[uint16]bool)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := uint16(in.Uint16Str())
+					in.WantColon()
+					v9 := in.ValueStart()
+					var v8 bool
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.UintMap, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v8 = (out.UintMap)[key]
+					}
+					v8 = bool(in.Bool())
+					if !in.ValueSkipped(v9) {
+						(out.UintMap)[key] = v8
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "text_map":
+			if in.IsNull() {
+				in.Skip()
+				out.TextMap = nil
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if out.TextMap == nil {
+					out.TextMap = make(map[compatTextKey]string)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					var key compatTextKey
+					if data := in.UnsafeBytes(); in.Ok() {
+						in.AddError(key.UnmarshalText(data))
+					}
+					in.WantColon()
+					v11 := in.ValueStart()
+					var v10 string
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.TextMap, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v10 = (out.TextMap)[key]
+					}
+					v10 = string(in.StringCompat())
+					if !in.ValueSkipped(v11) {
+						(out.TextMap)[key] = v10
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "inner":
+			easyjson2af28397DecodeGithubComMailruEasyjsonTests1(in, &out.Inner)
+		case "inner_ptr":
+			if in.IsNull() {
+				in.Skip()
+				out.InnerPtr = nil
+			} else {
+				if out.InnerPtr == nil {
+					out.InnerPtr = new(compatInner)
+				}
+				easyjson2af28397DecodeGithubComMailruEasyjsonTests1(in, out.InnerPtr)
+			}
+		case "inners":
+			if in.IsNull() {
+				in.Skip()
+				out.Inners = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Inners == nil {
+					if !in.IsDelim(']') {
+						out.Inners = make([]compatInner, 0, 1)
+					} else {
+						out.Inners = []compatInner{}
+					}
+				} else {
+					out.Inners = (out.Inners)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v12 compatInner
+					if len(out.Inners) < cap(out.Inners) {
+						v12 = (out.Inners)[:len(out.Inners)+1][len(out.Inners)]
+					}
+					easyjson2af28397DecodeGithubComMailruEasyjsonTests1(in, &v12)
+					out.Inners = append(out.Inners, v12)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "inner_map":
+			if in.IsNull() {
+				in.Skip()
+				out.InnerMap = nil
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if out.InnerMap == nil {
+					out.InnerMap = make(map[string]compatInner)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := string(in.String())
+					in.WantColon()
+					v14 := in.ValueStart()
+					var v13 compatInner
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.InnerMap, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v13 = (out.InnerMap)[key]
+					}
+					easyjson2af28397DecodeGithubComMailruEasyjsonTests1(in, &v13)
+					if !in.ValueSkipped(v14) {
+						(out.InnerMap)[key] = v13
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "any":
+			if data := in.Raw(); in.Ok() {
+				in.AddError(json.Unmarshal(data, &out.Any))
+			}
+		case "raw":
+			if data := in.Raw(); in.Ok() {
+				in.AddValueError((out.Raw).UnmarshalJSON(data))
+			}
+		case "text":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddValueError((out.Text).UnmarshalText(data))
+			}
+		case "<html>&":
+			out.HTML = string(in.StringCompat())
+		case "kelvin":
+			out.Kelvin = int(in.Int())
+		case "UPPER":
+			out.Upper = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2af28397EncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in StdlibCompat) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"str\":"
+		out.RawString(prefix[1:])
+		out.StringCompat(string(in.Str))
+	}
+	{
+		const prefix string = ",\"str_ptr\":"
+		out.RawString(prefix)
+		if in.StrPtr == nil {
+			out.RawString("null")
+		} else {
+			out.StringCompat(string(*in.StrPtr))
+		}
+	}
+	{
+		const prefix string = ",\"float32\":"
+		out.RawString(prefix)
+		out.Float32Compat(float32(in.Float32))
+	}
+	{
+		const prefix string = ",\"float64\":"
+		out.RawString(prefix)
+		out.Float64Compat(float64(in.Float64))
+	}
+	{
+		const prefix string = ",\"float_s\":"
+		out.RawString(prefix)
+		out.Float64CompatStr(float64(in.FloatS))
+	}
+	{
+		const prefix string = ",\"int\":"
+		out.RawString(prefix)
+		out.Int(int(in.Int))
+	}
+	{
+		const prefix string = ",\"int_s\":"
+		out.RawString(prefix)
+		out.Int64Str(int64(in.IntS))
+	}
+	{
+		const prefix string = ",\"uint8\":"
+		out.RawString(prefix)
+		out.Uint8(uint8(in.Uint8))
+	}
+	if in.Bool {
+		const prefix string = ",\"bool\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Bool))
+	}
+	{
+		const prefix string = ",\"bytes\":"
+		out.RawString(prefix)
+		out.Base64Bytes(in.Bytes)
+	}
+	{
+		const prefix string = ",\"array\":"
+		out.RawString(prefix)
+		out.RawByte('[')
+		for v17 := range in.Array {
+			if v17 > 0 {
+				out.RawByte(',')
+			}
+			out.Uint8(uint8((in.Array)[v17]))
+		}
+		out.RawByte(']')
+	}
+	{
+		const prefix string = ",\"floats\":"
+		out.RawString(prefix)
+		if in.Floats == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v18, v19 := range in.Floats {
+				if v18 > 0 {
+					out.RawByte(',')
+				}
+				out.Float64Compat(float64(v19))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"str_map\":"
+		out.RawString(prefix)
+		if in.StrMap == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			v20Pairs := make([]struct {
+				Name  string
+				Value int
+			}, 0, len(in.StrMap))
+			for v20Name, v20Value := range in.StrMap {
+				v20Pairs = append(v20Pairs, struct {
+					Name  string
+					Value int
+				}{string(v20Name), v20Value})
+			}
+			sort.SliceStable(v20Pairs, func(i, j int) bool { return v20Pairs[i].Name < v20Pairs[j].Name })
+			out.RawByte('{')
+			for v20I, v20Pair := range v20Pairs {
+				if v20I > 0 {
+					out.RawByte(',')
+				}
+				out.StringCompat(v20Pair.Name)
+				out.RawByte(':')
+				out.Int(int(v20Pair.Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"int_map\":"
+		out.RawString(prefix)
+		if in.IntMap == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			v21Pairs := make([]struct {
+				Name  string
+				Value string
+			}, 0, len(in.IntMap))
+			for v21Name, v21Value := range in.IntMap {
+				v21Pairs = append(v21Pairs, struct {
+					Name  string
+					Value string
+				}{strconv.FormatInt(int64(v21Name), 10), v21Value})
+			}
+			sort.SliceStable(v21Pairs, func(i, j int) bool { return v21Pairs[i].Name < v21Pairs[j].Name })
+			out.RawByte('{')
+			for v21I, v21Pair := range v21Pairs {
+				if v21I > 0 {
+					out.RawByte(',')
+				}
+				out.StringCompat(v21Pair.Name)
+				out.RawByte(':')
+				out.StringCompat(string(v21Pair.Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"uint_map\":"
+		out.RawString(prefix)
+		if in.UintMap == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			v22Pairs := make([]struct {
+				Name  string
+				Value bool
+			}, 0, len(in.UintMap))
+			for v22Name, v22Value := range in.UintMap {
+				v22Pairs = append(v22Pairs, struct {
+					Name  string
+					Value bool
+				}{strconv.FormatUint(uint64(v22Name), 10), v22Value})
+			}
+			sort.SliceStable(v22Pairs, func(i, j int) bool { return v22Pairs[i].Name < v22Pairs[j].Name })
+			out.RawByte('{')
+			for v22I, v22Pair := range v22Pairs {
+				if v22I > 0 {
+					out.RawByte(',')
+				}
+				out.StringCompat(v22Pair.Name)
+				out.RawByte(':')
+				out.Bool(bool(v22Pair.Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"text_map\":"
+		out.RawString(prefix)
+		if in.TextMap == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			v23Pairs := make([]struct {
+				Name  string
+				Value string
+			}, 0, len(in.TextMap))
+			for v23Name, v23Value := range in.TextMap {
+				v23Text, err := (v23Name).MarshalText()
+				if err != nil && out.Error == nil {
+					out.Error = err
+				}
+				v23Pairs = append(v23Pairs, struct {
+					Name  string
+					Value string
+				}{jwriter.ValidUTF8(string(v23Text)), v23Value})
+			}
+			sort.SliceStable(v23Pairs, func(i, j int) bool { return v23Pairs[i].Name < v23Pairs[j].Name })
+			out.RawByte('{')
+			for v23I, v23Pair := range v23Pairs {
+				if v23I > 0 {
+					out.RawByte(',')
+				}
+				out.StringCompat(v23Pair.Name)
+				out.RawByte(':')
+				out.StringCompat(string(v23Pair.Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"inner\":"
+		out.RawString(prefix)
+		easyjson2af28397EncodeGithubComMailruEasyjsonTests1(out, in.Inner)
+	}
+	if in.InnerPtr != nil {
+		const prefix string = ",\"inner_ptr\":"
+		out.RawString(prefix)
+		easyjson2af28397EncodeGithubComMailruEasyjsonTests1(out, *in.InnerPtr)
+	}
+	{
+		const prefix string = ",\"inners\":"
+		out.RawString(prefix)
+		if in.Inners == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v24, v25 := range in.Inners {
+				if v24 > 0 {
+					out.RawByte(',')
+				}
+				easyjson2af28397EncodeGithubComMailruEasyjsonTests1(out, v25)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"inner_map\":"
+		out.RawString(prefix)
+		if in.InnerMap == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			v26Pairs := make([]struct {
+				Name  string
+				Value compatInner
+			}, 0, len(in.InnerMap))
+			for v26Name, v26Value := range in.InnerMap {
+				v26Pairs = append(v26Pairs, struct {
+					Name  string
+					Value compatInner
+				}{string(v26Name), v26Value})
+			}
+			sort.SliceStable(v26Pairs, func(i, j int) bool { return v26Pairs[i].Name < v26Pairs[j].Name })
+			out.RawByte('{')
+			for v26I, v26Pair := range v26Pairs {
+				if v26I > 0 {
+					out.RawByte(',')
+				}
+				out.StringCompat(v26Pair.Name)
+				out.RawByte(':')
+				easyjson2af28397EncodeGithubComMailruEasyjsonTests1(out, v26Pair.Value)
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"any\":"
+		out.RawString(prefix)
+		if m, ok := in.Any.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Any.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.Any))
+		}
+	}
+	if len(in.Raw) != 0 {
+		const prefix string = ",\"raw\":"
+		out.RawString(prefix)
+		out.RawCompat((in.Raw).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.RawTextCompat((in.Text).MarshalText())
+	}
+	{
+		const prefix string = ",\"\\u003chtml\\u003e\\u0026\":"
+		out.RawString(prefix)
+		out.StringCompat(string(in.HTML))
+	}
+	{
+		const prefix string = ",\"kelvin\":"
+		out.RawString(prefix)
+		out.Int(int(in.Kelvin))
+	}
+	{
+		const prefix string = ",\"UPPER\":"
+		out.RawString(prefix)
+		out.Int(int(in.Upper))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v StdlibCompat) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2af28397EncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v StdlibCompat) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2af28397EncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *StdlibCompat) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	r.CheckValid()
+	easyjson2af28397DecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *StdlibCompat) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	l.CheckValid()
+	easyjson2af28397DecodeGithubComMailruEasyjsonTests(l, v)
+}
+func easyjson2af28397DecodeGithubComMailruEasyjsonTests1(in *jlexer.Lexer, out *compatInner) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		switch key {
+		case "a", "b", "m":
+		default:
+			switch {
+			case strings.EqualFold(key, "a"):
+				key = "a"
+			case strings.EqualFold(key, "b"):
+				key = "b"
+			case strings.EqualFold(key, "m"):
+				key = "m"
+			}
+		}
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "a":
+					out.A = ""
+				case "b":
+					out.B = nil
+				case "m":
+					out.M = nil
+				}
+			}
+			if !in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "b":
+					out.B = nil
+				case "m":
+					out.M = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "a":
+			out.A = string(in.StringCompat())
+		case "b":
+			if in.IsNull() {
+				in.Skip()
+				out.B = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.B == nil {
+					if !in.IsDelim(']') {
+						out.B = make([]int, 0, 8)
+					} else {
+						out.B = []int{}
+					}
+				} else {
+					out.B = (out.B)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v27 int
+					if len(out.B) < cap(out.B) {
+						v27 = (out.B)[:len(out.B)+1][len(out.B)]
+					}
+					v27 = int(in.Int())
+					out.B = append(out.B, v27)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "m":
+			if in.IsNull() {
+				in.Skip()
+				out.M = nil
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if out.M == nil {
+					out.M = make(map[string]string)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := string(in.String())
+					in.WantColon()
+					v29 := in.ValueStart()
+					var v28 string
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.M, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v28 = (out.M)[key]
+					}
+					v28 = string(in.StringCompat())
+					if !in.ValueSkipped(v29) {
+						(out.M)[key] = v28
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2af28397EncodeGithubComMailruEasyjsonTests1(out *jwriter.Writer, in compatInner) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"a\":"
+		out.RawString(prefix[1:])
+		out.StringCompat(string(in.A))
+	}
+	{
+		const prefix string = ",\"b\":"
+		out.RawString(prefix)
+		if in.B == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v30, v31 := range in.B {
+				if v30 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v31))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.M) != 0 {
+		const prefix string = ",\"m\":"
+		out.RawString(prefix)
+		{
+			v32Pairs := make([]struct {
+				Name  string
+				Value string
+			}, 0, len(in.M))
+			for v32Name, v32Value := range in.M {
+				v32Pairs = append(v32Pairs, struct {
+					Name  string
+					Value string
+				}{string(v32Name), v32Value})
+			}
+			sort.SliceStable(v32Pairs, func(i, j int) bool { return v32Pairs[i].Name < v32Pairs[j].Name })
+			out.RawByte('{')
+			for v32I, v32Pair := range v32Pairs {
+				if v32I > 0 {
+					out.RawByte(',')
+				}
+				out.StringCompat(v32Pair.Name)
+				out.RawByte(':')
+				out.StringCompat(string(v32Pair.Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
@@ -0,0 +1,315 @@
+package tests
+
+import (
+	"encoding/json"
+	"math"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mailru/easyjson"
+	"github.com/mailru/easyjson/jlexer"
+)
+
+// The tests below compare the code generated with the stdlib-compat profile to
+// encoding/json on randomly generated values and a set of tricky inputs.
+
+var compatStrings = []string{
+	"",
+	"a",
+	"ünïcödé",
+	"<script>alert('&amp;')</script>",
+	"\b\f\n\r\t\x00\x1f\x7f",
+	"  ",
+	"invalid \xff\xfe utf-8 \xe2\x82",
+	"quote\" back\\slash /",
+	"emoji 😀",
+}
+
+var compatFloats = []float64{
+	0, math.Copysign(0, -1), 1, -1.5, 0.1, 1e-6, 9.999999e-7, 1e-7, 1e20, 1e21, 123456789.125,
+	5e-324, math.MaxFloat64, -math.MaxFloat32, math.SmallestNonzeroFloat32,
+}
+
+func randomCompatString(r *rand.Rand) string {
+	if r.Intn(3) > 0 {
+		return compatStrings[r.Intn(len(compatStrings))]
+	}
+	b := make([]byte, r.Intn(8))
+	for i := range b {
+		b[i] = byte(r.Intn(256))
+	}
+	return string(b)
+}
+
+func randomCompatFloat(r *rand.Rand) float64 {
+	if r.Intn(2) == 0 {
+		return compatFloats[r.Intn(len(compatFloats))]
+	}
+	return r.NormFloat64() * math.Pow(10, float64(r.Intn(60)-30))
+}
+
+func randomCompatAny(r *rand.Rand, depth int) interface{} {
+	switch n := r.Intn(6); {
+	case n == 0:
+		return nil
+	case n == 1:
+		return randomCompatFloat(r)
+	case n == 2:
+		return randomCompatString(r)
+	case n == 3:
+		return r.Intn(2) == 0
+	case n == 4 && depth < 3:
+		var ret []interface{}
+		for i := r.Intn(3); i > 0; i-- {
+			ret = append(ret, randomCompatAny(r, depth+1))
+		}
+		return ret
+	case depth < 3:
+		ret := map[string]interface{}{}
+		for i := r.Intn(3); i > 0; i-- {
+			ret[randomCompatString(r)] = randomCompatAny(r, depth+1)
+		}
+		return ret
+	}
+	return "leaf"
+}
+
+func randomCompatInner(r *rand.Rand) compatInner {
+	v := compatInner{A: randomCompatString(r)}
+	if r.Intn(2) == 0 {
+		v.B = make([]int, r.Intn(3))
+		for i := range v.B {
+			v.B[i] = r.Int()
+		}
+	}
+	if r.Intn(2) == 0 {
+		v.M = map[string]string{}
+		for i := r.Intn(3); i > 0; i-- {
+			v.M[randomCompatString(r)] = randomCompatString(r)
+		}
+	}
+	return v
+}
+
+func randomStdlibCompat(r *rand.Rand) StdlibCompat {
+	v := StdlibCompat{
+		Str:     randomCompatString(r),
+		Float32: float32(randomCompatFloat(r)),
+		Float64: randomCompatFloat(r),
+		FloatS:  randomCompatFloat(r),
+		Int:     r.Int() - r.Int(),
+		IntS:    r.Int63() - r.Int63(),
+		Uint8:   uint8(r.Intn(256)),
+		Bool:    r.Intn(2) == 0,
+		Inner:   randomCompatInner(r),
+		Any:     randomCompatAny(r, 0),
+		Text:    compatText(randomCompatString(r)),
+		HTML:    randomCompatString(r),
+		Kelvin:  r.Intn(100),
+		Upper:   r.Intn(100),
+	}
+	if math.IsInf(float64(v.Float32), 0) {
+		v.Float32 = math.MaxFloat32
+	}
+	if r.Intn(2) == 0 {
+		s := randomCompatString(r)
+		v.StrPtr = &s
+	}
+	if r.Intn(3) > 0 {
+		v.Bytes = []byte(randomCompatString(r))
+	}
+	r.Read(v.Array[:])
+	for i := r.Intn(4); i > 0; i-- {
+		v.Floats = append(v.Floats, randomCompatFloat(r))
+	}
+
+	if r.Intn(3) > 0 {
+		v.StrMap = map[string]int{}
+		v.IntMap = map[int]string{}
+		v.UintMap = map[uint16]bool{}
+		v.TextMap = map[compatTextKey]string{}
+		v.InnerMap = map[string]compatInner{}
+		for i := r.Intn(5); i > 0; i-- {
+			v.StrMap[randomCompatString(r)] = r.Int()
+			v.IntMap[r.Intn(2000)-1000] = randomCompatString(r)
+			v.UintMap[uint16(r.Intn(1<<16))] = r.Intn(2) == 0
+			v.TextMap[compatTextKey{randomCompatString(r), randomCompatString(r)}] = randomCompatString(r)
+			v.InnerMap[randomCompatString(r)] = randomCompatInner(r)
+		}
+	}
+
+	if r.Intn(2) == 0 {
+		inner := randomCompatInner(r)
+		v.InnerPtr = &inner
+	}
+	for i := r.Intn(3); i > 0; i-- {
+		v.Inners = append(v.Inners, randomCompatInner(r))
+	}
+	if r.Intn(2) == 0 {
+		v.Raw = json.RawMessage(` { "a" : [1, 2] , "b":"<b>" } `)
+	}
+	return v
+}
+
+func TestStdlibCompatMarshal(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		v := randomStdlibCompat(r)
+
+		want, err := json.Marshal(stdlibCompatStd(v))
+		if err != nil {
+			t.Fatalf("[%d] json.Marshal() error: %v", i, err)
+		}
+		got, err := easyjson.Marshal(v)
+		if err != nil {
+			t.Fatalf("[%d] easyjson.Marshal() error: %v", i, err)
+		}
+		if string(got) != string(want) {
+			t.Fatalf("[%d] easyjson.Marshal() = \n%s\nwant\n%s", i, got, want)
+		}
+	}
+}
+
+func TestStdlibCompatMarshalErrors(t *testing.T) {
+	for i, v := range []StdlibCompat{
+		{Float64: math.NaN()},
+		{Float32: float32(math.Inf(1))},
+		{FloatS: math.Inf(-1)},
+		{Raw: json.RawMessage(`{"a":}`)},
+	} {
+		_, stdErr := json.Marshal(stdlibCompatStd(v))
+		_, err := easyjson.Marshal(v)
+		if (err == nil) != (stdErr == nil) {
+			t.Errorf("[%d] easyjson.Marshal() error: %v; json.Marshal() error: %v", i, err, stdErr)
+		}
+	}
+}
+
+var compatInputs = []string{
+	`{}`,
+	`null`,
+	` {"str":"a"} `,
+	`{"STR":"a","Int":1,"kelvin":1,"Kelvin":2,"upper":3,"Upper":4}`,
+	`{"str":"a","str":"b","STR":"c"}`,
+	`{"str_ptr":null,"bytes":null,"floats":null,"str_map":null,"inner_ptr":null,"inners":null,"any":null,"raw":null}`,
+	`{"str":null,"int":null,"inner":null,"array":null}`,
+	`{"str":"\ud800A \udc00 😀 é"}`,
+	"{\"str\":\"invalid \xff utf-8\"}",
+	"{\"str\":\"raw\tcontrol\"}",
+	`{"int":1.5}`,
+	`{"int":"1"}`,
+	`{"int":1e2}`,
+	`{"int":-0}`,
+	`{"int_s":1}`,
+	`{"int_s":"12"}`,
+	`{"float_s":"1.5"}`,
+	`{"float_s":1.5}`,
+	`{"uint8":256}`,
+	`{"uint8":-1}`,
+	`{"float64":1e400}`,
+	`{"float32":1e39}`,
+	`{"any":1e400}`,
+	`{"any":{"a":[1,"b",null,true]}}`,
+	`{"array":[1,2]}`,
+	`{"array":[1,2,3,4]}`,
+	`{"array":"AQID"}`,
+	`{"bytes":"AQID"}`,
+	`{"bytes":"not base64"}`,
+	`{"int_map":{"1":"a","-2":"b"}}`,
+	`{"int_map":{"x":"a"}}`,
+	`{"uint_map":{"65536":true}}`,
+	`{"text_map":{"a:b":"c"}}`,
+	`{"text_map":{"nocolon":"c"}}`,
+	`{"text":"ABC"}`,
+	`{"text":null,"inner":{"b":null}}`,
+	`{"inner":{"A":"x","b":[1,2]},"inners":[{"a":"y"},{}]}`,
+	`{"inner_map":{"k":{"m":{"x":"y"}}}}`,
+	`{"raw":{ "a" : 1 }}`,
+	`{"<html>&":"x","<html>&":"y"}`,
+	`{"-":1,"skip":2,"Skip":3}`,
+	`{"unknown":[1,{"a":[]}]}`,
+	`{"unknown":[1,}`,
+	`{"str":"a",}`,
+	`{"str":"a"`,
+	`{"str":"a"} x`,
+	`{"str":"a"}{}`,
+	`[]`,
+	`"str"`,
+	``,
+	`{"str" "a"}`,
+	`{'str':"a"}`,
+	`{"int":01}`,
+	`{"bool":True}`,
+}
+
+func TestStdlibCompatUnmarshal(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+
+	inputs := append([]string(nil), compatInputs...)
+	for i := 0; i < 200; i++ {
+		data, err := json.Marshal(stdlibCompatStd(randomStdlibCompat(r)))
+		if err != nil {
+			t.Fatalf("json.Marshal() error: %v", err)
+		}
+		inputs = append(inputs, string(data))
+		if i%4 == 0 {
+			inputs = append(inputs, strings.Replace(string(data), `"str":`, `"Str":`, -1))
+		}
+	}
+
+	for i, input := range inputs {
+		// Decode both into a zero value and into a populated one, as encoding/json keeps
+		// some of the existing data.
+		for _, seed := range []int64{0, int64(i) + 1} {
+			var got StdlibCompat
+			var want stdlibCompatStd
+			if seed != 0 {
+				// Maps and slices are reused by both decoders, so the values are populated
+				// separately to not share them.
+				data, err := json.Marshal(stdlibCompatStd(randomStdlibCompat(rand.New(rand.NewSource(seed)))))
+				if err != nil {
+					t.Fatalf("json.Marshal() error: %v", err)
+				}
+				if err := json.Unmarshal(data, &want); err != nil {
+					t.Fatalf("json.Unmarshal() error: %v", err)
+				}
+				if err := json.Unmarshal(data, (*stdlibCompatStd)(&got)); err != nil {
+					t.Fatalf("json.Unmarshal() error: %v", err)
+				}
+			}
+
+			stdErr := json.Unmarshal([]byte(input), &want)
+			err := easyjson.Unmarshal([]byte(input), &got)
+			if (err == nil) != (stdErr == nil) {
+				t.Errorf("[%d] easyjson.Unmarshal(%s) error: %v; json.Unmarshal() error: %v", i, input, err, stdErr)
+				continue
+			}
+			if err == nil && !reflect.DeepEqual(stdlibCompatStd(got), want) {
+				t.Errorf("[%d] easyjson.Unmarshal(%s) = \n%+v\nwant\n%+v", i, input, got, want)
+			}
+
+			// The std-compatible UnmarshalJSON must behave the same.
+			var got2 StdlibCompat
+			if seed == 0 {
+				if err := got2.UnmarshalJSON([]byte(input)); (err == nil) != (stdErr == nil) {
+					t.Errorf("[%d] UnmarshalJSON(%s) error: %v; json.Unmarshal() error: %v", i, input, err, stdErr)
+				}
+			}
+		}
+	}
+}
+
+func TestStdlibCompatKeepOnNull(t *testing.T) {
+	s := "a"
+	v := StdlibCompat{StrPtr: &s, Floats: []float64{1}, StrMap: map[string]int{"a": 1}}
+	l := jlexer.Lexer{Data: []byte(`{"str_ptr":null,"floats":null,"str_map":null}`), KeepOnNull: true}
+	v.UnmarshalEasyJSON(&l)
+	if err := l.Error(); err != nil {
+		t.Fatalf("UnmarshalEasyJSON() error: %v", err)
+	}
+	if v.StrPtr != &s || len(v.Floats) != 1 || len(v.StrMap) != 1 {
+		t.Errorf("UnmarshalEasyJSON() with KeepOnNull reset fields: %+v", v)
+	}
+}
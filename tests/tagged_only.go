@@ -0,0 +1,15 @@
+package tests
+
+//easyjson:json
+type TaggedOnly struct {
+	TaggedOnlyBase
+	ID       int    `json:"id"`
+	Name     string `json:",omitempty"`
+	Password string
+	Internal int `json:"-"`
+}
+
+type TaggedOnlyBase struct {
+	Version int `json:"version"`
+	Secret  string
+}
@@ -0,0 +1,30 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestTaggedOnly(t *testing.T) {
+	v := TaggedOnly{
+		TaggedOnlyBase: TaggedOnlyBase{Version: 2, Secret: "s"},
+		ID:             1,
+		Name:           "n",
+		Password:       "p",
+		Internal:       3,
+	}
+	want := `{"version":2,"id":1,"Name":"n"}`
+
+	data, err := easyjson.Marshal(v)
+	if err != nil || string(data) != want {
+		t.Errorf("Marshal() = %s, %v; want %s", data, err, want)
+	}
+
+	var got TaggedOnly
+	input := `{"version":2,"Secret":"s","id":1,"Name":"n","Password":"p","Internal":3}`
+	wantValue := TaggedOnly{TaggedOnlyBase: TaggedOnlyBase{Version: 2}, ID: 1, Name: "n"}
+	if err := easyjson.Unmarshal([]byte(input), &got); err != nil || got != wantValue {
+		t.Errorf("Unmarshal(%s) = %+v, %v; want %+v", input, got, err, wantValue)
+	}
+}
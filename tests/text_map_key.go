@@ -0,0 +1,84 @@
+package tests
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// TextMapKey is a composite map key marshaled as "a/b", or as an empty string if both parts
+// are empty.
+type TextMapKey struct {
+	A, B string
+}
+
+func (k TextMapKey) MarshalText() ([]byte, error) {
+	if k == (TextMapKey{}) {
+		return nil, nil
+	}
+	return []byte(k.A + "/" + k.B), nil
+}
+
+func (k *TextMapKey) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*k = TextMapKey{}
+		return nil
+	}
+	parts := strings.SplitN(string(text), "/", 2)
+	if len(parts) != 2 {
+		return fmt.Errorf("invalid key %q", text)
+	}
+	k.A, k.B = parts[0], parts[1]
+	return nil
+}
+
+// TextMapID is an array map key marshaled in hex.
+type TextMapID [4]byte
+
+func (id TextMapID) MarshalText() ([]byte, error) {
+	return []byte(hex.EncodeToString(id[:])), nil
+}
+
+func (id *TextMapID) UnmarshalText(text []byte) error {
+	if hex.DecodedLen(len(text)) != len(id) {
+		return fmt.Errorf("invalid id %q", text)
+	}
+	_, err := hex.Decode(id[:], text)
+	return err
+}
+
+// TextMapName is a string map key, written as is like encoding/json does despite its MarshalText,
+// and unmarshaled in lower case.
+type TextMapName string
+
+func (n TextMapName) MarshalText() ([]byte, error) {
+	return []byte(strings.ToUpper(string(n))), nil
+}
+
+func (n *TextMapName) UnmarshalText(text []byte) error {
+	*n = TextMapName(strings.ToLower(string(text)))
+	return nil
+}
+
+type TextMapKeyValue struct {
+	V int
+}
+
+//easyjson:json
+type TextMapKeyStruct struct {
+	M     map[TextMapKey]int
+	Empty map[TextMapKey]int
+	Ptr   map[TextMapKey]*TextMapKeyValue
+	IDs   map[TextMapID]string
+	Names map[TextMapName]int
+}
+
+var textMapKeyValue = TextMapKeyStruct{
+	M:     map[TextMapKey]int{{A: "a", B: "b"}: 1},
+	Empty: map[TextMapKey]int{{}: 2},
+	Ptr:   map[TextMapKey]*TextMapKeyValue{{A: "x", B: "y/z"}: {V: 3}},
+	IDs:   map[TextMapID]string{{0x01, 0x02, 0xab, 0xff}: "id"},
+	Names: map[TextMapName]int{"name": 4},
+}
+
+var textMapKeyString = `{"M":{"a/b":1},"Empty":{"":2},"Ptr":{"x/y/z":{"V":3}},"IDs":{"0102abff":"id"},"Names":{"name":4}}`
@@ -0,0 +1,455 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjson1a662ae2DecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *TextMapKeyStruct) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "M":
+					out.M = nil
+				case "Empty":
+					out.Empty = nil
+				case "Ptr":
+					out.Ptr = nil
+				case "IDs":
+					out.IDs = nil
+				case "Names":
+					out.Names = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "M":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.M == nil {
+					out.M = make(map[TextMapKey]int)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					var key TextMapKey
+					if data := in.UnsafeBytes(); in.Ok() {
+						in.AddError(key.UnmarshalText(data))
+					}
+					in.WantColon()
+					v2 := in.ValueStart()
+					var v1 int
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.M, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v1 = (out.M)[key]
+					}
+					v1 = int(in.Int())
+					if !in.ValueSkipped(v2) {
+						(out.M)[key] = v1
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "Empty":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.Empty == nil {
+					out.Empty = make(map[TextMapKey]int)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					var key TextMapKey
+					if data := in.UnsafeBytes(); in.Ok() {
+						in.AddError(key.UnmarshalText(data))
+					}
+					in.WantColon()
+					v4 := in.ValueStart()
+					var v3 int
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.Empty, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v3 = (out.Empty)[key]
+					}
+					v3 = int(in.Int())
+					if !in.ValueSkipped(v4) {
+						(out.Empty)[key] = v3
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "Ptr":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.Ptr == nil {
+					out.Ptr = make(map[TextMapKey]*TextMapKeyValue)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					var key TextMapKey
+					if data := in.UnsafeBytes(); in.Ok() {
+						in.AddError(key.UnmarshalText(data))
+					}
+					in.WantColon()
+					v6 := in.ValueStart()
+					var v5 *TextMapKeyValue
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.Ptr, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v5 = (out.Ptr)[key]
+					}
+					if in.IsNull() {
+						in.Skip()
+						v5 = nil
+					} else {
+						if v5 == nil {
+							v5 = new(TextMapKeyValue)
+						}
+						easyjson1a662ae2DecodeGithubComMailruEasyjsonTests1(in, v5)
+					}
+					if !in.ValueSkipped(v6) {
+						(out.Ptr)[key] = v5
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "IDs":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.IDs == nil {
+					out.IDs = make(map[TextMapID]string)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					var key TextMapID
+					if data := in.UnsafeBytes(); in.Ok() {
+						in.AddError(key.UnmarshalText(data))
+					}
+					in.WantColon()
+					v8 := in.ValueStart()
+					var v7 string
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.IDs, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v7 = (out.IDs)[key]
+					}
+					v7 = string(in.String())
+					if !in.ValueSkipped(v8) {
+						(out.IDs)[key] = v7
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "Names":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.Names == nil {
+					out.Names = make(map[TextMapName]int)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					var key TextMapName
+					if data := in.UnsafeBytes(); in.Ok() {
+						in.AddError(key.UnmarshalText(data))
+					}
+					in.WantColon()
+					v10 := in.ValueStart()
+					var v9 int
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.Names, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v9 = (out.Names)[key]
+					}
+					v9 = int(in.Int())
+					if !in.ValueSkipped(v10) {
+						(out.Names)[key] = v9
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1a662ae2EncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in TextMapKeyStruct) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"M\":"
+		out.RawString(prefix[1:])
+		if in.M == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v11First := true
+			for v11Name, v11Value := range in.M {
+				if v11First {
+					v11First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.TextKey((v11Name).MarshalText())
+				out.RawByte(':')
+				out.Int(int(v11Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"Empty\":"
+		out.RawString(prefix)
+		if in.Empty == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v12First := true
+			for v12Name, v12Value := range in.Empty {
+				if v12First {
+					v12First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.TextKey((v12Name).MarshalText())
+				out.RawByte(':')
+				out.Int(int(v12Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"Ptr\":"
+		out.RawString(prefix)
+		if in.Ptr == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v13First := true
+			for v13Name, v13Value := range in.Ptr {
+				if v13First {
+					v13First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.TextKey((v13Name).MarshalText())
+				out.RawByte(':')
+				if v13Value == nil {
+					out.RawString("null")
+				} else {
+					easyjson1a662ae2EncodeGithubComMailruEasyjsonTests1(out, *v13Value)
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"IDs\":"
+		out.RawString(prefix)
+		if in.IDs == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v14First := true
+			for v14Name, v14Value := range in.IDs {
+				if v14First {
+					v14First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.TextKey((v14Name).MarshalText())
+				out.RawByte(':')
+				out.String(string(v14Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"Names\":"
+		out.RawString(prefix)
+		if in.Names == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v15First := true
+			for v15Name, v15Value := range in.Names {
+				if v15First {
+					v15First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v15Name))
+				out.RawByte(':')
+				out.Int(int(v15Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TextMapKeyStruct) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1a662ae2EncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TextMapKeyStruct) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1a662ae2EncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TextMapKeyStruct) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1a662ae2DecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TextMapKeyStruct) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1a662ae2DecodeGithubComMailruEasyjsonTests(l, v)
+}
+func easyjson1a662ae2DecodeGithubComMailruEasyjsonTests1(in *jlexer.Lexer, out *TextMapKeyValue) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "V":
+					out.V = 0
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "V":
+			out.V = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1a662ae2EncodeGithubComMailruEasyjsonTests1(out *jwriter.Writer, in TextMapKeyValue) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"V\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.V))
+	}
+	out.RawByte('}')
+}
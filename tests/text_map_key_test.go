@@ -0,0 +1,34 @@
+package tests
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestTextMapKeyStdlib(t *testing.T) {
+	std, err := json.Marshal(textMapKeyValue)
+	if err != nil || string(std) != textMapKeyString {
+		t.Errorf("json.Marshal() = %s, %v; want %s", std, err, textMapKeyString)
+	}
+
+	// both decode the keys of string kinds with UnmarshalText
+	in := `{"IDs":{"0102abff":"id"},"Names":{"NAME":4}}`
+	want := TextMapKeyStruct{
+		IDs:   map[TextMapID]string{{0x01, 0x02, 0xab, 0xff}: "id"},
+		Names: map[TextMapName]int{"name": 4},
+	}
+	var v, stdV TextMapKeyStruct
+	if err := easyjson.Unmarshal([]byte(in), &v); err != nil || !reflect.DeepEqual(v, want) {
+		t.Errorf("Unmarshal() = %+v, %v; want %+v", v, err, want)
+	}
+	if err := json.Unmarshal([]byte(in), &stdV); err != nil || !reflect.DeepEqual(stdV, want) {
+		t.Errorf("json.Unmarshal() = %+v, %v; want %+v", stdV, err, want)
+	}
+
+	if err := easyjson.Unmarshal([]byte(`{"IDs":{"01":"short"}}`), &v); err == nil {
+		t.Error("Unmarshal() of an invalid key succeeded")
+	}
+}
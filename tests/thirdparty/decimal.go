@@ -0,0 +1,11 @@
+package thirdparty
+
+import "github.com/shopspring/decimal"
+
+//easyjson:json
+type Decimals struct {
+	Price    decimal.Decimal   `json:"price"`
+	Amount   decimal.Decimal   `json:"amount" easyjson:"number"`
+	Optional *decimal.Decimal  `json:"optional,omitempty"`
+	Rates    []decimal.Decimal `json:"rates"`
+}
@@ -0,0 +1,189 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package thirdparty
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	decimal "github.com/shopspring/decimal"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjson12a267dfDecodeGithubComMailruEasyjsonTestsThirdparty(in *jlexer.Lexer, out *Decimals) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "price":
+					out.Price = decimal.Decimal{}
+				case "amount":
+					out.Amount = decimal.Decimal{}
+				case "optional":
+					out.Optional = nil
+				case "rates":
+					out.Rates = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "price":
+			if in.IsNull() {
+				in.Skip()
+			} else if v1, v2 := decimal.NewFromString(string(in.JsonNumber())); v2 != nil {
+				in.AddError(v2)
+			} else {
+				out.Price = v1
+			}
+		case "amount":
+			if in.IsNull() {
+				in.Skip()
+			} else if v3, v4 := decimal.NewFromString(string(in.JsonNumber())); v4 != nil {
+				in.AddError(v4)
+			} else {
+				out.Amount = v3
+			}
+		case "optional":
+			if in.IsNull() {
+				in.Skip()
+				out.Optional = nil
+			} else {
+				if out.Optional == nil {
+					out.Optional = new(decimal.Decimal)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else if v5, v6 := decimal.NewFromString(string(in.JsonNumber())); v6 != nil {
+					in.AddError(v6)
+				} else {
+					*out.Optional = v5
+				}
+			}
+		case "rates":
+			if in.IsNull() {
+				in.Skip()
+				out.Rates = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Rates == nil {
+					if !in.IsDelim(']') {
+						out.Rates = make([]decimal.Decimal, 0, 4)
+					} else {
+						out.Rates = []decimal.Decimal{}
+					}
+				} else {
+					out.Rates = (out.Rates)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 decimal.Decimal
+					if in.IsNull() {
+						in.Skip()
+					} else if v8, v9 := decimal.NewFromString(string(in.JsonNumber())); v9 != nil {
+						in.AddError(v9)
+					} else {
+						v7 = v8
+					}
+					out.Rates = append(out.Rates, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson12a267dfEncodeGithubComMailruEasyjsonTestsThirdparty(out *jwriter.Writer, in Decimals) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix[1:])
+		out.String((in.Price).String())
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		out.RawString((in.Amount).String())
+	}
+	if in.Optional != nil {
+		const prefix string = ",\"optional\":"
+		out.RawString(prefix)
+		out.String((*in.Optional).String())
+	}
+	{
+		const prefix string = ",\"rates\":"
+		out.RawString(prefix)
+		if in.Rates == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v10, v11 := range in.Rates {
+				if v10 > 0 {
+					out.RawByte(',')
+				}
+				out.String((v11).String())
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Decimals) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson12a267dfEncodeGithubComMailruEasyjsonTestsThirdparty(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Decimals) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson12a267dfEncodeGithubComMailruEasyjsonTestsThirdparty(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Decimals) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson12a267dfDecodeGithubComMailruEasyjsonTestsThirdparty(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Decimals) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson12a267dfDecodeGithubComMailruEasyjsonTestsThirdparty(l, v)
+}
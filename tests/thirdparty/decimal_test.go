@@ -0,0 +1,55 @@
+package thirdparty
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mailru/easyjson"
+	"github.com/shopspring/decimal"
+)
+
+func TestDecimals(t *testing.T) {
+	v := Decimals{
+		Price:  decimal.RequireFromString("19.99"),
+		Amount: decimal.RequireFromString("-12345678901234567890.000001"),
+		Rates:  []decimal.Decimal{decimal.New(5, -2), decimal.Zero},
+	}
+	want := `{"price":"19.99","amount":-12345678901234567890.000001,"rates":["0.05","0"]}`
+
+	data, err := easyjson.Marshal(v)
+	if err != nil || string(data) != want {
+		t.Errorf("Marshal() = %s, %v; want %s", data, err, want)
+	}
+
+	var got Decimals
+	if err := easyjson.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	if !got.Price.Equal(v.Price) || !got.Amount.Equal(v.Amount) || len(got.Rates) != 2 || !got.Rates[0].Equal(v.Rates[0]) {
+		t.Errorf("Unmarshal() = %+v; want %+v", got, v)
+	}
+
+	// The input is read like the UnmarshalJSON method does it.
+	data = []byte(`{"price":19.99,"amount":"1e3","optional":"0.5"}`)
+	var std Decimals
+	if err := json.Unmarshal(data, &std); err != nil {
+		t.Fatalf("json.Unmarshal() error: %v", err)
+	}
+	got = Decimals{}
+	if err := easyjson.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	if !got.Price.Equal(std.Price) || !got.Amount.Equal(std.Amount) || got.Optional == nil || !got.Optional.Equal(*std.Optional) {
+		t.Errorf("Unmarshal(%s) = %+v; want %+v", data, got, std)
+	}
+
+	if err := easyjson.Unmarshal([]byte(`{"price":"1.2.3"}`), &got); err == nil {
+		t.Error("Unmarshal() of an invalid decimal succeeded")
+	}
+	if err := easyjson.Unmarshal([]byte(`{"price":""}`), &got); err == nil {
+		t.Error("Unmarshal() of an empty decimal succeeded")
+	}
+	if err := easyjson.Unmarshal([]byte(`{"rates":[null]}`), &got); err != nil || len(got.Rates) != 1 || !got.Rates[0].IsZero() {
+		t.Errorf("Unmarshal() of a null decimal = %+v, %v; want a zero rate", got.Rates, err)
+	}
+}
@@ -0,0 +1,3 @@
+// Package thirdparty tests marshaling the types of third-party packages. It is a module of its
+// own, so that the easyjson module does not depend on these packages.
+package thirdparty
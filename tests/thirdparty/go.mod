@@ -0,0 +1,15 @@
+module github.com/mailru/easyjson/tests/thirdparty
+
+go 1.18
+
+require (
+	github.com/francoispqt/gojay v1.2.13
+	github.com/google/uuid v1.6.0
+	github.com/mailru/easyjson v0.0.0
+	github.com/shopspring/decimal v1.4.0
+	google.golang.org/protobuf v1.34.2
+)
+
+require github.com/josharian/intern v1.0.0 // indirect
+
+replace github.com/mailru/easyjson => ../..
@@ -0,0 +1,12 @@
+package thirdparty
+
+//easyjson:json
+type GojayItem struct {
+	Name  string            `json:"name"`
+	Tags  []string          `json:"tags,omitempty"`
+	Attrs map[string]int    `json:"attrs,omitempty"`
+	Data  []byte            `json:"data" easyjson:"transform=base64"`
+	Skip  int               `json:"-"`
+	Inner *GojayItem        `json:"inner,omitempty"`
+	Extra map[string]string `json:"extra"`
+}
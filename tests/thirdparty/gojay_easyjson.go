@@ -0,0 +1,503 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package thirdparty
+
+import (
+	json "encoding/json"
+	gojay "github.com/francoispqt/gojay"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjson2cd15400DecodeGithubComMailruEasyjsonTestsThirdparty(in *jlexer.Lexer, out *GojayItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "name":
+					out.Name = ""
+				case "tags":
+					out.Tags = nil
+				case "attrs":
+					out.Attrs = nil
+				case "data":
+					out.Data = nil
+				case "inner":
+					out.Inner = nil
+				case "extra":
+					out.Extra = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]string, 0, 4)
+					} else {
+						out.Tags = []string{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 string
+					v1 = string(in.String())
+					out.Tags = append(out.Tags, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "attrs":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.Attrs == nil {
+					if !in.IsDelim('}') {
+						out.Attrs = make(map[string]int)
+					} else {
+						out.Attrs = nil
+					}
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := string(in.String())
+					in.WantColon()
+					v3 := in.ValueStart()
+					var v2 int
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.Attrs, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v2 = (out.Attrs)[key]
+					}
+					v2 = int(in.Int())
+					if !in.ValueSkipped(v3) {
+						(out.Attrs)[key] = v2
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "data":
+			if data := in.UnsafeBytes(); in.Ok() {
+				v4, err := easyjson.DecodeTransform("base64", data)
+				in.AddError(err)
+				out.Data = []uint8(v4)
+			}
+		case "inner":
+			if in.IsNull() {
+				in.Skip()
+				out.Inner = nil
+			} else {
+				if out.Inner == nil {
+					out.Inner = new(GojayItem)
+				}
+				easyjson2cd15400DecodeGithubComMailruEasyjsonTestsThirdparty(in, out.Inner)
+			}
+		case "extra":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.Extra == nil {
+					out.Extra = make(map[string]string)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := string(in.String())
+					in.WantColon()
+					v6 := in.ValueStart()
+					var v5 string
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.Extra, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v5 = (out.Extra)[key]
+					}
+					v5 = string(in.String())
+					if !in.ValueSkipped(v6) {
+						(out.Extra)[key] = v5
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2cd15400EncodeGithubComMailruEasyjsonTestsThirdparty(out *jwriter.Writer, in GojayItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	if len(in.Tags) != 0 {
+		const prefix string = ",\"tags\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v7, v8 := range in.Tags {
+				if v7 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v8))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Attrs) != 0 {
+		const prefix string = ",\"attrs\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v9First := true
+			for v9Name, v9Value := range in.Attrs {
+				if v9First {
+					v9First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v9Name))
+				out.RawByte(':')
+				out.Int(int(v9Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"data\":"
+		out.RawString(prefix)
+		out.TextString(easyjson.EncodeTransform("base64", []byte(in.Data)))
+	}
+	if in.Inner != nil {
+		const prefix string = ",\"inner\":"
+		out.RawString(prefix)
+		easyjson2cd15400EncodeGithubComMailruEasyjsonTestsThirdparty(out, *in.Inner)
+	}
+	{
+		const prefix string = ",\"extra\":"
+		out.RawString(prefix)
+		if in.Extra == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v10First := true
+			for v10Name, v10Value := range in.Extra {
+				if v10First {
+					v10First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v10Name))
+				out.RawByte(':')
+				out.String(string(v10Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GojayItem) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2cd15400EncodeGithubComMailruEasyjsonTestsThirdparty(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v GojayItem) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2cd15400EncodeGithubComMailruEasyjsonTestsThirdparty(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GojayItem) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2cd15400DecodeGithubComMailruEasyjsonTestsThirdparty(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *GojayItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2cd15400DecodeGithubComMailruEasyjsonTestsThirdparty(l, v)
+}
+
+// MarshalJSONObject supports gojay.MarshalerJSONObject interface
+func (v *GojayItem) MarshalJSONObject(enc *gojay.Encoder) {
+	out := &jwriter.Writer{}
+	{
+		out.String(string(v.Name))
+		data := gojay.EmbeddedJSON(out.Buffer.BuildBytes())
+		enc.AddEmbeddedJSONKey("name", &data)
+	}
+	if len(v.Tags) != 0 {
+		{
+			out.RawByte('[')
+			for v11, v12 := range v.Tags {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v12))
+			}
+			out.RawByte(']')
+		}
+		data := gojay.EmbeddedJSON(out.Buffer.BuildBytes())
+		enc.AddEmbeddedJSONKey("tags", &data)
+	}
+	if len(v.Attrs) != 0 {
+		{
+			out.RawByte('{')
+			v13First := true
+			for v13Name, v13Value := range v.Attrs {
+				if v13First {
+					v13First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v13Name))
+				out.RawByte(':')
+				out.Int(int(v13Value))
+			}
+			out.RawByte('}')
+		}
+		data := gojay.EmbeddedJSON(out.Buffer.BuildBytes())
+		enc.AddEmbeddedJSONKey("attrs", &data)
+	}
+	{
+		out.TextString(easyjson.EncodeTransform("base64", []byte(v.Data)))
+		data := gojay.EmbeddedJSON(out.Buffer.BuildBytes())
+		enc.AddEmbeddedJSONKey("data", &data)
+	}
+	if v.Inner != nil {
+		easyjson2cd15400EncodeGithubComMailruEasyjsonTestsThirdparty(out, *v.Inner)
+		data := gojay.EmbeddedJSON(out.Buffer.BuildBytes())
+		enc.AddEmbeddedJSONKey("inner", &data)
+	}
+	{
+		if v.Extra == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v14First := true
+			for v14Name, v14Value := range v.Extra {
+				if v14First {
+					v14First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v14Name))
+				out.RawByte(':')
+				out.String(string(v14Value))
+			}
+			out.RawByte('}')
+		}
+		data := gojay.EmbeddedJSON(out.Buffer.BuildBytes())
+		enc.AddEmbeddedJSONKey("extra", &data)
+	}
+}
+
+// IsNil supports gojay.MarshalerJSONObject interface
+func (v *GojayItem) IsNil() bool {
+	return v == nil
+}
+
+// UnmarshalJSONObject supports gojay.UnmarshalerJSONObject interface
+func (v *GojayItem) UnmarshalJSONObject(dec *gojay.Decoder, key string) error {
+	var data gojay.EmbeddedJSON
+	if err := dec.EmbeddedJSON(&data); err != nil {
+		return err
+	}
+	in := &jlexer.Lexer{Data: data}
+	switch key {
+	case "name":
+		v.Name = string(in.String())
+	case "tags":
+		if in.IsNull() {
+			in.Skip()
+			v.Tags = nil
+		} else if !in.IsDelim('[') {
+			in.Delim('[')
+			in.Delim(']')
+		} else {
+			in.Delim('[')
+			if v.Tags == nil {
+				if !in.IsDelim(']') {
+					v.Tags = make([]string, 0, 4)
+				} else {
+					v.Tags = []string{}
+				}
+			} else {
+				v.Tags = (v.Tags)[:0]
+			}
+			for !in.IsDelim(']') {
+				var v15 string
+				v15 = string(in.String())
+				v.Tags = append(v.Tags, v15)
+				in.WantComma()
+			}
+			in.Delim(']')
+		}
+	case "attrs":
+		if in.IsNull() {
+			in.Skip()
+		} else if !in.IsDelim('{') {
+			in.Delim('{')
+			in.Delim('}')
+		} else {
+			in.Delim('{')
+			if !in.MergePatch || v.Attrs == nil {
+				if !in.IsDelim('}') {
+					v.Attrs = make(map[string]int)
+				} else {
+					v.Attrs = nil
+				}
+			}
+			for !in.IsDelim('}') {
+				in.MarkKey()
+				key := string(in.String())
+				in.WantColon()
+				v17 := in.ValueStart()
+				var v16 int
+				if in.MergePatch {
+					if in.IsNull() {
+						in.Skip()
+						if !in.KeepOnNull {
+							delete(v.Attrs, key)
+						}
+						in.WantComma()
+						continue
+					}
+					v16 = (v.Attrs)[key]
+				}
+				v16 = int(in.Int())
+				if !in.ValueSkipped(v17) {
+					(v.Attrs)[key] = v16
+				}
+				in.WantComma()
+			}
+			in.Delim('}')
+		}
+	case "data":
+		if data := in.UnsafeBytes(); in.Ok() {
+			v18, err := easyjson.DecodeTransform("base64", data)
+			in.AddError(err)
+			v.Data = []uint8(v18)
+		}
+	case "inner":
+		if in.IsNull() {
+			in.Skip()
+			v.Inner = nil
+		} else {
+			if v.Inner == nil {
+				v.Inner = new(GojayItem)
+			}
+			easyjson2cd15400DecodeGithubComMailruEasyjsonTestsThirdparty(in, v.Inner)
+		}
+	case "extra":
+		if in.IsNull() {
+			in.Skip()
+		} else if !in.IsDelim('{') {
+			in.Delim('{')
+			in.Delim('}')
+		} else {
+			in.Delim('{')
+			if !in.MergePatch || v.Extra == nil {
+				v.Extra = make(map[string]string)
+			}
+			for !in.IsDelim('}') {
+				in.MarkKey()
+				key := string(in.String())
+				in.WantColon()
+				v20 := in.ValueStart()
+				var v19 string
+				if in.MergePatch {
+					if in.IsNull() {
+						in.Skip()
+						if !in.KeepOnNull {
+							delete(v.Extra, key)
+						}
+						in.WantComma()
+						continue
+					}
+					v19 = (v.Extra)[key]
+				}
+				v19 = string(in.String())
+				if !in.ValueSkipped(v20) {
+					(v.Extra)[key] = v19
+				}
+				in.WantComma()
+			}
+			in.Delim('}')
+		}
+	}
+	return in.Error()
+}
+
+// NKeys supports gojay.UnmarshalerJSONObject interface
+func (v *GojayItem) NKeys() int {
+	return 0
+}
@@ -0,0 +1,47 @@
+package thirdparty
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/francoispqt/gojay"
+	"github.com/mailru/easyjson"
+)
+
+func TestGojayAdapters(t *testing.T) {
+	v := &GojayItem{
+		Name:  "a",
+		Tags:  []string{"x", "y"},
+		Attrs: map[string]int{"n": 1},
+		Data:  []byte("raw"),
+		Skip:  5,
+		Inner: &GojayItem{Name: "b"},
+	}
+
+	data, err := gojay.MarshalJSONObject(v)
+	if err != nil {
+		t.Fatalf("gojay.MarshalJSONObject() error: %v", err)
+	}
+	// The output is the same as the one of the easyjson methods.
+	want, err := easyjson.Marshal(v)
+	if err != nil {
+		t.Fatalf("easyjson.Marshal() error: %v", err)
+	}
+	if string(data) != string(want) {
+		t.Errorf("gojay.MarshalJSONObject() = %s; want %s", data, want)
+	}
+
+	got := &GojayItem{}
+	if err := gojay.UnmarshalJSONObject(data, got); err != nil {
+		t.Fatalf("gojay.UnmarshalJSONObject(%s) error: %v", data, err)
+	}
+	v.Skip = 0
+	v.Inner.Data = []byte{} // written as "", not null
+	if !reflect.DeepEqual(got, v) {
+		t.Errorf("gojay.UnmarshalJSONObject(%s) = %+v; want %+v", data, got, v)
+	}
+
+	if err := gojay.UnmarshalJSONObject([]byte(`{"name":1}`), &GojayItem{}); err == nil {
+		t.Error("gojay.UnmarshalJSONObject() of a number name succeeded; want an error")
+	}
+}
@@ -0,0 +1,11 @@
+package thirdparty
+
+import "github.com/google/uuid"
+
+//easyjson:json
+type KnownTypes struct {
+	ID      uuid.UUID            `json:"id"`
+	Parent  *uuid.UUID           `json:"parent"`
+	Members []uuid.UUID          `json:"members"`
+	ByName  map[string]uuid.UUID `json:"by_name"`
+}
@@ -0,0 +1,219 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package thirdparty
+
+import (
+	json "encoding/json"
+	uuid "github.com/google/uuid"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjsonB3129ce9DecodeGithubComMailruEasyjsonTestsThirdparty(in *jlexer.Lexer, out *KnownTypes) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "id":
+					out.ID = uuid.UUID{}
+				case "parent":
+					out.Parent = nil
+				case "members":
+					out.Members = nil
+				case "by_name":
+					out.ByName = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			in.UUID((*[16]byte)(&out.ID))
+		case "parent":
+			if in.IsNull() {
+				in.Skip()
+				out.Parent = nil
+			} else {
+				if out.Parent == nil {
+					out.Parent = new(uuid.UUID)
+				}
+				in.UUID((*[16]byte)(&*out.Parent))
+			}
+		case "members":
+			if in.IsNull() {
+				in.Skip()
+				out.Members = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Members == nil {
+					if !in.IsDelim(']') {
+						out.Members = make([]uuid.UUID, 0, 4)
+					} else {
+						out.Members = []uuid.UUID{}
+					}
+				} else {
+					out.Members = (out.Members)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 uuid.UUID
+					in.UUID((*[16]byte)(&v1))
+					out.Members = append(out.Members, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "by_name":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.ByName == nil {
+					out.ByName = make(map[string]uuid.UUID)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := string(in.String())
+					in.WantColon()
+					v3 := in.ValueStart()
+					var v2 uuid.UUID
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.ByName, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v2 = (out.ByName)[key]
+					}
+					in.UUID((*[16]byte)(&v2))
+					if !in.ValueSkipped(v3) {
+						(out.ByName)[key] = v2
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonB3129ce9EncodeGithubComMailruEasyjsonTestsThirdparty(out *jwriter.Writer, in KnownTypes) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.UUID([16]byte(in.ID))
+	}
+	{
+		const prefix string = ",\"parent\":"
+		out.RawString(prefix)
+		if in.Parent == nil {
+			out.RawString("null")
+		} else {
+			out.UUID([16]byte(*in.Parent))
+		}
+	}
+	{
+		const prefix string = ",\"members\":"
+		out.RawString(prefix)
+		if in.Members == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v4, v5 := range in.Members {
+				if v4 > 0 {
+					out.RawByte(',')
+				}
+				out.UUID([16]byte(v5))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"by_name\":"
+		out.RawString(prefix)
+		if in.ByName == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v6First := true
+			for v6Name, v6Value := range in.ByName {
+				if v6First {
+					v6First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v6Name))
+				out.RawByte(':')
+				out.UUID([16]byte(v6Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v KnownTypes) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonB3129ce9EncodeGithubComMailruEasyjsonTestsThirdparty(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v KnownTypes) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonB3129ce9EncodeGithubComMailruEasyjsonTestsThirdparty(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *KnownTypes) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonB3129ce9DecodeGithubComMailruEasyjsonTestsThirdparty(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *KnownTypes) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonB3129ce9DecodeGithubComMailruEasyjsonTestsThirdparty(l, v)
+}
@@ -0,0 +1,53 @@
+package thirdparty
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mailru/easyjson"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+func TestKnownTypes(t *testing.T) {
+	parent := uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	v := KnownTypes{
+		ID:      uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+		Parent:  &parent,
+		Members: []uuid.UUID{uuid.Nil},
+		ByName:  map[string]uuid.UUID{"a": parent},
+	}
+	want := `{"id":"123e4567-e89b-12d3-a456-426614174000","parent":"f47ac10b-58cc-4372-a567-0e02b2c3d479",` +
+		`"members":["00000000-0000-0000-0000-000000000000"],"by_name":{"a":"f47ac10b-58cc-4372-a567-0e02b2c3d479"}}`
+
+	data, err := easyjson.Marshal(v)
+	if err != nil || string(data) != want {
+		t.Errorf("Marshal() = %s, %v; want %s", data, err, want)
+	}
+	if std, err := json.Marshal(v); err != nil || string(std) != string(data) {
+		t.Errorf("json.Marshal() = %s, %v; want %s", std, err, data)
+	}
+
+	var got KnownTypes
+	if err := easyjson.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	if !reflect.DeepEqual(got, v) {
+		t.Errorf("Unmarshal() = %+v; want %+v", got, v)
+	}
+
+	if err := easyjson.Unmarshal([]byte(`{"id":"123e4567"}`), &got); err == nil {
+		t.Error("Unmarshal() of an invalid UUID succeeded; want error")
+	}
+
+	w := jwriter.Writer{}
+	w.Buffer.EnsureSpace(256)
+	id := KnownTypes{ID: v.ID}
+	if n := testing.AllocsPerRun(100, func() {
+		w.Buffer.Buf = w.Buffer.Buf[:0]
+		id.MarshalEasyJSON(&w)
+	}); n != 0 {
+		t.Errorf("MarshalEasyJSON() allocates %v times; want 0", n)
+	}
+}
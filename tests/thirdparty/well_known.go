@@ -0,0 +1,19 @@
+package thirdparty
+
+import (
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+//easyjson:json
+type WellKnown struct {
+	Created *timestamppb.Timestamp   `json:"created"`
+	Timeout *durationpb.Duration     `json:"timeout"`
+	Name    *wrapperspb.StringValue  `json:"name"`
+	Count   *wrapperspb.Int64Value   `json:"count"`
+	Size    *wrapperspb.UInt64Value  `json:"size,omitempty"`
+	Ratio   *wrapperspb.DoubleValue  `json:"ratio,omitempty"`
+	Data    *wrapperspb.BytesValue   `json:"data,omitempty"`
+	Times   []*timestamppb.Timestamp `json:"times"`
+}
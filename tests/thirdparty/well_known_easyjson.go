@@ -0,0 +1,419 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package thirdparty
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	strconv "strconv"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjson4a359ff8DecodeGithubComMailruEasyjsonTestsThirdparty(in *jlexer.Lexer, out *WellKnown) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "created":
+					out.Created = nil
+				case "timeout":
+					out.Timeout = nil
+				case "name":
+					out.Name = nil
+				case "count":
+					out.Count = nil
+				case "size":
+					out.Size = nil
+				case "ratio":
+					out.Ratio = nil
+				case "data":
+					out.Data = nil
+				case "times":
+					out.Times = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "created":
+			if in.IsNull() {
+				in.Skip()
+				out.Created = nil
+			} else {
+				if out.Created == nil {
+					out.Created = new(timestamppb.Timestamp)
+				}
+				(*out.Created).Seconds, (*out.Created).Nanos = in.ProtoTimestamp()
+			}
+		case "timeout":
+			if in.IsNull() {
+				in.Skip()
+				out.Timeout = nil
+			} else {
+				if out.Timeout == nil {
+					out.Timeout = new(durationpb.Duration)
+				}
+				(*out.Timeout).Seconds, (*out.Timeout).Nanos = in.ProtoDuration()
+			}
+		case "name":
+			if in.IsNull() {
+				in.Skip()
+				out.Name = nil
+			} else {
+				if out.Name == nil {
+					out.Name = new(wrapperspb.StringValue)
+				}
+				(*out.Name).Value = string(in.String())
+			}
+		case "count":
+			if in.IsNull() {
+				in.Skip()
+				out.Count = nil
+			} else {
+				if out.Count == nil {
+					out.Count = new(wrapperspb.Int64Value)
+				}
+				if v1 := in.JsonNumber(); v1 != "" {
+					if v2, v3 := strconv.ParseInt(string(v1), 10, 64); v3 != nil {
+						in.AddValueError(v3)
+					} else {
+						(*out.Count).Value = v2
+					}
+				}
+			}
+		case "size":
+			if in.IsNull() {
+				in.Skip()
+				out.Size = nil
+			} else {
+				if out.Size == nil {
+					out.Size = new(wrapperspb.UInt64Value)
+				}
+				if v4 := in.JsonNumber(); v4 != "" {
+					if v5, v6 := strconv.ParseUint(string(v4), 10, 64); v6 != nil {
+						in.AddValueError(v6)
+					} else {
+						(*out.Size).Value = v5
+					}
+				}
+			}
+		case "ratio":
+			if in.IsNull() {
+				in.Skip()
+				out.Ratio = nil
+			} else {
+				if out.Ratio == nil {
+					out.Ratio = new(wrapperspb.DoubleValue)
+				}
+				(*out.Ratio).Value = float64(in.Float64())
+			}
+		case "data":
+			if in.IsNull() {
+				in.Skip()
+				out.Data = nil
+			} else {
+				if out.Data == nil {
+					out.Data = new(wrapperspb.BytesValue)
+				}
+				if in.IsNull() {
+					in.Skip()
+					(*out.Data).Value = nil
+				} else {
+					(*out.Data).Value = in.Bytes()
+				}
+			}
+		case "times":
+			if in.IsNull() {
+				in.Skip()
+				out.Times = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Times == nil {
+					if !in.IsDelim(']') {
+						out.Times = make([]*timestamppb.Timestamp, 0, 8)
+					} else {
+						out.Times = []*timestamppb.Timestamp{}
+					}
+				} else {
+					out.Times = (out.Times)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v8 *timestamppb.Timestamp
+					if in.IsNull() {
+						in.Skip()
+						v8 = nil
+					} else {
+						if v8 == nil {
+							v8 = new(timestamppb.Timestamp)
+						}
+						(*v8).Seconds, (*v8).Nanos = in.ProtoTimestamp()
+					}
+					out.Times = append(out.Times, v8)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4a359ff8EncodeGithubComMailruEasyjsonTestsThirdparty(out *jwriter.Writer, in WellKnown) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"created\":"
+		out.RawString(prefix[1:])
+		if in.Created == nil {
+			out.RawString("null")
+		} else {
+			out.ProtoTimestamp((*in.Created).Seconds, (*in.Created).Nanos)
+		}
+	}
+	{
+		const prefix string = ",\"timeout\":"
+		out.RawString(prefix)
+		if in.Timeout == nil {
+			out.RawString("null")
+		} else {
+			out.ProtoDuration((*in.Timeout).Seconds, (*in.Timeout).Nanos)
+		}
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		if in.Name == nil {
+			out.RawString("null")
+		} else {
+			out.String(string((*in.Name).Value))
+		}
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		if in.Count == nil {
+			out.RawString("null")
+		} else {
+			out.Int64Str((*in.Count).Value)
+		}
+	}
+	if in.Size != nil {
+		const prefix string = ",\"size\":"
+		out.RawString(prefix)
+		out.Uint64Str((*in.Size).Value)
+	}
+	if in.Ratio != nil {
+		const prefix string = ",\"ratio\":"
+		out.RawString(prefix)
+		out.Float64(float64((*in.Ratio).Value))
+	}
+	if in.Data != nil {
+		const prefix string = ",\"data\":"
+		out.RawString(prefix)
+		out.Base64Bytes((*in.Data).Value)
+	}
+	{
+		const prefix string = ",\"times\":"
+		out.RawString(prefix)
+		if in.Times == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			v11 := false
+			for _, v12 := range in.Times {
+				if v12 == nil && out.SkipNilElem() {
+					continue
+				}
+				if v11 {
+					out.RawByte(',')
+				}
+				v11 = true
+				if v12 == nil {
+					out.RawString("null")
+				} else {
+					out.ProtoTimestamp((*v12).Seconds, (*v12).Nanos)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjson4a359ff8ValidateGithubComMailruEasyjsonTestsThirdparty(in *jlexer.Lexer) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "created":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				_, _ = in.ProtoTimestamp()
+			}
+		case "timeout":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				_, _ = in.ProtoDuration()
+			}
+		case "name":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				_ = in.UnsafeString()
+			}
+		case "count":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				{
+					var v13 int64
+					if v14 := in.JsonNumber(); v14 != "" {
+						if v15, v16 := strconv.ParseInt(string(v14), 10, 64); v16 != nil {
+							in.AddValueError(v16)
+						} else {
+							v13 = v15
+						}
+					}
+					_ = v13
+				}
+			}
+		case "size":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				{
+					var v17 uint64
+					if v18 := in.JsonNumber(); v18 != "" {
+						if v19, v20 := strconv.ParseUint(string(v18), 10, 64); v20 != nil {
+							in.AddValueError(v20)
+						} else {
+							v17 = v19
+						}
+					}
+					_ = v17
+				}
+			}
+		case "ratio":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				_ = in.Float64()
+			}
+		case "data":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					_ = in.Bytes()
+				}
+			}
+		case "times":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('[')
+				for !in.IsDelim(']') {
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						_, _ = in.ProtoTimestamp()
+					}
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WellKnown) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4a359ff8EncodeGithubComMailruEasyjsonTestsThirdparty(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WellKnown) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4a359ff8EncodeGithubComMailruEasyjsonTestsThirdparty(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WellKnown) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4a359ff8DecodeGithubComMailruEasyjsonTestsThirdparty(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WellKnown) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4a359ff8DecodeGithubComMailruEasyjsonTestsThirdparty(l, v)
+}
+
+// ValidateEasyJSON supports easyjson.Validator interface
+func (*WellKnown) ValidateEasyJSON(l *jlexer.Lexer) error {
+	l.CheckValid()
+	easyjson4a359ff8ValidateGithubComMailruEasyjsonTestsThirdparty(l)
+	return l.Error()
+}
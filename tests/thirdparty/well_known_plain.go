@@ -0,0 +1,10 @@
+package thirdparty
+
+import "google.golang.org/protobuf/types/known/timestamppb"
+
+// WellKnownPlain is generated without -protobuf, so its timestamp is marshaled as a struct.
+//
+//easyjson:json
+type WellKnownPlain struct {
+	Created *timestamppb.Timestamp `json:"created"`
+}
@@ -0,0 +1,170 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package thirdparty
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjson43a21515DecodeGithubComMailruEasyjsonTestsThirdparty(in *jlexer.Lexer, out *WellKnownPlain) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "created":
+					out.Created = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "created":
+			if in.IsNull() {
+				in.Skip()
+				out.Created = nil
+			} else {
+				if out.Created == nil {
+					out.Created = new(timestamppb.Timestamp)
+				}
+				easyjson43a21515DecodeGoogleGolangOrgProtobufTypesKnownTimestamppb(in, out.Created)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson43a21515EncodeGithubComMailruEasyjsonTestsThirdparty(out *jwriter.Writer, in WellKnownPlain) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"created\":"
+		out.RawString(prefix[1:])
+		if in.Created == nil {
+			out.RawString("null")
+		} else {
+			easyjson43a21515EncodeGoogleGolangOrgProtobufTypesKnownTimestamppb(out, *in.Created)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WellKnownPlain) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson43a21515EncodeGithubComMailruEasyjsonTestsThirdparty(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WellKnownPlain) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson43a21515EncodeGithubComMailruEasyjsonTestsThirdparty(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WellKnownPlain) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson43a21515DecodeGithubComMailruEasyjsonTestsThirdparty(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WellKnownPlain) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson43a21515DecodeGithubComMailruEasyjsonTestsThirdparty(l, v)
+}
+func easyjson43a21515DecodeGoogleGolangOrgProtobufTypesKnownTimestamppb(in *jlexer.Lexer, out *timestamppb.Timestamp) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "seconds":
+					out.Seconds = 0
+				case "nanos":
+					out.Nanos = 0
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "seconds":
+			out.Seconds = int64(in.Int64())
+		case "nanos":
+			out.Nanos = int32(in.Int32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson43a21515EncodeGoogleGolangOrgProtobufTypesKnownTimestamppb(out *jwriter.Writer, in timestamppb.Timestamp) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Seconds != 0 {
+		const prefix string = ",\"seconds\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Seconds))
+	}
+	if in.Nanos != 0 {
+		const prefix string = ",\"nanos\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int32(int32(in.Nanos))
+	}
+	out.RawByte('}')
+}
@@ -0,0 +1,113 @@
+package thirdparty
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mailru/easyjson"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestWellKnown(t *testing.T) {
+	v := WellKnown{
+		Created: timestamppb.New(time.Date(1972, 1, 1, 10, 0, 20, 21e6, time.UTC)),
+		Timeout: durationpb.New(-1500 * time.Millisecond),
+		Name:    wrapperspb.String("a"),
+		Count:   wrapperspb.Int64(1 << 60),
+		Size:    wrapperspb.UInt64(7),
+		Data:    wrapperspb.Bytes([]byte("hi")),
+		Times:   []*timestamppb.Timestamp{{Seconds: 1, Nanos: 1}, nil},
+	}
+	want := `{"created":"1972-01-01T10:00:20.021Z","timeout":"-1.500s","name":"a","count":"1152921504606846976",` +
+		`"size":"7","data":"aGk=","times":["1970-01-01T00:00:01.000000001Z",null]}`
+
+	data, err := easyjson.Marshal(v)
+	if err != nil || string(data) != want {
+		t.Errorf("Marshal() = %s, %v; want %s", data, err, want)
+	}
+	for _, m := range []proto.Message{v.Created, v.Timeout, v.Count, v.Data, v.Times[0]} {
+		pj, err := protojson.Marshal(m)
+		if err != nil {
+			t.Fatalf("protojson.Marshal(%v) error: %v", m, err)
+		}
+		if !strings.Contains(want, string(pj)) {
+			t.Errorf("protojson.Marshal(%v) = %s; not in %s", m, pj, want)
+		}
+	}
+
+	var got WellKnown
+	if err := easyjson.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	for i, pair := range [][2]proto.Message{
+		{got.Created, v.Created}, {got.Timeout, v.Timeout}, {got.Name, v.Name}, {got.Count, v.Count},
+		{got.Size, v.Size}, {got.Data, v.Data}, {got.Times[0], v.Times[0]},
+	} {
+		if !proto.Equal(pair[0], pair[1]) {
+			t.Errorf("Unmarshal() value %d = %v; want %v", i, pair[0], pair[1])
+		}
+	}
+
+	// The forms accepted by protojson are accepted too.
+	if err := easyjson.Unmarshal([]byte(`{"created":"1972-01-01T12:00:20.021+02:00","timeout":"3s","count":12}`), &got); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	if !proto.Equal(got.Created, v.Created) || got.Timeout.AsDuration() != 3*time.Second || got.Count.Value != 12 {
+		t.Errorf("Unmarshal() = %v, %v, %v", got.Created, got.Timeout, got.Count)
+	}
+	for _, in := range []string{`{"created":"1972-01-01"}`, `{"timeout":"1.5"}`, `{"timeout":"1.1234567890s"}`, `{"count":"x"}`} {
+		if err := easyjson.Unmarshal([]byte(in), &got); err == nil {
+			t.Errorf("Unmarshal(%s) succeeded; want error", in)
+		}
+	}
+}
+
+func TestWellKnownValidate(t *testing.T) {
+	data, err := easyjson.Marshal(WellKnown{
+		Created: &timestamppb.Timestamp{Seconds: 5},
+		Timeout: durationpb.New(time.Second),
+		Count:   wrapperspb.Int64(7),
+		Times:   []*timestamppb.Timestamp{{Seconds: 1}, nil},
+	})
+	if err != nil {
+		t.Fatalf("Marshal() error: %v", err)
+	}
+	if err := easyjson.Validate(data, (*WellKnown)(nil)); err != nil {
+		t.Errorf("Validate(%s) error: %v", data, err)
+	}
+
+	for _, in := range []string{
+		`{"created":"1970-01-01T00:00:05Z","count":"7","size":7,"ratio":0.5,"data":"aGk="}`,
+		`{"created":null,"times":[null]}`,
+		`{"created":{"seconds":5}}`,
+		`{"timeout":"1.5"}`,
+		`{"count":"x"}`,
+		`{"name":1}`,
+	} {
+		var v WellKnown
+		want := easyjson.Unmarshal([]byte(in), &v)
+		got := easyjson.Validate([]byte(in), (*WellKnown)(nil))
+		if (got == nil) != (want == nil) {
+			t.Errorf("Validate(%s) error: %v; Unmarshal error: %v", in, got, want)
+		}
+	}
+}
+
+func TestWellKnownPlain(t *testing.T) {
+	v := WellKnownPlain{Created: &timestamppb.Timestamp{Seconds: 5}}
+	want := `{"created":{"seconds":5}}`
+	data, err := easyjson.Marshal(v)
+	if err != nil || string(data) != want {
+		t.Errorf("Marshal() = %s, %v; want %s", data, err, want)
+	}
+
+	var got WellKnownPlain
+	if err := easyjson.Unmarshal(data, &got); err != nil || !proto.Equal(got.Created, v.Created) {
+		t.Errorf("Unmarshal() = %v, %v; want %v", got.Created, err, v.Created)
+	}
+}
@@ -0,0 +1,13 @@
+package tests
+
+import "time"
+
+//easyjson:json
+type TimeLayouts struct {
+	Date     time.Time            `json:"date" easyjson:"layout=2006-01-02"`
+	HTTP     *time.Time           `json:"http,omitempty" easyjson:"layout=Mon, 02 Jan 2006 15:04:05 GMT"`
+	Unix     time.Time            `json:"unix" easyjson:"format=unix"`
+	Millis   []time.Time          `json:"millis" easyjson:"format=unixmilli"`
+	Deadline map[string]time.Time `json:"deadline,omitempty" easyjson:"format=unix"`
+	Default  time.Time            `json:"default"`
+}
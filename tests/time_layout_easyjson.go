@@ -0,0 +1,257 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjsonF898755aDecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *TimeLayouts) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "date":
+					out.Date = time.Time{}
+				case "http":
+					out.HTTP = nil
+				case "unix":
+					out.Unix = time.Time{}
+				case "millis":
+					out.Millis = nil
+				case "deadline":
+					out.Deadline = nil
+				case "default":
+					out.Default = time.Time{}
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "date":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Date = in.Time("2006-01-02")
+			}
+		case "http":
+			if in.IsNull() {
+				in.Skip()
+				out.HTTP = nil
+			} else {
+				if out.HTTP == nil {
+					out.HTTP = new(time.Time)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					*out.HTTP = in.Time("Mon, 02 Jan 2006 15:04:05 GMT")
+				}
+			}
+		case "unix":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Unix = in.UnixTime()
+			}
+		case "millis":
+			if in.IsNull() {
+				in.Skip()
+				out.Millis = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Millis == nil {
+					if !in.IsDelim(']') {
+						out.Millis = make([]time.Time, 0, 2)
+					} else {
+						out.Millis = []time.Time{}
+					}
+				} else {
+					out.Millis = (out.Millis)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 time.Time
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v1 = in.UnixMilliTime()
+					}
+					out.Millis = append(out.Millis, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "deadline":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.Deadline == nil {
+					if !in.IsDelim('}') {
+						out.Deadline = make(map[string]time.Time)
+					} else {
+						out.Deadline = nil
+					}
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := string(in.String())
+					in.WantColon()
+					v3 := in.ValueStart()
+					var v2 time.Time
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.Deadline, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v2 = (out.Deadline)[key]
+					}
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						v2 = in.UnixTime()
+					}
+					if !in.ValueSkipped(v3) {
+						(out.Deadline)[key] = v2
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "default":
+			if data := in.Raw(); in.Ok() {
+				in.AddValueError((out.Default).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF898755aEncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in TimeLayouts) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix[1:])
+		out.Time(in.Date, "2006-01-02")
+	}
+	if in.HTTP != nil {
+		const prefix string = ",\"http\":"
+		out.RawString(prefix)
+		out.Time(*in.HTTP, "Mon, 02 Jan 2006 15:04:05 GMT")
+	}
+	{
+		const prefix string = ",\"unix\":"
+		out.RawString(prefix)
+		out.UnixTime(in.Unix)
+	}
+	{
+		const prefix string = ",\"millis\":"
+		out.RawString(prefix)
+		if in.Millis == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v4, v5 := range in.Millis {
+				if v4 > 0 {
+					out.RawByte(',')
+				}
+				out.UnixMilliTime(v5)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Deadline) != 0 {
+		const prefix string = ",\"deadline\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v6First := true
+			for v6Name, v6Value := range in.Deadline {
+				if v6First {
+					v6First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v6Name))
+				out.RawByte(':')
+				out.UnixTime(v6Value)
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"default\":"
+		out.RawString(prefix)
+		out.Raw((in.Default).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TimeLayouts) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF898755aEncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TimeLayouts) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF898755aEncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TimeLayouts) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF898755aDecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TimeLayouts) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF898755aDecodeGithubComMailruEasyjsonTests(l, v)
+}
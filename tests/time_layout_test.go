@@ -0,0 +1,54 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestTimeLayouts(t *testing.T) {
+	date := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	at := time.Date(2024, 3, 1, 12, 30, 15, 250e6, time.UTC)
+	before := time.Date(1969, 12, 31, 23, 59, 59, 500e6, time.UTC)
+	http := at.Truncate(time.Second)
+	v := TimeLayouts{
+		Date:     date,
+		HTTP:     &http,
+		Unix:     http,
+		Millis:   []time.Time{at, before},
+		Deadline: map[string]time.Time{"a": http},
+		Default:  at,
+	}
+	want := `{"date":"2024-03-01","http":"Fri, 01 Mar 2024 12:30:15 GMT","unix":1709296215,` +
+		`"millis":[1709296215250,-500],"deadline":{"a":1709296215},"default":"2024-03-01T12:30:15.25Z"}`
+
+	data, err := easyjson.Marshal(v)
+	if err != nil || string(data) != want {
+		t.Errorf("Marshal() = %s, %v; want %s", data, err, want)
+	}
+
+	var got TimeLayouts
+	if err := easyjson.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	if !reflect.DeepEqual(got, v) {
+		t.Errorf("Unmarshal() = %+v; want %+v", got, v)
+	}
+
+	if err := easyjson.Unmarshal([]byte(`{"date":null,"unix":null}`), &got); err != nil || !got.Date.Equal(date) || !got.Unix.Equal(http) {
+		t.Errorf("Unmarshal() of nulls = %+v, %v; want the times unchanged", got, err)
+	}
+
+	for _, in := range []string{
+		`{"date":"2024-03-01T00:00:00Z"}`,
+		`{"date":1}`,
+		`{"unix":"1709296215"}`,
+		`{"millis":[1.5e3]}`,
+	} {
+		if err := easyjson.Unmarshal([]byte(in), &got); err == nil {
+			t.Errorf("Unmarshal(%s) did not fail", in)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package tests
+
+import (
+	"bytes"
+
+	"github.com/mailru/easyjson"
+)
+
+func init() {
+	easyjson.RegisterTransform("upper", upperTransform{})
+}
+
+// upperTransform upper-cases data on encoding and lower-cases it on decoding.
+type upperTransform struct{}
+
+func (upperTransform) Encode(data []byte) ([]byte, error) {
+	return bytes.ToUpper(data), nil
+}
+
+func (upperTransform) Decode(data []byte) ([]byte, error) {
+	return bytes.ToLower(data), nil
+}
+
+//easyjson:json
+type TransformStruct struct {
+	Name    string `json:"name" easyjson:"transform=upper"`
+	Payload []byte `json:"payload,omitempty" easyjson:"transform=gzipb64"`
+	Raw     []byte `json:"raw" easyjson:"transform=base64"`
+}
+
+var transformValue = TransformStruct{
+	Name:    "name",
+	Payload: []byte("a payload compressed with gzip"),
+	Raw:     []byte("raw"),
+}
+
+//easyjson:json
+type UnknownTransformStruct struct {
+	Name string `json:"name" easyjson:"transform=unknown"`
+}
@@ -0,0 +1,200 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjson3a4fd032DecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *UnknownTransformStruct) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "name":
+					out.Name = ""
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			if data := in.UnsafeBytes(); in.Ok() {
+				v1, err := easyjson.DecodeTransform("unknown", data)
+				in.AddError(err)
+				out.Name = string(v1)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3a4fd032EncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in UnknownTransformStruct) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.TextString(easyjson.EncodeTransform("unknown", []byte(in.Name)))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UnknownTransformStruct) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3a4fd032EncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UnknownTransformStruct) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3a4fd032EncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UnknownTransformStruct) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3a4fd032DecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UnknownTransformStruct) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3a4fd032DecodeGithubComMailruEasyjsonTests(l, v)
+}
+func easyjson3a4fd032DecodeGithubComMailruEasyjsonTests1(in *jlexer.Lexer, out *TransformStruct) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "name":
+					out.Name = ""
+				case "payload":
+					out.Payload = nil
+				case "raw":
+					out.Raw = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			if data := in.UnsafeBytes(); in.Ok() {
+				v2, err := easyjson.DecodeTransform("upper", data)
+				in.AddError(err)
+				out.Name = string(v2)
+			}
+		case "payload":
+			if data := in.UnsafeBytes(); in.Ok() {
+				v3, err := easyjson.DecodeTransform("gzipb64", data)
+				in.AddError(err)
+				out.Payload = []uint8(v3)
+			}
+		case "raw":
+			if data := in.UnsafeBytes(); in.Ok() {
+				v4, err := easyjson.DecodeTransform("base64", data)
+				in.AddError(err)
+				out.Raw = []uint8(v4)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3a4fd032EncodeGithubComMailruEasyjsonTests1(out *jwriter.Writer, in TransformStruct) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.TextString(easyjson.EncodeTransform("upper", []byte(in.Name)))
+	}
+	if len(in.Payload) != 0 {
+		const prefix string = ",\"payload\":"
+		out.RawString(prefix)
+		out.TextString(easyjson.EncodeTransform("gzipb64", []byte(in.Payload)))
+	}
+	{
+		const prefix string = ",\"raw\":"
+		out.RawString(prefix)
+		out.TextString(easyjson.EncodeTransform("base64", []byte(in.Raw)))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TransformStruct) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3a4fd032EncodeGithubComMailruEasyjsonTests1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TransformStruct) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3a4fd032EncodeGithubComMailruEasyjsonTests1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TransformStruct) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3a4fd032DecodeGithubComMailruEasyjsonTests1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TransformStruct) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3a4fd032DecodeGithubComMailruEasyjsonTests1(l, v)
+}
@@ -0,0 +1,68 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestTransformRoundTrip(t *testing.T) {
+	data, err := easyjson.Marshal(transformValue)
+	if err != nil {
+		t.Fatalf("easyjson.Marshal() error: %v", err)
+	}
+
+	var got TransformStruct
+	if err := easyjson.Unmarshal(data, &got); err != nil {
+		t.Fatalf("easyjson.Unmarshal(%s) error: %v", data, err)
+	}
+	if !reflect.DeepEqual(got, transformValue) {
+		t.Errorf("easyjson.Unmarshal(%s) = %+v; want %+v", data, got, transformValue)
+	}
+}
+
+func TestTransformEncode(t *testing.T) {
+	v := TransformStruct{Name: "name", Raw: []byte("raw")}
+
+	data, err := easyjson.Marshal(v)
+	if err != nil {
+		t.Fatalf("easyjson.Marshal() error: %v", err)
+	}
+	if want := `{"name":"NAME","raw":"cmF3"}`; string(data) != want {
+		t.Errorf("easyjson.Marshal() = %s; want %s", data, want)
+	}
+}
+
+func TestTransformEmpty(t *testing.T) {
+	data, err := easyjson.Marshal(TransformStruct{Raw: []byte{}})
+	if err != nil {
+		t.Fatalf("easyjson.Marshal() error: %v", err)
+	}
+	if want := `{"name":"","raw":""}`; string(data) != want {
+		t.Errorf("easyjson.Marshal() = %s; want %s", data, want)
+	}
+
+	var got TransformStruct
+	if err := easyjson.Unmarshal(data, &got); err != nil || got.Name != "" || len(got.Raw) != 0 {
+		t.Errorf("easyjson.Unmarshal(%s) = %+v, %v; want empty values", data, got, err)
+	}
+}
+
+func TestTransformUnknown(t *testing.T) {
+	if _, err := easyjson.Marshal(UnknownTransformStruct{Name: "name"}); err == nil {
+		t.Error("easyjson.Marshal() with an unknown transform succeeded; want error")
+	}
+
+	var v UnknownTransformStruct
+	if err := easyjson.Unmarshal([]byte(`{"name":"x"}`), &v); err == nil {
+		t.Error("easyjson.Unmarshal() with an unknown transform succeeded; want error")
+	}
+}
+
+func TestTransformInvalidData(t *testing.T) {
+	var v TransformStruct
+	if err := easyjson.Unmarshal([]byte(`{"raw":"%%%"}`), &v); err == nil {
+		t.Error("easyjson.Unmarshal() with invalid base64 succeeded; want error")
+	}
+}
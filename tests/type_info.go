@@ -0,0 +1,26 @@
+package tests
+
+// TypeInfoStruct is a record
+// with type info.
+//
+//easyjson:json
+type TypeInfoStruct struct {
+	TypeInfoEmbedded
+
+	// ID identifies the record.
+	ID       int64             `json:"id,required"`
+	Name     string            `json:"name,omitempty" db:"name"` // display name
+	Tags     []string          `json:"tags"`
+	Skipped  string            `json:"-"`
+	Children map[string]string `json:",omitempty"`
+
+	*TypeInfoUpdates
+}
+
+type TypeInfoEmbedded struct {
+	Created int64 `json:"created"` // Unix time of creation
+}
+
+type TypeInfoUpdates struct {
+	Updated int64 `json:"updated"`
+}
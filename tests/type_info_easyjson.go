@@ -0,0 +1,326 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	fmt "fmt"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjsonB1030825DecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *TypeInfoStruct) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	var IDSet bool
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			switch key {
+			case "updated":
+				if out.TypeInfoUpdates == nil {
+					out.TypeInfoUpdates = new(TypeInfoUpdates)
+				}
+			}
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "created":
+					out.Created = 0
+				case "id":
+					out.ID = 0
+				case "name":
+					out.Name = ""
+				case "tags":
+					out.Tags = nil
+				case "Children":
+					out.Children = nil
+				case "updated":
+					out.Updated = 0
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "created":
+			out.Created = int64(in.Int64())
+		case "id":
+			out.ID = int64(in.Int64())
+			IDSet = true
+		case "name":
+			out.Name = string(in.String())
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]string, 0, 4)
+					} else {
+						out.Tags = []string{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 string
+					v1 = string(in.String())
+					out.Tags = append(out.Tags, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Children":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.Children == nil {
+					if !in.IsDelim('}') {
+						out.Children = make(map[string]string)
+					} else {
+						out.Children = nil
+					}
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := string(in.String())
+					in.WantColon()
+					v3 := in.ValueStart()
+					var v2 string
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.Children, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v2 = (out.Children)[key]
+					}
+					v2 = string(in.String())
+					if !in.ValueSkipped(v3) {
+						(out.Children)[key] = v2
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "updated":
+			if out.TypeInfoUpdates == nil {
+				out.TypeInfoUpdates = new(TypeInfoUpdates)
+			}
+			out.Updated = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+	if !IDSet {
+		in.AddError(fmt.Errorf("key 'id' is required"))
+	}
+}
+func easyjsonB1030825EncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in TypeInfoStruct) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"created\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Created))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ID))
+	}
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"tags\":"
+		out.RawString(prefix)
+		if in.Tags == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v4, v5 := range in.Tags {
+				if v4 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v5))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Children) != 0 {
+		const prefix string = ",\"Children\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v6First := true
+			for v6Name, v6Value := range in.Children {
+				if v6First {
+					v6First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v6Name))
+				out.RawByte(':')
+				out.String(string(v6Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	if in.TypeInfoUpdates != nil {
+		const prefix string = ",\"updated\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Updated))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TypeInfoStruct) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonB1030825EncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TypeInfoStruct) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonB1030825EncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TypeInfoStruct) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonB1030825DecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TypeInfoStruct) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonB1030825DecodeGithubComMailruEasyjsonTests(l, v)
+}
+
+var easyjsonB1030825Type_infoGithubComMailruEasyjsonTests = &easyjson.TypeInfo{
+	Name:        "github.com/mailru/easyjson/tests.TypeInfoStruct",
+	Description: "TypeInfoStruct is a record\nwith type info.",
+	Fields: []easyjson.FieldInfo{
+		{
+			Name:        "Created",
+			JSONName:    "created",
+			Type:        "int64",
+			Tag:         "json:\"created\"",
+			Description: "Unix time of creation",
+			OmitEmpty:   false,
+			OmitZero:    false,
+			Required:    false,
+			Addr:        func(v interface{}) interface{} { return &v.(*TypeInfoStruct).Created },
+		},
+		{
+			Name:        "ID",
+			JSONName:    "id",
+			Type:        "int64",
+			Tag:         "json:\"id,required\"",
+			Description: "ID identifies the record.",
+			OmitEmpty:   false,
+			OmitZero:    false,
+			Required:    true,
+			Addr:        func(v interface{}) interface{} { return &v.(*TypeInfoStruct).ID },
+		},
+		{
+			Name:        "Name",
+			JSONName:    "name",
+			Type:        "string",
+			Tag:         "json:\"name,omitempty\" db:\"name\"",
+			Description: "display name",
+			OmitEmpty:   true,
+			OmitZero:    false,
+			Required:    false,
+			Addr:        func(v interface{}) interface{} { return &v.(*TypeInfoStruct).Name },
+		},
+		{
+			Name:      "Tags",
+			JSONName:  "tags",
+			Type:      "[]string",
+			Tag:       "json:\"tags\"",
+			OmitEmpty: false,
+			OmitZero:  false,
+			Required:  false,
+			Addr:      func(v interface{}) interface{} { return &v.(*TypeInfoStruct).Tags },
+		},
+		{
+			Name:      "Children",
+			JSONName:  "Children",
+			Type:      "map[string]string",
+			Tag:       "json:\",omitempty\"",
+			OmitEmpty: true,
+			OmitZero:  false,
+			Required:  false,
+			Addr:      func(v interface{}) interface{} { return &v.(*TypeInfoStruct).Children },
+		},
+		{
+			Name:      "Updated",
+			JSONName:  "updated",
+			Type:      "int64",
+			Tag:       "json:\"updated\"",
+			OmitEmpty: false,
+			OmitZero:  false,
+			Required:  false,
+			Addr: func(v interface{}) interface{} {
+				p := v.(*TypeInfoStruct)
+				if p.TypeInfoUpdates == nil {
+					return nil
+				}
+				return &p.Updated
+			},
+		},
+	},
+}
+
+func init() {
+	easyjson.RegisterTypeInfo(easyjsonB1030825Type_infoGithubComMailruEasyjsonTests)
+}
+
+// EasyJSONTypeInfo supports easyjson.TypeInfoProvider interface
+func (TypeInfoStruct) EasyJSONTypeInfo() *easyjson.TypeInfo {
+	return easyjsonB1030825Type_infoGithubComMailruEasyjsonTests
+}
@@ -0,0 +1,84 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestTypeInfo(t *testing.T) {
+	info := easyjson.LookupTypeInfo("github.com/mailru/easyjson/tests.TypeInfoStruct")
+	if info == nil {
+		t.Fatalf("LookupTypeInfo() = nil; want registered type info")
+	}
+
+	var p easyjson.TypeInfoProvider = TypeInfoStruct{}
+	if p.EasyJSONTypeInfo() != info {
+		t.Errorf("EasyJSONTypeInfo() = %p; want %p", p.EasyJSONTypeInfo(), info)
+	}
+
+	if want := "TypeInfoStruct is a record\nwith type info."; info.Description != want {
+		t.Errorf("Description = %q; want %q", info.Description, want)
+	}
+
+	want := []easyjson.FieldInfo{
+		{Name: "Created", JSONName: "created", Type: "int64", Tag: `json:"created"`, Description: "Unix time of creation"},
+		{Name: "ID", JSONName: "id", Type: "int64", Tag: `json:"id,required"`, Description: "ID identifies the record.", Required: true},
+		{Name: "Name", JSONName: "name", Type: "string", Tag: `json:"name,omitempty" db:"name"`, Description: "display name", OmitEmpty: true},
+		{Name: "Tags", JSONName: "tags", Type: "[]string", Tag: `json:"tags"`},
+		{Name: "Children", JSONName: "Children", Type: "map[string]string", Tag: `json:",omitempty"`, OmitEmpty: true},
+		{Name: "Updated", JSONName: "updated", Type: "int64", Tag: `json:"updated"`},
+	}
+	if len(info.Fields) != len(want) {
+		t.Fatalf("got %d fields; want %d: %+v", len(info.Fields), len(want), info.Fields)
+	}
+	for i, f := range info.Fields {
+		if f.Addr == nil {
+			t.Errorf("[%d] field %v has no Addr func", i, f.Name)
+		}
+		f.Addr = nil
+		if f.Name != want[i].Name || f.JSONName != want[i].JSONName || f.Type != want[i].Type ||
+			f.Tag != want[i].Tag || f.Description != want[i].Description ||
+			f.OmitEmpty != want[i].OmitEmpty || f.Required != want[i].Required {
+			t.Errorf("[%d] got field %+v; want %+v", i, f, want[i])
+		}
+	}
+
+	if got := info.Field("name").Tag.Get("db"); got != "name" {
+		t.Errorf(`Field("name").Tag.Get("db") = %q; want "name"`, got)
+	}
+	if f := info.Field("Skipped"); f != nil {
+		t.Errorf(`Field("Skipped") = %+v; want nil`, f)
+	}
+}
+
+func TestTypeInfoAddr(t *testing.T) {
+	info := TypeInfoStruct{}.EasyJSONTypeInfo()
+
+	var v TypeInfoStruct
+	*info.Field("id").Addr(&v).(*int64) = 5
+	*info.Field("created").Addr(&v).(*int64) = 7
+	*info.Field("tags").Addr(&v).(*[]string) = []string{"a"}
+
+	data, err := easyjson.Marshal(v)
+	if err != nil {
+		t.Errorf("easyjson.Marshal() error: %v", err)
+	}
+	if want := `{"created":7,"id":5,"tags":["a"]}`; string(data) != want {
+		t.Errorf("easyjson.Marshal() = %s; want %s", data, want)
+	}
+
+	if got := *info.Field("id").Addr(&v).(*int64); got != 5 {
+		t.Errorf("Addr() points to %v; want 5", got)
+	}
+
+	// The fields promoted through nil embedded pointers have no address.
+	if p := info.Field("updated").Addr(&v); p != nil {
+		t.Errorf("Addr() of a field of a nil embedded pointer = %v; want nil", p)
+	}
+	v.TypeInfoUpdates = &TypeInfoUpdates{}
+	*info.Field("updated").Addr(&v).(*int64) = 9
+	if v.Updated != 9 {
+		t.Errorf("Addr() of a field of an embedded pointer set %v; want 9", v.Updated)
+	}
+}
@@ -0,0 +1,33 @@
+package tests
+
+import "github.com/mailru/easyjson"
+
+type TypedAnimal interface {
+	Sound() string
+}
+
+//easyjson:json
+type TypedDog struct {
+	Name string `json:"name"`
+}
+
+func (d TypedDog) Sound() string { return "woof" }
+
+//easyjson:json
+type TypedCat struct {
+	Lives int `json:"lives"`
+}
+
+func (c *TypedCat) Sound() string { return "meow" }
+
+//easyjson:json
+type TypedZoo struct {
+	Pet    TypedAnimal            `json:"pet"`
+	Pets   []TypedAnimal          `json:"pets"`
+	ByName map[string]TypedAnimal `json:"by_name,omitempty"`
+}
+
+func init() {
+	easyjson.RegisterType("dog", func() easyjson.Unmarshaler { return &TypedDog{} })
+	easyjson.RegisterType("cat", func() easyjson.Unmarshaler { return &TypedCat{} })
+}
@@ -0,0 +1,358 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjsonA46e4c6aDecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *TypedZoo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "pet":
+					out.Pet = nil
+				case "pets":
+					out.Pets = nil
+				case "by_name":
+					out.ByName = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "pet":
+			if in.IsNull() {
+				in.Skip()
+				out.Pet = nil
+			} else if v1 := easyjson.UnmarshalTyped(in, (*TypedAnimal)(nil)); v1 != nil {
+				out.Pet = v1.(TypedAnimal)
+			}
+		case "pets":
+			if in.IsNull() {
+				in.Skip()
+				out.Pets = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Pets == nil {
+					if !in.IsDelim(']') {
+						out.Pets = make([]TypedAnimal, 0, 4)
+					} else {
+						out.Pets = []TypedAnimal{}
+					}
+				} else {
+					out.Pets = (out.Pets)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 TypedAnimal
+					if in.IsNull() {
+						in.Skip()
+						v2 = nil
+					} else if v3 := easyjson.UnmarshalTyped(in, (*TypedAnimal)(nil)); v3 != nil {
+						v2 = v3.(TypedAnimal)
+					}
+					out.Pets = append(out.Pets, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "by_name":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.ByName == nil {
+					if !in.IsDelim('}') {
+						out.ByName = make(map[string]TypedAnimal)
+					} else {
+						out.ByName = nil
+					}
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := string(in.String())
+					in.WantColon()
+					v5 := in.ValueStart()
+					var v4 TypedAnimal
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.ByName, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v4 = (out.ByName)[key]
+					}
+					if in.IsNull() {
+						in.Skip()
+						v4 = nil
+					} else if v6 := easyjson.UnmarshalTyped(in, (*TypedAnimal)(nil)); v6 != nil {
+						v4 = v6.(TypedAnimal)
+					}
+					if !in.ValueSkipped(v5) {
+						(out.ByName)[key] = v4
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA46e4c6aEncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in TypedZoo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"pet\":"
+		out.RawString(prefix[1:])
+		easyjson.MarshalTyped(out, in.Pet)
+	}
+	{
+		const prefix string = ",\"pets\":"
+		out.RawString(prefix)
+		if in.Pets == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v7, v8 := range in.Pets {
+				if v7 > 0 {
+					out.RawByte(',')
+				}
+				easyjson.MarshalTyped(out, v8)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.ByName) != 0 {
+		const prefix string = ",\"by_name\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v9First := true
+			for v9Name, v9Value := range in.ByName {
+				if v9First {
+					v9First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v9Name))
+				out.RawByte(':')
+				easyjson.MarshalTyped(out, v9Value)
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TypedZoo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonA46e4c6aEncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TypedZoo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonA46e4c6aEncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TypedZoo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonA46e4c6aDecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TypedZoo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonA46e4c6aDecodeGithubComMailruEasyjsonTests(l, v)
+}
+func easyjsonA46e4c6aDecodeGithubComMailruEasyjsonTests1(in *jlexer.Lexer, out *TypedDog) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "name":
+					out.Name = ""
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA46e4c6aEncodeGithubComMailruEasyjsonTests1(out *jwriter.Writer, in TypedDog) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TypedDog) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonA46e4c6aEncodeGithubComMailruEasyjsonTests1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TypedDog) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonA46e4c6aEncodeGithubComMailruEasyjsonTests1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TypedDog) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonA46e4c6aDecodeGithubComMailruEasyjsonTests1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TypedDog) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonA46e4c6aDecodeGithubComMailruEasyjsonTests1(l, v)
+}
+func easyjsonA46e4c6aDecodeGithubComMailruEasyjsonTests2(in *jlexer.Lexer, out *TypedCat) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "lives":
+					out.Lives = 0
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "lives":
+			out.Lives = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA46e4c6aEncodeGithubComMailruEasyjsonTests2(out *jwriter.Writer, in TypedCat) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"lives\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Lives))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TypedCat) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonA46e4c6aEncodeGithubComMailruEasyjsonTests2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TypedCat) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonA46e4c6aEncodeGithubComMailruEasyjsonTests2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TypedCat) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonA46e4c6aDecodeGithubComMailruEasyjsonTests2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TypedCat) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonA46e4c6aDecodeGithubComMailruEasyjsonTests2(l, v)
+}
@@ -0,0 +1,68 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestTypedInterfaceFields(t *testing.T) {
+	v := TypedZoo{
+		Pet:    TypedDog{Name: "rex"},
+		Pets:   []TypedAnimal{&TypedCat{Lives: 9}, nil, &TypedDog{Name: "max"}},
+		ByName: map[string]TypedAnimal{"tom": &TypedCat{Lives: 7}},
+	}
+	want := `{"pet":{"type":"dog","value":{"name":"rex"}},` +
+		`"pets":[{"type":"cat","value":{"lives":9}},null,{"type":"dog","value":{"name":"max"}}],` +
+		`"by_name":{"tom":{"type":"cat","value":{"lives":7}}}}`
+
+	data, err := easyjson.Marshal(v)
+	if err != nil || string(data) != want {
+		t.Errorf("Marshal() = %s, %v; want %s", data, err, want)
+	}
+
+	var got TypedZoo
+	if err := easyjson.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	v.Pet = &TypedDog{Name: "rex"}
+	if !reflect.DeepEqual(got, v) {
+		t.Errorf("Unmarshal() = %+v; want %+v", got, v)
+	}
+
+	if err := easyjson.Unmarshal([]byte(`{"pet":{"value":{"lives":1},"type":"cat"}}`), &got); err != nil {
+		t.Errorf("Unmarshal() with the value first error: %v", err)
+	} else if cat, ok := got.Pet.(*TypedCat); !ok || cat.Lives != 1 {
+		t.Errorf("Unmarshal() with the value first = %+v", got.Pet)
+	}
+
+	for _, in := range []string{
+		`{"pet":{"value":{}}}`,
+		`{"pet":{"type":"cow"}}`,
+		`{"pet":{"type":1}}`,
+		`{"pet":{"type":"cat","value":{"lives":"x"}}}`,
+		`{"pet":[]}`,
+	} {
+		if err := easyjson.Unmarshal([]byte(in), &got); err == nil {
+			t.Errorf("Unmarshal(%s) did not fail", in)
+		}
+	}
+
+	if _, err := easyjson.Marshal(TypedZoo{Pet: typedBird{}}); err == nil {
+		t.Error("Marshal() of an unregistered type did not fail")
+	}
+}
+
+type typedBird struct{}
+
+func (typedBird) Sound() string { return "tweet" }
+
+func TestRegisterTypeTwice(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("RegisterType() of a registered name did not panic")
+		}
+	}()
+	easyjson.RegisterType("dog", func() easyjson.Unmarshaler { return &TypedCat{} })
+}
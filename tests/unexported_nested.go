@@ -0,0 +1,34 @@
+package tests
+
+// UnexportedNested refers to unexported types of the package named like the identifiers
+// used by the generated code.
+//
+//easyjson:json
+type UnexportedNested struct {
+	Out  out            `json:"out"`
+	Keys map[string]key `json:"keys"`
+	List []*v1          `json:"list"`
+}
+
+type out struct {
+	In         string     `json:"in"`
+	IsTopLevel isTopLevel `json:"is_top_level"`
+}
+
+type key struct {
+	Value int `json:"value"`
+}
+
+type v1 struct {
+	Name string `json:"name"`
+}
+
+type isTopLevel bool
+
+var unexportedNestedValue = UnexportedNested{
+	Out:  out{In: "a", IsTopLevel: true},
+	Keys: map[string]key{"k": {Value: 1}},
+	List: []*v1{{Name: "b"}},
+}
+
+var unexportedNestedString = `{"out":{"in":"a","is_top_level":true},"keys":{"k":{"value":1}},"list":[{"name":"b"}]}`
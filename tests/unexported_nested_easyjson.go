@@ -0,0 +1,375 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+// aliases of the types named like identifiers of the generated code
+type (
+	easyjsonE3c5211cTypeIsTopLevel = isTopLevel
+	easyjsonE3c5211cTypeKey        = key
+	easyjsonE3c5211cTypeOut        = out
+	easyjsonE3c5211cTypeV1         = v1
+)
+
+func easyjsonE3c5211cDecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *UnexportedNested) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "out":
+					out.Out = easyjsonE3c5211cTypeOut{}
+				case "keys":
+					out.Keys = nil
+				case "list":
+					out.List = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "out":
+			easyjsonE3c5211cDecodeGithubComMailruEasyjsonTests1(in, &out.Out)
+		case "keys":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.Keys == nil {
+					out.Keys = make(map[string]easyjsonE3c5211cTypeKey)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := string(in.String())
+					in.WantColon()
+					v2 := in.ValueStart()
+					var v1 easyjsonE3c5211cTypeKey
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.Keys, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v1 = (out.Keys)[key]
+					}
+					easyjsonE3c5211cDecodeGithubComMailruEasyjsonTests2(in, &v1)
+					if !in.ValueSkipped(v2) {
+						(out.Keys)[key] = v1
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "list":
+			if in.IsNull() {
+				in.Skip()
+				out.List = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.List == nil {
+					if !in.IsDelim(']') {
+						out.List = make([]*easyjsonE3c5211cTypeV1, 0, 8)
+					} else {
+						out.List = []*easyjsonE3c5211cTypeV1{}
+					}
+				} else {
+					out.List = (out.List)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 *easyjsonE3c5211cTypeV1
+					if in.IsNull() {
+						in.Skip()
+						v3 = nil
+					} else {
+						if v3 == nil {
+							v3 = new(easyjsonE3c5211cTypeV1)
+						}
+						easyjsonE3c5211cDecodeGithubComMailruEasyjsonTests3(in, v3)
+					}
+					out.List = append(out.List, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE3c5211cEncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in UnexportedNested) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"out\":"
+		out.RawString(prefix[1:])
+		easyjsonE3c5211cEncodeGithubComMailruEasyjsonTests1(out, in.Out)
+	}
+	{
+		const prefix string = ",\"keys\":"
+		out.RawString(prefix)
+		if in.Keys == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v4First := true
+			for v4Name, v4Value := range in.Keys {
+				if v4First {
+					v4First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v4Name))
+				out.RawByte(':')
+				easyjsonE3c5211cEncodeGithubComMailruEasyjsonTests2(out, v4Value)
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"list\":"
+		out.RawString(prefix)
+		if in.List == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			v5 := false
+			for _, v6 := range in.List {
+				if v6 == nil && out.SkipNilElem() {
+					continue
+				}
+				if v5 {
+					out.RawByte(',')
+				}
+				v5 = true
+				if v6 == nil {
+					out.RawString("null")
+				} else {
+					easyjsonE3c5211cEncodeGithubComMailruEasyjsonTests3(out, *v6)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UnexportedNested) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonE3c5211cEncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UnexportedNested) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE3c5211cEncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UnexportedNested) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonE3c5211cDecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UnexportedNested) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE3c5211cDecodeGithubComMailruEasyjsonTests(l, v)
+}
+func easyjsonE3c5211cDecodeGithubComMailruEasyjsonTests3(in *jlexer.Lexer, out *easyjsonE3c5211cTypeV1) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "name":
+					out.Name = ""
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE3c5211cEncodeGithubComMailruEasyjsonTests3(out *jwriter.Writer, in easyjsonE3c5211cTypeV1) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	out.RawByte('}')
+}
+func easyjsonE3c5211cDecodeGithubComMailruEasyjsonTests2(in *jlexer.Lexer, out *easyjsonE3c5211cTypeKey) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "value":
+					out.Value = 0
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "value":
+			out.Value = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE3c5211cEncodeGithubComMailruEasyjsonTests2(out *jwriter.Writer, in easyjsonE3c5211cTypeKey) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"value\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Value))
+	}
+	out.RawByte('}')
+}
+func easyjsonE3c5211cDecodeGithubComMailruEasyjsonTests1(in *jlexer.Lexer, out *easyjsonE3c5211cTypeOut) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "in":
+					out.In = ""
+				case "is_top_level":
+					out.IsTopLevel = false
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "in":
+			out.In = string(in.String())
+		case "is_top_level":
+			out.IsTopLevel = easyjsonE3c5211cTypeIsTopLevel(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE3c5211cEncodeGithubComMailruEasyjsonTests1(out *jwriter.Writer, in easyjsonE3c5211cTypeOut) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"in\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.In))
+	}
+	{
+		const prefix string = ",\"is_top_level\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsTopLevel))
+	}
+	out.RawByte('}')
+}
@@ -1,6 +1,10 @@
 package tests
 
-import "github.com/mailru/easyjson"
+import (
+	"encoding/json"
+
+	"github.com/mailru/easyjson"
+)
 
 //easyjson:json
 type StructWithUnknownsProxy struct {
@@ -15,3 +19,9 @@ type StructWithUnknownsProxyWithOmitempty struct {
 
 	Field1 string `json:",omitempty"`
 }
+
+//easyjson:json
+type StructWithUnknownsMap struct {
+	Field1 string                     `json:",omitempty"`
+	Extra  map[string]json.RawMessage `json:"-" easyjson:"unknown"`
+}
@@ -1,8 +1,11 @@
 package tests
 
 import (
+	"encoding/json"
 	"reflect"
 	"testing"
+
+	"github.com/mailru/easyjson"
 )
 
 func TestUnknownFieldsProxy(t *testing.T) {
@@ -52,3 +55,49 @@ func TestUnknownFieldsProxyWithOmitempty(t *testing.T) {
 		t.Errorf("MarshalJSON expected to gen: %v. got: %v", baseJson, string(data))
 	}
 }
+
+func TestUnknownFieldsMap(t *testing.T) {
+	baseJson := `{"Field1":"123","extra":{"a": [1, 2]},"Field2":null}`
+
+	var s StructWithUnknownsMap
+	if err := s.UnmarshalJSON([]byte(baseJson)); err != nil {
+		t.Fatalf("UnmarshalJSON didn't expect error: %v", err)
+	}
+	want := StructWithUnknownsMap{
+		Field1: "123",
+		Extra:  map[string]json.RawMessage{"extra": json.RawMessage(`{"a": [1, 2]}`), "Field2": json.RawMessage(`null`)},
+	}
+	if !reflect.DeepEqual(s, want) {
+		t.Errorf("UnmarshalJSON expected to gen: %+v. got: %+v", want, s)
+	}
+
+	for _, v := range []StructWithUnknownsMap{{}, {Field1: "123"}} {
+		v.Extra = map[string]json.RawMessage{"extra": json.RawMessage(`{"a":1}`)}
+		data, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON didn't expect error: %v", err)
+		}
+		var got StructWithUnknownsMap
+		if err := got.UnmarshalJSON(data); err != nil || !reflect.DeepEqual(got, v) {
+			t.Errorf("MarshalJSON gen %s, unmarshaled as %+v, %v; want %+v", data, got, err, v)
+		}
+	}
+
+	// The collected members are written in the order of their names, except the ones named
+	// like the fields.
+	v := StructWithUnknownsMap{Extra: map[string]json.RawMessage{
+		"c": json.RawMessage(`3`), "a": json.RawMessage(`1`), "Field1": json.RawMessage(`"x"`), "b": json.RawMessage(`2`),
+	}}
+	for i := 0; i < 10; i++ {
+		if data, err := v.MarshalJSON(); err != nil || string(data) != `{"a":1,"b":2,"c":3}` {
+			t.Fatalf("MarshalJSON() = %s, %v; want {\"a\":1,\"b\":2,\"c\":3}", data, err)
+		}
+	}
+
+	if err := easyjson.ApplyMergePatch(&s, []byte(`{"Field2":null,"Field3":true}`)); err != nil {
+		t.Fatalf("ApplyMergePatch didn't expect error: %v", err)
+	}
+	if len(s.Extra) != 2 || string(s.Extra["Field3"]) != "true" || s.Extra["Field2"] != nil {
+		t.Errorf("ApplyMergePatch gen extra fields %q", s.Extra)
+	}
+}
@@ -0,0 +1,41 @@
+package tests
+
+import (
+	"database/sql"
+	"time"
+)
+
+//easyjson:json
+type ValidateRequest struct {
+	ID      int64             `json:"id,required"`
+	Name    string            `json:"name"`
+	Count   *int              `json:"count"`
+	Amount  int               `json:"amount,string"`
+	Tags    []string          `json:"tags"`
+	Point   [2]float64        `json:"point"`
+	Labels  map[string]int    `json:"labels"`
+	ByID    map[int]string    `json:"by_id"`
+	Data    []byte            `json:"data"`
+	Created time.Time         `json:"created"`
+	Extra   interface{}       `json:"extra"`
+	Item    ValidateItem      `json:"item"`
+	Items   []*ValidateItem   `json:"items"`
+	Nested  map[string][]bool `json:"nested"`
+	Hash    [2]byte           `json:"hash" easyjson:"hex"`
+}
+
+type ValidateItem struct {
+	Key   string `json:"key"`
+	Value uint8  `json:"value"`
+}
+
+//easyjson:json
+type ValidateList []ValidateItem
+
+//easyjson:json
+type ValidateNulls struct {
+	Name    sql.NullString  `json:"name"`
+	N       sql.NullInt64   `json:"n"`
+	Created sql.NullTime    `json:"created"`
+	Codes   []sql.NullInt32 `json:"codes"`
+}
@@ -0,0 +1,1180 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	sql "database/sql"
+	json "encoding/json"
+	fmt "fmt"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjson641065f2DecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *ValidateRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	var IDSet bool
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "id":
+					out.ID = 0
+				case "name":
+					out.Name = ""
+				case "count":
+					out.Count = nil
+				case "amount":
+					out.Amount = 0
+				case "tags":
+					out.Tags = nil
+				case "point":
+					out.Point = [2]float64{}
+				case "labels":
+					out.Labels = nil
+				case "by_id":
+					out.ByID = nil
+				case "data":
+					out.Data = nil
+				case "created":
+					out.Created = time.Time{}
+				case "extra":
+					out.Extra = nil
+				case "item":
+					out.Item = ValidateItem{}
+				case "items":
+					out.Items = nil
+				case "nested":
+					out.Nested = nil
+				case "hash":
+					out.Hash = [2]uint8{}
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int64(in.Int64())
+			IDSet = true
+		case "name":
+			out.Name = string(in.String())
+		case "count":
+			if in.IsNull() {
+				in.Skip()
+				out.Count = nil
+			} else {
+				if out.Count == nil {
+					out.Count = new(int)
+				}
+				*out.Count = int(in.Int())
+			}
+		case "amount":
+			out.Amount = int(in.IntStr())
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]string, 0, 4)
+					} else {
+						out.Tags = []string{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 string
+					v1 = string(in.String())
+					out.Tags = append(out.Tags, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "point":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				v2 := 0
+				for !in.IsDelim(']') {
+					if v2 < 2 {
+						(out.Point)[v2] = float64(in.Float64())
+						v2++
+					} else {
+						in.SkipRecursive()
+					}
+					in.WantComma()
+				}
+				for ; v2 < 2; v2++ {
+					(out.Point)[v2] = 0
+				}
+				in.Delim(']')
+			}
+		case "labels":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.Labels == nil {
+					out.Labels = make(map[string]int)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := string(in.String())
+					in.WantColon()
+					v4 := in.ValueStart()
+					var v3 int
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.Labels, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v3 = (out.Labels)[key]
+					}
+					v3 = int(in.Int())
+					if !in.ValueSkipped(v4) {
+						(out.Labels)[key] = v3
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "by_id":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.ByID == nil {
+					out.ByID = make(map[int]string)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := int(in.IntStr())
+					in.WantColon()
+					v6 := in.ValueStart()
+					var v5 string
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.ByID, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v5 = (out.ByID)[key]
+					}
+					v5 = string(in.String())
+					if !in.ValueSkipped(v6) {
+						(out.ByID)[key] = v5
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "data":
+			if in.IsNull() {
+				in.Skip()
+				out.Data = nil
+			} else {
+				out.Data = in.Bytes()
+			}
+		case "created":
+			if data := in.Raw(); in.Ok() {
+				in.AddValueError((out.Created).UnmarshalJSON(data))
+			}
+		case "extra":
+			if m, ok := out.Extra.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.Extra.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.Extra = in.Interface()
+			}
+		case "item":
+			easyjson641065f2DecodeGithubComMailruEasyjsonTests1(in, &out.Item)
+		case "items":
+			if in.IsNull() {
+				in.Skip()
+				out.Items = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Items == nil {
+					if !in.IsDelim(']') {
+						out.Items = make([]*ValidateItem, 0, 8)
+					} else {
+						out.Items = []*ValidateItem{}
+					}
+				} else {
+					out.Items = (out.Items)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v8 *ValidateItem
+					if in.IsNull() {
+						in.Skip()
+						v8 = nil
+					} else {
+						if v8 == nil {
+							v8 = new(ValidateItem)
+						}
+						easyjson641065f2DecodeGithubComMailruEasyjsonTests1(in, v8)
+					}
+					out.Items = append(out.Items, v8)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "nested":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.Nested == nil {
+					out.Nested = make(map[string][]bool)
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := string(in.String())
+					in.WantColon()
+					v10 := in.ValueStart()
+					var v9 []bool
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.Nested, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v9 = (out.Nested)[key]
+					}
+					if in.IsNull() {
+						in.Skip()
+						v9 = nil
+					} else if !in.IsDelim('[') {
+						in.Delim('[')
+						in.Delim(']')
+					} else {
+						in.Delim('[')
+						if v9 == nil {
+							if !in.IsDelim(']') {
+								v9 = make([]bool, 0, 64)
+							} else {
+								v9 = []bool{}
+							}
+						} else {
+							v9 = (v9)[:0]
+						}
+						for !in.IsDelim(']') {
+							var v11 bool
+							v11 = bool(in.Bool())
+							v9 = append(v9, v11)
+							in.WantComma()
+						}
+						in.Delim(']')
+					}
+					if !in.ValueSkipped(v10) {
+						(out.Nested)[key] = v9
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "hash":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.BytesHexInto((out.Hash)[:])
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+	if !IDSet {
+		in.AddError(fmt.Errorf("key 'id' is required"))
+	}
+}
+func easyjson641065f2EncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in ValidateRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		if in.Count == nil {
+			out.RawString("null")
+		} else {
+			out.Int(int(*in.Count))
+		}
+	}
+	{
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		out.IntStr(int(in.Amount))
+	}
+	{
+		const prefix string = ",\"tags\":"
+		out.RawString(prefix)
+		if in.Tags == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v12, v13 := range in.Tags {
+				if v12 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v13))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"point\":"
+		out.RawString(prefix)
+		out.RawByte('[')
+		for v14 := range in.Point {
+			if v14 > 0 {
+				out.RawByte(',')
+			}
+			out.Float64(float64((in.Point)[v14]))
+		}
+		out.RawByte(']')
+	}
+	{
+		const prefix string = ",\"labels\":"
+		out.RawString(prefix)
+		if in.Labels == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v15First := true
+			for v15Name, v15Value := range in.Labels {
+				if v15First {
+					v15First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v15Name))
+				out.RawByte(':')
+				out.Int(int(v15Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"by_id\":"
+		out.RawString(prefix)
+		if in.ByID == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v16First := true
+			for v16Name, v16Value := range in.ByID {
+				if v16First {
+					v16First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.IntStr(int(v16Name))
+				out.RawByte(':')
+				out.String(string(v16Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"data\":"
+		out.RawString(prefix)
+		out.Base64Bytes(in.Data)
+	}
+	{
+		const prefix string = ",\"created\":"
+		out.RawString(prefix)
+		out.Raw((in.Created).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"extra\":"
+		out.RawString(prefix)
+		if m, ok := in.Extra.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Extra.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.Extra))
+		}
+	}
+	{
+		const prefix string = ",\"item\":"
+		out.RawString(prefix)
+		easyjson641065f2EncodeGithubComMailruEasyjsonTests1(out, in.Item)
+	}
+	{
+		const prefix string = ",\"items\":"
+		out.RawString(prefix)
+		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			v19 := false
+			for _, v20 := range in.Items {
+				if v20 == nil && out.SkipNilElem() {
+					continue
+				}
+				if v19 {
+					out.RawByte(',')
+				}
+				v19 = true
+				if v20 == nil {
+					out.RawString("null")
+				} else {
+					easyjson641065f2EncodeGithubComMailruEasyjsonTests1(out, *v20)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"nested\":"
+		out.RawString(prefix)
+		if in.Nested == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v21First := true
+			for v21Name, v21Value := range in.Nested {
+				if v21First {
+					v21First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v21Name))
+				out.RawByte(':')
+				if v21Value == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+					out.RawString("null")
+				} else {
+					out.RawByte('[')
+					for v22, v23 := range v21Value {
+						if v22 > 0 {
+							out.RawByte(',')
+						}
+						out.Bool(bool(v23))
+					}
+					out.RawByte(']')
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"hash\":"
+		out.RawString(prefix)
+		out.RawStringHex((in.Hash)[:])
+	}
+	out.RawByte('}')
+}
+func easyjson641065f2ValidateGithubComMailruEasyjsonTests(in *jlexer.Lexer) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	var IDSet bool
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			_ = in.Int64()
+			IDSet = true
+		case "name":
+			_ = in.UnsafeString()
+		case "count":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				_ = in.Int()
+			}
+		case "amount":
+			_ = in.IntStr()
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('[')
+				for !in.IsDelim(']') {
+					_ = in.UnsafeString()
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "point":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('[')
+				v25 := 0
+				for ; !in.IsDelim(']'); v25++ {
+					if v25 >= 2 {
+						in.SkipRecursive()
+					} else {
+						_ = in.Float64()
+					}
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "labels":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					_ = in.UnsafeString()
+					in.WantColon()
+					_ = in.Int()
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "by_id":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					_ = in.IntStr()
+					in.WantColon()
+					_ = in.UnsafeString()
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "data":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				_ = in.Bytes()
+			}
+		case "created":
+			{
+				var v26 time.Time
+				if data := in.Raw(); in.Ok() {
+					in.AddValueError((v26).UnmarshalJSON(data))
+				}
+				_ = v26
+			}
+		case "extra":
+			in.SkipRecursive()
+		case "item":
+			easyjson641065f2ValidateGithubComMailruEasyjsonTests1(in)
+		case "items":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('[')
+				for !in.IsDelim(']') {
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						easyjson641065f2ValidateGithubComMailruEasyjsonTests1(in)
+					}
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "nested":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					_ = in.UnsafeString()
+					in.WantColon()
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						in.Delim('[')
+						for !in.IsDelim(']') {
+							_ = in.Bool()
+							in.WantComma()
+						}
+						in.Delim(']')
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "hash":
+			{
+				var v29 [2]uint8
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					in.BytesHexInto((v29)[:])
+				}
+				_ = v29
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+	if !IDSet {
+		in.AddError(fmt.Errorf("key 'id' is required"))
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ValidateRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson641065f2EncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ValidateRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson641065f2EncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ValidateRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson641065f2DecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ValidateRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson641065f2DecodeGithubComMailruEasyjsonTests(l, v)
+}
+
+// ValidateEasyJSON supports easyjson.Validator interface
+func (*ValidateRequest) ValidateEasyJSON(l *jlexer.Lexer) error {
+	l.CheckValid()
+	easyjson641065f2ValidateGithubComMailruEasyjsonTests(l)
+	return l.Error()
+}
+func easyjson641065f2DecodeGithubComMailruEasyjsonTests1(in *jlexer.Lexer, out *ValidateItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "key":
+					out.Key = ""
+				case "value":
+					out.Value = 0
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "key":
+			out.Key = string(in.String())
+		case "value":
+			out.Value = uint8(in.Uint8())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson641065f2EncodeGithubComMailruEasyjsonTests1(out *jwriter.Writer, in ValidateItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"key\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Key))
+	}
+	{
+		const prefix string = ",\"value\":"
+		out.RawString(prefix)
+		out.Uint8(uint8(in.Value))
+	}
+	out.RawByte('}')
+}
+func easyjson641065f2ValidateGithubComMailruEasyjsonTests1(in *jlexer.Lexer) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "key":
+			_ = in.UnsafeString()
+		case "value":
+			_ = in.Uint8()
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson641065f2DecodeGithubComMailruEasyjsonTests2(in *jlexer.Lexer, out *ValidateNulls) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "name":
+					out.Name = sql.NullString{}
+				case "n":
+					out.N = sql.NullInt64{}
+				case "created":
+					out.Created = sql.NullTime{}
+				case "codes":
+					out.Codes = nil
+				}
+			}
+			if !in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "name":
+					out.Name = sql.NullString{}
+				case "n":
+					out.N = sql.NullInt64{}
+				case "created":
+					out.Created = sql.NullTime{}
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			if in.IsNull() {
+				in.Skip()
+				out.Name = sql.NullString{}
+			} else {
+				(out.Name).String = string(in.String())
+				(out.Name).Valid = true
+			}
+		case "n":
+			if in.IsNull() {
+				in.Skip()
+				out.N = sql.NullInt64{}
+			} else {
+				(out.N).Int64 = int64(in.Int64())
+				(out.N).Valid = true
+			}
+		case "created":
+			if in.IsNull() {
+				in.Skip()
+				out.Created = sql.NullTime{}
+			} else {
+				if data := in.Raw(); in.Ok() {
+					in.AddValueError(((out.Created).Time).UnmarshalJSON(data))
+				}
+				(out.Created).Valid = true
+			}
+		case "codes":
+			if in.IsNull() {
+				in.Skip()
+				out.Codes = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Codes == nil {
+					if !in.IsDelim(']') {
+						out.Codes = make([]sql.NullInt32, 0, 8)
+					} else {
+						out.Codes = []sql.NullInt32{}
+					}
+				} else {
+					out.Codes = (out.Codes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v30 sql.NullInt32
+					if in.IsNull() {
+						in.Skip()
+						v30 = sql.NullInt32{}
+					} else {
+						(v30).Int32 = int32(in.Int32())
+						(v30).Valid = true
+					}
+					out.Codes = append(out.Codes, v30)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson641065f2EncodeGithubComMailruEasyjsonTests2(out *jwriter.Writer, in ValidateNulls) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		if !(in.Name).Valid {
+			out.RawString("null")
+		} else {
+			out.String(string((in.Name).String))
+		}
+	}
+	{
+		const prefix string = ",\"n\":"
+		out.RawString(prefix)
+		if !(in.N).Valid {
+			out.RawString("null")
+		} else {
+			out.Int64(int64((in.N).Int64))
+		}
+	}
+	{
+		const prefix string = ",\"created\":"
+		out.RawString(prefix)
+		if !(in.Created).Valid {
+			out.RawString("null")
+		} else {
+			out.Raw(((in.Created).Time).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"codes\":"
+		out.RawString(prefix)
+		if in.Codes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v31, v32 := range in.Codes {
+				if v31 > 0 {
+					out.RawByte(',')
+				}
+				if !(v32).Valid {
+					out.RawString("null")
+				} else {
+					out.Int32(int32((v32).Int32))
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjson641065f2ValidateGithubComMailruEasyjsonTests2(in *jlexer.Lexer) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				_ = in.UnsafeString()
+			}
+		case "n":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				_ = in.Int64()
+			}
+		case "created":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				{
+					var v33 time.Time
+					if data := in.Raw(); in.Ok() {
+						in.AddValueError((v33).UnmarshalJSON(data))
+					}
+					_ = v33
+				}
+			}
+		case "codes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('[')
+				for !in.IsDelim(']') {
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						_ = in.Int32()
+					}
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ValidateNulls) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson641065f2EncodeGithubComMailruEasyjsonTests2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ValidateNulls) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson641065f2EncodeGithubComMailruEasyjsonTests2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ValidateNulls) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson641065f2DecodeGithubComMailruEasyjsonTests2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ValidateNulls) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson641065f2DecodeGithubComMailruEasyjsonTests2(l, v)
+}
+
+// ValidateEasyJSON supports easyjson.Validator interface
+func (*ValidateNulls) ValidateEasyJSON(l *jlexer.Lexer) error {
+	l.CheckValid()
+	easyjson641065f2ValidateGithubComMailruEasyjsonTests2(l)
+	return l.Error()
+}
+func easyjson641065f2DecodeGithubComMailruEasyjsonTests3(in *jlexer.Lexer, out *ValidateList) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else if !in.IsDelim('[') {
+		in.Delim('[')
+		in.Delim(']')
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(ValidateList, 0, 2)
+			} else {
+				*out = ValidateList{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v35 ValidateItem
+			easyjson641065f2DecodeGithubComMailruEasyjsonTests1(in, &v35)
+			*out = append(*out, v35)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson641065f2EncodeGithubComMailruEasyjsonTests3(out *jwriter.Writer, in ValidateList) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v36, v37 := range in {
+			if v36 > 0 {
+				out.RawByte(',')
+			}
+			easyjson641065f2EncodeGithubComMailruEasyjsonTests1(out, v37)
+		}
+		out.RawByte(']')
+	}
+}
+func easyjson641065f2ValidateGithubComMailruEasyjsonTests3(in *jlexer.Lexer) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+	} else {
+		in.Delim('[')
+		for !in.IsDelim(']') {
+			easyjson641065f2ValidateGithubComMailruEasyjsonTests1(in)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ValidateList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson641065f2EncodeGithubComMailruEasyjsonTests3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ValidateList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson641065f2EncodeGithubComMailruEasyjsonTests3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ValidateList) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson641065f2DecodeGithubComMailruEasyjsonTests3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ValidateList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson641065f2DecodeGithubComMailruEasyjsonTests3(l, v)
+}
+
+// ValidateEasyJSON supports easyjson.Validator interface
+func (*ValidateList) ValidateEasyJSON(l *jlexer.Lexer) error {
+	l.CheckValid()
+	easyjson641065f2ValidateGithubComMailruEasyjsonTests3(l)
+	return l.Error()
+}
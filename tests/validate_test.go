@@ -0,0 +1,93 @@
+package tests
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestValidate(t *testing.T) {
+	for _, in := range []string{
+		`{"id":1}`,
+		`{"id":1,"name":"a\"b","count":2,"amount":"3","tags":["x",null],"point":[1,2,3]}`,
+		`{"id":1,"labels":{"a":1},"by_id":{"2":"b"},"data":"aGk=","created":"2020-01-02T03:04:05Z"}`,
+		`{"id":1,"extra":{"a":[1,{"b":null}]},"item":{"key":"k","value":255},"items":[null,{"value":1}]}`,
+		`{"id":1,"nested":{"a":[true,false]},"unknown":[{"x":1}],"count":null}`,
+		` {"id":1} `,
+		`{"id":"1"}`,
+		`{}`,
+		`{"id":1,"name":1}`,
+		`{"id":1,"count":"2"}`,
+		`{"id":1,"amount":3}`,
+		`{"id":1,"tags":"x"}`,
+		`{"id":1,"point":[1,"2"]}`,
+		`{"id":1,"labels":{"a":"1"}}`,
+		`{"id":1,"by_id":{"x":"b"}}`,
+		`{"id":1,"data":"!"}`,
+		`{"id":1,"created":"yesterday"}`,
+		`{"id":1,"extra":{"a":}}`,
+		`{"id":1,"item":{"value":256}}`,
+		`{"id":1,"items":[{"key":false}]}`,
+		`{"id":1,"nested":{"a":[1]}}`,
+		`{"id":1,"unknown":[1,}`,
+		`{"id":1,"hash":"0x00ff"}`,
+		`{"id":1,"hash":"0x00"}`,
+		`{"id":1} {}`,
+		`[]`,
+	} {
+		var v ValidateRequest
+		want := easyjson.Unmarshal([]byte(in), &v)
+		got := easyjson.Validate([]byte(in), (*ValidateRequest)(nil))
+		if (got == nil) != (want == nil) {
+			t.Errorf("Validate(%s) error: %v; Unmarshal error: %v", in, got, want)
+		}
+	}
+}
+
+func TestValidateList(t *testing.T) {
+	for _, in := range []string{
+		`[]`,
+		`null`,
+		`[{"key":"a"},{"value":2}]`,
+		`[{"key":1}]`,
+		`{}`,
+		`[{}] 1`,
+	} {
+		var v ValidateList
+		want := easyjson.Unmarshal([]byte(in), &v)
+		got := easyjson.Validate([]byte(in), (*ValidateList)(nil))
+		if (got == nil) != (want == nil) {
+			t.Errorf("Validate(%s) error: %v; Unmarshal error: %v", in, got, want)
+		}
+	}
+}
+
+func TestValidateSQLNulls(t *testing.T) {
+	data, err := easyjson.Marshal(ValidateNulls{
+		Name:  sql.NullString{String: "a", Valid: true},
+		N:     sql.NullInt64{Int64: 3, Valid: true},
+		Codes: []sql.NullInt32{{Int32: 1, Valid: true}, {}},
+	})
+	if err != nil {
+		t.Fatalf("Marshal() error: %v", err)
+	}
+	if err := easyjson.Validate(data, (*ValidateNulls)(nil)); err != nil {
+		t.Errorf("Validate(%s) error: %v", data, err)
+	}
+
+	for _, in := range []string{
+		`{"name":null,"n":null,"created":"2020-01-02T03:04:05Z"}`,
+		`{"name":{"String":"a","Valid":true}}`,
+		`{"n":"3"}`,
+		`{"created":"yesterday"}`,
+		`{"codes":[1,"2"]}`,
+	} {
+		var v ValidateNulls
+		want := easyjson.Unmarshal([]byte(in), &v)
+		got := easyjson.Validate([]byte(in), (*ValidateNulls)(nil))
+		if (got == nil) != (want == nil) {
+			t.Errorf("Validate(%s) error: %v; Unmarshal error: %v", in, got, want)
+		}
+	}
+}
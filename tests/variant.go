@@ -0,0 +1,40 @@
+package tests
+
+import "github.com/mailru/easyjson"
+
+type VariantShape interface {
+	Area() float64
+}
+
+//easyjson:json
+type VariantCircle struct {
+	R float64 `json:"r"`
+}
+
+func (c *VariantCircle) Area() float64 { return 3 * c.R * c.R }
+
+//easyjson:json
+type VariantSquare struct {
+	Kind string  `json:"type"`
+	Side float64 `json:"side"`
+}
+
+func (s *VariantSquare) Area() float64 { return s.Side * s.Side }
+
+//easyjson:json
+type VariantDrawing struct {
+	Main   VariantShape            `json:"main" easyjson:"discriminator=type"`
+	Shapes []VariantShape          `json:"shapes" easyjson:"discriminator=type"`
+	Named  map[string]VariantShape `json:"named,omitempty" easyjson:"discriminator=type"`
+}
+
+//easyjson:json
+type VariantEnvelope struct {
+	Shape VariantShape `json:"shape" easyjson:"typefield=kind"`
+	Kind  string       `json:"kind"`
+}
+
+func init() {
+	easyjson.RegisterVariant((*VariantShape)(nil), "circle", func() easyjson.Unmarshaler { return &VariantCircle{} })
+	easyjson.RegisterVariant((*VariantShape)(nil), "square", func() easyjson.Unmarshaler { return &VariantSquare{} })
+}
@@ -0,0 +1,460 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjson28164ed1DecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *VariantSquare) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "type":
+					out.Kind = ""
+				case "side":
+					out.Side = 0
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Kind = string(in.String())
+		case "side":
+			out.Side = float64(in.Float64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson28164ed1EncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in VariantSquare) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"side\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Side))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v VariantSquare) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson28164ed1EncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v VariantSquare) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson28164ed1EncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *VariantSquare) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson28164ed1DecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *VariantSquare) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson28164ed1DecodeGithubComMailruEasyjsonTests(l, v)
+}
+func easyjson28164ed1DecodeGithubComMailruEasyjsonTests1(in *jlexer.Lexer, out *VariantEnvelope) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	var ShapeRaw []byte
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "shape":
+					out.Shape = nil
+				case "kind":
+					out.Kind = ""
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "shape":
+			ShapeRaw = in.Raw()
+		case "kind":
+			out.Kind = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if ShapeRaw != nil {
+		if v1 := easyjson.UnmarshalVariantOf(in, ShapeRaw, "kind", string(out.Kind), (*VariantShape)(nil)); v1 != nil {
+			out.Shape = v1.(VariantShape)
+		}
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson28164ed1EncodeGithubComMailruEasyjsonTests1(out *jwriter.Writer, in VariantEnvelope) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"shape\":"
+		out.RawString(prefix[1:])
+		if m, ok := in.Shape.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if in.Shape == nil {
+			out.RawString("null")
+		} else {
+			out.Raw(json.Marshal(in.Shape))
+		}
+	}
+	{
+		const prefix string = ",\"kind\":"
+		out.RawString(prefix)
+		out.String(string(in.Kind))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v VariantEnvelope) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson28164ed1EncodeGithubComMailruEasyjsonTests1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v VariantEnvelope) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson28164ed1EncodeGithubComMailruEasyjsonTests1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *VariantEnvelope) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson28164ed1DecodeGithubComMailruEasyjsonTests1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *VariantEnvelope) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson28164ed1DecodeGithubComMailruEasyjsonTests1(l, v)
+}
+func easyjson28164ed1DecodeGithubComMailruEasyjsonTests2(in *jlexer.Lexer, out *VariantDrawing) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "main":
+					out.Main = nil
+				case "shapes":
+					out.Shapes = nil
+				case "named":
+					out.Named = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "main":
+			if in.IsNull() {
+				in.Skip()
+				out.Main = nil
+			} else if v2 := easyjson.UnmarshalVariant(in, "type", (*VariantShape)(nil)); v2 != nil {
+				out.Main = v2.(VariantShape)
+			}
+		case "shapes":
+			if in.IsNull() {
+				in.Skip()
+				out.Shapes = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Shapes == nil {
+					if !in.IsDelim(']') {
+						out.Shapes = make([]VariantShape, 0, 4)
+					} else {
+						out.Shapes = []VariantShape{}
+					}
+				} else {
+					out.Shapes = (out.Shapes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 VariantShape
+					if in.IsNull() {
+						in.Skip()
+						v3 = nil
+					} else if v4 := easyjson.UnmarshalVariant(in, "type", (*VariantShape)(nil)); v4 != nil {
+						v3 = v4.(VariantShape)
+					}
+					out.Shapes = append(out.Shapes, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "named":
+			if in.IsNull() {
+				in.Skip()
+			} else if !in.IsDelim('{') {
+				in.Delim('{')
+				in.Delim('}')
+			} else {
+				in.Delim('{')
+				if !in.MergePatch || out.Named == nil {
+					if !in.IsDelim('}') {
+						out.Named = make(map[string]VariantShape)
+					} else {
+						out.Named = nil
+					}
+				}
+				for !in.IsDelim('}') {
+					in.MarkKey()
+					key := string(in.String())
+					in.WantColon()
+					v6 := in.ValueStart()
+					var v5 VariantShape
+					if in.MergePatch {
+						if in.IsNull() {
+							in.Skip()
+							if !in.KeepOnNull {
+								delete(out.Named, key)
+							}
+							in.WantComma()
+							continue
+						}
+						v5 = (out.Named)[key]
+					}
+					if in.IsNull() {
+						in.Skip()
+						v5 = nil
+					} else if v7 := easyjson.UnmarshalVariant(in, "type", (*VariantShape)(nil)); v7 != nil {
+						v5 = v7.(VariantShape)
+					}
+					if !in.ValueSkipped(v6) {
+						(out.Named)[key] = v5
+					}
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson28164ed1EncodeGithubComMailruEasyjsonTests2(out *jwriter.Writer, in VariantDrawing) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"main\":"
+		out.RawString(prefix[1:])
+		easyjson.MarshalVariant(out, "type", (*VariantShape)(nil), in.Main)
+	}
+	{
+		const prefix string = ",\"shapes\":"
+		out.RawString(prefix)
+		if in.Shapes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.Shapes {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				easyjson.MarshalVariant(out, "type", (*VariantShape)(nil), v9)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Named) != 0 {
+		const prefix string = ",\"named\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v10First := true
+			for v10Name, v10Value := range in.Named {
+				if v10First {
+					v10First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v10Name))
+				out.RawByte(':')
+				easyjson.MarshalVariant(out, "type", (*VariantShape)(nil), v10Value)
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v VariantDrawing) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson28164ed1EncodeGithubComMailruEasyjsonTests2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v VariantDrawing) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson28164ed1EncodeGithubComMailruEasyjsonTests2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *VariantDrawing) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson28164ed1DecodeGithubComMailruEasyjsonTests2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *VariantDrawing) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson28164ed1DecodeGithubComMailruEasyjsonTests2(l, v)
+}
+func easyjson28164ed1DecodeGithubComMailruEasyjsonTests3(in *jlexer.Lexer, out *VariantCircle) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "r":
+					out.R = 0
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "r":
+			out.R = float64(in.Float64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson28164ed1EncodeGithubComMailruEasyjsonTests3(out *jwriter.Writer, in VariantCircle) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"r\":"
+		out.RawString(prefix[1:])
+		out.Float64(float64(in.R))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v VariantCircle) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson28164ed1EncodeGithubComMailruEasyjsonTests3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v VariantCircle) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson28164ed1EncodeGithubComMailruEasyjsonTests3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *VariantCircle) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson28164ed1DecodeGithubComMailruEasyjsonTests3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *VariantCircle) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson28164ed1DecodeGithubComMailruEasyjsonTests3(l, v)
+}
@@ -0,0 +1,77 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestVariantFields(t *testing.T) {
+	v := VariantDrawing{
+		Main:   &VariantCircle{R: 1},
+		Shapes: []VariantShape{&VariantSquare{Kind: "square", Side: 2}, nil, &VariantCircle{R: 3}},
+	}
+	want := `{"main":{"type":"circle","r":1},"shapes":[{"type":"square","side":2},null,{"type":"circle","r":3}]}`
+
+	data, err := easyjson.Marshal(v)
+	if err != nil || string(data) != want {
+		t.Errorf("Marshal() = %s, %v; want %s", data, err, want)
+	}
+
+	var got VariantDrawing
+	if err := easyjson.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	if !reflect.DeepEqual(got, v) {
+		t.Errorf("Unmarshal() = %+v; want %+v", got, v)
+	}
+
+	for _, in := range []string{
+		`{"main":{"r":1}}`,
+		`{"main":{"type":"triangle"}}`,
+		`{"main":{"type":1}}`,
+		`{"main":{"type":"circle","r":"x"}}`,
+		`{"main":[]}`,
+	} {
+		if err := easyjson.Unmarshal([]byte(in), &got); err == nil {
+			t.Errorf("Unmarshal(%s) did not fail", in)
+		}
+	}
+}
+
+func TestVariantTypeFields(t *testing.T) {
+	v := VariantEnvelope{Shape: &VariantCircle{R: 2}, Kind: "circle"}
+	want := `{"shape":{"r":2},"kind":"circle"}`
+
+	data, err := easyjson.Marshal(v)
+	if err != nil || string(data) != want {
+		t.Errorf("Marshal() = %s, %v; want %s", data, err, want)
+	}
+
+	for _, test := range []struct {
+		data string
+		want VariantEnvelope
+	}{
+		{want, v},
+		{`{"kind":"square","shape":{"side":3}}`, VariantEnvelope{Shape: &VariantSquare{Side: 3}, Kind: "square"}},
+		{`{"kind":"circle","shape":null}`, VariantEnvelope{Kind: "circle"}},
+	} {
+		var got VariantEnvelope
+		if err := easyjson.Unmarshal([]byte(test.data), &got); err != nil || !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Unmarshal(%s) = %+v, %v; want %+v", test.data, got, err, test.want)
+		}
+	}
+
+	for _, in := range []string{
+		`{"shape":{"r":1}}`,
+		`{"shape":{"r":1},"kind":"triangle"}`,
+		`{"shape":{"r":"x"},"kind":"circle"}`,
+		`{"shape":{"r":1},"kind":"circle"`,
+	} {
+		var got VariantEnvelope
+		if err := easyjson.Unmarshal([]byte(in), &got); err == nil {
+			t.Errorf("Unmarshal(%s) did not fail", in)
+		}
+	}
+}
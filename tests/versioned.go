@@ -0,0 +1,11 @@
+package tests
+
+//easyjson:json
+type VersionedStruct struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name" easyjson:"until=v3"`
+	Title   string `json:"title" easyjson:"since=v3"`
+	Comment string `json:"comment,omitempty" easyjson:"since=v2"`
+}
+
+var versionedValue = VersionedStruct{ID: 1, Name: "name", Title: "title", Comment: "comment"}
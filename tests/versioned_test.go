@@ -0,0 +1,33 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/mailru/easyjson/jwriter"
+)
+
+func TestVersionedFields(t *testing.T) {
+	for i, test := range []struct {
+		Version string
+		Want    string
+	}{
+		{"", `{"id":1,"name":"name","title":"title","comment":"comment"}`},
+		{"v1", `{"id":1,"name":"name"}`},
+		{"v2", `{"id":1,"name":"name","comment":"comment"}`},
+		{"v2.5", `{"id":1,"name":"name","comment":"comment"}`},
+		{"v3", `{"id":1,"title":"title","comment":"comment"}`},
+		{"v10", `{"id":1,"title":"title","comment":"comment"}`},
+	} {
+		w := jwriter.Writer{}
+		w.SetVersion(test.Version)
+		versionedValue.MarshalEasyJSON(&w)
+
+		data, err := w.BuildBytes()
+		if err != nil {
+			t.Errorf("[%d] MarshalEasyJSON() error: %v", i, err)
+		}
+		if string(data) != test.Want {
+			t.Errorf("[%d] MarshalEasyJSON() for version %q = %s; want %s", i, test.Version, data, test.Want)
+		}
+	}
+}
@@ -0,0 +1,14 @@
+package tests
+
+// XMLFeedItem is an element of a feed converted from XML, with attributes as @-prefixed members
+// and the text content as the #text member.
+//
+//easyjson:json
+type XMLFeedItem struct {
+	ID       string        `json:"@id"`
+	Lang     string        `json:"@xml:lang,omitempty"`
+	Text     string        `json:"#text"`
+	Ref      string        `json:"'@ref,href',omitempty"`
+	Quoted   string        `json:"'@say \\'hi\\''"`
+	Children []XMLFeedItem `json:"item,omitempty"`
+}
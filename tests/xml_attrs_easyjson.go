@@ -0,0 +1,172 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tests
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+// fail on initialization if the easyjson runtime can not run this code
+var _ = easyjson.CheckGeneratedVersion(2)
+
+func easyjsonC67626e8DecodeGithubComMailruEasyjsonTests(in *jlexer.Lexer, out *XMLFeedItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			if in.MergePatch && !in.KeepOnNull {
+				switch key {
+				case "@id":
+					out.ID = ""
+				case "@xml:lang":
+					out.Lang = ""
+				case "#text":
+					out.Text = ""
+				case "@ref,href":
+					out.Ref = ""
+				case "@say 'hi'":
+					out.Quoted = ""
+				case "item":
+					out.Children = nil
+				}
+			}
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "@id":
+			out.ID = string(in.String())
+		case "@xml:lang":
+			out.Lang = string(in.String())
+		case "#text":
+			out.Text = string(in.String())
+		case "@ref,href":
+			out.Ref = string(in.String())
+		case "@say 'hi'":
+			out.Quoted = string(in.String())
+		case "item":
+			if in.IsNull() {
+				in.Skip()
+				out.Children = nil
+			} else if !in.IsDelim('[') {
+				in.Delim('[')
+				in.Delim(']')
+			} else {
+				in.Delim('[')
+				if out.Children == nil {
+					if !in.IsDelim(']') {
+						out.Children = make([]XMLFeedItem, 0, 0)
+					} else {
+						out.Children = []XMLFeedItem{}
+					}
+				} else {
+					out.Children = (out.Children)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 XMLFeedItem
+					easyjsonC67626e8DecodeGithubComMailruEasyjsonTests(in, &v1)
+					out.Children = append(out.Children, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonC67626e8EncodeGithubComMailruEasyjsonTests(out *jwriter.Writer, in XMLFeedItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"@id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	if in.Lang != "" {
+		const prefix string = ",\"@xml:lang\":"
+		out.RawString(prefix)
+		out.String(string(in.Lang))
+	}
+	{
+		const prefix string = ",\"#text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	if in.Ref != "" {
+		const prefix string = ",\"@ref,href\":"
+		out.RawString(prefix)
+		out.String(string(in.Ref))
+	}
+	{
+		const prefix string = ",\"@say 'hi'\":"
+		out.RawString(prefix)
+		out.String(string(in.Quoted))
+	}
+	if len(in.Children) != 0 {
+		const prefix string = ",\"item\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v2, v3 := range in.Children {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonC67626e8EncodeGithubComMailruEasyjsonTests(out, v3)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v XMLFeedItem) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonC67626e8EncodeGithubComMailruEasyjsonTests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v XMLFeedItem) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC67626e8EncodeGithubComMailruEasyjsonTests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *XMLFeedItem) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonC67626e8DecodeGithubComMailruEasyjsonTests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *XMLFeedItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC67626e8DecodeGithubComMailruEasyjsonTests(l, v)
+}
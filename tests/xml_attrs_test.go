@@ -0,0 +1,31 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mailru/easyjson"
+)
+
+func TestXMLAttrs(t *testing.T) {
+	v := XMLFeedItem{
+		ID:       "1",
+		Lang:     "en",
+		Text:     "feed",
+		Ref:      "#2",
+		Quoted:   "q",
+		Children: []XMLFeedItem{{ID: "2", Text: "item"}},
+	}
+	want := `{"@id":"1","@xml:lang":"en","#text":"feed","@ref,href":"#2","@say 'hi'":"q",` +
+		`"item":[{"@id":"2","#text":"item","@say 'hi'":""}]}`
+
+	data, err := easyjson.Marshal(v)
+	if err != nil || string(data) != want {
+		t.Errorf("Marshal() = %s, %v; want %s", data, err, want)
+	}
+
+	var got XMLFeedItem
+	if err := easyjson.Unmarshal([]byte(want), &got); err != nil || !reflect.DeepEqual(got, v) {
+		t.Errorf("Unmarshal() = %+v, %v; want %+v", got, err, v)
+	}
+}
@@ -0,0 +1,131 @@
+package easyjson
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sync"
+)
+
+// Transform converts field data on marshaling and back on unmarshaling. Transforms are
+// applied to []byte and string fields tagged with `easyjson:"transform=name"`: the encoded
+// data is written as a JSON string, so it should be text (e.g. base64 encoded).
+type Transform interface {
+	Encode(data []byte) ([]byte, error)
+
+	// Decode must not keep or return the data slice, as it may refer to the input buffer.
+	Decode(data []byte) ([]byte, error)
+}
+
+var (
+	transformsMu sync.RWMutex
+	transforms   = map[string]Transform{
+		"base64":  base64Transform{},
+		"gzipb64": GzipBase64Transform{},
+	}
+)
+
+// RegisterTransform makes a transform available by the given name, replacing the transform
+// registered by that name before. It is intended to be called from init functions.
+func RegisterTransform(name string, t Transform) {
+	transformsMu.Lock()
+	transforms[name] = t
+	transformsMu.Unlock()
+}
+
+func getTransform(name string) (Transform, error) {
+	transformsMu.RLock()
+	t := transforms[name]
+	transformsMu.RUnlock()
+
+	if t == nil {
+		return nil, fmt.Errorf("easyjson: unknown transform %q", name)
+	}
+	return t, nil
+}
+
+// EncodeTransform encodes data with the transform registered by the given name.
+func EncodeTransform(name string, data []byte) ([]byte, error) {
+	t, err := getTransform(name)
+	if err != nil {
+		return nil, err
+	}
+	return t.Encode(data)
+}
+
+// DecodeTransform decodes data with the transform registered by the given name.
+func DecodeTransform(name string, data []byte) ([]byte, error) {
+	t, err := getTransform(name)
+	if err != nil {
+		return nil, err
+	}
+	return t.Decode(data)
+}
+
+// base64Transform encodes data with standard base64 encoding.
+type base64Transform struct{}
+
+func (base64Transform) Encode(data []byte) ([]byte, error) {
+	ret := make([]byte, base64.StdEncoding.EncodedLen(len(data)))
+	base64.StdEncoding.Encode(ret, data)
+	return ret, nil
+}
+
+func (base64Transform) Decode(data []byte) ([]byte, error) {
+	ret := make([]byte, base64.StdEncoding.DecodedLen(len(data)))
+	n, err := base64.StdEncoding.Decode(ret, data)
+	return ret[:n], err
+}
+
+// DefaultGzipMaxSize is the limit of the decompressed data of GzipBase64Transform if MaxSize is
+// not set.
+const DefaultGzipMaxSize = 32 << 20
+
+// GzipBase64Transform compresses data with gzip and then encodes it with standard base64
+// encoding. It is registered as "gzipb64"; registering it again by that name changes the limit:
+//
+//	easyjson.RegisterTransform("gzipb64", easyjson.GzipBase64Transform{MaxSize: 1 << 30})
+type GzipBase64Transform struct {
+	// MaxSize limits the size of the decompressed data, guarding against small inputs
+	// decompressing into gigabytes. Zero means DefaultGzipMaxSize.
+	MaxSize int64
+}
+
+func (GzipBase64Transform) Encode(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return base64Transform{}.Encode(buf.Bytes())
+}
+
+func (t GzipBase64Transform) Decode(data []byte) ([]byte, error) {
+	compressed, err := base64Transform{}.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+	r, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, err
+	}
+
+	max := t.MaxSize
+	if max <= 0 {
+		max = DefaultGzipMaxSize
+	}
+	ret, err := ioutil.ReadAll(io.LimitReader(r, max+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(ret)) > max {
+		return nil, fmt.Errorf("easyjson: gzip data decompresses to more than %d bytes", max)
+	}
+	return ret, nil
+}
@@ -0,0 +1,31 @@
+package easyjson
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestGzipBase64TransformMaxSize(t *testing.T) {
+	data := bytes.Repeat([]byte("a"), 1000)
+	encoded, err := GzipBase64Transform{}.Encode(data)
+	if err != nil {
+		t.Fatalf("Encode() error: %v", err)
+	}
+
+	for _, test := range []struct {
+		MaxSize int64
+		Ok      bool
+	}{
+		{0, true},
+		{1000, true},
+		{999, false},
+	} {
+		got, err := GzipBase64Transform{MaxSize: test.MaxSize}.Decode(encoded)
+		if test.Ok && (err != nil || !bytes.Equal(got, data)) {
+			t.Errorf("Decode() with MaxSize %d = %d bytes, %v; want %d bytes", test.MaxSize, len(got), err, len(data))
+		}
+		if !test.Ok && err == nil {
+			t.Errorf("Decode() with MaxSize %d succeeded; want an error", test.MaxSize)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package easyjson
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+var (
+	typesMu    sync.RWMutex
+	types      = map[string]func() Unmarshaler{}
+	typesNames = map[reflect.Type]string{} // names of the registered types and of their elements
+)
+
+// RegisterType registers the concrete type of the values returned by newValue, e.g. a pointer to
+// a struct, with the name written as the "type" member of the objects that values of non-empty
+// interface types without marshaling methods are marshaled into, e.g. {"type":"circle","value":
+// {...}}. Such values are unmarshaled as new values of the type registered for the name, and
+// values of the element type of a registered pointer type are marshaled with its name too. It
+// panics if the name or the type is already registered.
+func RegisterType(name string, newValue func() Unmarshaler) {
+	vt := reflect.TypeOf(newValue())
+
+	typesMu.Lock()
+	defer typesMu.Unlock()
+
+	if _, ok := types[name]; ok {
+		panic(fmt.Sprintf("easyjson: reuse of the type name %q", name))
+	}
+	if other, ok := typesNames[vt]; ok {
+		panic(fmt.Sprintf("easyjson: type %v is already registered as %q", vt, other))
+	}
+	types[name] = newValue
+	typesNames[vt] = name
+	if vt.Kind() == reflect.Ptr {
+		if _, ok := typesNames[vt.Elem()]; !ok {
+			typesNames[vt.Elem()] = name
+		}
+	}
+}
+
+// UnmarshalTyped unmarshals the object in the input of l, with the "type" and "value" members,
+// into a new value of the type registered for the name in the "type" member, which must implement
+// the interface type pointed to by iface. It is called by the generated code, and returns nil on
+// errors, which are added to l.
+func UnmarshalTyped(l *jlexer.Lexer, iface interface{}) interface{} {
+	data := l.Raw()
+	if !l.Ok() {
+		return nil
+	}
+	it := reflect.TypeOf(iface).Elem()
+
+	var (
+		name   string
+		nameOk bool
+		value  []byte
+	)
+	tl := jlexer.Lexer{Data: data}
+	tl.Delim('{')
+	for !tl.IsDelim('}') {
+		key := tl.UnsafeFieldName(false)
+		tl.WantColon()
+		switch key {
+		case "type":
+			name, nameOk = tl.String(), true
+		case "value":
+			value = tl.Raw()
+		default:
+			tl.SkipRecursive()
+		}
+		tl.WantComma()
+	}
+	tl.Delim('}')
+	if err := tl.Error(); err != nil {
+		l.AddError(err)
+		return nil
+	}
+
+	if !nameOk {
+		l.AddError(&jlexer.LexerError{
+			Reason: fmt.Sprintf("missing type name of %v", it),
+			Data:   string(data),
+		})
+		return nil
+	}
+	typesMu.RLock()
+	newValue := types[name]
+	typesMu.RUnlock()
+	if newValue == nil {
+		l.AddError(&jlexer.LexerError{
+			Reason: fmt.Sprintf("unknown type name %q of %v", name, it),
+			Data:   string(data),
+		})
+		return nil
+	}
+
+	v := newValue()
+	if !reflect.TypeOf(v).Implements(it) {
+		l.AddError(&jlexer.LexerError{
+			Reason: fmt.Sprintf("type %q (%T) does not implement %v", name, v, it),
+			Data:   string(data),
+		})
+		return nil
+	}
+	if value != nil {
+		vl := jlexer.Lexer{Data: value, UseMultipleErrors: l.UseMultipleErrors}
+		v.UnmarshalEasyJSON(&vl)
+		if err := vl.Error(); err != nil {
+			l.AddError(err)
+			return nil
+		}
+	}
+	return v
+}
+
+// MarshalTyped marshals v, a value of a non-empty interface type, as an object with the name its
+// type is registered with as the "type" member and v as the "value" member. It is called by the
+// generated code.
+func MarshalTyped(w *jwriter.Writer, v interface{}) {
+	if v == nil {
+		w.RawString("null")
+		return
+	}
+	m, ok := v.(Marshaler)
+	if !ok {
+		w.Raw(nil, fmt.Errorf("easyjson: type %T does not implement easyjson.Marshaler", v))
+		return
+	}
+
+	typesMu.RLock()
+	name, ok := typesNames[reflect.TypeOf(v)]
+	typesMu.RUnlock()
+	if !ok {
+		w.Raw(nil, fmt.Errorf("easyjson: type %T is not registered", v))
+		return
+	}
+
+	w.RawString(`{"type":`)
+	w.String(name)
+	w.RawString(`,"value":`)
+	m.MarshalEasyJSON(w)
+	w.RawByte('}')
+}
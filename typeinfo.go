@@ -0,0 +1,84 @@
+package easyjson
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+)
+
+// TypeInfo is a static description of a struct type generated with the -field_info flag.
+// It allows other libraries (validators, ORMs, schema generators) to introspect the JSON
+// layout of generated types without walking them with reflection.
+type TypeInfo struct {
+	Name        string      // Import path qualified type name, e.g. "example.com/pkg.Type".
+	Description string      // Doc comment of the type.
+	Fields      []FieldInfo // Fields in the order they are marshaled in.
+}
+
+// FieldInfo describes a struct field the way easyjson marshals it.
+type FieldInfo struct {
+	Name     string            // Go field name.
+	JSONName string            // Name of the JSON object member.
+	Type     string            // Go type of the field, e.g. "[]string" or "pkg.Type".
+	Tag      reflect.StructTag // Full struct tag of the field.
+
+	// Description is the doc comment of the field, or its line comment if it has none.
+	Description string
+
+	OmitEmpty bool // If the field is left out when empty.
+	OmitZero  bool // If the field is left out when it is the zero value.
+	Required  bool // If the field must be present on unmarshaling.
+
+	// Addr returns a pointer to the field of v, which must be a pointer to the described
+	// type, or nil for a field promoted through a nil embedded struct pointer.
+	Addr func(v interface{}) interface{}
+}
+
+// Field returns the field marshaled as the given JSON object member, or nil if there is none.
+func (t *TypeInfo) Field(jsonName string) *FieldInfo {
+	for i := range t.Fields {
+		if t.Fields[i].JSONName == jsonName {
+			return &t.Fields[i]
+		}
+	}
+	return nil
+}
+
+// TypeInfoProvider is implemented by types generated with the -field_info flag.
+type TypeInfoProvider interface {
+	EasyJSONTypeInfo() *TypeInfo
+}
+
+var (
+	typeInfosMu sync.RWMutex
+	typeInfos   = map[string]*TypeInfo{}
+)
+
+// RegisterTypeInfo makes type info available by the type name, replacing the info
+// registered by that name before. It is called from init functions of the generated code.
+func RegisterTypeInfo(info *TypeInfo) {
+	typeInfosMu.Lock()
+	typeInfos[info.Name] = info
+	typeInfosMu.Unlock()
+}
+
+// LookupTypeInfo returns the type info registered by the import path qualified type name,
+// or nil if there is none.
+func LookupTypeInfo(name string) *TypeInfo {
+	typeInfosMu.RLock()
+	defer typeInfosMu.RUnlock()
+	return typeInfos[name]
+}
+
+// TypeInfos returns the type info of all registered types, sorted by name.
+func TypeInfos() []*TypeInfo {
+	typeInfosMu.RLock()
+	ret := make([]*TypeInfo, 0, len(typeInfos))
+	for _, info := range typeInfos {
+		ret = append(ret, info)
+	}
+	typeInfosMu.RUnlock()
+
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Name < ret[j].Name })
+	return ret
+}
@@ -0,0 +1,217 @@
+package easyjson
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/mailru/easyjson/jlexer"
+)
+
+var unmarshalerType = reflect.TypeOf((*Unmarshaler)(nil)).Elem()
+
+// UnmarshalValue decodes the JSON in data into v, which must be an Unmarshaler, or a pointer to
+// a string, bool, number or interface{}, or to a pointer, slice, array or string-keyed map of
+// them or of Unmarshalers, e.g. *[]int or *map[string]*User. Common types like *string and
+// *[]string are decoded without reflection.
+func UnmarshalValue(data []byte, v interface{}) error {
+	l := jlexer.Lexer{Data: data}
+	if u, ok := v.(Unmarshaler); ok {
+		return unmarshal(&l, u)
+	}
+	o, start := observeStart()
+	return observeUnmarshal(o, start, v, len(data), decodeValue(&l, v))
+}
+
+// decodeValue decodes the input of l into v, which is not an Unmarshaler, for UnmarshalValue.
+func decodeValue(l *jlexer.Lexer, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("easyjson: UnmarshalValue of non-pointer or nil %T", v)
+	}
+
+	if l.IsNull() {
+		if err := checkValueType(rv.Type().Elem()); err != nil {
+			return err
+		}
+		unmarshalValue(l, rv.Elem())
+		l.Consumed()
+		return l.Error()
+	}
+
+	switch v := v.(type) {
+	case *string:
+		*v = l.String()
+	case *bool:
+		*v = l.Bool()
+	case *int:
+		*v = l.Int()
+	case *int64:
+		*v = l.Int64()
+	case *uint64:
+		*v = l.Uint64()
+	case *float64:
+		*v = l.Float64()
+	case *interface{}:
+		*v = l.Interface()
+	case *[]string:
+		*v = (*v)[:0]
+		l.Delim('[')
+		for !l.IsDelim(']') {
+			*v = append(*v, l.String())
+			l.WantComma()
+		}
+		l.Delim(']')
+	case *[]interface{}:
+		*v = (*v)[:0]
+		l.Delim('[')
+		for !l.IsDelim(']') {
+			*v = append(*v, l.Interface())
+			l.WantComma()
+		}
+		l.Delim(']')
+	case *map[string]interface{}:
+		if *v == nil {
+			*v = make(map[string]interface{})
+		}
+		l.Delim('{')
+		for !l.IsDelim('}') {
+			key := l.String()
+			l.WantColon()
+			(*v)[key] = l.Interface()
+			l.WantComma()
+		}
+		l.Delim('}')
+	default:
+		if err := checkValueType(rv.Type().Elem()); err != nil {
+			return err
+		}
+		unmarshalValue(l, rv.Elem())
+	}
+	l.Consumed()
+	return l.Error()
+}
+
+// checkValueType returns an error if UnmarshalValue can not decode into a value of type t.
+func checkValueType(t reflect.Type) error {
+	if reflect.PtrTo(t).Implements(unmarshalerType) {
+		return nil
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return nil
+	case reflect.Interface:
+		if t.NumMethod() == 0 {
+			return nil
+		}
+	case reflect.Ptr, reflect.Slice, reflect.Array:
+		return checkValueType(t.Elem())
+	case reflect.Map:
+		if t.Key().Kind() == reflect.String {
+			return checkValueType(t.Elem())
+		}
+	}
+	return fmt.Errorf("easyjson: UnmarshalValue of unsupported type %v", t)
+}
+
+// unmarshalValue decodes the input of l into v, of a type accepted by checkValueType.
+func unmarshalValue(l *jlexer.Lexer, v reflect.Value) {
+	if v.CanAddr() && v.Addr().Type().Implements(unmarshalerType) {
+		if v.Kind() == reflect.Ptr && l.IsNull() {
+			l.Skip()
+			v.Set(reflect.Zero(v.Type()))
+			return
+		}
+		v.Addr().Interface().(Unmarshaler).UnmarshalEasyJSON(l)
+		return
+	}
+	if l.IsNull() {
+		l.Skip()
+		switch v.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Interface:
+			v.Set(reflect.Zero(v.Type()))
+		}
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(l.String())
+	case reflect.Bool:
+		v.SetBool(l.Bool())
+	case reflect.Int:
+		v.SetInt(int64(l.Int()))
+	case reflect.Int8:
+		v.SetInt(int64(l.Int8()))
+	case reflect.Int16:
+		v.SetInt(int64(l.Int16()))
+	case reflect.Int32:
+		v.SetInt(int64(l.Int32()))
+	case reflect.Int64:
+		v.SetInt(l.Int64())
+	case reflect.Uint, reflect.Uintptr:
+		v.SetUint(uint64(l.Uint()))
+	case reflect.Uint8:
+		v.SetUint(uint64(l.Uint8()))
+	case reflect.Uint16:
+		v.SetUint(uint64(l.Uint16()))
+	case reflect.Uint32:
+		v.SetUint(uint64(l.Uint32()))
+	case reflect.Uint64:
+		v.SetUint(l.Uint64())
+	case reflect.Float32:
+		v.SetFloat(float64(l.Float32()))
+	case reflect.Float64:
+		v.SetFloat(l.Float64())
+	case reflect.Interface:
+		if i := l.Interface(); i != nil {
+			v.Set(reflect.ValueOf(i))
+		} else {
+			v.Set(reflect.Zero(v.Type()))
+		}
+	case reflect.Ptr:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		unmarshalValue(l, v.Elem())
+	case reflect.Slice:
+		v.SetLen(0)
+		if v.IsNil() {
+			v.Set(reflect.MakeSlice(v.Type(), 0, 0))
+		}
+		l.Delim('[')
+		for !l.IsDelim(']') {
+			v.Set(reflect.Append(v, reflect.Zero(v.Type().Elem())))
+			unmarshalValue(l, v.Index(v.Len()-1))
+			l.WantComma()
+		}
+		l.Delim(']')
+	case reflect.Array:
+		l.Delim('[')
+		for i := 0; !l.IsDelim(']'); i++ {
+			if i < v.Len() {
+				unmarshalValue(l, v.Index(i))
+			} else {
+				l.SkipRecursive()
+			}
+			l.WantComma()
+		}
+		l.Delim(']')
+	case reflect.Map:
+		if v.IsNil() {
+			v.Set(reflect.MakeMap(v.Type()))
+		}
+		l.Delim('{')
+		for !l.IsDelim('}') {
+			key := reflect.ValueOf(l.String()).Convert(v.Type().Key())
+			l.WantColon()
+			elem := reflect.New(v.Type().Elem()).Elem()
+			unmarshalValue(l, elem)
+			v.SetMapIndex(key, elem)
+			l.WantComma()
+		}
+		l.Delim('}')
+	}
+}
@@ -0,0 +1,97 @@
+package easyjson
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUnmarshalValue(t *testing.T) {
+	var (
+		s     string
+		b     bool
+		i     int
+		f     float64
+		i8    int8
+		iface interface{}
+		ss    []string
+		is    []int
+		arr   [2]int
+		pi    *int
+		m     map[string]interface{}
+		mi    map[string][]uint16
+		mr    map[string]*RawMessage
+	)
+	one := 1
+	for _, test := range []struct {
+		In   string
+		Ptr  interface{}
+		Want interface{}
+	}{
+		{`"a"`, &s, "a"},
+		{`true`, &b, true},
+		{`12`, &i, 12},
+		{`1.5`, &f, 1.5},
+		{`-8`, &i8, int8(-8)},
+		{`[1,"a"]`, &iface, []interface{}{float64(1), "a"}},
+		{`["a","b"]`, &ss, []string{"a", "b"}},
+		{`null`, &ss, []string(nil)},
+		{`[1,2,3]`, &is, []int{1, 2, 3}},
+		{`[1,2,3]`, &arr, [2]int{1, 2}},
+		{`1`, &pi, &one},
+		{`{"a":1,"b":null}`, &m, map[string]interface{}{"a": float64(1), "b": nil}},
+		{`{"a":[1],"b":null}`, &mi, map[string][]uint16{"a": {1}, "b": nil}},
+		{`{"a":{"b":1},"c":null}`, &mr, map[string]*RawMessage{"a": rawPtr(`{"b":1}`), "c": nil}},
+	} {
+		if err := UnmarshalValue([]byte(test.In), test.Ptr); err != nil {
+			t.Errorf("UnmarshalValue(%s) error: %v", test.In, err)
+			continue
+		}
+		if got := reflect.ValueOf(test.Ptr).Elem().Interface(); !reflect.DeepEqual(got, test.Want) {
+			t.Errorf("UnmarshalValue(%s) = %#v; want %#v", test.In, got, test.Want)
+		}
+	}
+}
+
+func rawPtr(s string) *RawMessage {
+	r := RawMessage(s)
+	return &r
+}
+
+func TestUnmarshalValueErrors(t *testing.T) {
+	var (
+		s  string
+		i8 int8
+		is []int
+		st struct{ A int }
+		mk map[int]int
+	)
+	for _, test := range []struct {
+		In  string
+		Ptr interface{}
+	}{
+		{`1`, &s},
+		{`"a" 1`, &s},
+		{`300`, &i8},
+		{`[1,`, &is},
+		{`{}`, &st},
+		{`{}`, &mk},
+		{`1`, s},
+		{`1`, (*string)(nil)},
+	} {
+		if err := UnmarshalValue([]byte(test.In), test.Ptr); err == nil {
+			t.Errorf("UnmarshalValue(%s, %T) did not fail", test.In, test.Ptr)
+		}
+	}
+}
+
+func TestUnmarshalValueNull(t *testing.T) {
+	i, ss, m := 1, []string{"a"}, map[string]interface{}{}
+	for _, ptr := range []interface{}{&i, &ss, &m} {
+		if err := UnmarshalValue([]byte(`null`), ptr); err != nil {
+			t.Errorf("UnmarshalValue(null, %T) error: %v", ptr, err)
+		}
+	}
+	if i != 1 || ss != nil || m != nil {
+		t.Errorf("UnmarshalValue(null) = %v, %v, %v; want 1, nil, nil", i, ss, m)
+	}
+}
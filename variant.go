@@ -0,0 +1,164 @@
+package easyjson
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+// variantKey identifies a variant of an interface type by the value of its discriminator.
+type variantKey struct {
+	iface reflect.Type
+	value string
+}
+
+// variantTypeKey identifies a variant of an interface type by its concrete type.
+type variantTypeKey struct {
+	iface, typ reflect.Type
+}
+
+var (
+	variantsMu    sync.RWMutex
+	variants      = map[variantKey]func() Unmarshaler{}
+	variantValues = map[variantTypeKey]string{} // discriminator values of the variants
+)
+
+// RegisterVariant registers the concrete type unmarshaled into the fields of the interface type
+// pointed to by iface, e.g. (*Shape)(nil), tagged easyjson:"discriminator=<name>", when the
+// discriminator member of the object is value. newValue returns a new value of the type, e.g. a
+// pointer to a struct, which must implement the interface. It panics if value is already
+// registered for the interface type.
+func RegisterVariant(iface interface{}, value string, newValue func() Unmarshaler) {
+	it := reflect.TypeOf(iface).Elem()
+	vt := reflect.TypeOf(newValue())
+	if !vt.Implements(it) {
+		panic(fmt.Sprintf("easyjson: variant type %v does not implement %v", vt, it))
+	}
+
+	variantsMu.Lock()
+	defer variantsMu.Unlock()
+
+	key := variantKey{it, value}
+	if _, ok := variants[key]; ok {
+		panic(fmt.Sprintf("easyjson: reuse of the discriminator value %q for %v", value, it))
+	}
+	variants[key] = newValue
+	variantValues[variantTypeKey{it, vt}] = value
+}
+
+// UnmarshalVariant unmarshals the object in the input of l into a new value of the variant of
+// the interface type pointed to by iface registered for the value of the discriminator member.
+// It is called by the generated code, and returns nil on errors, which are added to l.
+func UnmarshalVariant(l *jlexer.Lexer, discriminator string, iface interface{}) interface{} {
+	data := l.Raw()
+	if !l.Ok() {
+		return nil
+	}
+	value, ok := findStringMember(data, discriminator)
+	return unmarshalVariant(l, data, discriminator, value, ok, reflect.TypeOf(iface).Elem())
+}
+
+// UnmarshalVariantOf unmarshals data, the raw value of a member buffered until the end of its
+// object, into a new value of the variant of the interface type pointed to by iface registered
+// for value, the one of the sibling discriminator member of the object, which may come after
+// data in the input. An empty value is a missing discriminator. It is called by the generated
+// code, and returns nil on errors, which are added to l.
+func UnmarshalVariantOf(l *jlexer.Lexer, data []byte, discriminator, value string, iface interface{}) interface{} {
+	return unmarshalVariant(l, data, discriminator, value, value != "", reflect.TypeOf(iface).Elem())
+}
+
+// unmarshalVariant unmarshals data into a new value of the variant registered for the interface
+// type it and the discriminator value, which is missing if not ok.
+func unmarshalVariant(l *jlexer.Lexer, data []byte, discriminator, value string, ok bool, it reflect.Type) interface{} {
+	if !ok {
+		l.AddError(&jlexer.LexerError{
+			Reason: fmt.Sprintf("missing discriminator %q of %v", discriminator, it),
+			Data:   string(data),
+		})
+		return nil
+	}
+
+	variantsMu.RLock()
+	newValue := variants[variantKey{it, value}]
+	variantsMu.RUnlock()
+	if newValue == nil {
+		l.AddError(&jlexer.LexerError{
+			Reason: fmt.Sprintf("unknown discriminator %q value %q of %v", discriminator, value, it),
+			Data:   string(data),
+		})
+		return nil
+	}
+
+	v := newValue()
+	vl := jlexer.Lexer{Data: data, UseMultipleErrors: l.UseMultipleErrors}
+	v.UnmarshalEasyJSON(&vl)
+	if err := vl.Error(); err != nil {
+		l.AddError(err)
+		return nil
+	}
+	return v
+}
+
+// MarshalVariant marshals v, a value of the interface type pointed to by iface, adding the
+// discriminator member first if the marshaled object lacks it and the type of v is registered.
+// It is called by the generated code.
+func MarshalVariant(w *jwriter.Writer, discriminator string, iface interface{}, v interface{}) {
+	if v == nil {
+		w.RawString("null")
+		return
+	}
+	m, ok := v.(Marshaler)
+	if !ok {
+		w.Raw(nil, fmt.Errorf("easyjson: variant type %T does not implement easyjson.Marshaler", v))
+		return
+	}
+
+	variantsMu.RLock()
+	value, registered := variantValues[variantTypeKey{reflect.TypeOf(iface).Elem(), reflect.TypeOf(v)}]
+	variantsMu.RUnlock()
+
+	vw := jwriter.Writer{Flags: w.Flags, NoEscapeHTML: w.NoEscapeHTML}
+	vw.SetVersion(w.Version())
+	m.MarshalEasyJSON(&vw)
+	data, err := vw.BuildBytes()
+	if err != nil {
+		w.Raw(nil, err)
+		return
+	}
+	if _, ok := findStringMember(data, discriminator); ok || !registered || len(data) < 2 || data[0] != '{' {
+		w.Raw(data, nil)
+		return
+	}
+
+	w.RawByte('{')
+	w.String(discriminator)
+	w.RawByte(':')
+	w.String(value)
+	if data[1] != '}' {
+		w.RawByte(',')
+	}
+	w.Raw(data[1:], nil)
+}
+
+// findStringMember returns the value of the string member of the object in data with the name.
+func findStringMember(data []byte, name string) (string, bool) {
+	l := jlexer.Lexer{Data: data}
+	if l.IsNull() {
+		return "", false
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		if key == name {
+			value := l.String()
+			return value, l.Ok()
+		}
+		l.SkipRecursive()
+		l.WantComma()
+	}
+	return "", false
+}
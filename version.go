@@ -0,0 +1,30 @@
+package easyjson
+
+import "fmt"
+
+// RuntimeVersion is the version of the interface between the generated code and this package.
+// It is increased when the code generated by the easyjson command can not run with earlier
+// versions of the package.
+//
+// Version 2 adds the Lexer and Writer methods of UUIDs, IP addresses, big numbers, protobuf
+// well-known types and the byte and time formats, StringNoEscape, TextString, the value errors
+// (AddValueError, ValueStart, ValueSkipped) and map keys (MarkKey) of the Lexer, and the Any,
+// KeyOrder and UnmarshalVariantOf helpers.
+const RuntimeVersion = 2
+
+// minGeneratedVersion is the earliest RuntimeVersion of the generated code this package can
+// run.
+const minGeneratedVersion = 1
+
+// CheckGeneratedVersion panics if the code generated for the runtime version v can not run with
+// this package, e.g. if the easyjson command used is of another module version than the one
+// the program is built with. It is called by the generated code on package initialization.
+func CheckGeneratedVersion(v int) bool {
+	if v < minGeneratedVersion || v > RuntimeVersion {
+		panic(fmt.Sprintf("easyjson: code generated for runtime version %d can not run with runtime version %d "+
+			"(supporting generated code of versions %d to %d): regenerate the code with the easyjson command "+
+			"of the github.com/mailru/easyjson version the program is built with",
+			v, RuntimeVersion, minGeneratedVersion, RuntimeVersion))
+	}
+	return true
+}
@@ -0,0 +1,23 @@
+package easyjson
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckGeneratedVersion(t *testing.T) {
+	if !CheckGeneratedVersion(RuntimeVersion) {
+		t.Error("CheckGeneratedVersion(RuntimeVersion) = false")
+	}
+
+	for _, v := range []int{0, RuntimeVersion + 1} {
+		func() {
+			defer func() {
+				if r, ok := recover().(string); !ok || !strings.Contains(r, "regenerate the code") {
+					t.Errorf("CheckGeneratedVersion(%d) panicked with %v; want a version mismatch", v, r)
+				}
+			}()
+			CheckGeneratedVersion(v)
+		}()
+	}
+}